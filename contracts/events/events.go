@@ -6,6 +6,10 @@ const (
 	// AflPlayerMatchUpdated is published by the AFL service when a player's match stats change.
 	AflPlayerMatchUpdated = "AFL.PlayerMatchUpdated"
 
+	// AflPlayerMerged is published by the AFL service when a duplicate AFL player is merged
+	// into the player they duplicate.
+	AflPlayerMerged = "AFL.PlayerMerged"
+
 	// AflMatchUpdated is published by the AFL service on match status transitions
 	// (no_data→partial, partial→final). Carries full participation status snapshot.
 	AflMatchUpdated = "AFL.MatchUpdated"
@@ -23,28 +27,37 @@ const (
 
 	// FflMatchScoreFinalized is published by the FFL service when both clubs in an FFL match are finalized.
 	FflMatchScoreFinalized = "FFL.MatchScoreFinalized"
+
+	// FflWaiverClaimProcessed is published by the FFL service for each waiver claim decided
+	// when a round's waivers are processed, whether the claim succeeded or failed.
+	FflWaiverClaimProcessed = "FFL.WaiverClaimProcessed"
 )
 
 // AflPlayerMatchUpdatedPayload carries the full player match stats. Note there is no status field —
 // participation status is carried exclusively by AflMatchUpdatedPayload, which tracks the match state.
+// Extra carries the stats beyond the core ones (contested possessions, time on ground, …) keyed
+// as the AFL service names them; a key is absent when no source has reported it.
 type AflPlayerMatchUpdatedPayload struct {
-	PlayerMatchID  int `json:"player_match_id"`
-	PlayerSeasonID int `json:"player_season_id"`
-	ClubMatchID    int `json:"club_match_id"`
-	RoundID        int `json:"round_id"`
-	Kicks          int `json:"kicks"`
-	Handballs      int `json:"handballs"`
-	Marks          int `json:"marks"`
-	Hitouts        int `json:"hitouts"`
-	Tackles        int `json:"tackles"`
-	Goals          int `json:"goals"`
-	Behinds        int `json:"behinds"`
+	PlayerMatchID  int                `json:"player_match_id"`
+	PlayerSeasonID int                `json:"player_season_id"`
+	ClubMatchID    int                `json:"club_match_id"`
+	RoundID        int                `json:"round_id"`
+	Kicks          int                `json:"kicks"`
+	Handballs      int                `json:"handballs"`
+	Marks          int                `json:"marks"`
+	Hitouts        int                `json:"hitouts"`
+	Tackles        int                `json:"tackles"`
+	Goals          int                `json:"goals"`
+	Behinds        int                `json:"behinds"`
+	Extra          map[string]float64 `json:"extra,omitempty"`
 }
 
-// AflMatchUpdatedPayload is published on AFL match status transitions.
-// PlayerSeasonIDStatusMap maps afl_player_season_id → status ("playing"/"played"/"dnp").
-// On partial: players with stats → "playing". On final: players with stats → "played";
-// all squad members without stats → "dnp".
+// AflMatchUpdatedPayload is published on AFL match status transitions, and before the
+// match whenever player availability is recorded.
+// PlayerSeasonIDStatusMap maps afl_player_season_id → status.
+// On no_data: players with reported availability → "named"/"emergency"/"injured"/"suspended".
+// On partial: players with stats → "playing"; others keep their availability.
+// On final: players with stats → "played"; all squad members without stats → "dnp".
 type AflMatchUpdatedPayload struct {
 	MatchID                 int            `json:"match_id"`
 	RoundID                 int            `json:"round_id"`
@@ -53,6 +66,16 @@ type AflMatchUpdatedPayload struct {
 	PlayerSeasonIDStatusMap map[int]string `json:"player_season_id_status_map"`
 }
 
+// AflPlayerMergedPayload identifies a duplicate AFL player merged into the surviving player,
+// who now has the duplicate's player seasons. A duplicate player season at a club season the
+// survivor already had is merged into the survivor's; PlayerSeasonIDs maps each of those
+// duplicate → survivor player_season_id. The duplicate's other player seasons keep their IDs.
+type AflPlayerMergedPayload struct {
+	SurvivorPlayerID  int         `json:"survivor_player_id"`
+	DuplicatePlayerID int         `json:"duplicate_player_id"`
+	PlayerSeasonIDs   map[int]int `json:"player_season_ids"`
+}
+
 // FflPlayerMatchInfo describes a single player's position and role in an FFL team snapshot.
 type FflPlayerMatchInfo struct {
 	Position            string `json:"position"`
@@ -89,3 +112,18 @@ type FflMatchScoreFinalizedPayload struct {
 	MatchID int `json:"match_id"`
 	RoundID int `json:"round_id"`
 }
+
+// FflWaiverClaimProcessedPayload carries the outcome of a single waiver claim. On success
+// PlayerSeasonID is the claimed player's new squad entry, active from FromRoundID, and the
+// dropped player season ends at RoundID. FailureReason is set only on failure.
+type FflWaiverClaimProcessedPayload struct {
+	ClaimID            int    `json:"claim_id"`
+	RoundID            int    `json:"round_id"`
+	ClubSeasonID       int    `json:"club_season_id"`
+	AFLPlayerSeasonID  int    `json:"afl_player_season_id"`
+	DropPlayerSeasonID int    `json:"drop_player_season_id"`
+	Status             string `json:"status"`
+	FailureReason      string `json:"failure_reason,omitempty"`
+	PlayerSeasonID     *int   `json:"player_season_id,omitempty"`
+	FromRoundID        *int   `json:"from_round_id,omitempty"`
+}
@@ -87,6 +87,20 @@ CREATE TABLE IF NOT EXISTS afl.club_match (
     CONSTRAINT uni_afl_club_match UNIQUE (club_season_id, match_id)
 );
 
+-- Create club_match_quarter table: a club's score at the end of each quarter,
+-- including the quarters before it, as the scoreboard shows it
+CREATE TABLE IF NOT EXISTS afl.club_match_quarter (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    club_match_id INTEGER NOT NULL REFERENCES afl.club_match(id) ON DELETE CASCADE,
+    quarter INTEGER NOT NULL,
+    goals INTEGER NOT NULL,
+    behinds INTEGER NOT NULL,
+    CONSTRAINT uni_afl_club_match_quarter UNIQUE (club_match_id, quarter)
+);
+
 -- Create player table
 CREATE TABLE IF NOT EXISTS afl.player (
     id SERIAL PRIMARY KEY,
@@ -124,9 +138,24 @@ CREATE TABLE IF NOT EXISTS afl.player_match (
     tackles INTEGER DEFAULT 0,
     goals INTEGER DEFAULT 0,
     behinds INTEGER DEFAULT 0,
+    extra_stats JSONB NOT NULL DEFAULT '{}',
     CONSTRAINT uni_afl_player_match UNIQUE (player_season_id, club_match_id)
 );
 
+-- Create player_availability table
+CREATE TABLE IF NOT EXISTS afl.player_availability (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    player_season_id INTEGER NOT NULL REFERENCES afl.player_season(id) ON DELETE CASCADE,
+    round_id INTEGER NOT NULL REFERENCES afl.round(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    expected_return VARCHAR(100),
+    source VARCHAR(50) NOT NULL,
+    CONSTRAINT uni_afl_player_availability UNIQUE (player_season_id, round_id)
+);
+
 -- Create indexes for foreign keys and performance
 CREATE INDEX IF NOT EXISTS idx_afl_season_league_id ON afl.season(league_id);
 CREATE INDEX IF NOT EXISTS idx_afl_round_season_id ON afl.round(season_id);
@@ -142,6 +171,7 @@ CREATE INDEX IF NOT EXISTS idx_afl_player_season_from_round_id ON afl.player_sea
 CREATE INDEX IF NOT EXISTS idx_afl_player_season_to_round_id ON afl.player_season(to_round_id);
 CREATE INDEX IF NOT EXISTS idx_afl_player_match_club_match_id ON afl.player_match(club_match_id);
 CREATE INDEX IF NOT EXISTS idx_afl_player_match_player_season_id ON afl.player_match(player_season_id);
+CREATE INDEX IF NOT EXISTS idx_afl_player_availability_round_id ON afl.player_availability(round_id);
 
 -- Create indexes for soft delete queries
 CREATE INDEX IF NOT EXISTS idx_afl_league_deleted_at ON afl.league(deleted_at);
@@ -151,7 +181,9 @@ CREATE INDEX IF NOT EXISTS idx_afl_match_deleted_at ON afl.match(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_club_deleted_at ON afl.club(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_club_season_deleted_at ON afl.club_season(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_club_match_deleted_at ON afl.club_match(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_afl_club_match_quarter_deleted_at ON afl.club_match_quarter(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_player_deleted_at ON afl.player(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_player_season_deleted_at ON afl.player_season(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_afl_player_match_deleted_at ON afl.player_match(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_afl_player_availability_deleted_at ON afl.player_availability(deleted_at);
 
@@ -19,7 +19,9 @@ CREATE TABLE IF NOT EXISTS ffl.season (
     deleted_at TIMESTAMP WITH TIME ZONE,
     league_id INTEGER NOT NULL REFERENCES ffl.league(id) ON DELETE CASCADE,
     name VARCHAR(255) NOT NULL,
-    afl_season_id INTEGER NOT NULL
+    afl_season_id INTEGER NOT NULL,
+    min_squad_size INTEGER,
+    max_squad_size INTEGER
 );
 
 -- Create round table
@@ -133,6 +135,118 @@ CREATE TABLE IF NOT EXISTS ffl.player_match (
     CONSTRAINT uni_ffl_player_match UNIQUE (player_season_id, club_match_id)
 );
 
+-- Create draft table
+CREATE TABLE IF NOT EXISTS ffl.draft (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    season_id INTEGER NOT NULL REFERENCES ffl.season(id) ON DELETE CASCADE,
+    style VARCHAR(50) NOT NULL,
+    rounds INTEGER NOT NULL,
+    pick_seconds INTEGER NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    clock_started_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT uni_ffl_draft_season UNIQUE (season_id)
+);
+
+-- Create draft_pick table
+CREATE TABLE IF NOT EXISTS ffl.draft_pick (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    draft_id INTEGER NOT NULL REFERENCES ffl.draft(id) ON DELETE CASCADE,
+    pick_number INTEGER NOT NULL,
+    round INTEGER NOT NULL,
+    club_season_id INTEGER NOT NULL REFERENCES ffl.club_season(id) ON DELETE CASCADE,
+    afl_player_season_id INTEGER,
+    player_season_id INTEGER REFERENCES ffl.player_season(id),
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    picked_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT uni_ffl_draft_pick UNIQUE (draft_id, pick_number)
+);
+
+-- Create draft_ranking table (per-club auto-pick list)
+CREATE TABLE IF NOT EXISTS ffl.draft_ranking (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    draft_id INTEGER NOT NULL REFERENCES ffl.draft(id) ON DELETE CASCADE,
+    club_season_id INTEGER NOT NULL REFERENCES ffl.club_season(id) ON DELETE CASCADE,
+    afl_player_season_id INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    CONSTRAINT uni_ffl_draft_ranking UNIQUE (draft_id, club_season_id, afl_player_season_id)
+);
+
+-- Create waiver_schedule table (when each round's waivers are processed)
+CREATE TABLE IF NOT EXISTS ffl.waiver_schedule (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    round_id INTEGER NOT NULL REFERENCES ffl.round(id) ON DELETE CASCADE,
+    process_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT uni_ffl_waiver_schedule_round UNIQUE (round_id)
+);
+
+-- Create waiver_claim table
+CREATE TABLE IF NOT EXISTS ffl.waiver_claim (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    round_id INTEGER NOT NULL REFERENCES ffl.round(id) ON DELETE CASCADE,
+    club_season_id INTEGER NOT NULL REFERENCES ffl.club_season(id) ON DELETE CASCADE,
+    afl_player_season_id INTEGER NOT NULL,
+    drop_player_season_id INTEGER NOT NULL REFERENCES ffl.player_season(id),
+    priority INTEGER NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    failure_reason VARCHAR(255),
+    player_season_id INTEGER REFERENCES ffl.player_season(id),
+    processed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Data Ops: forum post format per FFL club (per ADR-016: adapter-owned, no FK to core tables).
+-- spec holds the format's patterns as JSON; see application.PostFormat.
+CREATE TABLE IF NOT EXISTS ffl.dataops_post_format (
+    club_id INTEGER NOT NULL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    name VARCHAR(255) NOT NULL,
+    spec JSONB NOT NULL
+);
+
+-- Data Ops: forum nicknames and misspellings → AFL player (per ADR-016: adapter-owned, no FK).
+-- alias is normalised (lowercase, single spaces); learned rows come from confirmed low-confidence matches.
+-- A null afl_player_id marks a strip word: a nickname written inside a name ("Jack Journeyman Smith")
+-- and dropped from it, which names no player of its own.
+CREATE TABLE IF NOT EXISTS ffl.dataops_player_alias (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    alias VARCHAR(255) NOT NULL UNIQUE,
+    afl_player_id INTEGER,
+    learned BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Data Ops: every team post parsed for a club match, kept for audit (per ADR-016: adapter-owned, no FK).
+-- parsed holds the parser's rows with resolver confidence; confirmed holds the team saved after
+-- review, and is null until then. Both are JSON lists of resolved players.
+CREATE TABLE IF NOT EXISTS ffl.dataops_team_submission (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    club_match_id INTEGER NOT NULL,
+    author VARCHAR(255),
+    format VARCHAR(255),
+    post TEXT NOT NULL,
+    parsed JSONB NOT NULL,
+    confirmed JSONB,
+    confirmed_at TIMESTAMP WITH TIME ZONE
+);
+
 -- Create indexes for foreign keys and performance
 CREATE INDEX IF NOT EXISTS idx_season_league_id ON ffl.season(league_id);
 CREATE INDEX IF NOT EXISTS idx_round_season_id ON ffl.round(season_id);
@@ -148,6 +262,12 @@ CREATE INDEX IF NOT EXISTS idx_player_season_from_round_id ON ffl.player_season(
 CREATE INDEX IF NOT EXISTS idx_player_season_to_round_id ON ffl.player_season(to_round_id);
 CREATE INDEX IF NOT EXISTS idx_player_match_club_match_id ON ffl.player_match(club_match_id);
 CREATE INDEX IF NOT EXISTS idx_player_match_player_season_id ON ffl.player_match(player_season_id);
+CREATE INDEX IF NOT EXISTS idx_draft_pick_draft_id ON ffl.draft_pick(draft_id);
+CREATE INDEX IF NOT EXISTS idx_draft_pick_club_season_id ON ffl.draft_pick(club_season_id);
+CREATE INDEX IF NOT EXISTS idx_draft_ranking_draft_club ON ffl.draft_ranking(draft_id, club_season_id);
+CREATE INDEX IF NOT EXISTS idx_waiver_claim_round_id ON ffl.waiver_claim(round_id);
+CREATE INDEX IF NOT EXISTS idx_waiver_claim_club_season_id ON ffl.waiver_claim(club_season_id);
+CREATE INDEX IF NOT EXISTS idx_dataops_team_submission_club_match_id ON ffl.dataops_team_submission(club_match_id);
 
 -- Create indexes for soft delete queries
 CREATE INDEX IF NOT EXISTS idx_league_deleted_at ON ffl.league(deleted_at);
@@ -160,3 +280,8 @@ CREATE INDEX IF NOT EXISTS idx_club_match_deleted_at ON ffl.club_match(deleted_a
 CREATE INDEX IF NOT EXISTS idx_player_deleted_at ON ffl.player(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_player_season_deleted_at ON ffl.player_season(deleted_at);
 CREATE INDEX IF NOT EXISTS idx_player_match_deleted_at ON ffl.player_match(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_draft_deleted_at ON ffl.draft(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_draft_pick_deleted_at ON ffl.draft_pick(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_draft_ranking_deleted_at ON ffl.draft_ranking(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_waiver_schedule_deleted_at ON ffl.waiver_schedule(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_waiver_claim_deleted_at ON ffl.waiver_claim(deleted_at);
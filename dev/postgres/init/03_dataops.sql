@@ -23,3 +23,60 @@ CREATE TABLE IF NOT EXISTS afl.dataops_player_source (
     updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     PRIMARY KEY (source, external_season, external_club, external_player)
 );
+
+-- Cross-source stats verification: where a match's sources disagree with each
+-- other, or the stored stats don't add up to a source's score table. Replaced
+-- each time the match is verified; reviewed before the match is marked final.
+CREATE TABLE IF NOT EXISTS afl.dataops_stats_discrepancy (
+    id               SERIAL PRIMARY KEY,
+    match_id         INTEGER NOT NULL,
+    club_match_id    INTEGER NOT NULL,
+    kind             TEXT NOT NULL,
+    player_season_id INTEGER,
+    player_name      TEXT NOT NULL DEFAULT '',
+    stat             TEXT NOT NULL,
+    source           TEXT NOT NULL,
+    value            INTEGER NOT NULL,
+    other_source     TEXT NOT NULL,
+    other_value      INTEGER NOT NULL,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_afl_dataops_stats_discrepancy_match_id ON afl.dataops_stats_discrepancy(match_id);
+
+-- Stats import previews: an import worked out against the stored stats but not
+-- written. plan is the JSON diff, per club match; applying the preview writes
+-- it, once, and only if the stored stats haven't changed since.
+CREATE TABLE IF NOT EXISTS afl.dataops_stats_preview (
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    match_id   INTEGER NOT NULL,
+    source     TEXT NOT NULL,
+    plan       JSONB NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE
+);
+
+-- AFL season player import proposals: each club's list for a season compared
+-- with the stored players, as a new player, a player continuing (at the same
+-- or a new club) or a player the list no longer has. Nothing is written to
+-- the player tables until an admin accepts a proposal. Re-proposing replaces
+-- the season's pending proposals; a decided one isn't proposed again.
+CREATE TABLE IF NOT EXISTS afl.dataops_player_proposal (
+    id                 SERIAL PRIMARY KEY,
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    season_id          INTEGER NOT NULL,
+    source             TEXT NOT NULL DEFAULT '',
+    kind               TEXT NOT NULL,
+    listed_name        TEXT NOT NULL DEFAULT '',
+    club_season_id     INTEGER NOT NULL,
+    club_name          TEXT NOT NULL,
+    player_id          INTEGER,
+    player_name        TEXT NOT NULL DEFAULT '',
+    previous_club_name TEXT NOT NULL DEFAULT '',
+    confidence         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    status             TEXT NOT NULL DEFAULT 'pending',
+    player_season_id   INTEGER,
+    decided_at         TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_afl_dataops_player_proposal_season_id ON afl.dataops_player_proposal(season_id);
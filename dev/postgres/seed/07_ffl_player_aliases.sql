@@ -0,0 +1,23 @@
+-- Forum nicknames the team post parser knows out of the box.
+-- Runs after 03_afl_historical.sql; re-running leaves existing aliases as they are.
+BEGIN;
+
+-- Nicknames that name a player
+INSERT INTO ffl.dataops_player_alias (alias, afl_player_id)
+SELECT v.alias, (SELECT ap.id FROM afl.player ap WHERE ap.name = v.name ORDER BY ap.id LIMIT 1)
+FROM (VALUES
+    ('tdk', 'Tom De Koning'),
+    ('t de koning', 'Tom De Koning'),
+    ('the bont', 'Marcus Bontempelli')
+) AS v(alias, name)
+WHERE EXISTS (SELECT 1 FROM afl.player ap WHERE ap.name = v.name)
+ON CONFLICT (alias) DO NOTHING;
+
+-- Strip words: nicknames written inside a name ("Jack Journeyman Smith") and dropped from it
+INSERT INTO ffl.dataops_player_alias (alias, afl_player_id)
+VALUES
+    ('journeyman', NULL),
+    ('mountain goat', NULL)
+ON CONFLICT (alias) DO NOTHING;
+
+COMMIT;
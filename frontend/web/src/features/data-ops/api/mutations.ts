@@ -1,9 +1,10 @@
 import gql from 'graphql-tag'
 
 export const IMPORT_AFL_MATCH_STATS = gql`
-  mutation ImportAFLMatchStats($matchId: ID!) {
-    importAFLMatchStats(matchId: $matchId) {
+  mutation ImportAFLMatchStats($matchId: ID!, $source: String) {
+    importAFLMatchStats(matchId: $matchId, source: $source) {
       matchId
+      source
       homeClubName
       awayClubName
       homePlayerCount
@@ -17,6 +18,85 @@ export const IMPORT_AFL_MATCH_STATS = gql`
   }
 `
 
+export const IMPORT_AFL_ROUND_STATS = gql`
+  mutation ImportAFLRoundStats($roundId: ID!, $markFinal: Boolean, $source: String) {
+    importAFLRoundStats(roundId: $roundId, markFinal: $markFinal, source: $source) {
+      roundId
+      matches {
+        matchId
+        status
+        markedFinal
+        error
+        stats {
+          matchId
+          source
+          homeClubName
+          awayClubName
+          homePlayerCount
+          awayPlayerCount
+          unmatchedPlayers {
+            parsedName
+            clubMatchId
+            kicks handballs marks hitouts tackles goals behinds
+          }
+        }
+      }
+    }
+  }
+`
+
+export const VERIFY_AFL_MATCH_STATS = gql`
+  mutation VerifyAFLMatchStats($matchId: ID!, $threshold: Int) {
+    verifyAFLMatchStats(matchId: $matchId, threshold: $threshold) {
+      matchId
+      sources
+      discrepancies { clubMatchId kind playerName stat source value otherSource otherValue }
+    }
+  }
+`
+
+export const PREVIEW_AFL_MATCH_STATS_IMPORT = gql`
+  mutation PreviewAFLMatchStatsImport($matchId: ID!, $source: String) {
+    previewAFLMatchStatsImport(matchId: $matchId, source: $source) {
+      id
+      matchId
+      roundId
+      source
+      appliedAt
+      clubMatches {
+        clubMatchId clubName oldScore newScore oldRushedBehinds newRushedBehinds
+        players {
+          playerSeasonId playerName kind
+          changes { stat old new }
+          kicks handballs marks hitouts tackles goals behinds
+        }
+      }
+      unmatchedPlayers { parsedName clubMatchId }
+    }
+  }
+`
+
+export const APPLY_AFL_STATS_IMPORT_PREVIEW = gql`
+  mutation ApplyAFLStatsImportPreview($previewId: ID!) {
+    applyAFLStatsImportPreview(previewId: $previewId) {
+      id
+      matchId
+      roundId
+      source
+      appliedAt
+      clubMatches {
+        clubMatchId clubName oldScore newScore oldRushedBehinds newRushedBehinds
+        players {
+          playerSeasonId playerName kind
+          changes { stat old new }
+          kicks handballs marks hitouts tackles goals behinds
+        }
+      }
+      unmatchedPlayers { parsedName clubMatchId }
+    }
+  }
+`
+
 export const MARK_AFL_MATCH_STATS_COMPLETE = gql`
   mutation MarkAFLMatchStatsComplete($matchId: ID!, $complete: Boolean!) {
     markAFLMatchStatsComplete(matchId: $matchId, complete: $complete) {
@@ -61,6 +141,7 @@ export const ADD_FFL_PLAYER_TO_SEASON = gql`
 export const PARSE_TEAM_SUBMISSION = gql`
   mutation ParseFFLTeamSubmission($input: ParseFFLTeamSubmissionInput!) {
     parseFFLTeamSubmission(input: $input) {
+      submissionId
       resolvedPlayers {
         parsedName
         clubHint
@@ -82,13 +163,16 @@ export const PARSE_TEAM_SUBMISSION = gql`
 export const CONFIRM_TEAM_SUBMISSION = gql`
   mutation ConfirmFFLTeamSubmission($input: ConfirmFFLTeamSubmissionInput!) {
     confirmFFLTeamSubmission(input: $input) {
-      id
-      playerSeasonId
-      player { id aflPlayer { name } }
-      position
-      backupPositions
-      interchangePosition
-      score
+      playerMatches {
+        id
+        playerSeasonId
+        player { id aflPlayer { name } }
+        position
+        backupPositions
+        interchangePosition
+        score
+      }
+      completeness { complete }
     }
   }
 `
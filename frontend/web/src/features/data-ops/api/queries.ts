@@ -10,11 +10,23 @@ export const GET_AFL_ROUND_STATS = gql`
         dataStatus
         homeClubMatch { id clubSeasonId club { id name } score playerMatches { id } }
         awayClubMatch { id clubSeasonId club { id name } score playerMatches { id } }
+        statsDiscrepancies { clubMatchId kind playerName stat source value otherSource otherValue }
       }
     }
   }
 `
 
+export const GET_FFL_SCORE_IMPACT = gql`
+  query GetFFLScoreImpact($aflRoundId: ID!, $changes: [FFLAFLStatsInput!]!) {
+    fflScoreImpact(aflRoundId: $aflRoundId, changes: $changes) {
+      clubMatch { id club { id name } }
+      oldScore
+      newScore
+      players { playerMatch { id score player { id aflPlayer { name } } } newScore }
+    }
+  }
+`
+
 export const GET_FFL_DATA_OPS = gql`
   query GetFFLDataOps($seasonId: ID!) {
     fflSeason(id: $seasonId) {
@@ -30,14 +30,22 @@ export const REMOVE_FFL_PLAYER_FROM_SEASON = gql`
 export const SET_FFL_TEAM = gql`
   mutation SetFFLTeam($input: SetFFLTeamInput!) {
     setFFLTeam(input: $input) {
-      id
-      playerSeasonId
-      player { id aflPlayer { id name } }
-      position
-      status
-      backupPositions
-      interchangePosition
-      score
+      playerMatches {
+        id
+        playerSeasonId
+        player { id aflPlayer { id name } }
+        position
+        status
+        backupPositions
+        interchangePosition
+        score
+      }
+      completeness {
+        complete
+        emptySlots { position empty }
+        uncoveredPositions
+        noInterchange
+      }
     }
   }
 `
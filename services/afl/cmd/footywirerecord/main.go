@@ -0,0 +1,55 @@
+// Command footywirerecord records FootyWire pages for the footywire package's
+// replay corpus: a match's basic and advanced statistics, the current fixture
+// list and a season's fixture. Pages are fetched through sourcehttp with its
+// default rate limit and retries and no cache, and each page fetched is
+// written to -dir as it was served.
+//
+//	go run ./cmd/footywirerecord -mid 11402 -year 2026
+//
+// The match and season fixture pages are parsed too, so a change to the
+// site's markup shows up here before it reaches an import.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+
+	"xffl/services/afl/internal/infrastructure/footywire"
+	"xffl/services/afl/internal/infrastructure/httpreplay"
+	"xffl/services/afl/internal/infrastructure/sourcehttp"
+)
+
+func main() {
+	dir := flag.String("dir", "internal/infrastructure/footywire/testdata/corpus", "directory to record pages into")
+	mid := flag.String("mid", "11402", "FootyWire match ID to record the statistics pages of")
+	year := flag.Int("year", 2026, "season to record the fixture of")
+	flag.Parse()
+
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+	ctx := context.Background()
+
+	cfg := sourcehttp.DefaultConfig()
+	cfg.Transport = httpreplay.NewRecorder(*dir, nil)
+	client := footywire.NewFootywireClient(footywire.DefaultBaseURL, sourcehttp.New(cfg))
+
+	failed := false
+	if _, err := client.ParseMatch(ctx, *mid); err != nil {
+		slog.Error("match statistics", slog.String("mid", *mid), slog.Any("error", err))
+		failed = true
+	}
+	// With no matches to find, this only fetches the current fixture list.
+	if _, err := client.FindRoundMids(ctx, "", nil); err != nil {
+		slog.Error("current fixture list", slog.Any("error", err))
+		failed = true
+	}
+	if _, err := client.ListSeasonFixture(ctx, *year); err != nil {
+		slog.Error("season fixture", slog.Int("year", *year), slog.Any("error", err))
+		failed = true
+	}
+	if failed {
+		os.Exit(1)
+	}
+	slog.Info("recorded FootyWire pages", slog.String("dir", *dir))
+}
@@ -0,0 +1,35 @@
+// Command footywirestub serves saved FootyWire pages, standing in for
+// footywire.com so stats can be imported with no network.
+//
+// Point the AFL service at it with FOOTYWIRE_BASE_URL:
+//
+//	go run ./cmd/footywirestub -addr :8089
+//	FOOTYWIRE_BASE_URL=http://localhost:8089/afl/footy go run ./cmd/main.go
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"xffl/services/afl/internal/infrastructure/httpreplay"
+)
+
+func main() {
+	addr := flag.String("addr", ":8089", "address to listen on")
+	dir := flag.String("dir", "internal/infrastructure/footywire/testdata/corpus", "directory of saved pages")
+	flag.Parse()
+
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+	if _, err := os.Stat(*dir); err != nil {
+		slog.Error("unable to read saved pages", slog.String("dir", *dir), slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	slog.Info("serving saved FootyWire pages", slog.String("addr", *addr), slog.String("dir", *dir))
+	if err := http.ListenAndServe(*addr, httpreplay.Handler(*dir)); err != nil {
+		slog.Error("server stopped", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
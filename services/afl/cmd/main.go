@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -14,9 +15,12 @@ import (
 
 	aflv1 "xffl/contracts/gen/afl/v1"
 	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/infrastructure/afltables"
+	"xffl/services/afl/internal/infrastructure/footywire"
+	"xffl/services/afl/internal/infrastructure/httpreplay"
 	pg "xffl/services/afl/internal/infrastructure/postgres"
 	"xffl/services/afl/internal/infrastructure/postgres/sqlcgen"
-	"xffl/services/afl/internal/infrastructure/footywire"
+	"xffl/services/afl/internal/infrastructure/sourcehttp"
 	gql "xffl/services/afl/internal/interface/graphql"
 	rpcsrv "xffl/services/afl/internal/interface/twirp"
 	"xffl/shared/clock"
@@ -61,6 +65,7 @@ func main() {
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewAvailabilityRepository(q),
 	)
 
 	dispatcher := pgevents.New(pool, "xffl_events")
@@ -80,7 +85,16 @@ func main() {
 		dispatcher,
 	)
 
-	footywireClient := footywire.NewFootywireClient()
+	footywireClient := footywire.NewFootywireClient(os.Getenv("FOOTYWIRE_BASE_URL"), sourceClientFromEnv(ctx))
+	statsSources := map[string]application.StatsSource{
+		footywire.Source: {Parser: footywireClient, Discovery: footywireClient},
+	}
+	// AFLTABLES_DIR holds afltables CSV exports; the source is only offered
+	// when it is set.
+	if dir := os.Getenv("AFLTABLES_DIR"); dir != "" {
+		afltablesClient := afltables.NewClient(dir)
+		statsSources[afltables.Source] = application.StatsSource{Parser: afltablesClient, Discovery: afltablesClient, Players: afltablesClient}
+	}
 	dataOps := application.NewDataOpsCommands(
 		db,
 		pg.NewMatchRepository(q),
@@ -90,15 +104,58 @@ func main() {
 		pg.NewRoundRepository(q, pool),
 		pg.NewPlayerSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
+		pg.NewAvailabilityRepository(q),
 		pg.NewDataopsMatchSourceRepository(q),
 		pg.NewDataopsPlayerSourceRepository(q),
-		footywireClient,
-		footywireClient,
-		footywire.NewLevenshteinResolver(),
+		pg.NewDataopsStatsDiscrepancyRepository(q, pool),
+		pg.NewDataopsStatsPreviewRepository(q),
+		statsSources,
+		footywire.NewNameResolver(),
+		dispatcher,
+	)
+
+	liveStats := application.NewLiveStatsCommands(clk, pg.NewMatchRepository(q), dataOps, application.LiveStatsConfig{
+		MatchLength: durationFromEnv(ctx, "LIVE_STATS_MATCH_LENGTH", 3*time.Hour),
+		Grace:       durationFromEnv(ctx, "LIVE_STATS_GRACE", time.Hour),
+	})
+	go runEvery(ctx, "LIVE_STATS_INTERVAL", 2*time.Minute, "live stats polling", liveStats.PollLiveMatches)
+
+	availability := application.NewAvailabilityCommands(
+		db,
+		pg.NewAvailabilityRepository(q),
+		pg.NewRoundRepository(q, pool),
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		footywire.NewNameResolver(),
 		dispatcher,
 	)
 
-	resolver := &gql.Resolver{Queries: queries, Commands: commands, DataOps: dataOps}
+	seasonSetup := application.NewSeasonSetupCommands(db, pg.NewClubRepository(q), statsSources)
+	playerImport := application.NewPlayerImportCommands(
+		db,
+		pg.NewSeasonRepository(q),
+		pg.NewClubRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewPlayerRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewDataopsPlayerSourceRepository(q),
+		pg.NewDataopsPlayerProposalRepository(q),
+		statsSources,
+		footywire.NewNameResolver(),
+	)
+
+	resolver := &gql.Resolver{
+		Queries:      queries,
+		Commands:     commands,
+		DataOps:      dataOps,
+		Availability: availability,
+		SeasonSetup:  seasonSetup,
+		PlayerImport: playerImport,
+	}
 	srv := handler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
 	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
 		ctx = pg.WithQueryCounter(ctx)
@@ -153,3 +210,72 @@ func clockFromEnv(ctx context.Context) clock.Clock {
 	}
 	return clock.RealClock{}
 }
+
+// sourceClientFromEnv returns the HTTP client data sources are fetched with.
+// Requests to a host are SOURCE_MIN_INTERVAL (default 1s) apart and time out
+// after SOURCE_TIMEOUT (default 30s). Responses are cached in
+// SOURCE_CACHE_DIR, by default xffl/sources in the user's cache directory.
+//
+// FOOTYWIRE_REPLAY_DIR replays the recorded pages in a directory instead of
+// fetching them, with no network. FOOTYWIRE_RECORD_DIR records every page
+// fetched into a directory. The cache is off for both, so every page comes
+// from the directory or is fetched.
+func sourceClientFromEnv(ctx context.Context) *sourcehttp.Client {
+	cfg := sourcehttp.DefaultConfig()
+	cfg.MinInterval = durationFromEnv(ctx, "SOURCE_MIN_INTERVAL", cfg.MinInterval)
+	cfg.Timeout = durationFromEnv(ctx, "SOURCE_TIMEOUT", cfg.Timeout)
+	cfg.CacheDir = os.Getenv("SOURCE_CACHE_DIR")
+	if cfg.CacheDir == "" {
+		if dir, err := os.UserCacheDir(); err == nil {
+			cfg.CacheDir = filepath.Join(dir, "xffl", "sources")
+		} else {
+			slog.WarnContext(ctx, "no cache directory for source responses", slog.Any("error", err))
+		}
+	}
+
+	if dir := os.Getenv("FOOTYWIRE_REPLAY_DIR"); dir != "" {
+		slog.InfoContext(ctx, "replaying FootyWire pages", slog.String("dir", dir))
+		cfg.Transport = httpreplay.NewReplayer(dir)
+		cfg.CacheDir = ""
+	} else if dir := os.Getenv("FOOTYWIRE_RECORD_DIR"); dir != "" {
+		slog.InfoContext(ctx, "recording FootyWire pages", slog.String("dir", dir))
+		cfg.Transport = httpreplay.NewRecorder(dir, nil)
+		cfg.CacheDir = ""
+	}
+	return sourcehttp.New(cfg)
+}
+
+// durationFromEnv returns the duration in envVar, or def if it is unset. Used
+// for the live stats window: LIVE_STATS_MATCH_LENGTH (default 3h) is how long
+// after its start a match is expected to end, and LIVE_STATS_GRACE (default
+// 1h) how long polling carries on after that.
+func durationFromEnv(ctx context.Context, envVar string, def time.Duration) time.Duration {
+	v := os.Getenv(envVar)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		slog.ErrorContext(ctx, "invalid "+envVar, slog.String("value", v), slog.Any("error", err))
+		os.Exit(1)
+	}
+	return d
+}
+
+// runEvery calls fn on a fixed interval until ctx is done. Used for live stats
+// polling (LIVE_STATS_INTERVAL, default 2m); the env var overrides the default.
+func runEvery(ctx context.Context, envVar string, interval time.Duration, name string, fn func(context.Context) error) {
+	interval = durationFromEnv(ctx, envVar, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				slog.ErrorContext(ctx, name+" failed", slog.Any("error", err))
+			}
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"xffl/contracts/events"
+	"xffl/services/afl/internal/domain"
+	sharedevents "xffl/shared/events"
+)
+
+// SetPlayerAvailabilityParams are the inputs to SetPlayerAvailability.
+type SetPlayerAvailabilityParams struct {
+	PlayerSeasonID int
+	RoundID        int
+	Status         domain.AvailabilityStatus
+	ExpectedReturn *string
+}
+
+// UnmatchedAvailability is a report from an availability source whose player
+// could not be resolved to a player season.
+type UnmatchedAvailability struct {
+	PlayerName string
+	ClubName   string
+	Status     domain.AvailabilityStatus
+}
+
+// ImportAvailabilityResult summarises an availability import for a round.
+type ImportAvailabilityResult struct {
+	RoundID   int
+	Recorded  []domain.PlayerAvailability
+	Unmatched []UnmatchedAvailability
+}
+
+// AvailabilityCommands records pre-match player availability and tells FFL
+// about it via AFL.MatchUpdated, so teams can be checked before lockout.
+type AvailabilityCommands struct {
+	tx            TxManager
+	availability  domain.AvailabilityRepository
+	rounds        domain.RoundRepository
+	matches       domain.MatchRepository
+	clubMatches   domain.ClubMatchRepository
+	clubSeasons   domain.ClubSeasonRepository
+	clubs         domain.ClubRepository
+	playerSeasons domain.PlayerSeasonRepository
+	playerMatches domain.PlayerMatchRepository
+	resolver      PlayerResolver
+	dispatcher    sharedevents.Dispatcher
+}
+
+func NewAvailabilityCommands(
+	tx TxManager,
+	availability domain.AvailabilityRepository,
+	rounds domain.RoundRepository,
+	matches domain.MatchRepository,
+	clubMatches domain.ClubMatchRepository,
+	clubSeasons domain.ClubSeasonRepository,
+	clubs domain.ClubRepository,
+	playerSeasons domain.PlayerSeasonRepository,
+	playerMatches domain.PlayerMatchRepository,
+	resolver PlayerResolver,
+	dispatcher sharedevents.Dispatcher,
+) *AvailabilityCommands {
+	return &AvailabilityCommands{
+		tx:            tx,
+		availability:  availability,
+		rounds:        rounds,
+		matches:       matches,
+		clubMatches:   clubMatches,
+		clubSeasons:   clubSeasons,
+		clubs:         clubs,
+		playerSeasons: playerSeasons,
+		playerMatches: playerMatches,
+		resolver:      resolver,
+		dispatcher:    dispatcher,
+	}
+}
+
+// SetPlayerAvailability records one player's availability for a round, replacing
+// any earlier entry, and republishes the status map for the player's match.
+func (c *AvailabilityCommands) SetPlayerAvailability(ctx context.Context, params SetPlayerAvailabilityParams) (domain.PlayerAvailability, error) {
+	a := domain.PlayerAvailability{
+		PlayerSeasonID: params.PlayerSeasonID,
+		RoundID:        params.RoundID,
+		Status:         params.Status,
+		ExpectedReturn: params.ExpectedReturn,
+		Source:         domain.AvailabilitySourceManual,
+	}
+	if err := a.Validate(); err != nil {
+		return domain.PlayerAvailability{}, err
+	}
+	ps, err := c.playerSeasons.FindByID(ctx, params.PlayerSeasonID)
+	if err != nil {
+		return domain.PlayerAvailability{}, fmt.Errorf("find player season: %w", err)
+	}
+	round, err := c.rounds.FindByID(ctx, params.RoundID)
+	if err != nil {
+		return domain.PlayerAvailability{}, fmt.Errorf("find round: %w", err)
+	}
+
+	saved, err := c.availability.Upsert(ctx, a)
+	if err != nil {
+		return domain.PlayerAvailability{}, fmt.Errorf("upsert availability: %w", err)
+	}
+
+	c.publishRound(ctx, round, map[int]bool{ps.ClubSeasonID: true})
+	return saved, nil
+}
+
+// ImportAvailability pulls a round's availability from source, resolves each
+// reported player against the squads of the clubs playing that round, and
+// records the confident matches. Unresolved reports are returned for review.
+func (c *AvailabilityCommands) ImportAvailability(ctx context.Context, source AvailabilitySource, roundID int) (ImportAvailabilityResult, error) {
+	round, err := c.rounds.FindByID(ctx, roundID)
+	if err != nil {
+		return ImportAvailabilityResult{}, fmt.Errorf("find round: %w", err)
+	}
+	reports, err := source.FetchAvailability(ctx, round)
+	if err != nil {
+		return ImportAvailabilityResult{}, fmt.Errorf("fetch availability from %s: %w", source.Name(), err)
+	}
+
+	clubSeasonByName, err := c.roundClubSeasons(ctx, roundID)
+	if err != nil {
+		return ImportAvailabilityResult{}, err
+	}
+	candidates := make(map[int][]PlayerCandidate)
+
+	result := ImportAvailabilityResult{RoundID: roundID}
+	var entries []domain.PlayerAvailability
+	affected := make(map[int]bool)
+	for _, r := range reports {
+		unmatched := UnmatchedAvailability{PlayerName: r.PlayerName, ClubName: r.ClubName, Status: r.Status}
+		csID, ok := clubSeasonByName[r.ClubName]
+		if !ok {
+			result.Unmatched = append(result.Unmatched, unmatched)
+			continue
+		}
+		if _, loaded := candidates[csID]; !loaded {
+			rows, err := c.playerSeasons.FindByClubSeasonIDWithPlayer(ctx, csID)
+			if err != nil {
+				return ImportAvailabilityResult{}, fmt.Errorf("load squad for club season %d: %w", csID, err)
+			}
+			pool := make([]PlayerCandidate, len(rows))
+			for i, row := range rows {
+				pool[i] = PlayerCandidate{PlayerSeasonID: row.PlayerSeasonID, Name: row.Name}
+			}
+			candidates[csID] = pool
+		}
+		matches, err := c.resolver.Resolve(ctx, r.PlayerName, r.ClubName, candidates[csID])
+		if err != nil || len(matches) == 0 || matches[0].Confidence < confidenceThreshold {
+			result.Unmatched = append(result.Unmatched, unmatched)
+			continue
+		}
+		a := domain.PlayerAvailability{
+			PlayerSeasonID: matches[0].Candidate.PlayerSeasonID,
+			RoundID:        roundID,
+			Status:         r.Status,
+			ExpectedReturn: r.ExpectedReturn,
+			Source:         source.Name(),
+		}
+		if err := a.Validate(); err != nil {
+			return ImportAvailabilityResult{}, fmt.Errorf("%s (%s): %w", r.PlayerName, r.ClubName, err)
+		}
+		entries = append(entries, a)
+		affected[csID] = true
+	}
+
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		result.Recorded = make([]domain.PlayerAvailability, 0, len(entries))
+		for _, a := range entries {
+			saved, err := repos.Availability.Upsert(ctx, a)
+			if err != nil {
+				return fmt.Errorf("upsert availability for player season %d: %w", a.PlayerSeasonID, err)
+			}
+			result.Recorded = append(result.Recorded, saved)
+		}
+		return nil
+	})
+	if err != nil {
+		return ImportAvailabilityResult{}, err
+	}
+
+	c.publishRound(ctx, round, affected)
+	return result, nil
+}
+
+// roundClubSeasons maps each club playing in the round to its club season.
+func (c *AvailabilityCommands) roundClubSeasons(ctx context.Context, roundID int) (map[string]int, error) {
+	matches, err := c.matches.FindByRoundID(ctx, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("load matches for round %d: %w", roundID, err)
+	}
+	out := make(map[string]int)
+	for _, m := range matches {
+		for _, cmID := range []int{m.Home.ID, m.Away.ID} {
+			cm, err := c.clubMatches.FindByID(ctx, cmID)
+			if err != nil {
+				return nil, fmt.Errorf("load club match %d: %w", cmID, err)
+			}
+			cs, err := c.clubSeasons.FindByID(ctx, cm.ClubSeasonID)
+			if err != nil {
+				return nil, fmt.Errorf("load club season %d: %w", cm.ClubSeasonID, err)
+			}
+			club, err := c.clubs.FindByID(ctx, cs.ClubID)
+			if err != nil {
+				return nil, fmt.Errorf("load club %d: %w", cs.ClubID, err)
+			}
+			out[club.Name] = cs.ID
+		}
+	}
+	return out, nil
+}
+
+// publishRound publishes AFL.MatchUpdated for every match in the round involving
+// one of the given club seasons. Matches already final are skipped: their
+// statuses are settled. Failures are logged, not returned.
+func (c *AvailabilityCommands) publishRound(ctx context.Context, round domain.Round, clubSeasonIDs map[int]bool) {
+	matches, err := c.matches.FindByRoundID(ctx, round.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "load matches for availability update failed", slog.Int("round_id", round.ID), slog.Any("error", err))
+		return
+	}
+	for _, m := range matches {
+		if m.DataStatus == domain.MatchDataFinal {
+			continue
+		}
+		home, homeErr := c.clubMatches.FindByID(ctx, m.Home.ID)
+		away, awayErr := c.clubMatches.FindByID(ctx, m.Away.ID)
+		if homeErr != nil || awayErr != nil {
+			slog.WarnContext(ctx, "load club_matches for availability update failed",
+				slog.Int("match_id", m.ID), slog.Any("home_err", homeErr), slog.Any("away_err", awayErr))
+			continue
+		}
+		if !clubSeasonIDs[home.ClubSeasonID] && !clubSeasonIDs[away.ClubSeasonID] {
+			continue
+		}
+		statusMap, err := matchStatusMap(ctx, c.playerSeasons, c.playerMatches, c.availability, m, []domain.ClubMatch{home, away}, nil)
+		if err != nil {
+			slog.WarnContext(ctx, "build status map failed", slog.Int("match_id", m.ID), slog.Any("error", err))
+			continue
+		}
+		b, err := json.Marshal(events.AflMatchUpdatedPayload{
+			MatchID:                 m.ID,
+			RoundID:                 round.ID,
+			SeasonID:                round.SeasonID,
+			MatchStatus:             string(m.DataStatus),
+			PlayerSeasonIDStatusMap: statusMap,
+		})
+		if err != nil {
+			continue
+		}
+		if err := c.dispatcher.Publish(ctx, events.AflMatchUpdated, b); err != nil {
+			slog.WarnContext(ctx, "publish AflMatchUpdated(availability) failed", slog.Int("match_id", m.ID), slog.Any("error", err))
+		}
+	}
+}
+
+// matchStatusMap gathers the squads, stats and availability for a match and
+// builds its AFL.MatchUpdated status map. withStats, when non-nil, names the
+// players to report as playing; otherwise every player with a player_match
+// row in clubMatches counts.
+func matchStatusMap(
+	ctx context.Context,
+	playerSeasons domain.PlayerSeasonRepository,
+	playerMatches domain.PlayerMatchRepository,
+	availability domain.AvailabilityRepository,
+	m domain.Match,
+	clubMatches []domain.ClubMatch,
+	withStats map[int]bool,
+) (map[int]string, error) {
+	var squad []int
+	for _, cm := range clubMatches {
+		rows, err := playerSeasons.FindByClubSeasonIDWithPlayer(ctx, cm.ClubSeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("load squad for club season %d: %w", cm.ClubSeasonID, err)
+		}
+		for _, r := range rows {
+			squad = append(squad, r.PlayerSeasonID)
+		}
+	}
+
+	if withStats == nil {
+		withStats = make(map[int]bool)
+		for _, cm := range clubMatches {
+			pms, err := playerMatches.FindByClubMatchID(ctx, cm.ID)
+			if err != nil {
+				return nil, fmt.Errorf("load player matches for club match %d: %w", cm.ID, err)
+			}
+			for _, pm := range pms {
+				withStats[pm.PlayerSeasonID] = true
+			}
+		}
+	}
+
+	entries, err := availability.FindByPlayerSeasonIDsAndRoundID(ctx, squad, m.RoundID)
+	if err != nil {
+		return nil, fmt.Errorf("load availability: %w", err)
+	}
+	avail := make(map[int]domain.AvailabilityStatus, len(entries))
+	for _, a := range entries {
+		avail[a.PlayerSeasonID] = a.Status
+	}
+	return domain.MatchPlayerStatuses(m.DataStatus, squad, withStats, avail), nil
+}
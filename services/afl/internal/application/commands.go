@@ -9,10 +9,18 @@ import (
 
 // WriteRepos provides repository access within a transaction.
 type WriteRepos struct {
+	Seasons       domain.SeasonRepository
+	Rounds        domain.RoundRepository
+	ClubSeasons   domain.ClubSeasonRepository
+	Matches       domain.MatchRepository
 	Players       domain.PlayerRepository
 	PlayerSeasons domain.PlayerSeasonRepository
 	PlayerMatches domain.PlayerMatchRepository
 	ClubMatches   domain.ClubMatchRepository
+	Availability  domain.AvailabilityRepository
+	StatsPreviews DataopsStatsPreviewRepository
+	PlayerSources DataopsPlayerSourceRepository
+	Proposals     DataopsPlayerProposalRepository
 }
 
 // TxManager abstracts transactional execution.
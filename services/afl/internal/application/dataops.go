@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
@@ -13,10 +14,14 @@ import (
 )
 
 const (
-	footywireSource     = "footywire"
+	// DefaultStatsSource is the source stats are imported from when the
+	// caller doesn't choose one.
+	DefaultStatsSource  = "footywire"
 	confidenceThreshold = 0.85
 )
 
+var ErrUnknownStatsSource = errors.New("unknown stats source")
+
 // UnmatchedAFLPlayer holds the parsed stats for a player who could not be matched during import.
 type UnmatchedAFLPlayer struct {
 	ParsedName  string
@@ -32,12 +37,16 @@ type UnmatchedAFLPlayer struct {
 
 // ImportAFLStatsResult summarises what was written for each club in a match.
 type ImportAFLStatsResult struct {
-	MatchID          int
-	HomeClubName     string
-	AwayClubName     string
-	HomePlayerCount  int
-	AwayPlayerCount  int
-	UnmatchedPlayers []UnmatchedAFLPlayer
+	MatchID         int
+	Source          string
+	HomeClubName    string
+	AwayClubName    string
+	HomePlayerCount int
+	AwayPlayerCount int
+	// ChangedPlayerCount is how many players' stats differ from those
+	// already stored; only they are published.
+	ChangedPlayerCount int
+	UnmatchedPlayers   []UnmatchedAFLPlayer
 }
 
 // DataOpsCommands handles AFL stats import operations.
@@ -50,10 +59,12 @@ type DataOpsCommands struct {
 	rounds          domain.RoundRepository
 	playerSeasons   domain.PlayerSeasonRepository
 	playerMatches   domain.PlayerMatchRepository
+	availability    domain.AvailabilityRepository
 	sourceMap       DataopsMatchSourceRepository
 	playerSourceMap DataopsPlayerSourceRepository
-	statsParser     StatsParser
-	discovery       FixtureDiscovery
+	discrepancies   DataopsStatsDiscrepancyRepository
+	previews        DataopsStatsPreviewRepository
+	sources         map[string]StatsSource
 	resolver        PlayerResolver
 	dispatcher      sharedevents.Dispatcher
 }
@@ -67,10 +78,12 @@ func NewDataOpsCommands(
 	rounds domain.RoundRepository,
 	playerSeasons domain.PlayerSeasonRepository,
 	playerMatches domain.PlayerMatchRepository,
+	availability domain.AvailabilityRepository,
 	sourceMap DataopsMatchSourceRepository,
 	playerSourceMap DataopsPlayerSourceRepository,
-	statsParser StatsParser,
-	discovery FixtureDiscovery,
+	discrepancies DataopsStatsDiscrepancyRepository,
+	previews DataopsStatsPreviewRepository,
+	sources map[string]StatsSource,
 	resolver PlayerResolver,
 	dispatcher sharedevents.Dispatcher,
 ) *DataOpsCommands {
@@ -83,35 +96,62 @@ func NewDataOpsCommands(
 		rounds:          rounds,
 		playerSeasons:   playerSeasons,
 		playerMatches:   playerMatches,
+		availability:    availability,
 		sourceMap:       sourceMap,
 		playerSourceMap: playerSourceMap,
-		statsParser:     statsParser,
-		discovery:       discovery,
+		discrepancies:   discrepancies,
+		previews:        previews,
+		sources:         sources,
 		resolver:        resolver,
 		dispatcher:      dispatcher,
 	}
 }
 
-// ImportAFLStats scrapes match stats from FootyWire, resolves player names, and writes
-// afl.player_match records. Sets stats_import_status to "partial".
-func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (ImportAFLStatsResult, error) {
+// ImportAFLStats imports match stats from source, or DefaultStatsSource if
+// source is empty, resolves player names, and writes afl.player_match
+// records. Sets stats_import_status to "partial".
+func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int, source string) (ImportAFLStatsResult, error) {
+	t, err := c.loadImportTarget(ctx, matchID, source)
+	if err != nil {
+		return ImportAFLStatsResult{}, err
+	}
+	return c.importMatchStats(ctx, t.source, t.match, t.round, t.homeClubName, t.awayClubName, t.mid)
+}
+
+// importTarget is a match about to have its stats read from a source.
+type importTarget struct {
+	source       string
+	match        domain.Match // loaded with its player matches
+	round        domain.Round
+	homeClubName string
+	awayClubName string
+	mid          string // the source's ID for the match
+}
+
+// loadImportTarget loads a match with its player matches, and resolves its
+// club names and source's ID for it.
+func (c *DataOpsCommands) loadImportTarget(ctx context.Context, matchID int, source string) (importTarget, error) {
+	source, err := c.statsSource(source)
+	if err != nil {
+		return importTarget{}, err
+	}
 	match, err := c.matches.FindByIDWithDetails(ctx, matchID)
 	if err != nil {
-		return ImportAFLStatsResult{}, fmt.Errorf("load match: %w", err)
+		return importTarget{}, fmt.Errorf("load match: %w", err)
 	}
 
 	round, err := c.rounds.FindByID(ctx, match.RoundID)
 	if err != nil {
-		return ImportAFLStatsResult{}, fmt.Errorf("load round: %w", err)
+		return importTarget{}, fmt.Errorf("load round: %w", err)
 	}
 
 	homeClubName, err := c.clubNameForClubSeason(ctx, match.Home.ClubSeasonID)
 	if err != nil {
-		return ImportAFLStatsResult{}, fmt.Errorf("resolve home club: %w", err)
+		return importTarget{}, fmt.Errorf("resolve home club: %w", err)
 	}
 	awayClubName, err := c.clubNameForClubSeason(ctx, match.Away.ClubSeasonID)
 	if err != nil {
-		return ImportAFLStatsResult{}, fmt.Errorf("resolve away club: %w", err)
+		return importTarget{}, fmt.Errorf("resolve away club: %w", err)
 	}
 
 	slog.InfoContext(ctx, "importing AFL stats",
@@ -119,154 +159,99 @@ func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (Impo
 		slog.String("round", round.Name),
 		slog.String("home", homeClubName),
 		slog.String("away", awayClubName),
+		slog.String("source", source),
 	)
 
-	mid, err := c.resolveMid(ctx, matchID, round.Name, homeClubName, awayClubName)
+	mid, err := c.resolveMid(ctx, source, round.Name, FixtureQuery{
+		MatchID:   matchID,
+		HomeClub:  homeClubName,
+		AwayClub:  awayClubName,
+		StartTime: match.StartTime,
+	})
 	if err != nil {
-		return ImportAFLStatsResult{}, fmt.Errorf("resolve footywire mid: %w", err)
-	}
-	slog.InfoContext(ctx, "footywire mid resolved", slog.String("mid", mid))
+		return importTarget{}, fmt.Errorf("resolve %s mid: %w", source, err)
+	}
+	slog.InfoContext(ctx, "source mid resolved", slog.String("source", source), slog.String("mid", mid))
+
+	return importTarget{
+		source:       source,
+		match:        match,
+		round:        round,
+		homeClubName: homeClubName,
+		awayClubName: awayClubName,
+		mid:          mid,
+	}, nil
+}
 
-	stats, err := c.statsParser.ParseMatch(ctx, mid)
+// importMatchStats parses source's stats for mid and writes them for match,
+// whose club names the caller has already resolved. match must be loaded
+// with its player matches, which the new stats are compared against so that
+// only changed stat lines are published.
+func (c *DataOpsCommands) importMatchStats(ctx context.Context, source string, match domain.Match, round domain.Round, homeClubName, awayClubName, mid string) (ImportAFLStatsResult, error) {
+	matchID := match.ID
+	wasPartial := match.DataStatus == domain.MatchDataPartial
+	stats, err := c.sources[source].Parser.ParseMatch(ctx, mid)
 	if err != nil {
 		return ImportAFLStatsResult{}, fmt.Errorf("parse match stats: %w", err)
 	}
 
 	result := ImportAFLStatsResult{
 		MatchID:      matchID,
+		Source:       source,
 		HomeClubName: homeClubName,
 		AwayClubName: awayClubName,
 	}
 
-	type clubWork struct {
-		cm            domain.ClubMatch
-		clubName      string // DB name — used for candidate lookup
-		statsClubName string // score table name — used to filter parsed players
-		teamGoals     int
-		teamBehinds   int
-		counter       *int
-	}
-
-	works := []clubWork{
-		{cm: match.Home, clubName: homeClubName, statsClubName: stats.HomeClubName, teamGoals: stats.HomeTeamGoals, teamBehinds: stats.HomeTeamBehinds, counter: &result.HomePlayerCount},
-		{cm: match.Away, clubName: awayClubName, statsClubName: stats.AwayClubName, teamGoals: stats.AwayTeamGoals, teamBehinds: stats.AwayTeamBehinds, counter: &result.AwayPlayerCount},
+	clubs, err := c.resolveMatchStats(ctx, source, match, round, homeClubName, awayClubName, stats)
+	if err != nil {
+		return ImportAFLStatsResult{}, err
 	}
+	counters := []*int{&result.HomePlayerCount, &result.AwayPlayerCount}
 
-	var allWritten []domain.PlayerMatch
+	var allWritten, allChanged []domain.PlayerMatch
 	var roundID int
 
-	for _, w := range works {
-		candidates, err := c.buildCandidates(ctx, w.cm.ClubSeasonID)
-		if err != nil {
-			return ImportAFLStatsResult{}, fmt.Errorf("build candidates for %s: %w", w.clubName, err)
-		}
-
-		playerStats := filterByClub(stats.Players, w.statsClubName)
-
-		// Sum behinds from ALL parsed player stats regardless of match confidence —
-		// this gives the accurate denominator for computing rushed behinds.
-		var totalParsedBehinds int
-		for _, ps := range playerStats {
-			totalParsedBehinds += ps.Behinds
+	for i, rc := range clubs {
+		var written, changed []domain.PlayerMatch
+		stored := make(map[int]domain.PlayerMatch, len(rc.cm.PlayerMatches))
+		for _, pm := range rc.cm.PlayerMatches {
+			stored[pm.PlayerSeasonID] = pm
 		}
 
-		var written []domain.PlayerMatch
-		var unmatched []UnmatchedAFLPlayer
-
-		seasonStr := strconv.Itoa(round.SeasonID)
-		clubSeasonStr := strconv.Itoa(w.cm.ClubSeasonID)
-
 		err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
-			written = make([]domain.PlayerMatch, 0, len(playerStats))
-			for _, ps := range playerStats {
-				// Check source map before fuzzy matching — auto-resolves previously confirmed mismatches.
-				var psID int
-				mappedID, found, err := c.playerSourceMap.FindPlayerSeasonID(ctx, footywireSource, seasonStr, clubSeasonStr, ps.Name)
-				if err != nil {
-					slog.WarnContext(ctx, "player source map lookup failed", slog.String("player", ps.Name), slog.Any("error", err))
-				} else if found {
-					psID = mappedID
-				}
-
-				if psID == 0 {
-					resolveName := ps.Name
-					if ps.CanonicalName != "" {
-						resolveName = ps.CanonicalName
-					}
-					matches, err := c.resolver.Resolve(ctx, resolveName, w.clubName, candidates)
-					if err != nil {
-						slog.WarnContext(ctx, "resolver error", slog.String("player", ps.Name), slog.Any("error", err))
-						unmatched = append(unmatched, UnmatchedAFLPlayer{ParsedName: ps.Name, ClubMatchID: w.cm.ID,
-							Kicks: ps.Kicks, Handballs: ps.Handballs, Marks: ps.Marks, Hitouts: ps.Hitouts,
-							Tackles: ps.Tackles, Goals: ps.Goals, Behinds: ps.Behinds})
-						continue
-					}
-					if len(matches) == 0 || matches[0].Confidence < confidenceThreshold {
-						slog.WarnContext(ctx, "no confident match for player",
-							slog.String("player", ps.Name),
-							slog.String("canonicalName", ps.CanonicalName),
-							slog.String("club", w.clubName),
-						)
-						unmatched = append(unmatched, UnmatchedAFLPlayer{ParsedName: ps.Name, ClubMatchID: w.cm.ID,
-							Kicks: ps.Kicks, Handballs: ps.Handballs, Marks: ps.Marks, Hitouts: ps.Hitouts,
-							Tackles: ps.Tackles, Goals: ps.Goals, Behinds: ps.Behinds})
-						continue
-					}
-					psID = matches[0].Candidate.PlayerSeasonID
-				}
-
-				kicks, handballs, marks, hitouts, tackles, goals, behinds :=
-					ps.Kicks, ps.Handballs, ps.Marks, ps.Hitouts, ps.Tackles, ps.Goals, ps.Behinds
-
-				pm, err := repos.PlayerMatches.Upsert(ctx, domain.UpsertPlayerMatchParams{
-					ClubMatchID:    w.cm.ID,
-					PlayerSeasonID: psID,
-					Kicks:          &kicks,
-					Handballs:      &handballs,
-					Marks:          &marks,
-					Hitouts:        &hitouts,
-					Tackles:        &tackles,
-					Goals:          &goals,
-					Behinds:        &behinds,
-				})
+			written = make([]domain.PlayerMatch, 0, len(rc.players))
+			changed = nil
+			for _, p := range rc.players {
+				pm, err := repos.PlayerMatches.Upsert(ctx, upsertStatLine(rc.cm.ID, p.playerSeasonID, p.stats))
 				if err != nil {
-					return fmt.Errorf("upsert player_match for %s: %w", ps.Name, err)
+					return fmt.Errorf("upsert player_match for %s: %w", p.stats.Name, err)
 				}
 				written = append(written, pm)
+				if prev, ok := stored[p.playerSeasonID]; !ok || !prev.SameStats(pm) {
+					changed = append(changed, pm)
+				}
 			}
 
-			// Recalculate club score from updated player records.
-			allPlayerMatches, err := repos.PlayerMatches.FindByClubMatchID(ctx, w.cm.ID)
-			if err != nil {
-				return fmt.Errorf("reload player matches: %w", err)
-			}
-			cm := w.cm
-			cm.PlayerMatches = allPlayerMatches
-			if err := repos.ClubMatches.UpdateScore(ctx, w.cm.ID, cm.Score()); err != nil {
-				return fmt.Errorf("update club score: %w", err)
-			}
-
-			// Rushed behinds = team total behinds − sum of all player behinds from the
-			// stats page. Using the full parsed total (not just matched players) keeps
-			// this accurate even when some players are not yet in the DB.
-			rushedBehinds := w.teamBehinds - totalParsedBehinds
-			if rushedBehinds < 0 {
-				rushedBehinds = 0
+			if err := updateClubScore(ctx, repos, rc.cm, rc.rushedBehinds); err != nil {
+				return err
 			}
-			if err := repos.ClubMatches.UpdateRushedBehinds(ctx, w.cm.ID, rushedBehinds); err != nil {
-				return fmt.Errorf("update rushed behinds: %w", err)
+			if len(rc.quarters) > 0 {
+				if err := repos.ClubMatches.SetQuarterScores(ctx, rc.cm.ID, rc.quarters); err != nil {
+					return fmt.Errorf("set quarter scores for %s: %w", rc.clubName, err)
+				}
 			}
 
-			roundID, err = repos.ClubMatches.FindRoundID(ctx, w.cm.ID)
+			roundID, err = repos.ClubMatches.FindRoundID(ctx, rc.cm.ID)
 			return err
 		})
 		if err != nil {
 			return ImportAFLStatsResult{}, err
 		}
 
-		*w.counter = len(written)
+		*counters[i] = len(written)
 		allWritten = append(allWritten, written...)
-		result.UnmatchedPlayers = append(result.UnmatchedPlayers, unmatched...)
+		allChanged = append(allChanged, changed...)
+		result.UnmatchedPlayers = append(result.UnmatchedPlayers, rc.unmatched...)
 	}
 
 	// Update match data status (outside transaction — best-effort).
@@ -274,8 +259,137 @@ func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (Impo
 		slog.WarnContext(ctx, "failed to update match data status", slog.Int("match_id", matchID), slog.Any("error", err))
 	}
 
-	// Fire per-player stats events.
+	result.ChangedPlayerCount = len(allChanged)
+	if len(allChanged) == 0 && wasPartial {
+		// Nothing new since the last import: the match was already partial
+		// and FFL already has every stat line.
+		return result, nil
+	}
+
+	// Fire per-player stats events for changed stat lines only.
+	c.publishPlayerMatches(ctx, roundID, allChanged)
+
+	written := make(map[int]bool, len(allWritten))
 	for _, pm := range allWritten {
+		written[pm.PlayerSeasonID] = true
+	}
+	c.publishMatchPartial(ctx, match, round.SeasonID, roundID, written)
+
+	return result, nil
+}
+
+// resolvedClub is one club's side of a source's match stats, with each
+// player's name resolved to a player season.
+type resolvedClub struct {
+	cm            domain.ClubMatch
+	clubName      string
+	players       []resolvedPlayer
+	unmatched     []UnmatchedAFLPlayer
+	rushedBehinds int
+	quarters      []domain.QuarterScore
+	names         map[int]string // candidate names by player season ID
+}
+
+// resolvedPlayer is a player's stat line from a source and the player season
+// their name resolved to.
+type resolvedPlayer struct {
+	playerSeasonID int
+	stats          PlayerStats
+}
+
+// resolveMatchStats splits a source's match stats by club, home then away,
+// resolving each player's name as an import would.
+func (c *DataOpsCommands) resolveMatchStats(ctx context.Context, source string, match domain.Match, round domain.Round, homeClubName, awayClubName string, stats MatchStats) ([]resolvedClub, error) {
+	sides := []struct {
+		cm            domain.ClubMatch
+		clubName      string // DB name — used for candidate lookup
+		statsClubName string // score table name — used to filter parsed players
+		teamBehinds   int
+		quarters      []domain.QuarterScore
+	}{
+		{cm: match.Home, clubName: homeClubName, statsClubName: stats.HomeClubName, teamBehinds: stats.HomeTeamBehinds, quarters: stats.HomeQuarters},
+		{cm: match.Away, clubName: awayClubName, statsClubName: stats.AwayClubName, teamBehinds: stats.AwayTeamBehinds, quarters: stats.AwayQuarters},
+	}
+
+	clubs := make([]resolvedClub, 0, len(sides))
+	for _, side := range sides {
+		candidates, err := c.buildCandidates(ctx, side.cm.ClubSeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("build candidates for %s: %w", side.clubName, err)
+		}
+		rc := resolvedClub{cm: side.cm, clubName: side.clubName, quarters: side.quarters, names: make(map[int]string, len(candidates))}
+		for _, cand := range candidates {
+			rc.names[cand.PlayerSeasonID] = cand.Name
+		}
+
+		seasonStr := strconv.Itoa(round.SeasonID)
+		clubSeasonStr := strconv.Itoa(side.cm.ClubSeasonID)
+
+		// Sum behinds from ALL parsed player stats regardless of match confidence —
+		// this gives the accurate denominator for computing rushed behinds.
+		var totalParsedBehinds int
+		for _, ps := range filterByClub(stats.Players, side.statsClubName) {
+			totalParsedBehinds += ps.Behinds
+
+			psID, ok := c.resolvePlayerSeason(ctx, source, seasonStr, clubSeasonStr, side.clubName, ps, candidates)
+			if !ok {
+				rc.unmatched = append(rc.unmatched, UnmatchedAFLPlayer{ParsedName: ps.Name, ClubMatchID: side.cm.ID,
+					Kicks: ps.Kicks, Handballs: ps.Handballs, Marks: ps.Marks, Hitouts: ps.Hitouts,
+					Tackles: ps.Tackles, Goals: ps.Goals, Behinds: ps.Behinds})
+				continue
+			}
+			rc.players = append(rc.players, resolvedPlayer{playerSeasonID: psID, stats: ps})
+		}
+
+		// Rushed behinds = team total behinds − sum of all player behinds from the
+		// stats page. Using the full parsed total (not just matched players) keeps
+		// this accurate even when some players are not yet in the DB.
+		rc.rushedBehinds = max(side.teamBehinds-totalParsedBehinds, 0)
+		clubs = append(clubs, rc)
+	}
+	return clubs, nil
+}
+
+// upsertStatLine returns the params writing a source's stat line for a
+// player season in a club match.
+func upsertStatLine(clubMatchID, playerSeasonID int, ps PlayerStats) domain.UpsertPlayerMatchParams {
+	kicks, handballs, marks, hitouts, tackles, goals, behinds :=
+		ps.Kicks, ps.Handballs, ps.Marks, ps.Hitouts, ps.Tackles, ps.Goals, ps.Behinds
+	return domain.UpsertPlayerMatchParams{
+		ClubMatchID:    clubMatchID,
+		PlayerSeasonID: playerSeasonID,
+		Kicks:          &kicks,
+		Handballs:      &handballs,
+		Marks:          &marks,
+		Hitouts:        &hitouts,
+		Tackles:        &tackles,
+		Goals:          &goals,
+		Behinds:        &behinds,
+		Extra:          ps.Extra,
+	}
+}
+
+// updateClubScore sets a club match's rushed behinds and recalculates its
+// score from its stored player matches.
+func updateClubScore(ctx context.Context, repos WriteRepos, cm domain.ClubMatch, rushedBehinds int) error {
+	playerMatches, err := repos.PlayerMatches.FindByClubMatchID(ctx, cm.ID)
+	if err != nil {
+		return fmt.Errorf("reload player matches: %w", err)
+	}
+	cm.PlayerMatches = playerMatches
+	cm.RushedBehinds = rushedBehinds
+	if err := repos.ClubMatches.UpdateScore(ctx, cm.ID, cm.Score()); err != nil {
+		return fmt.Errorf("update club score: %w", err)
+	}
+	if err := repos.ClubMatches.UpdateRushedBehinds(ctx, cm.ID, rushedBehinds); err != nil {
+		return fmt.Errorf("update rushed behinds: %w", err)
+	}
+	return nil
+}
+
+// publishPlayerMatches publishes AflPlayerMatchUpdated for each stat line.
+func (c *DataOpsCommands) publishPlayerMatches(ctx context.Context, roundID int, playerMatches []domain.PlayerMatch) {
+	for _, pm := range playerMatches {
 		payload, err := json.Marshal(events.AflPlayerMatchUpdatedPayload{
 			PlayerMatchID:  pm.ID,
 			PlayerSeasonID: pm.PlayerSeasonID,
@@ -288,6 +402,7 @@ func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (Impo
 			Tackles:        pm.Tackles,
 			Goals:          pm.Goals,
 			Behinds:        pm.Behinds,
+			Extra:          pm.Extra,
 		})
 		if err != nil {
 			slog.WarnContext(ctx, "marshal AflPlayerMatchUpdated failed", slog.Any("error", err))
@@ -297,16 +412,22 @@ func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (Impo
 			slog.WarnContext(ctx, "publish AflPlayerMatchUpdated failed", slog.Any("error", err))
 		}
 	}
+}
 
-	// Fire one AFL.MatchUpdated(partial) with all written player_season_ids → "playing".
-	statusMap := make(map[int]string, len(allWritten))
-	for _, pm := range allWritten {
-		statusMap[pm.PlayerSeasonID] = "playing"
+// publishMatchPartial fires one AFL.MatchUpdated(partial): written
+// player_season_ids → "playing", other squad members keep any pre-match
+// availability.
+func (c *DataOpsCommands) publishMatchPartial(ctx context.Context, match domain.Match, seasonID, roundID int, written map[int]bool) {
+	match.DataStatus = domain.MatchDataPartial
+	statusMap, err := matchStatusMap(ctx, c.playerSeasons, c.playerMatches, c.availability, match, []domain.ClubMatch{match.Home, match.Away}, written)
+	if err != nil {
+		slog.WarnContext(ctx, "load availability for AflMatchUpdated(partial) failed", slog.Any("error", err))
+		statusMap = domain.MatchPlayerStatuses(domain.MatchDataPartial, nil, written, nil)
 	}
 	if matchUpdPayload, err := json.Marshal(events.AflMatchUpdatedPayload{
-		MatchID:                 matchID,
+		MatchID:                 match.ID,
 		RoundID:                 roundID,
-		SeasonID:                round.SeasonID,
+		SeasonID:                seasonID,
 		MatchStatus:             string(domain.MatchDataPartial),
 		PlayerSeasonIDStatusMap: statusMap,
 	}); err == nil {
@@ -314,8 +435,6 @@ func (c *DataOpsCommands) ImportAFLStats(ctx context.Context, matchID int) (Impo
 			slog.WarnContext(ctx, "publish AflMatchUpdated(partial) failed", slog.Any("error", err))
 		}
 	}
-
-	return result, nil
 }
 
 // MarkMatchStatsFinal sets data_status to "final" (or back to "partial").
@@ -370,37 +489,18 @@ func (c *DataOpsCommands) MarkMatchStatsFinal(ctx context.Context, matchID int,
 	}
 
 	// Build PlayerSeasonIDStatusMap: played players from player_match rows; rest are dnp.
-	homePlayerMatches, _ := c.playerMatches.FindByClubMatchID(ctx, match.Home.ID)
-	awayPlayerMatches, _ := c.playerMatches.FindByClubMatchID(ctx, match.Away.ID)
-
-	playedPSIDs := make(map[int]bool)
-	for _, pm := range append(homePlayerMatches, awayPlayerMatches...) {
-		playedPSIDs[pm.PlayerSeasonID] = true
+	// Use the loaded club_matches (FindByID on match doesn't populate ClubSeasonID).
+	var loaded []domain.ClubMatch
+	if homeErr == nil {
+		loaded = append(loaded, homeClubMatch)
 	}
-
-	// Use the loaded club_match ClubSeasonIDs (FindByID on match doesn't populate ClubSeasonID).
-	homeClubSeasonID := homeClubMatch.ClubSeasonID
-	awayClubSeasonID := awayClubMatch.ClubSeasonID
-	if homeErr != nil {
-		homeClubSeasonID = 0
-	}
-	if awayErr != nil {
-		awayClubSeasonID = 0
+	if awayErr == nil {
+		loaded = append(loaded, awayClubMatch)
 	}
-
-	statusMap := make(map[int]string)
-	for _, clubSeasonID := range []int{homeClubSeasonID, awayClubSeasonID} {
-		if clubSeasonID == 0 {
-			continue
-		}
-		psRows, _ := c.playerSeasons.FindByClubSeasonIDWithPlayer(ctx, clubSeasonID)
-		for _, ps := range psRows {
-			if playedPSIDs[ps.PlayerSeasonID] {
-				statusMap[ps.PlayerSeasonID] = "played"
-			} else {
-				statusMap[ps.PlayerSeasonID] = "dnp"
-			}
-		}
+	statusMap, err := matchStatusMap(ctx, c.playerSeasons, c.playerMatches, c.availability, match, loaded, nil)
+	if err != nil {
+		slog.WarnContext(ctx, "build AflMatchUpdated(final) status map failed", slog.Int("match_id", matchID), slog.Any("error", err))
+		statusMap = map[int]string{}
 	}
 
 	matchUpdPayload, _ := json.Marshal(events.AflMatchUpdatedPayload{
@@ -432,9 +532,22 @@ func (c *DataOpsCommands) recalculateAFLLadder(ctx context.Context, seasonID int
 	return nil
 }
 
-// resolveMid returns the FootyWire mid for a match, scraping the fixture list if needed.
-func (c *DataOpsCommands) resolveMid(ctx context.Context, matchID int, roundName, homeClub, awayClub string) (string, error) {
-	mid, found, err := c.sourceMap.FindByMatchID(ctx, footywireSource, matchID)
+// statsSource returns the name of the stats source to import from: source,
+// or DefaultStatsSource if it is empty.
+func (c *DataOpsCommands) statsSource(source string) (string, error) {
+	if source == "" {
+		source = DefaultStatsSource
+	}
+	if _, ok := c.sources[source]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownStatsSource, source)
+	}
+	return source, nil
+}
+
+// resolveMid returns source's ID for a match, looking it up in the source's
+// fixture if it isn't cached.
+func (c *DataOpsCommands) resolveMid(ctx context.Context, source, roundName string, match FixtureQuery) (string, error) {
+	mid, found, err := c.sourceMap.FindByMatchID(ctx, source, match.MatchID)
 	if err != nil {
 		return "", fmt.Errorf("lookup source map: %w", err)
 	}
@@ -442,19 +555,51 @@ func (c *DataOpsCommands) resolveMid(ctx context.Context, matchID int, roundName
 		return mid, nil
 	}
 
-	mid, err = c.discovery.FindMatchMid(ctx, roundName, homeClub, awayClub)
+	mid, err = c.sources[source].Discovery.FindMatchMid(ctx, roundName, match)
 	if err != nil {
 		return "", fmt.Errorf("discover mid: %w", err)
 	}
 
-	if err := c.sourceMap.Store(ctx, footywireSource, mid, matchID); err != nil {
+	if err := c.sourceMap.Store(ctx, source, mid, match.MatchID); err != nil {
 		// Non-fatal: mid was found, just couldn't cache it.
-		slog.WarnContext(ctx, "failed to cache footywire mid", slog.Int("match_id", matchID), slog.Any("error", err))
+		slog.WarnContext(ctx, "failed to cache source mid", slog.String("source", source), slog.Int("match_id", match.MatchID), slog.Any("error", err))
 	}
 
 	return mid, nil
 }
 
+// resolvePlayerSeason returns the player season a source's player name is
+// for: a mapping confirmed earlier for the source, else the resolver's match
+// among candidates if it is confident enough.
+func (c *DataOpsCommands) resolvePlayerSeason(ctx context.Context, source, seasonStr, clubSeasonStr, clubName string, ps PlayerStats, candidates []PlayerCandidate) (int, bool) {
+	// Check source map before fuzzy matching — auto-resolves previously confirmed mismatches.
+	mappedID, found, err := c.playerSourceMap.FindPlayerSeasonID(ctx, source, seasonStr, clubSeasonStr, ps.Name)
+	if err != nil {
+		slog.WarnContext(ctx, "player source map lookup failed", slog.String("player", ps.Name), slog.Any("error", err))
+	} else if found {
+		return mappedID, true
+	}
+
+	resolveName := ps.Name
+	if ps.CanonicalName != "" {
+		resolveName = ps.CanonicalName
+	}
+	matches, err := c.resolver.Resolve(ctx, resolveName, clubName, candidates)
+	if err != nil {
+		slog.WarnContext(ctx, "resolver error", slog.String("player", ps.Name), slog.Any("error", err))
+		return 0, false
+	}
+	if len(matches) == 0 || matches[0].Confidence < confidenceThreshold {
+		slog.WarnContext(ctx, "no confident match for player",
+			slog.String("player", ps.Name),
+			slog.String("canonicalName", ps.CanonicalName),
+			slog.String("club", clubName),
+		)
+		return 0, false
+	}
+	return matches[0].Candidate.PlayerSeasonID, true
+}
+
 // clubNameForClubSeason resolves the AFL club name for a club_season_id.
 func (c *DataOpsCommands) clubNameForClubSeason(ctx context.Context, clubSeasonID int) (string, error) {
 	cs, err := c.clubSeasons.FindByID(ctx, clubSeasonID)
@@ -496,13 +641,19 @@ type ResolveAFLPlayerMatchParams struct {
 	Tackles        int
 	Goals          int
 	Behinds        int
-	ParsedName     *string // non-nil when the footywire name differed from the matched player name
+	ParsedName     *string // non-nil when the source's name differed from the matched player name
+	// Source is the stats source ParsedName is mapped for; DefaultStatsSource if empty.
+	Source string
 }
 
 // ResolveAFLPlayerMatch persists an optional source name mapping and upserts the player match record.
 // The source mapping (if provided) is written before the transaction so a retry will auto-resolve.
 func (c *DataOpsCommands) ResolveAFLPlayerMatch(ctx context.Context, params ResolveAFLPlayerMatchParams) (domain.PlayerMatch, error) {
 	if params.ParsedName != nil {
+		source, err := c.statsSource(params.Source)
+		if err != nil {
+			return domain.PlayerMatch{}, err
+		}
 		cm, err := c.clubMatches.FindByID(ctx, params.ClubMatchID)
 		if err != nil {
 			return domain.PlayerMatch{}, fmt.Errorf("load club match for source mapping: %w", err)
@@ -513,7 +664,7 @@ func (c *DataOpsCommands) ResolveAFLPlayerMatch(ctx context.Context, params Reso
 		}
 		seasonStr := strconv.Itoa(cs.SeasonID)
 		clubSeasonStr := strconv.Itoa(cm.ClubSeasonID)
-		if err := c.playerSourceMap.Store(ctx, footywireSource, seasonStr, clubSeasonStr, *params.ParsedName, params.PlayerSeasonID); err != nil {
+		if err := c.playerSourceMap.Store(ctx, source, seasonStr, clubSeasonStr, *params.ParsedName, params.PlayerSeasonID); err != nil {
 			return domain.PlayerMatch{}, fmt.Errorf("store player source mapping: %w", err)
 		}
 	}
@@ -576,6 +727,7 @@ func (c *DataOpsCommands) ResolveAFLPlayerMatch(ctx context.Context, params Reso
 		Tackles:        result.Tackles,
 		Goals:          result.Goals,
 		Behinds:        result.Behinds,
+		Extra:          result.Extra,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to marshal AflPlayerMatchUpdated event", slog.Any("error", err))
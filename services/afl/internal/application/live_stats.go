@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"xffl/services/afl/internal/domain"
+	"xffl/shared/clock"
+)
+
+// LiveStatsConfig sets which matches the live stats poller considers in
+// progress.
+type LiveStatsConfig struct {
+	// MatchLength is how long after its start a match is expected to end.
+	MatchLength time.Duration
+	// Grace is how long polling carries on after the expected end, for
+	// matches that run late and stats corrected after the siren.
+	Grace time.Duration
+}
+
+// LiveStatsCommands keeps stats for AFL matches in progress up to date, so
+// FFL scores move during a round without an admin importing each match.
+type LiveStatsCommands struct {
+	clock   clock.Clock
+	matches domain.MatchRepository
+	dataOps *DataOpsCommands
+	config  LiveStatsConfig
+}
+
+func NewLiveStatsCommands(clk clock.Clock, matches domain.MatchRepository, dataOps *DataOpsCommands, config LiveStatsConfig) *LiveStatsCommands {
+	return &LiveStatsCommands{clock: clk, matches: matches, dataOps: dataOps, config: config}
+}
+
+// PollLiveMatches re-imports stats for every match that has started, isn't
+// final, and is within MatchLength + Grace of its start. Only stat lines that
+// changed since the last import are published. Called on an interval; a
+// match whose stats can't be imported yet (FootyWire may not list it until
+// the first quarter is underway) is logged and tried again next time.
+func (c *LiveStatsCommands) PollLiveMatches(ctx context.Context) error {
+	now := c.clock.Now()
+	matches, err := c.matches.FindUnfinishedStartedBetween(ctx, now.Add(-(c.config.MatchLength + c.config.Grace)), now)
+	if err != nil {
+		return fmt.Errorf("find matches in progress: %w", err)
+	}
+	for _, m := range matches {
+		result, err := c.dataOps.ImportAFLStats(ctx, m.ID, DefaultStatsSource)
+		if err != nil {
+			slog.WarnContext(ctx, "live stats import failed", slog.Int("match_id", m.ID), slog.Any("error", err))
+			continue
+		}
+		slog.InfoContext(ctx, "live stats imported",
+			slog.Int("match_id", m.ID),
+			slog.Int("changed", result.ChangedPlayerCount),
+			slog.Int("unmatched", len(result.UnmatchedPlayers)),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,531 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"xffl/services/afl/internal/domain"
+	"xffl/shared/namematch"
+)
+
+var (
+	ErrSeasonNotSetUp         = errors.New("season not set up")
+	ErrEmptyPlayerList        = errors.New("player list is empty")
+	ErrNoPlayerLists          = errors.New("source has no player lists")
+	ErrUnknownListClub        = errors.New("player list names clubs without a club season")
+	ErrPlayerProposalNotFound = errors.New("player proposal not found")
+	ErrPlayerProposalDecided  = errors.New("player proposal already decided")
+)
+
+// PlayerProposalKind is the change a player proposal makes if accepted.
+type PlayerProposalKind string
+
+const (
+	PlayerProposalNew        PlayerProposalKind = "new"        // adds the player, with a player season at the listed club
+	PlayerProposalContinuing PlayerProposalKind = "continuing" // gives a stored player a player season at the listed club
+	PlayerProposalRetiring   PlayerProposalKind = "retiring"   // last season's player isn't listed; nothing is written
+)
+
+// PlayerProposalStatus is whether an admin has decided a player proposal.
+type PlayerProposalStatus string
+
+const (
+	PlayerProposalPending  PlayerProposalStatus = "pending"
+	PlayerProposalAccepted PlayerProposalStatus = "accepted"
+	PlayerProposalRejected PlayerProposalStatus = "rejected"
+)
+
+// PlayerProposal is one change an AFL season player import proposes.
+type PlayerProposal struct {
+	ID       int
+	SeasonID int
+	Source   string // the stats source the list came from; empty for a list from elsewhere
+	Kind     PlayerProposalKind
+	Status   PlayerProposalStatus
+	// ListedName is the player's name as listed; empty for a retirement.
+	ListedName string
+	// ClubSeasonID is the club season the player is listed at, or for a
+	// retirement, the one they played last season.
+	ClubSeasonID int
+	ClubName     string
+	PlayerID     int // the stored player; 0 for a new player
+	PlayerName   string
+	// PreviousClubName is a continuing player's club in their latest season.
+	PreviousClubName string
+	Confidence       float64 // of a continuing player's name match
+	PlayerSeasonID   int     // the player season accepting the proposal created
+	CreatedAt        time.Time
+	DecidedAt        *time.Time
+}
+
+// ProposeAFLPlayerImportParams are the inputs to ProposeAFLPlayerImport.
+type ProposeAFLPlayerImportParams struct {
+	Year int
+	// Source is the stats source whose player lists are read; DefaultStatsSource
+	// if empty. When Players is given it only names where they came from, and
+	// may be empty.
+	Source string
+	// Players is a list read from elsewhere, such as a file. The source's
+	// lists are read when it is nil.
+	Players []ListedPlayer
+}
+
+// ProposeAFLPlayerImportResult reports a player import: how the list compared
+// with the stored players, and the proposals awaiting review.
+type ProposeAFLPlayerImportResult struct {
+	SeasonID   int
+	SeasonName string
+	Listed     int
+	// InSeason is how many listed players already have a player season in
+	// the season; they aren't proposed.
+	InSeason int
+	// Decided is how many listed players, and retirements, were decided in an
+	// earlier review; they aren't proposed again.
+	Decided   int
+	Proposals []PlayerProposal
+}
+
+// PlayerImportCommands proposes a season's AFL players from each club's list,
+// and writes the proposals an admin accepts.
+type PlayerImportCommands struct {
+	tx            TxManager
+	seasons       domain.SeasonRepository
+	clubs         domain.ClubRepository
+	clubSeasons   domain.ClubSeasonRepository
+	players       domain.PlayerRepository
+	playerSeasons domain.PlayerSeasonRepository
+	playerSources DataopsPlayerSourceRepository
+	proposals     DataopsPlayerProposalRepository
+	sources       map[string]StatsSource
+	resolver      PlayerResolver
+	matcher       *namematch.Matcher
+}
+
+func NewPlayerImportCommands(
+	tx TxManager,
+	seasons domain.SeasonRepository,
+	clubs domain.ClubRepository,
+	clubSeasons domain.ClubSeasonRepository,
+	players domain.PlayerRepository,
+	playerSeasons domain.PlayerSeasonRepository,
+	playerSources DataopsPlayerSourceRepository,
+	proposals DataopsPlayerProposalRepository,
+	sources map[string]StatsSource,
+	resolver PlayerResolver,
+) *PlayerImportCommands {
+	return &PlayerImportCommands{
+		tx:            tx,
+		seasons:       seasons,
+		clubs:         clubs,
+		clubSeasons:   clubSeasons,
+		players:       players,
+		playerSeasons: playerSeasons,
+		playerSources: playerSources,
+		proposals:     proposals,
+		sources:       sources,
+		resolver:      resolver,
+		matcher:       namematch.New(namematch.AFLClubs),
+	}
+}
+
+// squadPlayer is a player season of a season, with its club's name.
+type squadPlayer struct {
+	domain.PlayerSeasonWithPlayer
+	clubName string
+}
+
+// ProposeAFLPlayerImport compares each club's list for a season with the
+// stored players and proposes the changes for review, replacing the season's
+// pending proposals. A listed player is matched to last season's players by
+// name, with their listed club as a hint, then to any stored player sharing
+// their surname: a match continues, and anyone else is new. Last season's
+// players nobody listed are proposed as retiring. Nothing is written to the
+// player tables until a proposal is accepted.
+//
+// Listed players already in the season, by a confirmed source mapping or by
+// name, aren't proposed, so the import can be re-run as lists change; nor are
+// proposals decided in an earlier review.
+func (c *PlayerImportCommands) ProposeAFLPlayerImport(ctx context.Context, params ProposeAFLPlayerImportParams) (ProposeAFLPlayerImportResult, error) {
+	source := params.Source
+	listed := params.Players
+	if listed == nil {
+		if source == "" {
+			source = DefaultStatsSource
+		}
+		s, ok := c.sources[source]
+		if !ok {
+			return ProposeAFLPlayerImportResult{}, fmt.Errorf("%w: %q", ErrUnknownStatsSource, source)
+		}
+		if s.Players == nil {
+			return ProposeAFLPlayerImportResult{}, fmt.Errorf("%w: %s", ErrNoPlayerLists, source)
+		}
+		var err error
+		if listed, err = s.Players.ListPlayers(ctx, params.Year); err != nil {
+			return ProposeAFLPlayerImportResult{}, fmt.Errorf("list %s players: %w", source, err)
+		}
+	}
+	if len(listed) == 0 {
+		return ProposeAFLPlayerImportResult{}, ErrEmptyPlayerList
+	}
+
+	result := ProposeAFLPlayerImportResult{SeasonName: SeasonName(params.Year)}
+	season, found, err := c.seasons.FindByName(ctx, result.SeasonName)
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("find season: %w", err)
+	}
+	if !found {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("%w: %s", ErrSeasonNotSetUp, result.SeasonName)
+	}
+	result.SeasonID = season.ID
+
+	clubs, err := c.clubs.FindAll(ctx)
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("load clubs: %w", err)
+	}
+	clubsByName := make(map[string]domain.Club, len(clubs))
+	clubNames := make(map[int]string, len(clubs)) // club ID → name
+	for _, club := range clubs {
+		clubsByName[club.Name] = club
+		clubNames[club.ID] = club.Name
+	}
+
+	clubSeasons, current, err := c.loadSquads(ctx, season.ID, clubNames)
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, err
+	}
+	var previous []squadPlayer
+	prevSeason, found, err := c.seasons.FindByName(ctx, SeasonName(params.Year-1))
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("find previous season: %w", err)
+	}
+	if found {
+		if _, previous, err = c.loadSquads(ctx, prevSeason.ID, clubNames); err != nil {
+			return ProposeAFLPlayerImportResult{}, err
+		}
+	}
+
+	stored, err := c.proposals.FindBySeasonID(ctx, season.ID)
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("load proposals: %w", err)
+	}
+	decidedListings := make(map[string]bool)
+	decidedRetirements := make(map[int]bool) // player ID
+	for _, p := range stored {
+		switch {
+		case p.Status == PlayerProposalPending:
+		case p.Kind == PlayerProposalRetiring:
+			decidedRetirements[p.PlayerID] = true
+		default:
+			decidedListings[listingKey(p.ClubSeasonID, p.ListedName)] = true
+		}
+	}
+
+	// Resolve every club first so all unknown clubs are reported at once.
+	listedClubSeasons := make([]domain.ClubSeason, len(listed))
+	var unknown []string
+	for i, lp := range listed {
+		club, ok := findClub(c.matcher, clubsByName, lp.Club)
+		cs, hasSeason := clubSeasons[club.ID]
+		if !ok || !hasSeason {
+			if !slices.Contains(unknown, fmt.Sprintf("%q", lp.Club)) {
+				unknown = append(unknown, fmt.Sprintf("%q", lp.Club))
+			}
+			continue
+		}
+		listedClubSeasons[i] = cs
+	}
+	if len(unknown) > 0 {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("%w: %s", ErrUnknownListClub, strings.Join(unknown, ", "))
+	}
+
+	inSeason := make(map[int]bool, len(current)) // player ID
+	for _, sp := range current {
+		inSeason[sp.PlayerID] = true
+	}
+	claimed := make(map[int]bool) // player IDs proposed as continuing
+	seen := make(map[string]bool)
+	var proposals []PlayerProposal
+	for i, lp := range listed {
+		cs := listedClubSeasons[i]
+		clubName := clubNames[cs.ClubID]
+		key := listingKey(cs.ID, lp.Name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result.Listed++
+
+		if c.listedInSeason(ctx, source, season.ID, cs.ID, lp.Name, clubName, current) {
+			result.InSeason++
+			continue
+		}
+		if decidedListings[key] {
+			result.Decided++
+			continue
+		}
+
+		p := PlayerProposal{
+			SeasonID:     season.ID,
+			Source:       source,
+			Kind:         PlayerProposalNew,
+			Status:       PlayerProposalPending,
+			ListedName:   lp.Name,
+			ClubSeasonID: cs.ID,
+			ClubName:     clubName,
+		}
+		pool := slices.DeleteFunc(slices.Clone(previous), func(sp squadPlayer) bool { return claimed[sp.PlayerID] || inSeason[sp.PlayerID] })
+		match, ok := c.resolve(ctx, lp.Name, clubName, pool)
+		if !ok {
+			// Not in last season's squads: a player returning after a year
+			// or more away, by surname.
+			if pool, err = c.returningPlayers(ctx, lp.Name, previous, inSeason, claimed); err != nil {
+				return ProposeAFLPlayerImportResult{}, err
+			}
+			match, ok = c.resolve(ctx, lp.Name, clubName, pool)
+		}
+		if ok {
+			p.Kind = PlayerProposalContinuing
+			p.PlayerID, p.PlayerName = match.sp.PlayerID, match.sp.Name
+			p.PreviousClubName = match.sp.clubName
+			p.Confidence = match.confidence
+			claimed[match.sp.PlayerID] = true
+		}
+		proposals = append(proposals, p)
+	}
+
+	for _, sp := range previous {
+		if claimed[sp.PlayerID] || inSeason[sp.PlayerID] {
+			continue
+		}
+		if decidedRetirements[sp.PlayerID] {
+			result.Decided++
+			continue
+		}
+		proposals = append(proposals, PlayerProposal{
+			SeasonID:     season.ID,
+			Source:       source,
+			Kind:         PlayerProposalRetiring,
+			Status:       PlayerProposalPending,
+			ClubSeasonID: sp.ClubSeasonID,
+			ClubName:     sp.clubName,
+			PlayerID:     sp.PlayerID,
+			PlayerName:   sp.Name,
+		})
+	}
+
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		result.Proposals, err = repos.Proposals.ReplacePending(ctx, season.ID, proposals)
+		return err
+	})
+	if err != nil {
+		return ProposeAFLPlayerImportResult{}, fmt.Errorf("store proposals: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerProposals lists a season's player proposals, or only those with
+// status if it isn't empty.
+func (c *PlayerImportCommands) PlayerProposals(ctx context.Context, seasonID int, status PlayerProposalStatus) ([]PlayerProposal, error) {
+	proposals, err := c.proposals.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if status != "" {
+		proposals = slices.DeleteFunc(proposals, func(p PlayerProposal) bool { return p.Status != status })
+	}
+	return proposals, nil
+}
+
+// AcceptPlayerProposals writes pending proposals in one transaction: a new
+// player is added, and they or a continuing player are given a player season
+// at the listed club. A continuing player listed under another name is mapped
+// to it for the list's source, so its stats import resolves them. An accepted
+// retirement writes nothing; the player isn't proposed again.
+func (c *PlayerImportCommands) AcceptPlayerProposals(ctx context.Context, ids []int) ([]PlayerProposal, error) {
+	return c.decide(ctx, ids, PlayerProposalAccepted)
+}
+
+// RejectPlayerProposals rejects pending proposals without writing them; they
+// aren't proposed again.
+func (c *PlayerImportCommands) RejectPlayerProposals(ctx context.Context, ids []int) ([]PlayerProposal, error) {
+	return c.decide(ctx, ids, PlayerProposalRejected)
+}
+
+func (c *PlayerImportCommands) decide(ctx context.Context, ids []int, status PlayerProposalStatus) ([]PlayerProposal, error) {
+	var decided []PlayerProposal
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		proposals, err := repos.Proposals.FindByIDs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("load proposals: %w", err)
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(proposals, func(p PlayerProposal) bool { return p.ID == id }) {
+				return fmt.Errorf("%w: %d", ErrPlayerProposalNotFound, id)
+			}
+		}
+		for _, p := range proposals {
+			if p.Status != PlayerProposalPending {
+				return fmt.Errorf("proposal %d: %w", p.ID, ErrPlayerProposalDecided)
+			}
+			var playerSeasonID int
+			if status == PlayerProposalAccepted {
+				if playerSeasonID, err = acceptPlayerProposal(ctx, repos, p); err != nil {
+					return fmt.Errorf("accept proposal %d: %w", p.ID, err)
+				}
+			}
+			if err := repos.Proposals.Decide(ctx, p.ID, status, playerSeasonID); err != nil {
+				return fmt.Errorf("proposal %d: %w", p.ID, err)
+			}
+		}
+		decided, err = repos.Proposals.FindByIDs(ctx, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decided, nil
+}
+
+// acceptPlayerProposal writes a proposal, returning the player season it
+// created, if any.
+func acceptPlayerProposal(ctx context.Context, repos WriteRepos, p PlayerProposal) (int, error) {
+	switch p.Kind {
+	case PlayerProposalNew:
+		player, err := repos.Players.Create(ctx, p.ListedName)
+		if err != nil {
+			return 0, fmt.Errorf("create player: %w", err)
+		}
+		ps, err := repos.PlayerSeasons.Create(ctx, player.ID, p.ClubSeasonID)
+		if err != nil {
+			return 0, fmt.Errorf("create player season: %w", err)
+		}
+		return ps.ID, nil
+	case PlayerProposalContinuing:
+		ps, err := repos.PlayerSeasons.Create(ctx, p.PlayerID, p.ClubSeasonID)
+		if err != nil {
+			return 0, fmt.Errorf("create player season: %w", err)
+		}
+		if p.Source != "" && p.ListedName != p.PlayerName {
+			err := repos.PlayerSources.Store(ctx, p.Source, strconv.Itoa(p.SeasonID), strconv.Itoa(p.ClubSeasonID), p.ListedName, ps.ID)
+			if err != nil {
+				return 0, fmt.Errorf("store player source mapping: %w", err)
+			}
+		}
+		return ps.ID, nil
+	default:
+		return 0, nil
+	}
+}
+
+// loadSquads loads a season's club seasons, by club ID, and its player
+// seasons.
+func (c *PlayerImportCommands) loadSquads(ctx context.Context, seasonID int, clubNames map[int]string) (map[int]domain.ClubSeason, []squadPlayer, error) {
+	clubSeasons, err := c.clubSeasons.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load club seasons: %w", err)
+	}
+	byClub := make(map[int]domain.ClubSeason, len(clubSeasons))
+	var squads []squadPlayer
+	for _, cs := range clubSeasons {
+		byClub[cs.ClubID] = cs
+		rows, err := c.playerSeasons.FindByClubSeasonIDWithPlayer(ctx, cs.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load players of club season %d: %w", cs.ID, err)
+		}
+		for _, r := range rows {
+			squads = append(squads, squadPlayer{PlayerSeasonWithPlayer: r, clubName: clubNames[cs.ClubID]})
+		}
+	}
+	return byClub, squads, nil
+}
+
+// listedInSeason reports whether a listed player already has a player season
+// in the season: by the source's confirmed mapping, else by name.
+func (c *PlayerImportCommands) listedInSeason(ctx context.Context, source string, seasonID, clubSeasonID int, name, clubName string, current []squadPlayer) bool {
+	if source != "" {
+		_, found, err := c.playerSources.FindPlayerSeasonID(ctx, source, strconv.Itoa(seasonID), strconv.Itoa(clubSeasonID), name)
+		if err != nil {
+			slog.WarnContext(ctx, "player source map lookup failed", slog.String("player", name), slog.Any("error", err))
+		} else if found {
+			return true
+		}
+	}
+	_, ok := c.resolve(ctx, name, clubName, current)
+	return ok
+}
+
+// returningPlayers lists stored players sharing name's surname who aren't in
+// last season's squads, this season's, or already claimed, each with their
+// latest player season.
+func (c *PlayerImportCommands) returningPlayers(ctx context.Context, name string, previous []squadPlayer, inSeason, claimed map[int]bool) ([]squadPlayer, error) {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	found, err := c.players.Search(ctx, fields[len(fields)-1])
+	if err != nil {
+		return nil, fmt.Errorf("search players: %w", err)
+	}
+	var pool []squadPlayer
+	for _, p := range found {
+		if inSeason[p.ID] || claimed[p.ID] || slices.ContainsFunc(previous, func(sp squadPlayer) bool { return sp.PlayerID == p.ID }) {
+			continue
+		}
+		ps, ok, err := c.playerSeasons.FindLatestByPlayerID(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("find latest season of player %d: %w", p.ID, err)
+		}
+		if !ok {
+			continue
+		}
+		sp := squadPlayer{PlayerSeasonWithPlayer: domain.PlayerSeasonWithPlayer{
+			PlayerSeasonID: ps.ID,
+			PlayerID:       p.ID,
+			ClubSeasonID:   ps.ClubSeasonID,
+			Name:           p.Name,
+		}}
+		if cs, err := c.clubSeasons.FindByID(ctx, ps.ClubSeasonID); err == nil {
+			if club, err := c.clubs.FindByID(ctx, cs.ClubID); err == nil {
+				sp.clubName = club.Name
+			}
+		}
+		pool = append(pool, sp)
+	}
+	return pool, nil
+}
+
+type squadMatch struct {
+	sp         squadPlayer
+	confidence float64
+}
+
+// resolve finds the player in pool name confidently matches, with clubName
+// as a hint.
+func (c *PlayerImportCommands) resolve(ctx context.Context, name, clubName string, pool []squadPlayer) (squadMatch, bool) {
+	if len(pool) == 0 {
+		return squadMatch{}, false
+	}
+	candidates := make([]PlayerCandidate, len(pool))
+	byID := make(map[int]squadPlayer, len(pool))
+	for i, sp := range pool {
+		candidates[i] = PlayerCandidate{PlayerSeasonID: sp.PlayerSeasonID, Name: sp.Name, Club: sp.clubName}
+		byID[sp.PlayerSeasonID] = sp
+	}
+	matches, err := c.resolver.Resolve(ctx, name, clubName, candidates)
+	if err != nil || len(matches) == 0 || matches[0].Confidence < confidenceThreshold {
+		return squadMatch{}, false
+	}
+	return squadMatch{sp: byID[matches[0].Candidate.PlayerSeasonID], confidence: matches[0].Confidence}, true
+}
+
+// listingKey identifies a listed player by their club season and normalised
+// name.
+func listingKey(clubSeasonID int, name string) string {
+	return strconv.Itoa(clubSeasonID) + "/" + namematch.Normalise(name)
+}
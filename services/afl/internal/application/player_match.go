@@ -61,6 +61,7 @@ func (c *Commands) UpdatePlayerMatch(ctx context.Context, params domain.UpsertPl
 		Tackles:        result.Tackles,
 		Goals:          result.Goals,
 		Behinds:        result.Behinds,
+		Extra:          result.Extra,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to marshal AflPlayerMatchUpdated event", slog.Any("error", err))
@@ -0,0 +1,208 @@
+package application
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"xffl/contracts/events"
+	"xffl/services/afl/internal/domain"
+	"xffl/shared/namematch"
+)
+
+// DefaultDuplicateConfidence is the lowest name similarity at which two
+// players are suggested as duplicates when the caller doesn't choose one.
+const DefaultDuplicateConfidence = 0.8
+
+var (
+	ErrMergeSamePlayer  = errors.New("a player can't be merged into themselves")
+	ErrMergeSharedMatch = errors.New("both players have stats for the same match")
+)
+
+// MergeAFLPlayersResult describes a merge. MovedPlayerSeasonIDs are the
+// duplicate's player seasons now the survivor's; MergedPlayerSeasonIDs maps
+// each duplicate player season at a club season the survivor already had to
+// the survivor's player season there.
+type MergeAFLPlayersResult struct {
+	Survivor              domain.Player
+	DuplicateID           int
+	MovedPlayerSeasonIDs  []int
+	MergedPlayerSeasonIDs map[int]int
+}
+
+// MergeAFLPlayers merges a duplicate player into survivor in one transaction.
+// The duplicate's player seasons move to the survivor, except at a club
+// season the survivor already has a player season: there the duplicate's
+// player matches, availability and source names move to the survivor's
+// player season, and the duplicate's is deleted. The duplicate player is
+// then deleted, and FFL is notified via AFL.PlayerMerged.
+//
+// The merge fails with ErrMergeSharedMatch if both players have stats for
+// the same match, as one person can't have played it twice.
+func (c *DataOpsCommands) MergeAFLPlayers(ctx context.Context, survivorID, duplicateID int) (MergeAFLPlayersResult, error) {
+	if survivorID == duplicateID {
+		return MergeAFLPlayersResult{}, ErrMergeSamePlayer
+	}
+	result := MergeAFLPlayersResult{DuplicateID: duplicateID, MergedPlayerSeasonIDs: map[int]int{}}
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		survivor, err := repos.Players.FindByID(ctx, survivorID)
+		if err != nil {
+			return fmt.Errorf("load player %d: %w", survivorID, err)
+		}
+		if _, err := repos.Players.FindByID(ctx, duplicateID); err != nil {
+			return fmt.Errorf("load player %d: %w", duplicateID, err)
+		}
+		result.Survivor = survivor
+
+		survivorSeasons, err := repos.PlayerSeasons.FindByPlayerID(ctx, survivorID)
+		if err != nil {
+			return fmt.Errorf("load player seasons: %w", err)
+		}
+		byClubSeason := make(map[int]int, len(survivorSeasons))
+		for _, ps := range survivorSeasons {
+			byClubSeason[ps.ClubSeasonID] = ps.ID
+		}
+		duplicateSeasons, err := repos.PlayerSeasons.FindByPlayerID(ctx, duplicateID)
+		if err != nil {
+			return fmt.Errorf("load player seasons: %w", err)
+		}
+
+		for _, ps := range duplicateSeasons {
+			target, ok := byClubSeason[ps.ClubSeasonID]
+			if !ok {
+				if err := repos.PlayerSeasons.Reassign(ctx, ps.ID, survivorID); err != nil {
+					return fmt.Errorf("move player season %d: %w", ps.ID, err)
+				}
+				result.MovedPlayerSeasonIDs = append(result.MovedPlayerSeasonIDs, ps.ID)
+				continue
+			}
+			if err := mergePlayerSeason(ctx, repos, ps.ID, target); err != nil {
+				return err
+			}
+			result.MergedPlayerSeasonIDs[ps.ID] = target
+		}
+
+		if err := repos.Players.Delete(ctx, duplicateID); err != nil {
+			return fmt.Errorf("delete player %d: %w", duplicateID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return MergeAFLPlayersResult{}, err
+	}
+
+	slog.InfoContext(ctx, "merged AFL players",
+		slog.Int("survivor_player_id", survivorID),
+		slog.Int("duplicate_player_id", duplicateID),
+		slog.Int("moved_player_seasons", len(result.MovedPlayerSeasonIDs)),
+		slog.Int("merged_player_seasons", len(result.MergedPlayerSeasonIDs)),
+	)
+
+	payload, err := json.Marshal(events.AflPlayerMergedPayload{
+		SurvivorPlayerID:  survivorID,
+		DuplicatePlayerID: duplicateID,
+		PlayerSeasonIDs:   result.MergedPlayerSeasonIDs,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal AflPlayerMerged event", slog.Any("error", err))
+		return result, nil
+	}
+	if err := c.dispatcher.Publish(ctx, events.AflPlayerMerged, payload); err != nil {
+		slog.ErrorContext(ctx, "failed to publish AflPlayerMerged event", slog.Any("error", err))
+	}
+	return result, nil
+}
+
+// mergePlayerSeason moves a duplicate player season's player matches,
+// availability and source names to target, then deletes it.
+func mergePlayerSeason(ctx context.Context, repos WriteRepos, duplicate, target int) error {
+	duplicateMatches, err := repos.PlayerMatches.FindByPlayerSeasonID(ctx, duplicate)
+	if err != nil {
+		return fmt.Errorf("load player matches: %w", err)
+	}
+	targetMatches, err := repos.PlayerMatches.FindByPlayerSeasonID(ctx, target)
+	if err != nil {
+		return fmt.Errorf("load player matches: %w", err)
+	}
+	played := make(map[int]bool, len(targetMatches))
+	for _, pm := range targetMatches {
+		played[pm.ClubMatchID] = true
+	}
+	for _, pm := range duplicateMatches {
+		if played[pm.ClubMatchID] {
+			return fmt.Errorf("%w: club match %d", ErrMergeSharedMatch, pm.ClubMatchID)
+		}
+	}
+
+	if err := repos.PlayerMatches.ReassignPlayerSeason(ctx, duplicate, target); err != nil {
+		return fmt.Errorf("move player matches: %w", err)
+	}
+	if err := repos.Availability.ReassignPlayerSeason(ctx, duplicate, target); err != nil {
+		return fmt.Errorf("move availability: %w", err)
+	}
+	if err := repos.PlayerSources.ReassignPlayerSeason(ctx, duplicate, target); err != nil {
+		return fmt.Errorf("move source names: %w", err)
+	}
+	if err := repos.PlayerSeasons.Delete(ctx, duplicate); err != nil {
+		return fmt.Errorf("delete player season %d: %w", duplicate, err)
+	}
+	return nil
+}
+
+// PlayerDuplicate is two players who are likely the same person, each with
+// their most recent player season.
+type PlayerDuplicate struct {
+	Player     PlayerWithLatestSeason
+	Other      PlayerWithLatestSeason
+	Confidence float64
+}
+
+// PlayerDuplicates suggests players who are likely duplicates: players with
+// the same surname who never played in the same match, and whose names are
+// at least minConfidence alike (DefaultDuplicateConfidence if zero). The
+// likeliest come first.
+func (q *Queries) PlayerDuplicates(ctx context.Context, minConfidence float64) ([]PlayerDuplicate, error) {
+	if minConfidence == 0 {
+		minConfidence = DefaultDuplicateConfidence
+	}
+	pairs, err := q.players.FindDuplicateCandidates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find duplicate candidates: %w", err)
+	}
+
+	var out []PlayerDuplicate
+	latest := map[int]PlayerWithLatestSeason{}
+	withLatest := func(p domain.Player) (PlayerWithLatestSeason, error) {
+		if pl, ok := latest[p.ID]; ok {
+			return pl, nil
+		}
+		ps, found, err := q.playerSeasons.FindLatestByPlayerID(ctx, p.ID)
+		if err != nil {
+			return PlayerWithLatestSeason{}, err
+		}
+		pl := PlayerWithLatestSeason{Player: p, LatestSeasonID: ps.ID, HasLatestSeason: found}
+		latest[p.ID] = pl
+		return pl, nil
+	}
+	for _, pair := range pairs {
+		conf := namematch.Similarity(pair.Player.Name, pair.Other.Name)
+		if conf < minConfidence {
+			continue
+		}
+		player, err := withLatest(pair.Player)
+		if err != nil {
+			return nil, fmt.Errorf("load latest player season: %w", err)
+		}
+		other, err := withLatest(pair.Other)
+		if err != nil {
+			return nil, fmt.Errorf("load latest player season: %w", err)
+		}
+		out = append(out, PlayerDuplicate{Player: player, Other: other, Confidence: conf})
+	}
+	slices.SortStableFunc(out, func(a, b PlayerDuplicate) int { return cmp.Compare(b.Confidence, a.Confidence) })
+	return out, nil
+}
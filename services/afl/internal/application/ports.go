@@ -1,6 +1,11 @@
 package application
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"xffl/services/afl/internal/domain"
+)
 
 // PlayerCandidate is a known AFL player available for fuzzy name matching.
 type PlayerCandidate struct {
@@ -29,7 +34,11 @@ type MatchStats struct {
 	HomeTeamBehinds int
 	AwayTeamGoals   int
 	AwayTeamBehinds int
-	Players         []PlayerStats
+	// HomeQuarters and AwayQuarters are the scoreboard at the end of each
+	// quarter; nil when the source doesn't give a quarter-by-quarter score.
+	HomeQuarters []domain.QuarterScore
+	AwayQuarters []domain.QuarterScore
+	Players      []PlayerStats
 }
 
 // PlayerStats is one player's stats from a match page.
@@ -44,6 +53,7 @@ type PlayerStats struct {
 	Tackles       int
 	Goals         int
 	Behinds       int
+	Extra         domain.ExtraStats // stats beyond the core ones, as far as the source has them
 }
 
 // StatsParser parses player stats for a single match from an external source.
@@ -54,7 +64,59 @@ type StatsParser interface {
 
 // FixtureDiscovery finds the external source ID for a match when not already cached.
 type FixtureDiscovery interface {
-	FindMatchMid(ctx context.Context, roundName, homeClub, awayClub string) (string, error)
+	FindMatchMid(ctx context.Context, roundName string, match FixtureQuery) (string, error)
+	// FindRoundMids finds several matches of a round from one fetch of the
+	// fixture list, keyed by FixtureQuery.MatchID. Matches not in the fixture
+	// are left out.
+	FindRoundMids(ctx context.Context, roundName string, matches []FixtureQuery) (map[int]FixtureMatch, error)
+	// ListSeasonFixture lists every match of a season's fixture, round by
+	// round in the order the source lists them.
+	ListSeasonFixture(ctx context.Context, year int) ([]FixtureListing, error)
+}
+
+// FixtureQuery identifies a match to find in a source's fixture.
+type FixtureQuery struct {
+	MatchID   int
+	HomeClub  string
+	AwayClub  string
+	StartTime time.Time // for sources covering more than the current season
+}
+
+// FixtureMatch is a match as listed in a source's fixture.
+type FixtureMatch struct {
+	Mid      string
+	Complete bool // the fixture shows the match's final score
+}
+
+// FixtureListing is a match as listed in a season's fixture. Clubs are named
+// as the source writes them.
+type FixtureListing struct {
+	RoundName string
+	HomeClub  string
+	AwayClub  string
+	Venue     string
+	StartTime time.Time // zero when the source has no time for the match yet
+}
+
+// ListedPlayer is a player on a club's list for a season, as a source names
+// them.
+type ListedPlayer struct {
+	Name string
+	Club string
+}
+
+// PlayerListSource lists each club's players for a season.
+type PlayerListSource interface {
+	ListPlayers(ctx context.Context, year int) ([]ListedPlayer, error)
+}
+
+// StatsSource is an external source of match stats: its stats parser and the
+// fixture its match IDs are found in. Its match and player mappings are
+// stored under the name it is registered by.
+type StatsSource struct {
+	Parser    StatsParser
+	Discovery FixtureDiscovery
+	Players   PlayerListSource // nil if the source has no player lists
 }
 
 // DataopsMatchSourceRepository persists the mapping of afl.match_id → external source ID
@@ -77,4 +139,55 @@ type PlayerSourceKey struct {
 type DataopsPlayerSourceRepository interface {
 	FindPlayerSeasonID(ctx context.Context, source, externalSeason, externalClub, externalPlayer string) (playerSeasonID int, found bool, err error)
 	Store(ctx context.Context, source, externalSeason, externalClub, externalPlayer string, playerSeasonID int) error
+	// ReassignPlayerSeason points a player season's source names at another.
+	ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error
+}
+
+// DataopsStatsDiscrepancyRepository persists the discrepancies found the last
+// time each match's stats were verified.
+type DataopsStatsDiscrepancyRepository interface {
+	// ReplaceForMatch replaces a match's discrepancies with discrepancies.
+	ReplaceForMatch(ctx context.Context, matchID int, discrepancies []StatsDiscrepancy) error
+	FindByMatchID(ctx context.Context, matchID int) ([]StatsDiscrepancy, error)
+}
+
+// DataopsStatsPreviewRepository persists stats imports previewed for review,
+// until one is applied.
+type DataopsStatsPreviewRepository interface {
+	Create(ctx context.Context, preview StatsPreview) (StatsPreview, error)
+	FindByID(ctx context.Context, id int) (StatsPreview, error)
+	// MarkApplied marks a preview applied, returning ErrStatsPreviewApplied
+	// if it already was.
+	MarkApplied(ctx context.Context, id int) error
+}
+
+// DataopsPlayerProposalRepository persists the proposals of AFL season player
+// imports until an admin decides them.
+type DataopsPlayerProposalRepository interface {
+	// ReplacePending replaces a season's pending proposals with proposals,
+	// returning them with their IDs.
+	ReplacePending(ctx context.Context, seasonID int, proposals []PlayerProposal) ([]PlayerProposal, error)
+	FindBySeasonID(ctx context.Context, seasonID int) ([]PlayerProposal, error)
+	FindByIDs(ctx context.Context, ids []int) ([]PlayerProposal, error)
+	// Decide records a pending proposal's decision, and the player season
+	// accepting it created, returning ErrPlayerProposalDecided if it was
+	// already decided.
+	Decide(ctx context.Context, id int, status PlayerProposalStatus, playerSeasonID int) error
+}
+
+// AvailabilityReport is one player's availability as published by a source,
+// before the player is resolved to a player season.
+type AvailabilityReport struct {
+	PlayerName     string
+	ClubName       string // as stored in afl.club
+	Status         domain.AvailabilityStatus
+	ExpectedReturn *string
+}
+
+// AvailabilitySource supplies player availability for a round from an external
+// feed, e.g. club team announcements or the weekly injury list. Name is recorded
+// as the source of each entry.
+type AvailabilitySource interface {
+	Name() string
+	FetchAvailability(ctx context.Context, round domain.Round) ([]AvailabilityReport, error)
 }
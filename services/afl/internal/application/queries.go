@@ -19,6 +19,7 @@ type Queries struct {
 	players       domain.PlayerRepository
 	playerMatches domain.PlayerMatchRepository
 	playerSeasons domain.PlayerSeasonRepository
+	availability  domain.AvailabilityRepository
 }
 
 func NewQueries(
@@ -32,6 +33,7 @@ func NewQueries(
 	players domain.PlayerRepository,
 	playerMatches domain.PlayerMatchRepository,
 	playerSeasons domain.PlayerSeasonRepository,
+	availability domain.AvailabilityRepository,
 ) *Queries {
 	return &Queries{
 		clock:         clk,
@@ -44,6 +46,7 @@ func NewQueries(
 		players:       players,
 		playerMatches: playerMatches,
 		playerSeasons: playerSeasons,
+		availability:  availability,
 	}
 }
 
@@ -95,6 +98,29 @@ func (q *Queries) GetClubMatches(ctx context.Context, matchID int) ([]domain.Clu
 	return q.clubMatches.FindByMatchID(ctx, matchID)
 }
 
+// GetQuarterScoresByClubMatchIDs returns each club match's score at the end
+// of each quarter, keyed by club match ID. Club matches with no recorded
+// quarters are absent from the map.
+func (q *Queries) GetQuarterScoresByClubMatchIDs(ctx context.Context, clubMatchIDs []int) (map[int][]domain.QuarterScore, error) {
+	return q.clubMatches.FindQuarterScores(ctx, clubMatchIDs)
+}
+
+// GetMatchTimeline returns how a match's scoreboard moved break by break,
+// with no breaks when its quarter scores weren't recorded.
+func (q *Queries) GetMatchTimeline(ctx context.Context, matchID int) (domain.MatchTimeline, error) {
+	match, err := q.matches.FindByID(ctx, matchID)
+	if err != nil {
+		return domain.MatchTimeline{}, err
+	}
+	quarters, err := q.clubMatches.FindQuarterScores(ctx, []int{match.Home.ID, match.Away.ID})
+	if err != nil {
+		return domain.MatchTimeline{}, err
+	}
+	match.Home.Quarters = quarters[match.Home.ID]
+	match.Away.Quarters = quarters[match.Away.ID]
+	return match.Timeline(), nil
+}
+
 func (q *Queries) GetPlayerMatches(ctx context.Context, clubMatchID int) ([]domain.PlayerMatch, error) {
 	return q.playerMatches.FindByClubMatchID(ctx, clubMatchID)
 }
@@ -187,3 +213,7 @@ func (q *Queries) GetPlayerForPlayerSeason(ctx context.Context, playerSeasonID i
 	}
 	return q.players.FindByID(ctx, ps.PlayerID)
 }
+
+func (q *Queries) GetRoundAvailability(ctx context.Context, roundID int) ([]domain.PlayerAvailability, error) {
+	return q.availability.FindByRoundID(ctx, roundID)
+}
@@ -0,0 +1,177 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"xffl/services/afl/internal/domain"
+)
+
+// roundImportParallelism bounds how many matches of a round are imported at
+// once, to go easy on FootyWire and the connection pool.
+const roundImportParallelism = 3
+
+// RoundMatchImportStatus is what happened to one match in a round import.
+type RoundMatchImportStatus string
+
+const (
+	RoundMatchImported RoundMatchImportStatus = "imported"
+	RoundMatchSkipped  RoundMatchImportStatus = "skipped" // already final; re-import it on its own to correct it
+	RoundMatchFailed   RoundMatchImportStatus = "failed"
+)
+
+// ImportAFLRoundStatsParams are the inputs to ImportAFLRoundStats.
+type ImportAFLRoundStatsParams struct {
+	RoundID int
+	// MarkFinal marks each imported match final when the source shows it
+	// complete and every player was matched.
+	MarkFinal bool
+	// Source is the stats source to import from; DefaultStatsSource if empty.
+	Source string
+}
+
+// RoundMatchImport reports one match of a round import.
+type RoundMatchImport struct {
+	MatchID      int
+	HomeClubName string
+	AwayClubName string
+	Status       RoundMatchImportStatus
+	Stats        ImportAFLStatsResult // counts and unmatched players, when imported
+	MarkedFinal  bool
+	Error        string // why the match failed, or why an imported match wasn't marked final
+}
+
+// ImportAFLRoundStatsResult reports each match of a round import, in the round's match order.
+type ImportAFLRoundStatsResult struct {
+	RoundID int
+	Matches []RoundMatchImport
+}
+
+// ImportAFLRoundStats imports stats for every match in a round. Source match
+// IDs not already cached are found with a single fetch of the fixture list;
+// the matches are then imported concurrently. A match that fails is reported
+// rather than failing the round, so one bad page doesn't hold up the rest.
+// Matches already final are skipped.
+func (c *DataOpsCommands) ImportAFLRoundStats(ctx context.Context, params ImportAFLRoundStatsParams) (ImportAFLRoundStatsResult, error) {
+	source, err := c.statsSource(params.Source)
+	if err != nil {
+		return ImportAFLRoundStatsResult{}, err
+	}
+	round, err := c.rounds.FindByID(ctx, params.RoundID)
+	if err != nil {
+		return ImportAFLRoundStatsResult{}, fmt.Errorf("load round: %w", err)
+	}
+	matches, err := c.matches.FindByRoundID(ctx, params.RoundID)
+	if err != nil {
+		return ImportAFLRoundStatsResult{}, fmt.Errorf("load matches: %w", err)
+	}
+
+	result := ImportAFLRoundStatsResult{RoundID: params.RoundID, Matches: make([]RoundMatchImport, len(matches))}
+	details := make([]domain.Match, len(matches))
+	var queries []FixtureQuery
+	mids := make(map[int]string, len(matches))
+	for i, m := range matches {
+		report := &result.Matches[i]
+		report.MatchID = m.ID
+		match, err := c.matches.FindByIDWithDetails(ctx, m.ID)
+		if err != nil {
+			return ImportAFLRoundStatsResult{}, fmt.Errorf("load match %d: %w", m.ID, err)
+		}
+		details[i] = match
+		if report.HomeClubName, err = c.clubNameForClubSeason(ctx, match.Home.ClubSeasonID); err != nil {
+			return ImportAFLRoundStatsResult{}, fmt.Errorf("resolve home club for match %d: %w", m.ID, err)
+		}
+		if report.AwayClubName, err = c.clubNameForClubSeason(ctx, match.Away.ClubSeasonID); err != nil {
+			return ImportAFLRoundStatsResult{}, fmt.Errorf("resolve away club for match %d: %w", m.ID, err)
+		}
+		if match.DataStatus == domain.MatchDataFinal {
+			report.Status = RoundMatchSkipped
+			continue
+		}
+
+		mid, found, err := c.sourceMap.FindByMatchID(ctx, source, m.ID)
+		if err != nil {
+			return ImportAFLRoundStatsResult{}, fmt.Errorf("lookup source map: %w", err)
+		}
+		if found {
+			mids[m.ID] = mid
+		}
+		// Completion is only listed in the fixture, so marking final needs
+		// every match looked up, not just the uncached ones.
+		if !found || params.MarkFinal {
+			queries = append(queries, FixtureQuery{MatchID: m.ID, HomeClub: report.HomeClubName, AwayClub: report.AwayClubName, StartTime: match.StartTime})
+		}
+	}
+
+	var fixture map[int]FixtureMatch
+	if len(queries) > 0 {
+		fixture, err = c.sources[source].Discovery.FindRoundMids(ctx, round.Name, queries)
+		if err != nil {
+			return ImportAFLRoundStatsResult{}, fmt.Errorf("discover mids: %w", err)
+		}
+		for matchID, fm := range fixture {
+			if _, cached := mids[matchID]; cached {
+				continue
+			}
+			mids[matchID] = fm.Mid
+			if err := c.sourceMap.Store(ctx, source, fm.Mid, matchID); err != nil {
+				slog.WarnContext(ctx, "failed to cache source mid", slog.String("source", source), slog.Int("match_id", matchID), slog.Any("error", err))
+			}
+		}
+	}
+
+	sem := make(chan struct{}, roundImportParallelism)
+	var wg sync.WaitGroup
+	for i := range result.Matches {
+		report := &result.Matches[i]
+		if report.Status == RoundMatchSkipped {
+			continue
+		}
+		mid, ok := mids[report.MatchID]
+		if !ok {
+			report.Status = RoundMatchFailed
+			report.Error = fmt.Sprintf("match not found in %s fixture: %s v %s", source, report.HomeClubName, report.AwayClubName)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			stats, err := c.importMatchStats(ctx, source, details[i], round, report.HomeClubName, report.AwayClubName, mid)
+			if err != nil {
+				slog.WarnContext(ctx, "round import: match failed", slog.Int("match_id", report.MatchID), slog.Any("error", err))
+				report.Status = RoundMatchFailed
+				report.Error = err.Error()
+				return
+			}
+			report.Status = RoundMatchImported
+			report.Stats = stats
+		}()
+	}
+	wg.Wait()
+
+	if !params.MarkFinal {
+		return result, nil
+	}
+	// One at a time: marking final recalculates the ladder from every final
+	// match, and concurrent recalculations could overwrite each other.
+	for i := range result.Matches {
+		report := &result.Matches[i]
+		if report.Status != RoundMatchImported || !fixture[report.MatchID].Complete || len(report.Stats.UnmatchedPlayers) > 0 {
+			continue
+		}
+		if _, err := c.MarkMatchStatsFinal(ctx, report.MatchID, true); err != nil {
+			slog.WarnContext(ctx, "round import: mark final failed", slog.Int("match_id", report.MatchID), slog.Any("error", err))
+			report.Error = fmt.Sprintf("mark final: %v", err)
+			continue
+		}
+		report.MarkedFinal = true
+	}
+
+	return result, nil
+}
@@ -0,0 +1,405 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"xffl/services/afl/internal/domain"
+	"xffl/shared/namematch"
+)
+
+// aflLeague is the league AFL seasons are created in.
+const aflLeague = "AFL"
+
+var (
+	ErrEmptyFixture       = errors.New("fixture lists no matches")
+	ErrUnknownFixtureClub = errors.New("fixture names clubs not in afl.club")
+)
+
+// SeasonName returns the name an AFL season is stored under, e.g. "AFL 2026".
+func SeasonName(year int) string {
+	return fmt.Sprintf("AFL %d", year)
+}
+
+// SeasonSetupMatchStatus is what a season setup did to one match.
+type SeasonSetupMatchStatus string
+
+const (
+	SeasonMatchCreated   SeasonSetupMatchStatus = "created"
+	SeasonMatchUpdated   SeasonSetupMatchStatus = "updated"
+	SeasonMatchUnchanged SeasonSetupMatchStatus = "unchanged"
+	SeasonMatchUnlisted  SeasonSetupMatchStatus = "unlisted" // stored but no longer in the fixture; left as it is
+)
+
+// SetupAFLSeasonParams are the inputs to SetupAFLSeason.
+type SetupAFLSeasonParams struct {
+	Year int
+	// Source is the stats source whose fixture is listed; DefaultStatsSource
+	// if empty. It is ignored when Fixture is given.
+	Source string
+	// Fixture is a fixture read from elsewhere, such as a file. The source's
+	// fixture is listed when it is nil.
+	Fixture []FixtureListing
+}
+
+// SeasonSetupMatch reports one match of a season setup.
+type SeasonSetupMatch struct {
+	MatchID      int
+	RoundName    string
+	HomeClubName string // as stored in afl.club
+	AwayClubName string
+	Venue        string
+	StartTime    time.Time
+	Status       SeasonSetupMatchStatus
+	Changes      []string // what an update changed, e.g. "venue: MCG → Marvel Stadium"
+}
+
+// SetupAFLSeasonResult reports a season setup: what was created, and each
+// match in fixture order followed by stored matches the fixture no longer lists.
+type SetupAFLSeasonResult struct {
+	SeasonID           int
+	SeasonName         string
+	SeasonCreated      bool
+	RoundsCreated      []string
+	ClubSeasonsCreated []string // club names
+	Matches            []SeasonSetupMatch
+}
+
+// SeasonSetupCommands creates and updates an AFL season from its fixture.
+type SeasonSetupCommands struct {
+	tx      TxManager
+	clubs   domain.ClubRepository
+	sources map[string]StatsSource
+	matcher *namematch.Matcher
+}
+
+func NewSeasonSetupCommands(tx TxManager, clubs domain.ClubRepository, sources map[string]StatsSource) *SeasonSetupCommands {
+	return &SeasonSetupCommands{
+		tx:      tx,
+		clubs:   clubs,
+		sources: sources,
+		matcher: namematch.New(namematch.AFLClubs),
+	}
+}
+
+// fixtureMatch is a fixture listing with its clubs resolved.
+type fixtureMatch struct {
+	FixtureListing
+	home, away domain.Club
+}
+
+// SetupAFLSeason creates a season's rounds, club seasons, matches and club
+// matches from its fixture, creating the season itself if need be. It can be
+// re-run as the fixture changes: a stored match is found by its round and
+// clubs, or, if it hasn't been played, by its clubs alone when it has moved
+// round, and its round, venue, start time and home side are brought in line
+// with the fixture. A venue or start time the fixture doesn't give is left
+// as stored. Stored matches the fixture no longer lists are reported, not
+// deleted, as they may have stats.
+func (c *SeasonSetupCommands) SetupAFLSeason(ctx context.Context, params SetupAFLSeasonParams) (SetupAFLSeasonResult, error) {
+	listings := params.Fixture
+	if listings == nil {
+		source := params.Source
+		if source == "" {
+			source = DefaultStatsSource
+		}
+		s, ok := c.sources[source]
+		if !ok {
+			return SetupAFLSeasonResult{}, fmt.Errorf("%w: %q", ErrUnknownStatsSource, source)
+		}
+		var err error
+		if listings, err = s.Discovery.ListSeasonFixture(ctx, params.Year); err != nil {
+			return SetupAFLSeasonResult{}, fmt.Errorf("list %s fixture: %w", source, err)
+		}
+	}
+	if len(listings) == 0 {
+		return SetupAFLSeasonResult{}, ErrEmptyFixture
+	}
+	fixture, clubs, err := c.resolveFixtureClubs(ctx, listings)
+	if err != nil {
+		return SetupAFLSeasonResult{}, err
+	}
+
+	result := SetupAFLSeasonResult{SeasonName: SeasonName(params.Year)}
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		season, found, err := repos.Seasons.FindByName(ctx, result.SeasonName)
+		if err != nil {
+			return fmt.Errorf("find season: %w", err)
+		}
+		if !found {
+			if season, err = repos.Seasons.Create(ctx, aflLeague, result.SeasonName); err != nil {
+				return fmt.Errorf("create season: %w", err)
+			}
+			result.SeasonCreated = true
+		}
+		result.SeasonID = season.ID
+
+		roundIDs, roundNames, err := setupRounds(ctx, repos, season.ID, fixture, &result)
+		if err != nil {
+			return err
+		}
+		clubSeasonIDs, err := setupClubSeasons(ctx, repos, season.ID, fixture, &result)
+		if err != nil {
+			return err
+		}
+		clubNames := make(map[int]string, len(clubSeasonIDs)) // club season ID → club name
+		for clubID, csID := range clubSeasonIDs {
+			clubNames[csID] = clubs[clubID].Name
+		}
+
+		stored, err := repos.Matches.FindBySeasonID(ctx, season.ID)
+		if err != nil {
+			return fmt.Errorf("load matches: %w", err)
+		}
+		want := make([]domain.Match, len(fixture))
+		for i, fm := range fixture {
+			want[i] = domain.Match{
+				RoundID:   roundIDs[fm.RoundName],
+				Home:      domain.ClubMatch{ClubSeasonID: clubSeasonIDs[fm.home.ID]},
+				Away:      domain.ClubMatch{ClubSeasonID: clubSeasonIDs[fm.away.ID]},
+				Venue:     fm.Venue,
+				StartTime: fm.StartTime,
+			}
+		}
+		storedFor, claimed := claimStoredMatches(stored, want)
+
+		for i, fm := range fixture {
+			report := SeasonSetupMatch{
+				RoundName:    fm.RoundName,
+				HomeClubName: fm.home.Name,
+				AwayClubName: fm.away.Name,
+				Venue:        fm.Venue,
+				StartTime:    fm.StartTime,
+			}
+			w := want[i]
+			j := storedFor[i]
+			if j < 0 {
+				created, err := repos.Matches.Create(ctx, w)
+				if err != nil {
+					return fmt.Errorf("create %s match %s v %s: %w", fm.RoundName, fm.home.Name, fm.away.Name, err)
+				}
+				report.MatchID, report.Status = created.ID, SeasonMatchCreated
+				result.Matches = append(result.Matches, report)
+				continue
+			}
+
+			m := stored[j]
+			w.ID = m.ID
+			if w.Venue == "" {
+				w.Venue = m.Venue
+			}
+			if w.StartTime.IsZero() {
+				w.StartTime = m.StartTime
+			}
+			report.MatchID, report.Venue, report.StartTime = m.ID, w.Venue, w.StartTime
+			report.Changes = scheduleChanges(m, w, roundNames)
+			if len(report.Changes) > 0 {
+				if err := repos.Matches.UpdateSchedule(ctx, w); err != nil {
+					return fmt.Errorf("update match %d: %w", m.ID, err)
+				}
+			}
+			if m.Home.ClubSeasonID != w.Home.ClubSeasonID {
+				if err := repos.Matches.SwapSides(ctx, m.ID); err != nil {
+					return fmt.Errorf("swap sides of match %d: %w", m.ID, err)
+				}
+				report.Changes = append(report.Changes, "home and away swapped")
+			}
+			report.Status = SeasonMatchUnchanged
+			if len(report.Changes) > 0 {
+				report.Status = SeasonMatchUpdated
+			}
+			result.Matches = append(result.Matches, report)
+		}
+
+		for j, m := range stored {
+			if claimed[j] {
+				continue
+			}
+			result.Matches = append(result.Matches, SeasonSetupMatch{
+				MatchID:      m.ID,
+				RoundName:    roundNames[m.RoundID],
+				HomeClubName: clubNames[m.Home.ClubSeasonID],
+				AwayClubName: clubNames[m.Away.ClubSeasonID],
+				Venue:        m.Venue,
+				StartTime:    m.StartTime,
+				Status:       SeasonMatchUnlisted,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return SetupAFLSeasonResult{}, err
+	}
+	return result, nil
+}
+
+// resolveFixtureClubs resolves the clubs of each listing to afl.club rows,
+// returning the clubs by ID. Every unknown club name is reported at once.
+func (c *SeasonSetupCommands) resolveFixtureClubs(ctx context.Context, listings []FixtureListing) ([]fixtureMatch, map[int]domain.Club, error) {
+	all, err := c.clubs.FindAll(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load clubs: %w", err)
+	}
+	byName := make(map[string]domain.Club, len(all))
+	byID := make(map[int]domain.Club, len(all))
+	for _, club := range all {
+		byName[club.Name] = club
+		byID[club.ID] = club
+	}
+	var unknown []string
+	resolve := func(name string) domain.Club {
+		club, ok := findClub(c.matcher, byName, name)
+		if !ok {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		}
+		return club
+	}
+
+	fixture := make([]fixtureMatch, len(listings))
+	for i, l := range listings {
+		fixture[i] = fixtureMatch{FixtureListing: l, home: resolve(l.HomeClub), away: resolve(l.AwayClub)}
+		if fixture[i].home.ID != 0 && fixture[i].home.ID == fixture[i].away.ID {
+			return nil, nil, fmt.Errorf("%s lists %s playing itself", l.RoundName, fixture[i].home.Name)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownFixtureClub, strings.Join(unknown, ", "))
+	}
+	return fixture, byID, nil
+}
+
+// findClub finds the club a source names among clubs by name, by the shared
+// club aliases or as stored.
+func findClub(matcher *namematch.Matcher, byName map[string]domain.Club, name string) (domain.Club, bool) {
+	if canonical, ok := matcher.Club(name); ok {
+		if club, ok := byName[canonical]; ok {
+			return club, true
+		}
+	}
+	club, ok := byName[name]
+	return club, ok
+}
+
+// setupRounds creates the fixture's rounds the season doesn't have, in
+// fixture order, returning every round's ID by name and name by ID.
+func setupRounds(ctx context.Context, repos WriteRepos, seasonID int, fixture []fixtureMatch, result *SetupAFLSeasonResult) (map[string]int, map[int]string, error) {
+	rounds, err := repos.Rounds.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load rounds: %w", err)
+	}
+	ids := make(map[string]int, len(rounds))
+	names := make(map[int]string, len(rounds))
+	for _, r := range rounds {
+		ids[r.Name] = r.ID
+		names[r.ID] = r.Name
+	}
+	for _, fm := range fixture {
+		if _, ok := ids[fm.RoundName]; ok {
+			continue
+		}
+		r, err := repos.Rounds.Create(ctx, seasonID, fm.RoundName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("create round %q: %w", fm.RoundName, err)
+		}
+		ids[r.Name] = r.ID
+		names[r.ID] = r.Name
+		result.RoundsCreated = append(result.RoundsCreated, r.Name)
+	}
+	return ids, names, nil
+}
+
+// setupClubSeasons creates a club season for each club in the fixture
+// without one, returning every club season's ID by club ID.
+func setupClubSeasons(ctx context.Context, repos WriteRepos, seasonID int, fixture []fixtureMatch, result *SetupAFLSeasonResult) (map[int]int, error) {
+	clubSeasons, err := repos.ClubSeasons.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("load club seasons: %w", err)
+	}
+	ids := make(map[int]int, len(clubSeasons))
+	for _, cs := range clubSeasons {
+		ids[cs.ClubID] = cs.ID
+	}
+	for _, fm := range fixture {
+		for _, club := range []domain.Club{fm.home, fm.away} {
+			if _, ok := ids[club.ID]; ok {
+				continue
+			}
+			cs, err := repos.ClubSeasons.Create(ctx, club.ID, seasonID)
+			if err != nil {
+				return nil, fmt.Errorf("create club season for %s: %w", club.Name, err)
+			}
+			ids[club.ID] = cs.ID
+			result.ClubSeasonsCreated = append(result.ClubSeasonsCreated, club.Name)
+		}
+	}
+	return ids, nil
+}
+
+// claimStoredMatches pairs each wanted match with the stored match it is, by
+// index into stored, or -1 if it is new. A stored match is the same match if
+// it is between the same clubs in the same round, whichever is at home; a
+// match that hasn't been played is also the same match if it has moved
+// round. claimed reports which stored matches were paired.
+func claimStoredMatches(stored, want []domain.Match) (storedFor []int, claimed []bool) {
+	storedFor = make([]int, len(want))
+	claimed = make([]bool, len(stored))
+	claim := func(i int, same func(m domain.Match) bool) {
+		for j, m := range stored {
+			if !claimed[j] && sameClubs(m, want[i]) && same(m) {
+				storedFor[i], claimed[j] = j, true
+				return
+			}
+		}
+	}
+	for i := range want {
+		storedFor[i] = -1
+		claim(i, func(m domain.Match) bool { return m.RoundID == want[i].RoundID })
+	}
+	for i := range want {
+		if storedFor[i] < 0 {
+			claim(i, func(m domain.Match) bool { return m.DataStatus == domain.MatchDataNoData })
+		}
+	}
+	return storedFor, claimed
+}
+
+// sameClubs reports whether a and b are between the same club seasons,
+// whichever is at home.
+func sameClubs(a, b domain.Match) bool {
+	return (a.Home.ClubSeasonID == b.Home.ClubSeasonID && a.Away.ClubSeasonID == b.Away.ClubSeasonID) ||
+		(a.Home.ClubSeasonID == b.Away.ClubSeasonID && a.Away.ClubSeasonID == b.Home.ClubSeasonID)
+}
+
+// scheduleChanges describes how want's round, venue and start time differ
+// from stored's.
+func scheduleChanges(stored, want domain.Match, roundNames map[int]string) []string {
+	var changes []string
+	if stored.RoundID != want.RoundID {
+		changes = append(changes, fmt.Sprintf("round: %s → %s", roundNames[stored.RoundID], roundNames[want.RoundID]))
+	}
+	if stored.Venue != want.Venue {
+		changes = append(changes, fmt.Sprintf("venue: %s → %s", orNone(stored.Venue), want.Venue))
+	}
+	if !stored.StartTime.Equal(want.StartTime) {
+		changes = append(changes, fmt.Sprintf("start: %s → %s", formatStart(stored.StartTime), formatStart(want.StartTime)))
+	}
+	return changes
+}
+
+// formatStart formats a start time in AFL time, as the fixture is published.
+func formatStart(t time.Time) string {
+	if t.IsZero() {
+		return "none"
+	}
+	return t.In(adelaideLoc).Format("Mon 2 Jan 15:04 MST")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,306 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"xffl/services/afl/internal/domain"
+)
+
+var (
+	ErrStatsPreviewApplied = errors.New("stats preview already applied")
+	ErrStatsPreviewStale   = errors.New("stored stats changed since the preview; preview the import again")
+)
+
+// PlayerStatsDiffKind is how a previewed import changes a player's stats.
+type PlayerStatsDiffKind string
+
+const (
+	// PlayerStatsAdded is a player the source lists with no stored stats.
+	PlayerStatsAdded PlayerStatsDiffKind = "added"
+	// PlayerStatsChanged is a player whose stored stats differ from the source's.
+	PlayerStatsChanged PlayerStatsDiffKind = "changed"
+	// PlayerStatsRemoved is a player with stored stats the source no longer
+	// lists, or whose name no longer resolves.
+	PlayerStatsRemoved PlayerStatsDiffKind = "removed"
+)
+
+// StatChange is one stat a previewed import changes for a player.
+type StatChange struct {
+	Stat string // kicks, handballs, marks, hitouts, tackles, goals or behinds
+	Old  int
+	New  int
+}
+
+// PlayerStatsDiff is how a previewed import changes one player's stats. Old
+// is the stored stat line and New the source's; an added player has no Old
+// stats and a removed player no New stats.
+type PlayerStatsDiff struct {
+	PlayerSeasonID int
+	PlayerName     string
+	Kind           PlayerStatsDiffKind
+	Old            PlayerStats
+	New            PlayerStats
+}
+
+// Changes returns the stats that differ between the stored and new stat lines.
+func (d PlayerStatsDiff) Changes() []StatChange {
+	var changes []StatChange
+	for _, stat := range statFields {
+		if o, n := stat.get(d.Old), stat.get(d.New); o != n {
+			changes = append(changes, StatChange{Stat: stat.name, Old: o, New: n})
+		}
+	}
+	return changes
+}
+
+// ClubStatsPreview is how a previewed import changes one club's side of a
+// match. Players lists only players whose stats change.
+type ClubStatsPreview struct {
+	ClubMatchID      int
+	ClubName         string
+	OldScore         int
+	NewScore         int
+	OldRushedBehinds int
+	NewRushedBehinds int
+	Players          []PlayerStatsDiff
+}
+
+// StatsPreview is a match's stats import worked out against the stored stats
+// but not written, so a correction can be reviewed before it is applied.
+type StatsPreview struct {
+	ID               int
+	MatchID          int
+	RoundID          int
+	Source           string
+	Clubs            []ClubStatsPreview // home then away
+	UnmatchedPlayers []UnmatchedAFLPlayer
+	CreatedAt        time.Time
+	AppliedAt        *time.Time
+}
+
+// PreviewAFLStats works out what importing a match's stats from source, or
+// DefaultStatsSource if source is empty, would change, without writing
+// anything but the preview. Names resolve as they would on import. Players
+// the source lists that no name resolves for are reported as unmatched, and
+// stored players they might be are reported as removed, so unmatched names
+// are best resolved before previewing.
+func (c *DataOpsCommands) PreviewAFLStats(ctx context.Context, matchID int, source string) (StatsPreview, error) {
+	t, err := c.loadImportTarget(ctx, matchID, source)
+	if err != nil {
+		return StatsPreview{}, err
+	}
+	stats, err := c.sources[t.source].Parser.ParseMatch(ctx, t.mid)
+	if err != nil {
+		return StatsPreview{}, fmt.Errorf("parse match stats: %w", err)
+	}
+	clubs, err := c.resolveMatchStats(ctx, t.source, t.match, t.round, t.homeClubName, t.awayClubName, stats)
+	if err != nil {
+		return StatsPreview{}, err
+	}
+
+	preview := StatsPreview{MatchID: t.match.ID, RoundID: t.match.RoundID, Source: t.source}
+	for _, rc := range clubs {
+		club := ClubStatsPreview{
+			ClubMatchID:      rc.cm.ID,
+			ClubName:         rc.clubName,
+			OldScore:         rc.cm.Score(),
+			OldRushedBehinds: rc.cm.RushedBehinds,
+			NewRushedBehinds: rc.rushedBehinds,
+		}
+		stored := make(map[int]domain.PlayerMatch, len(rc.cm.PlayerMatches))
+		for _, pm := range rc.cm.PlayerMatches {
+			stored[pm.PlayerSeasonID] = pm
+		}
+
+		after := domain.ClubMatch{RushedBehinds: rc.rushedBehinds}
+		listed := make(map[int]bool, len(rc.players))
+		for _, p := range rc.players {
+			listed[p.playerSeasonID] = true
+			next := domain.PlayerMatch{Goals: p.stats.Goals, Behinds: p.stats.Behinds}
+			after.PlayerMatches = append(after.PlayerMatches, next)
+
+			diff := PlayerStatsDiff{PlayerSeasonID: p.playerSeasonID, PlayerName: p.stats.Name, Kind: PlayerStatsAdded, New: statLine(p.stats)}
+			if prev, ok := stored[p.playerSeasonID]; ok {
+				diff.Kind, diff.Old = PlayerStatsChanged, playerMatchStats(prev)
+				if sameStatLine(diff.Old, diff.New) {
+					continue
+				}
+			}
+			club.Players = append(club.Players, diff)
+		}
+		for _, pm := range rc.cm.PlayerMatches {
+			if !listed[pm.PlayerSeasonID] {
+				club.Players = append(club.Players, PlayerStatsDiff{PlayerSeasonID: pm.PlayerSeasonID,
+					PlayerName: rc.names[pm.PlayerSeasonID], Kind: PlayerStatsRemoved, Old: playerMatchStats(pm)})
+			}
+		}
+		club.NewScore = after.Score()
+
+		preview.Clubs = append(preview.Clubs, club)
+		preview.UnmatchedPlayers = append(preview.UnmatchedPlayers, rc.unmatched...)
+	}
+
+	preview, err = c.previews.Create(ctx, preview)
+	if err != nil {
+		return StatsPreview{}, fmt.Errorf("store preview: %w", err)
+	}
+	return preview, nil
+}
+
+// ApplyAFLStatsPreview writes a previewed import: added and changed players'
+// stats are written, removed players' stats deleted, and each club's rushed
+// behinds and score set. The stored stats must still be those the preview
+// was worked out against, else ErrStatsPreviewStale is returned; a preview
+// applies once.
+//
+// Each changed stat line publishes AflPlayerMatchUpdated, a removed player's
+// with no stats. A final match is marked final again, re-deriving its result
+// and the ladder; any other match becomes partial.
+func (c *DataOpsCommands) ApplyAFLStatsPreview(ctx context.Context, id int) (StatsPreview, error) {
+	preview, err := c.previews.FindByID(ctx, id)
+	if err != nil {
+		return StatsPreview{}, fmt.Errorf("load preview: %w", err)
+	}
+	if preview.AppliedAt != nil {
+		return StatsPreview{}, ErrStatsPreviewApplied
+	}
+	match, err := c.matches.FindByIDWithDetails(ctx, preview.MatchID)
+	if err != nil {
+		return StatsPreview{}, fmt.Errorf("load match: %w", err)
+	}
+	round, err := c.rounds.FindByID(ctx, match.RoundID)
+	if err != nil {
+		return StatsPreview{}, fmt.Errorf("load round: %w", err)
+	}
+	stored := make(map[int]map[int]domain.PlayerMatch, 2)
+	for _, cm := range []domain.ClubMatch{match.Home, match.Away} {
+		stored[cm.ID] = make(map[int]domain.PlayerMatch, len(cm.PlayerMatches))
+		for _, pm := range cm.PlayerMatches {
+			stored[cm.ID][pm.PlayerSeasonID] = pm
+		}
+	}
+	if !previewCurrent(preview, match, stored) {
+		return StatsPreview{}, ErrStatsPreviewStale
+	}
+
+	var changed []domain.PlayerMatch
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		// Claim the preview first, so it can't be applied twice at once.
+		if err := repos.StatsPreviews.MarkApplied(ctx, preview.ID); err != nil {
+			return err
+		}
+		changed = nil
+		for _, club := range preview.Clubs {
+			for _, d := range club.Players {
+				if d.Kind == PlayerStatsRemoved {
+					prev := stored[club.ClubMatchID][d.PlayerSeasonID]
+					if err := repos.PlayerMatches.Delete(ctx, prev.ID); err != nil {
+						return fmt.Errorf("delete player_match for %s: %w", d.PlayerName, err)
+					}
+					changed = append(changed, domain.PlayerMatch{ID: prev.ID, ClubMatchID: club.ClubMatchID, PlayerSeasonID: d.PlayerSeasonID})
+					continue
+				}
+				pm, err := repos.PlayerMatches.Upsert(ctx, upsertStatLine(club.ClubMatchID, d.PlayerSeasonID, d.New))
+				if err != nil {
+					return fmt.Errorf("upsert player_match for %s: %w", d.PlayerName, err)
+				}
+				changed = append(changed, pm)
+			}
+			cm := match.Home
+			if club.ClubMatchID == match.Away.ID {
+				cm = match.Away
+			}
+			if err := updateClubScore(ctx, repos, cm, club.NewRushedBehinds); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return StatsPreview{}, err
+	}
+
+	c.publishPlayerMatches(ctx, match.RoundID, changed)
+	if match.DataStatus == domain.MatchDataFinal {
+		if _, err := c.MarkMatchStatsFinal(ctx, match.ID, true); err != nil {
+			slog.WarnContext(ctx, "re-finalise match after applying stats preview failed", slog.Int("match_id", match.ID), slog.Any("error", err))
+		}
+	} else {
+		if err := c.matches.UpdateDataStatus(ctx, match.ID, domain.MatchDataPartial); err != nil {
+			slog.WarnContext(ctx, "failed to update match data status", slog.Int("match_id", match.ID), slog.Any("error", err))
+		}
+		written := make(map[int]bool)
+		for _, cm := range []domain.ClubMatch{match.Home, match.Away} {
+			playerMatches, err := c.playerMatches.FindByClubMatchID(ctx, cm.ID)
+			if err != nil {
+				slog.WarnContext(ctx, "reload player matches after applying stats preview failed", slog.Int("club_match_id", cm.ID), slog.Any("error", err))
+				continue
+			}
+			for _, pm := range playerMatches {
+				written[pm.PlayerSeasonID] = true
+			}
+		}
+		c.publishMatchPartial(ctx, match, round.SeasonID, match.RoundID, written)
+	}
+
+	return c.previews.FindByID(ctx, preview.ID)
+}
+
+// previewCurrent reports whether a match's stored stats, by club match and
+// player season, are still those preview was worked out against.
+func previewCurrent(preview StatsPreview, match domain.Match, stored map[int]map[int]domain.PlayerMatch) bool {
+	for _, club := range preview.Clubs {
+		var cm domain.ClubMatch
+		switch club.ClubMatchID {
+		case match.Home.ID:
+			cm = match.Home
+		case match.Away.ID:
+			cm = match.Away
+		default:
+			return false
+		}
+		if cm.RushedBehinds != club.OldRushedBehinds || cm.Score() != club.OldScore {
+			return false
+		}
+		for _, d := range club.Players {
+			prev, ok := stored[club.ClubMatchID][d.PlayerSeasonID]
+			if d.Kind == PlayerStatsAdded {
+				if ok {
+					return false
+				}
+				continue
+			}
+			if !ok || !sameStatLine(playerMatchStats(prev), d.Old) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// statLine returns a source's stat line without its names or extra stats, as
+// compared with stored stats. Previews cover the stats FFL scores.
+func statLine(ps PlayerStats) PlayerStats {
+	return PlayerStats{Kicks: ps.Kicks, Handballs: ps.Handballs, Marks: ps.Marks, Hitouts: ps.Hitouts,
+		Tackles: ps.Tackles, Goals: ps.Goals, Behinds: ps.Behinds}
+}
+
+// sameStatLine reports whether a and b have the same core stats.
+func sameStatLine(a, b PlayerStats) bool {
+	for _, stat := range statFields {
+		if stat.get(a) != stat.get(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// playerMatchStats returns a stored player match's stat line.
+func playerMatchStats(pm domain.PlayerMatch) PlayerStats {
+	return PlayerStats{Kicks: pm.Kicks, Handballs: pm.Handballs, Marks: pm.Marks, Hitouts: pm.Hitouts,
+		Tackles: pm.Tackles, Goals: pm.Goals, Behinds: pm.Behinds}
+}
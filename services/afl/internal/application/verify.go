@@ -0,0 +1,282 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"xffl/services/afl/internal/domain"
+)
+
+// StoredStats names the stats already imported for a match where a
+// discrepancy compares them with a source.
+const StoredStats = "stored"
+
+var ErrTooFewSources = errors.New("verifying stats needs two sources with the match")
+
+// DiscrepancyKind is what a stats discrepancy is about.
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyStat is a player's stat differing between two sources by
+	// more than the threshold.
+	DiscrepancyStat DiscrepancyKind = "stat"
+	// DiscrepancyMissingPlayer is a player listed by one source and not the other.
+	DiscrepancyMissingPlayer DiscrepancyKind = "missing_player"
+	// DiscrepancyScore is the stored stats and rushed behinds not adding up to
+	// a club's goals or behinds in a source's score table.
+	DiscrepancyScore DiscrepancyKind = "score"
+)
+
+// StatsDiscrepancy is one thing a match's sources disagree on: Source says
+// Value and OtherSource says OtherValue. For a missing player Source lists
+// the player and OtherSource doesn't; for a score, OtherSource is StoredStats.
+type StatsDiscrepancy struct {
+	MatchID        int
+	ClubMatchID    int
+	Kind           DiscrepancyKind
+	PlayerSeasonID int    // 0 for a score, or a player no source name resolved for
+	PlayerName     string // empty for a score
+	Stat           string // kicks, handballs, marks, hitouts, tackles, goals or behinds
+	Source         string
+	Value          int
+	OtherSource    string
+	OtherValue     int
+}
+
+// VerifyAFLMatchStatsParams are the inputs to VerifyAFLMatchStats.
+type VerifyAFLMatchStatsParams struct {
+	MatchID int
+	// Threshold is how far a player's stat may differ between sources before
+	// it is flagged; sources often disagree by one on a contested possession.
+	Threshold int
+}
+
+// VerifyAFLMatchStatsResult reports a match's verification.
+type VerifyAFLMatchStatsResult struct {
+	MatchID       int
+	Sources       []string // the sources compared, the baseline first
+	Discrepancies []StatsDiscrepancy
+}
+
+// statFields are the stats in a stat line, in the order discrepancies and
+// previewed changes list them.
+var statFields = []struct {
+	name string
+	get  func(PlayerStats) int
+}{
+	{"kicks", func(p PlayerStats) int { return p.Kicks }},
+	{"handballs", func(p PlayerStats) int { return p.Handballs }},
+	{"marks", func(p PlayerStats) int { return p.Marks }},
+	{"hitouts", func(p PlayerStats) int { return p.Hitouts }},
+	{"tackles", func(p PlayerStats) int { return p.Tackles }},
+	{"goals", func(p PlayerStats) int { return p.Goals }},
+	{"behinds", func(p PlayerStats) int { return p.Behinds }},
+}
+
+// sourceStats is one source's stats for a match.
+type sourceStats struct {
+	source string
+	stats  MatchStats
+}
+
+// VerifyAFLMatchStats compares a match's stats across every source that has
+// the match, against DefaultStatsSource when it is one of them. Each player's
+// stats are compared by the player season their name resolves to, as on
+// import, and a player only one source lists is flagged. Each source's score
+// table is then checked against the stored stats: the players' goals, and
+// their behinds plus rushed behinds, must reproduce the club's score.
+//
+// The discrepancies found replace those stored for the match, so they can be
+// reviewed before it is marked final.
+func (c *DataOpsCommands) VerifyAFLMatchStats(ctx context.Context, params VerifyAFLMatchStatsParams) (VerifyAFLMatchStatsResult, error) {
+	match, err := c.matches.FindByIDWithDetails(ctx, params.MatchID)
+	if err != nil {
+		return VerifyAFLMatchStatsResult{}, fmt.Errorf("load match: %w", err)
+	}
+	round, err := c.rounds.FindByID(ctx, match.RoundID)
+	if err != nil {
+		return VerifyAFLMatchStatsResult{}, fmt.Errorf("load round: %w", err)
+	}
+	homeClubName, err := c.clubNameForClubSeason(ctx, match.Home.ClubSeasonID)
+	if err != nil {
+		return VerifyAFLMatchStatsResult{}, fmt.Errorf("resolve home club: %w", err)
+	}
+	awayClubName, err := c.clubNameForClubSeason(ctx, match.Away.ClubSeasonID)
+	if err != nil {
+		return VerifyAFLMatchStatsResult{}, fmt.Errorf("resolve away club: %w", err)
+	}
+
+	names := make([]string, 0, len(c.sources))
+	for name := range c.sources {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		// The default source is the baseline the others are compared with.
+		if (a == DefaultStatsSource) != (b == DefaultStatsSource) {
+			if a == DefaultStatsSource {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	query := FixtureQuery{MatchID: match.ID, HomeClub: homeClubName, AwayClub: awayClubName, StartTime: match.StartTime}
+	var sources []sourceStats
+	for _, name := range names {
+		mid, err := c.resolveMid(ctx, name, round.Name, query)
+		if err != nil {
+			slog.WarnContext(ctx, "verify: match not found in source", slog.String("source", name), slog.Int("match_id", match.ID), slog.Any("error", err))
+			continue
+		}
+		stats, err := c.sources[name].Parser.ParseMatch(ctx, mid)
+		if err != nil {
+			slog.WarnContext(ctx, "verify: parse match stats failed", slog.String("source", name), slog.Int("match_id", match.ID), slog.Any("error", err))
+			continue
+		}
+		sources = append(sources, sourceStats{source: name, stats: stats})
+	}
+	if len(sources) < 2 {
+		return VerifyAFLMatchStatsResult{}, ErrTooFewSources
+	}
+
+	result := VerifyAFLMatchStatsResult{MatchID: match.ID}
+	for _, s := range sources {
+		result.Sources = append(result.Sources, s.source)
+	}
+
+	sides := []struct {
+		cm       domain.ClubMatch
+		clubName string
+		home     bool
+	}{
+		{cm: match.Home, clubName: homeClubName, home: true},
+		{cm: match.Away, clubName: awayClubName},
+	}
+	for _, side := range sides {
+		candidates, err := c.buildCandidates(ctx, side.cm.ClubSeasonID)
+		if err != nil {
+			return VerifyAFLMatchStatsResult{}, fmt.Errorf("build candidates for %s: %w", side.clubName, err)
+		}
+		seasonStr := strconv.Itoa(round.SeasonID)
+		clubSeasonStr := strconv.Itoa(side.cm.ClubSeasonID)
+
+		players := make([]map[verifiedPlayer]PlayerStats, len(sources))
+		for i, s := range sources {
+			statsClubName := s.stats.AwayClubName
+			if side.home {
+				statsClubName = s.stats.HomeClubName
+			}
+			players[i] = make(map[verifiedPlayer]PlayerStats)
+			for _, ps := range filterByClub(s.stats.Players, statsClubName) {
+				p := verifiedPlayer{name: ps.Name}
+				if psID, ok := c.resolvePlayerSeason(ctx, s.source, seasonStr, clubSeasonStr, side.clubName, ps, candidates); ok {
+					p = verifiedPlayer{playerSeasonID: psID}
+				}
+				players[i][p] = ps
+			}
+		}
+		for i := 1; i < len(sources); i++ {
+			result.Discrepancies = append(result.Discrepancies,
+				comparePlayers(side.cm, sources[0].source, players[0], sources[i].source, players[i], params.Threshold)...)
+		}
+
+		for _, s := range sources {
+			goals, behinds := s.stats.AwayTeamGoals, s.stats.AwayTeamBehinds
+			if side.home {
+				goals, behinds = s.stats.HomeTeamGoals, s.stats.HomeTeamBehinds
+			}
+			result.Discrepancies = append(result.Discrepancies, checkScore(side.cm, s.source, goals, behinds)...)
+		}
+	}
+	for i := range result.Discrepancies {
+		result.Discrepancies[i].MatchID = match.ID
+	}
+
+	if err := c.discrepancies.ReplaceForMatch(ctx, match.ID, result.Discrepancies); err != nil {
+		return VerifyAFLMatchStatsResult{}, fmt.Errorf("store discrepancies: %w", err)
+	}
+	return result, nil
+}
+
+// StatsDiscrepancies returns the discrepancies found the last time a match's
+// stats were verified.
+func (c *DataOpsCommands) StatsDiscrepancies(ctx context.Context, matchID int) ([]StatsDiscrepancy, error) {
+	return c.discrepancies.FindByMatchID(ctx, matchID)
+}
+
+// verifiedPlayer identifies a player across sources: by player season when
+// the source's name resolves to one, else by the name as written.
+type verifiedPlayer struct {
+	playerSeasonID int
+	name           string
+}
+
+// comparePlayers flags players one source lists and the other doesn't, and
+// stats differing by more than threshold. Players are compared in base's
+// order, then other's players base doesn't list.
+func comparePlayers(cm domain.ClubMatch, baseSource string, base map[verifiedPlayer]PlayerStats, otherSource string, other map[verifiedPlayer]PlayerStats, threshold int) []StatsDiscrepancy {
+	var found []StatsDiscrepancy
+	for _, p := range sortedPlayers(base) {
+		bs := base[p]
+		os, ok := other[p]
+		if !ok {
+			found = append(found, StatsDiscrepancy{ClubMatchID: cm.ID, Kind: DiscrepancyMissingPlayer,
+				PlayerSeasonID: p.playerSeasonID, PlayerName: bs.Name, Source: baseSource, OtherSource: otherSource})
+			continue
+		}
+		for _, stat := range statFields {
+			bv, ov := stat.get(bs), stat.get(os)
+			if bv-ov > threshold || ov-bv > threshold {
+				found = append(found, StatsDiscrepancy{ClubMatchID: cm.ID, Kind: DiscrepancyStat,
+					PlayerSeasonID: p.playerSeasonID, PlayerName: bs.Name, Stat: stat.name,
+					Source: baseSource, Value: bv, OtherSource: otherSource, OtherValue: ov})
+			}
+		}
+	}
+	for _, p := range sortedPlayers(other) {
+		if _, ok := base[p]; !ok {
+			found = append(found, StatsDiscrepancy{ClubMatchID: cm.ID, Kind: DiscrepancyMissingPlayer,
+				PlayerSeasonID: p.playerSeasonID, PlayerName: other[p].Name, Source: otherSource, OtherSource: baseSource})
+		}
+	}
+	return found
+}
+
+// sortedPlayers returns players' keys by name, so discrepancies are listed
+// in the same order each time.
+func sortedPlayers(players map[verifiedPlayer]PlayerStats) []verifiedPlayer {
+	keys := make([]verifiedPlayer, 0, len(players))
+	for p := range players {
+		keys = append(keys, p)
+	}
+	slices.SortFunc(keys, func(a, b verifiedPlayer) int {
+		return strings.Compare(players[a].Name, players[b].Name)
+	})
+	return keys
+}
+
+// checkScore flags the club's stored goals, or stored behinds plus rushed
+// behinds, not matching source's score table.
+func checkScore(cm domain.ClubMatch, source string, teamGoals, teamBehinds int) []StatsDiscrepancy {
+	goals, behinds := 0, cm.RushedBehinds
+	for _, pm := range cm.PlayerMatches {
+		goals += pm.Goals
+		behinds += pm.Behinds
+	}
+	var found []StatsDiscrepancy
+	if goals != teamGoals {
+		found = append(found, StatsDiscrepancy{ClubMatchID: cm.ID, Kind: DiscrepancyScore, Stat: "goals",
+			Source: source, Value: teamGoals, OtherSource: StoredStats, OtherValue: goals})
+	}
+	if behinds != teamBehinds {
+		found = append(found, StatsDiscrepancy{ClubMatchID: cm.ID, Kind: DiscrepancyScore, Stat: "behinds",
+			Source: source, Value: teamBehinds, OtherSource: StoredStats, OtherValue: behinds})
+	}
+	return found
+}
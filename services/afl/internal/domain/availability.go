@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrInvalidAvailability = errors.New("availability status must be named, emergency, injured or suspended")
+	ErrExpectedReturn      = errors.New("expected return can only be set for injured players")
+)
+
+// AvailabilityStatus is a player's pre-match standing for a round: picked in
+// the club's team, listed as an emergency, or unavailable.
+type AvailabilityStatus string
+
+const (
+	AvailabilityNamed     AvailabilityStatus = "named"
+	AvailabilityEmergency AvailabilityStatus = "emergency"
+	AvailabilityInjured   AvailabilityStatus = "injured"
+	AvailabilitySuspended AvailabilityStatus = "suspended"
+)
+
+// Source recorded on availability entered through the API rather than a feed.
+const AvailabilitySourceManual = "manual"
+
+// PlayerAvailability is one player's availability for one round. A round has
+// at most one entry per player; later reports replace earlier ones.
+type PlayerAvailability struct {
+	ID             int
+	PlayerSeasonID int
+	RoundID        int
+	Status         AvailabilityStatus
+	ExpectedReturn *string // injured only, as published, e.g. "2-3 weeks", "Round 12", "TBC"
+	Source         string
+}
+
+// Validate checks the status is known and an expected return is only given for an injury.
+func (a PlayerAvailability) Validate() error {
+	switch a.Status {
+	case AvailabilityNamed, AvailabilityEmergency, AvailabilityInjured, AvailabilitySuspended:
+	default:
+		return ErrInvalidAvailability
+	}
+	if a.ExpectedReturn != nil && a.Status != AvailabilityInjured {
+		return ErrExpectedReturn
+	}
+	return nil
+}
+
+// MatchPlayerStatuses builds the player status map carried by AFL.MatchUpdated
+// for one match, keyed by player season ID.
+//
+//   - no_data: players with an availability entry carry their availability.
+//   - partial: players in withStats are "playing"; others keep their availability.
+//   - final: every squad player is "played" if in withStats, otherwise "dnp".
+//
+// squad lists both clubs' player season IDs and is only consulted when final.
+func MatchPlayerStatuses(status MatchDataStatus, squad []int, withStats map[int]bool, availability map[int]AvailabilityStatus) map[int]string {
+	out := make(map[int]string)
+	if status == MatchDataFinal {
+		for _, id := range squad {
+			if withStats[id] {
+				out[id] = "played"
+			} else {
+				out[id] = "dnp"
+			}
+		}
+		return out
+	}
+	for id, a := range availability {
+		out[id] = string(a)
+	}
+	if status == MatchDataPartial {
+		for id, ok := range withStats {
+			if ok {
+				out[id] = "playing"
+			}
+		}
+	}
+	return out
+}
+
+type AvailabilityRepository interface {
+	Upsert(ctx context.Context, a PlayerAvailability) (PlayerAvailability, error)
+	FindByRoundID(ctx context.Context, roundID int) ([]PlayerAvailability, error)
+	FindByPlayerSeasonIDsAndRoundID(ctx context.Context, playerSeasonIDs []int, roundID int) ([]PlayerAvailability, error)
+	// ReassignPlayerSeason moves a player season's availability to another,
+	// for the rounds the other has none.
+	ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error
+}
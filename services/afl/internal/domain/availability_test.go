@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerAvailability_Validate(t *testing.T) {
+	weeks := "2-3 weeks"
+	tests := []struct {
+		name string
+		a    PlayerAvailability
+		want error
+	}{
+		{"named", PlayerAvailability{Status: AvailabilityNamed}, nil},
+		{"emergency", PlayerAvailability{Status: AvailabilityEmergency}, nil},
+		{"suspended", PlayerAvailability{Status: AvailabilitySuspended}, nil},
+		{"injured with expected return", PlayerAvailability{Status: AvailabilityInjured, ExpectedReturn: &weeks}, nil},
+		{"injured without expected return", PlayerAvailability{Status: AvailabilityInjured}, nil},
+		{"unknown status", PlayerAvailability{Status: "resting"}, ErrInvalidAvailability},
+		{"empty status", PlayerAvailability{}, ErrInvalidAvailability},
+		{"expected return when not injured", PlayerAvailability{Status: AvailabilitySuspended, ExpectedReturn: &weeks}, ErrExpectedReturn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.a.Validate(), tt.want)
+		})
+	}
+}
+
+func TestMatchPlayerStatuses(t *testing.T) {
+	squad := []int{1, 2, 3, 4}
+	availability := map[int]AvailabilityStatus{
+		1: AvailabilityNamed,
+		2: AvailabilityEmergency,
+		3: AvailabilityInjured,
+	}
+	withStats := map[int]bool{1: true}
+
+	tests := []struct {
+		name   string
+		status MatchDataStatus
+		want   map[int]string
+	}{
+		{"pre-match carries availability only", MatchDataNoData,
+			map[int]string{1: "named", 2: "emergency", 3: "injured"}},
+		{"partial overlays players with stats", MatchDataPartial,
+			map[int]string{1: "playing", 2: "emergency", 3: "injured"}},
+		{"final covers the whole squad", MatchDataFinal,
+			map[int]string{1: "played", 2: "dnp", 3: "dnp", 4: "dnp"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchPlayerStatuses(tt.status, squad, withStats, availability))
+		})
+	}
+}
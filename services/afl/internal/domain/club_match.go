@@ -9,6 +9,22 @@ type ClubMatch struct {
 	RushedBehinds int
 	StoredScore   int
 	PlayerMatches []PlayerMatch
+	// Quarters holds the scoreboard at the end of each quarter, in quarter
+	// order; empty when the source didn't give a quarter-by-quarter score.
+	Quarters []QuarterScore
+}
+
+// QuarterScore is a club's score at the end of a quarter, counting the
+// quarters before it, as the scoreboard shows it.
+type QuarterScore struct {
+	Quarter int
+	Goals   int
+	Behinds int
+}
+
+// Score returns the points the goals and behinds are worth.
+func (q QuarterScore) Score() int {
+	return q.Goals*6 + q.Behinds
 }
 
 // Score computes the total score from player contributions and rushed behinds.
@@ -26,4 +42,10 @@ type ClubMatchRepository interface {
 	FindRoundID(ctx context.Context, clubMatchID int) (int, error)
 	UpdateScore(ctx context.Context, id int, score int) error
 	UpdateRushedBehinds(ctx context.Context, id int, rushedBehinds int) error
+	// FindQuarterScores returns each club match's quarter scores in quarter
+	// order, keyed by club match ID; club matches without any are absent.
+	FindQuarterScores(ctx context.Context, clubMatchIDs []int) (map[int][]QuarterScore, error)
+	// SetQuarterScores stores a club match's quarter scores, replacing any
+	// already stored for the same quarters.
+	SetQuarterScores(ctx context.Context, clubMatchID int, quarters []QuarterScore) error
 }
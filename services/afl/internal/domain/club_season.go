@@ -19,4 +19,6 @@ type ClubSeasonRepository interface {
 	FindBySeasonID(ctx context.Context, seasonID int) ([]ClubSeason, error)
 	FindByID(ctx context.Context, id int) (ClubSeason, error)
 	Update(ctx context.Context, cs ClubSeason) error
+	// Create creates a club's season, or restores it if it was deleted.
+	Create(ctx context.Context, clubID, seasonID int) (ClubSeason, error)
 }
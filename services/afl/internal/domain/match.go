@@ -73,6 +73,19 @@ type MatchRepository interface {
 	FindByIDWithDetails(ctx context.Context, id int) (Match, error)
 	FindByIDs(ctx context.Context, ids []int) (map[int]Match, error)
 	FindFinalBySeasonID(ctx context.Context, seasonID int) ([]Match, error)
+	// FindBySeasonID returns a season's matches, each side with its club
+	// season but not its player matches.
+	FindBySeasonID(ctx context.Context, seasonID int) ([]Match, error)
+	// FindUnfinishedStartedBetween returns matches not yet final that started
+	// in [after, before], earliest first.
+	FindUnfinishedStartedBetween(ctx context.Context, after, before time.Time) ([]Match, error)
 	UpdateDataStatus(ctx context.Context, matchID int, status MatchDataStatus) error
 	UpdateResult(ctx context.Context, matchID int, result MatchResult) error
+	// Create creates a match and its home and away club matches, from
+	// m's round, venue, start time and sides' club seasons.
+	Create(ctx context.Context, m Match) (Match, error)
+	// UpdateSchedule moves a match to m's round, venue and start time.
+	UpdateSchedule(ctx context.Context, m Match) error
+	// SwapSides makes a match's home side the away side and vice versa.
+	SwapSides(ctx context.Context, matchID int) error
 }
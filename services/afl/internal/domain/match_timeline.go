@@ -0,0 +1,93 @@
+package domain
+
+// QuarterBreak is the state of a match at the end of a quarter.
+type QuarterBreak struct {
+	Quarter int
+	Home    QuarterScore
+	Away    QuarterScore
+	// Margin is the home club's lead at the break; negative when the away
+	// club leads.
+	Margin int
+	// QuarterMargin is the home club's lead over the quarter alone.
+	QuarterMargin int
+}
+
+// QuarterResult returns which club won the quarter itself.
+func (b QuarterBreak) QuarterResult() MatchResult {
+	return resultFromMargin(b.QuarterMargin)
+}
+
+// MatchTimeline is how the scoreboard moved across a match, break by break.
+type MatchTimeline struct {
+	Breaks []QuarterBreak
+	// ComebackDeficit is the most the eventual winner trailed by at a break
+	// before the last; zero when the winner never trailed, the match was
+	// drawn, or the match isn't over yet.
+	ComebackDeficit int
+}
+
+// ComebackWin reports whether the winner trailed at a break before winning.
+func (t MatchTimeline) ComebackWin() bool {
+	return t.ComebackDeficit > 0
+}
+
+// Timeline derives the match timeline from each side's Quarters. Only
+// quarters both sides have a score for are included, so the timeline is
+// empty when either side has none. The comeback is only derived once the
+// fourth quarter's break is in or the match is final, as until then the last
+// break isn't the result.
+func (m *Match) Timeline() MatchTimeline {
+	away := make(map[int]QuarterScore, len(m.Away.Quarters))
+	for _, q := range m.Away.Quarters {
+		away[q.Quarter] = q
+	}
+
+	var t MatchTimeline
+	prevMargin := 0
+	for _, home := range m.Home.Quarters {
+		a, ok := away[home.Quarter]
+		if !ok {
+			continue
+		}
+		margin := home.Score() - a.Score()
+		t.Breaks = append(t.Breaks, QuarterBreak{
+			Quarter:       home.Quarter,
+			Home:          home,
+			Away:          a,
+			Margin:        margin,
+			QuarterMargin: margin - prevMargin,
+		})
+		prevMargin = margin
+	}
+	if len(t.Breaks) == 0 {
+		return t
+	}
+	last := t.Breaks[len(t.Breaks)-1]
+	if last.Quarter < 4 && m.DataStatus != MatchDataFinal {
+		return t
+	}
+
+	final := last.Margin
+	for _, b := range t.Breaks[:len(t.Breaks)-1] {
+		var deficit int
+		switch {
+		case final > 0:
+			deficit = -b.Margin
+		case final < 0:
+			deficit = b.Margin
+		}
+		t.ComebackDeficit = max(t.ComebackDeficit, deficit)
+	}
+	return t
+}
+
+func resultFromMargin(margin int) MatchResult {
+	switch {
+	case margin > 0:
+		return MatchResultHomeWin
+	case margin < 0:
+		return MatchResultAwayWin
+	default:
+		return MatchResultDraw
+	}
+}
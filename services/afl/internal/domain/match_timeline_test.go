@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quarters(scores ...[2]int) []QuarterScore {
+	out := make([]QuarterScore, len(scores))
+	for i, s := range scores {
+		out[i] = QuarterScore{Quarter: i + 1, Goals: s[0], Behinds: s[1]}
+	}
+	return out
+}
+
+func TestQuarterScore_Score(t *testing.T) {
+	assert.Equal(t, 60, QuarterScore{Goals: 9, Behinds: 6}.Score())
+	assert.Equal(t, 0, QuarterScore{}.Score())
+}
+
+func TestMatch_Timeline(t *testing.T) {
+	m := Match{
+		Home: ClubMatch{Quarters: quarters([2]int{2, 3}, [2]int{5, 5}, [2]int{9, 6}, [2]int{12, 10})},
+		Away: ClubMatch{Quarters: quarters([2]int{4, 1}, [2]int{7, 4}, [2]int{9, 8}, [2]int{11, 9})},
+	}
+
+	tl := m.Timeline()
+
+	require.Len(t, tl.Breaks, 4)
+	margins := make([]int, len(tl.Breaks))
+	results := make([]MatchResult, len(tl.Breaks))
+	for i, b := range tl.Breaks {
+		margins[i] = b.Margin
+		results[i] = b.QuarterResult()
+	}
+	// 15-25, 35-46, 60-62, 82-75
+	assert.Equal(t, []int{-10, -11, -2, 7}, margins)
+	assert.Equal(t, []MatchResult{MatchResultAwayWin, MatchResultAwayWin, MatchResultHomeWin, MatchResultHomeWin}, results)
+	assert.Equal(t, 9, tl.Breaks[2].QuarterMargin)
+	assert.Equal(t, QuarterScore{Quarter: 3, Goals: 9, Behinds: 6}, tl.Breaks[2].Home)
+	assert.Equal(t, 11, tl.ComebackDeficit)
+	assert.True(t, tl.ComebackWin())
+}
+
+func TestMatch_Timeline_Comebacks(t *testing.T) {
+	tests := []struct {
+		name        string
+		home        []QuarterScore
+		away        []QuarterScore
+		wantDeficit int
+	}{
+		{
+			"winner led at every break",
+			quarters([2]int{3, 2}, [2]int{6, 4}, [2]int{9, 6}, [2]int{12, 8}),
+			quarters([2]int{1, 1}, [2]int{3, 2}, [2]int{5, 3}, [2]int{7, 4}),
+			0,
+		},
+		{
+			"away comeback",
+			quarters([2]int{5, 2}, [2]int{8, 4}, [2]int{9, 6}, [2]int{10, 8}),
+			quarters([2]int{1, 1}, [2]int{3, 2}, [2]int{8, 3}, [2]int{12, 4}),
+			32,
+		},
+		{
+			"lead taken in the last quarter",
+			quarters([2]int{3, 2}, [2]int{6, 4}, [2]int{9, 6}, [2]int{9, 6}),
+			quarters([2]int{1, 1}, [2]int{3, 2}, [2]int{5, 3}, [2]int{12, 4}),
+			27,
+		},
+		{
+			"draw is never a comeback",
+			quarters([2]int{1, 1}, [2]int{2, 2}, [2]int{3, 3}, [2]int{4, 4}),
+			quarters([2]int{3, 3}, [2]int{3, 3}, [2]int{4, 4}, [2]int{4, 4}),
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Match{Home: ClubMatch{Quarters: tt.home}, Away: ClubMatch{Quarters: tt.away}}
+			tl := m.Timeline()
+			assert.Equal(t, tt.wantDeficit, tl.ComebackDeficit)
+			assert.Equal(t, tt.wantDeficit > 0, tl.ComebackWin())
+		})
+	}
+}
+
+func TestMatch_Timeline_NoComebackBeforeTheEnd(t *testing.T) {
+	// Away trails by 32 at half time and leads at three-quarter time.
+	home := quarters([2]int{5, 2}, [2]int{8, 4}, [2]int{9, 6})
+	away := quarters([2]int{1, 1}, [2]int{3, 2}, [2]int{12, 3})
+
+	m := Match{Home: ClubMatch{Quarters: home}, Away: ClubMatch{Quarters: away}, DataStatus: MatchDataPartial}
+	tl := m.Timeline()
+	require.Len(t, tl.Breaks, 3)
+	assert.Zero(t, tl.ComebackDeficit)
+	assert.False(t, tl.ComebackWin())
+
+	// A match called final without a fourth-quarter break still counts.
+	m.DataStatus = MatchDataFinal
+	assert.Equal(t, 32, m.Timeline().ComebackDeficit)
+}
+
+func TestMatch_Timeline_OnlySharedQuarters(t *testing.T) {
+	m := Match{
+		Home: ClubMatch{Quarters: quarters([2]int{2, 3}, [2]int{5, 5})},
+		Away: ClubMatch{Quarters: quarters([2]int{4, 1})},
+	}
+	tl := m.Timeline()
+	require.Len(t, tl.Breaks, 1)
+	assert.Equal(t, 1, tl.Breaks[0].Quarter)
+
+	assert.Empty(t, (&Match{Home: m.Home}).Timeline().Breaks)
+}
@@ -13,10 +13,20 @@ type PlayerWithClub struct {
 	ClubName string
 }
 
+// PlayerPair is two players who may be the same person.
+type PlayerPair struct {
+	Player Player
+	Other  Player
+}
+
 type PlayerRepository interface {
 	Create(ctx context.Context, name string) (Player, error)
+	Delete(ctx context.Context, id int) error
 	FindByID(ctx context.Context, id int) (Player, error)
 	FindByIDs(ctx context.Context, ids []int) ([]Player, error)
 	FindByIDsWithClub(ctx context.Context, ids []int) ([]PlayerWithClub, error)
+	// FindDuplicateCandidates returns pairs of players with the same
+	// surname who never played in the same match.
+	FindDuplicateCandidates(ctx context.Context) ([]PlayerPair, error)
 	Search(ctx context.Context, query string) ([]Player, error)
 }
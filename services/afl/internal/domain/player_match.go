@@ -1,10 +1,49 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"maps"
+)
 
 // PointsPerGoal is the number of points a goal is worth in AFL.
 const PointsPerGoal = 6
 
+// Extra stat keys. Sources report these beyond the core stats scoring has
+// always used; a source leaves out the ones it doesn't have.
+const (
+	StatContestedPossessions   = "contested_possessions"
+	StatUncontestedPossessions = "uncontested_possessions"
+	StatEffectiveDisposals     = "effective_disposals"
+	StatDisposalEfficiency     = "disposal_efficiency_pct"
+	StatContestedMarks         = "contested_marks"
+	StatMarksInside50          = "marks_inside_50"
+	StatInside50s              = "inside_50s"
+	StatRebound50s             = "rebound_50s"
+	StatClearances             = "clearances"
+	StatCentreClearances       = "centre_clearances"
+	StatStoppageClearances     = "stoppage_clearances"
+	StatClangers               = "clangers"
+	StatFreesFor               = "frees_for"
+	StatFreesAgainst           = "frees_against"
+	StatGoalAssists            = "goal_assists"
+	StatOnePercenters          = "one_percenters"
+	StatBounces                = "bounces"
+	StatScoreInvolvements      = "score_involvements"
+	StatMetresGained           = "metres_gained"
+	StatTurnovers              = "turnovers"
+	StatIntercepts             = "intercepts"
+	StatTacklesInside50        = "tackles_inside_50"
+	StatFantasyPoints          = "fantasy_points"
+	StatSupercoachPoints       = "supercoach_points"
+	StatBrownlowVotes          = "brownlow_votes"
+	StatTimeOnGround           = "time_on_ground_pct"
+)
+
+// ExtraStats are a player's stats in a match beyond the core stats, keyed by
+// the Stat constants. Keys are open-ended: a new source stat needs a key, not
+// a schema change.
+type ExtraStats map[string]float64
+
 // PlayerMatch holds AFL player match data. MatchDataStatus is populated when loaded via
 // a query that joins afl.match; it is empty for Upsert return values.
 type PlayerMatch struct {
@@ -19,6 +58,7 @@ type PlayerMatch struct {
 	Tackles         int
 	Goals           int
 	Behinds         int
+	Extra           ExtraStats
 }
 
 // Disposals returns the total disposals (kicks + handballs).
@@ -31,6 +71,13 @@ func (pm PlayerMatch) Score() int {
 	return pm.Goals*PointsPerGoal + pm.Behinds
 }
 
+// SameStats reports whether pm and other record the same stat line.
+func (pm PlayerMatch) SameStats(other PlayerMatch) bool {
+	return pm.Kicks == other.Kicks && pm.Handballs == other.Handballs && pm.Marks == other.Marks &&
+		pm.Hitouts == other.Hitouts && pm.Tackles == other.Tackles && pm.Goals == other.Goals &&
+		pm.Behinds == other.Behinds && maps.Equal(pm.Extra, other.Extra)
+}
+
 // AFLPlayerMatchStatus derives the AFL player match status from the match's data_status.
 // A player_match row existing means the player has stats; match finality determines whether
 // they are playing, played, or merely named (pre-match / no data yet).
@@ -46,7 +93,8 @@ func (pm PlayerMatch) AFLPlayerMatchStatus() string {
 }
 
 // UpsertPlayerMatchParams holds optional fields for creating or updating a PlayerMatch.
-// Nil fields are left unchanged on update.
+// Nil fields are left unchanged on update. Extra is merged into the stored extra
+// stats, so a source without some of them doesn't clear another's.
 type UpsertPlayerMatchParams struct {
 	ClubMatchID    int
 	PlayerSeasonID int
@@ -57,13 +105,17 @@ type UpsertPlayerMatchParams struct {
 	Tackles        *int
 	Goals          *int
 	Behinds        *int
+	Extra          ExtraStats
 }
 
 type PlayerMatchRepository interface {
+	Delete(ctx context.Context, id int) error
 	FindByClubMatchID(ctx context.Context, clubMatchID int) ([]PlayerMatch, error)
 	FindByID(ctx context.Context, id int) (PlayerMatch, error)
 	FindByIDs(ctx context.Context, ids []int) ([]PlayerMatch, error)
 	FindByPlayerSeasonID(ctx context.Context, playerSeasonID int) ([]PlayerMatch, error)
 	FindByPlayerSeasonIDsAndRoundID(ctx context.Context, playerSeasonIDs []int, roundID int) ([]PlayerMatch, error)
+	// ReassignPlayerSeason moves a player season's player matches to another.
+	ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error
 	Upsert(ctx context.Context, params UpsertPlayerMatchParams) (PlayerMatch, error)
 }
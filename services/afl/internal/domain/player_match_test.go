@@ -65,3 +65,20 @@ func TestPlayerMatch_Score(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayerMatch_SameStats(t *testing.T) {
+	pm := PlayerMatch{ID: 1, Kicks: 10, Handballs: 5, Marks: 4, Hitouts: 0, Tackles: 3, Goals: 2, Behinds: 1}
+
+	other := pm
+	other.ID, other.MatchDataStatus = 2, string(MatchDataFinal)
+	assert.True(t, pm.SameStats(other), "only the stat line is compared")
+
+	other.Behinds++
+	assert.False(t, pm.SameStats(other))
+
+	other = pm
+	other.Extra = ExtraStats{}
+	assert.True(t, pm.SameStats(other), "no extra stats are the same as empty ones")
+	other.Extra = ExtraStats{StatContestedPossessions: 9}
+	assert.False(t, pm.SameStats(other))
+}
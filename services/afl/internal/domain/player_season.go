@@ -27,4 +27,8 @@ type PlayerSeasonRepository interface {
 	FindByClubSeasonIDWithPlayer(ctx context.Context, clubSeasonID int) ([]PlayerSeasonWithPlayer, error)
 	FindIDsBySeasonID(ctx context.Context, seasonID int, nameQuery *string) ([]int, error)
 	FindLatestByPlayerID(ctx context.Context, playerID int) (PlayerSeason, bool, error)
+	FindByPlayerID(ctx context.Context, playerID int) ([]PlayerSeason, error)
+	// Reassign moves a player season to another player.
+	Reassign(ctx context.Context, id, playerID int) error
+	Delete(ctx context.Context, id int) error
 }
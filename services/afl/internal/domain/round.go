@@ -20,6 +20,7 @@ type RoundWithStart struct {
 type RoundRepository interface {
 	FindBySeasonID(ctx context.Context, seasonID int) ([]Round, error)
 	FindByID(ctx context.Context, id int) (Round, error)
+	Create(ctx context.Context, seasonID int, name string) (Round, error)
 	// FindNeighbours returns at most two rounds: the most recently started
 	// (first_match_dt <= asOf) and the first upcoming (first_match_dt > asOf).
 	FindNeighbours(ctx context.Context, asOf time.Time) ([]RoundWithStart, error)
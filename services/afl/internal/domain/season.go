@@ -11,4 +11,7 @@ type Season struct {
 type SeasonRepository interface {
 	FindAll(ctx context.Context) ([]Season, error)
 	FindByID(ctx context.Context, id int) (Season, error)
+	FindByName(ctx context.Context, name string) (Season, bool, error)
+	// Create creates a season in the league named leagueName.
+	Create(ctx context.Context, leagueName, name string) (Season, error)
 }
@@ -0,0 +1,291 @@
+// Package afltables reads AFL match stats from afltables.com CSV exports kept
+// on local disk. It is the source the historical seed was generated from, and
+// imports matches the same way as FootyWire so stats from the two can be
+// compared and gaps in one filled from the other.
+package afltables
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"xffl/services/afl/internal/application"
+	"xffl/shared/namematch"
+)
+
+// Source is the key afltables' match and player mappings are stored under.
+const Source = "afltables"
+
+// Client implements application.StatsParser, application.FixtureDiscovery
+// and application.PlayerListSource from the CSV files in a directory. Every *.csv file in it is read, so
+// seasons can be split across files or kept in one. Files are read on each
+// call, so a corrected export is picked up without a restart.
+//
+// A match's ID is its season, round and clubs as afltables writes them,
+// e.g. "2025/1/Carlton/Richmond".
+type Client struct {
+	dir     string
+	matcher *namematch.Matcher
+}
+
+func NewClient(dir string) *Client {
+	return &Client{dir: dir, matcher: namematch.New(namematch.AFLClubs)}
+}
+
+// ParseMatch returns the stats of the match mid identifies.
+func (c *Client) ParseMatch(_ context.Context, mid string) (application.MatchStats, error) {
+	key, err := parseMid(mid)
+	if err != nil {
+		return application.MatchStats{}, err
+	}
+	rows, err := c.season(key.season)
+	if err != nil {
+		return application.MatchStats{}, err
+	}
+	var matchRows []row
+	for _, r := range rows {
+		if r.key == key {
+			matchRows = append(matchRows, r)
+		}
+	}
+	if len(matchRows) == 0 {
+		return application.MatchStats{}, fmt.Errorf("match %q not found in %s", mid, c.dir)
+	}
+	return matchStats(matchRows), nil
+}
+
+// FindMatchMid finds the match in the season it starts in. Matches in the
+// exports have all been played.
+func (c *Client) FindMatchMid(_ context.Context, roundName string, match application.FixtureQuery) (string, error) {
+	if match.StartTime.IsZero() {
+		return "", fmt.Errorf("match %d has no start time to find its season by", match.MatchID)
+	}
+	rows, err := c.season(fmt.Sprint(match.StartTime.Year()))
+	if err != nil {
+		return "", err
+	}
+	key, ok := c.findMatch(rows, roundName, match)
+	if !ok {
+		return "", fmt.Errorf("match not found: season=%d round=%q home=%q away=%q",
+			match.StartTime.Year(), roundName, match.HomeClub, match.AwayClub)
+	}
+	return key.String(), nil
+}
+
+// FindRoundMids finds each of matches in the season it starts in, reading
+// the exports once per season. Every match found is complete.
+func (c *Client) FindRoundMids(_ context.Context, roundName string, matches []application.FixtureQuery) (map[int]application.FixtureMatch, error) {
+	seasons := make(map[string][]row)
+	found := make(map[int]application.FixtureMatch, len(matches))
+	for _, m := range matches {
+		if m.StartTime.IsZero() {
+			continue
+		}
+		season := fmt.Sprint(m.StartTime.Year())
+		rows, ok := seasons[season]
+		if !ok {
+			var err error
+			if rows, err = c.season(season); err != nil {
+				return nil, err
+			}
+			seasons[season] = rows
+		}
+		if key, ok := c.findMatch(rows, roundName, m); ok {
+			found[m.MatchID] = application.FixtureMatch{Mid: key.String(), Complete: true}
+		}
+	}
+	return found, nil
+}
+
+// ListSeasonFixture lists the matches in year's exports, in the order they
+// first appear. Exports only have matches already played.
+func (c *Client) ListSeasonFixture(_ context.Context, year int) ([]application.FixtureListing, error) {
+	rows, err := c.season(fmt.Sprint(year))
+	if err != nil {
+		return nil, err
+	}
+	var fixture []application.FixtureListing
+	seen := make(map[matchKey]bool)
+	for _, r := range rows {
+		if seen[r.key] {
+			continue
+		}
+		seen[r.key] = true
+		fixture = append(fixture, application.FixtureListing{
+			RoundName: roundName(r.key.round),
+			HomeClub:  r.key.home,
+			AwayClub:  r.key.away,
+			Venue:     r.venue,
+			StartTime: r.start,
+		})
+	}
+	return fixture, nil
+}
+
+// ListPlayers lists each player who played in year's exports, with the club
+// they played for, in the order they first appear. Exports only have players
+// who have played, so the list grows through the season.
+func (c *Client) ListPlayers(_ context.Context, year int) ([]application.ListedPlayer, error) {
+	rows, err := c.season(fmt.Sprint(year))
+	if err != nil {
+		return nil, err
+	}
+	var players []application.ListedPlayer
+	seen := make(map[application.ListedPlayer]bool)
+	for _, r := range rows {
+		p := application.ListedPlayer{Name: r.player.Name, Club: r.player.ClubName}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		players = append(players, p)
+	}
+	return players, nil
+}
+
+// findMatch returns the key of the match in rows played in roundName between
+// the query's clubs.
+func (c *Client) findMatch(rows []row, roundName string, match application.FixtureQuery) (matchKey, bool) {
+	round := roundCode(roundName)
+	for _, r := range rows {
+		if roundCode(r.key.round) == round && c.sameClub(r.key.home, match.HomeClub) && c.sameClub(r.key.away, match.AwayClub) {
+			return r.key, true
+		}
+	}
+	return matchKey{}, false
+}
+
+// sameClub reports whether a and b name the same club: afltables writes
+// clubs without their nicknames ("Carlton" for "Carlton Blues").
+func (c *Client) sameClub(a, b string) bool {
+	return c.matcher.SameClub(a, b) || namematch.Normalise(a) == namematch.Normalise(b)
+}
+
+// matchStats builds a match's stats from its player rows. Team scores come
+// from the export's score columns, or are summed from the players when it
+// has none, leaving no rushed behinds.
+func matchStats(rows []row) application.MatchStats {
+	first := rows[0]
+	stats := application.MatchStats{
+		HomeClubName:    first.key.home,
+		AwayClubName:    first.key.away,
+		HomeTeamGoals:   first.homeGoals,
+		HomeTeamBehinds: first.homeBehinds,
+		AwayTeamGoals:   first.awayGoals,
+		AwayTeamBehinds: first.awayBehinds,
+		Players:         make([]application.PlayerStats, len(rows)),
+	}
+	for i, r := range rows {
+		stats.Players[i] = r.player
+		if first.teamScores {
+			continue
+		}
+		if r.player.ClubName == first.key.home {
+			stats.HomeTeamGoals += r.player.Goals
+			stats.HomeTeamBehinds += r.player.Behinds
+		} else {
+			stats.AwayTeamGoals += r.player.Goals
+			stats.AwayTeamBehinds += r.player.Behinds
+		}
+	}
+	return stats
+}
+
+// matchKey identifies a match as afltables writes it.
+type matchKey struct {
+	season, round, home, away string
+}
+
+func (k matchKey) String() string {
+	return strings.Join([]string{k.season, k.round, k.home, k.away}, "/")
+}
+
+func parseMid(mid string) (matchKey, error) {
+	parts := strings.SplitN(mid, "/", 4)
+	if len(parts) != 4 {
+		return matchKey{}, fmt.Errorf("invalid afltables match id %q: want season/round/home/away", mid)
+	}
+	return matchKey{season: parts[0], round: parts[1], home: parts[2], away: parts[3]}, nil
+}
+
+// finalCodes are the afltables codes of finals rounds, keyed by the names
+// afl.round uses for them.
+var finalCodes = map[string]string{
+	"ELIMINATION FINAL": "EF",
+	"QUALIFYING FINAL":  "QF",
+	"SEMI FINAL":        "SF",
+	"PRELIMINARY FINAL": "PF",
+	"GRAND FINAL":       "GF",
+}
+
+// roundName returns the name afl.round uses for an afltables round code:
+// "5" is "Round 5", "0" is "Opening Round" and "GF" is "Grand Final".
+func roundName(code string) string {
+	switch code {
+	case "0":
+		return "Opening Round"
+	case "EF":
+		return "Elimination Final"
+	case "QF":
+		return "Qualifying Final"
+	case "SF":
+		return "Semi Final"
+	case "PF":
+		return "Preliminary Final"
+	case "GF":
+		return "Grand Final"
+	}
+	return "Round " + code
+}
+
+// venueTimezones are the timezones of venues outside Melbourne's, as
+// afltables names them. Start times in the exports are local to the venue.
+var venueTimezones = map[string]string{
+	"Adelaide Oval":    "Australia/Adelaide",
+	"Football Park":    "Australia/Adelaide",
+	"Norwood Oval":     "Australia/Adelaide",
+	"Barossa Park":     "Australia/Adelaide",
+	"Perth Stadium":    "Australia/Perth",
+	"Subiaco":          "Australia/Perth",
+	"W.A.C.A.":         "Australia/Perth",
+	"Gabba":            "Australia/Brisbane",
+	"Carrara":          "Australia/Brisbane",
+	"Marrara Oval":     "Australia/Darwin",
+	"Traeger Park":     "Australia/Darwin",
+	"Jiangwan Stadium": "Asia/Shanghai",
+}
+
+// venueLocation returns the timezone of a venue, Melbourne's unless it is
+// one of venueTimezones.
+func venueLocation(venue string) *time.Location {
+	name, ok := venueTimezones[venue]
+	if !ok {
+		name = "Australia/Melbourne"
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// roundCode returns the afltables code of a round named either way:
+// "Round 5" and "5" are "5", "Opening Round" and "OR" are "0", and
+// "Grand Final" and "GF" are "GF".
+func roundCode(name string) string {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if code, ok := finalCodes[name]; ok {
+		return code
+	}
+	switch name {
+	case "OPENING ROUND", "OR":
+		return "0"
+	}
+	name = strings.TrimSpace(strings.TrimPrefix(name, "ROUND"))
+	if n, err := strconv.Atoi(strings.TrimPrefix(name, "R")); err == nil {
+		return strconv.Itoa(n)
+	}
+	return name
+}
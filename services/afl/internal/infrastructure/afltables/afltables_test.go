@@ -0,0 +1,222 @@
+package afltables
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/domain"
+)
+
+func startingIn(year int) time.Time {
+	return time.Date(year, 5, 1, 19, 30, 0, 0, time.UTC)
+}
+
+func TestFindMatchMid(t *testing.T) {
+	c := NewClient("testdata")
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		round     string
+		home      string
+		away      string
+		year      int
+		wantMid   string
+		wantError bool
+	}{
+		{name: "club names with nicknames", round: "Round 1", home: "Carlton Blues", away: "Richmond Tigers", year: 2025, wantMid: "2025/1/Carlton/Richmond"},
+		{name: "season from the start time", round: "Round 1", home: "Carlton", away: "Richmond", year: 2024, wantMid: "2024/1/Carlton/Richmond"},
+		{name: "club written in full", round: "Round 1", home: "Greater Western Sydney Giants", away: "Collingwood Magpies", year: 2025, wantMid: "2025/1/Greater Western Sydney/Collingwood"},
+		{name: "finals round", round: "Qualifying Final", home: "Collingwood", away: "Adelaide Crows", year: 2025, wantMid: "2025/QF/Collingwood/Adelaide"},
+		{name: "home and away swapped", round: "Round 1", home: "Richmond", away: "Carlton", year: 2025, wantError: true},
+		{name: "wrong round", round: "Round 2", home: "Carlton", away: "Richmond", year: 2025, wantError: true},
+		{name: "season not exported", round: "Round 1", home: "Carlton", away: "Richmond", year: 2023, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mid, err := c.FindMatchMid(ctx, tt.round, application.FixtureQuery{
+				MatchID:   1,
+				HomeClub:  tt.home,
+				AwayClub:  tt.away,
+				StartTime: startingIn(tt.year),
+			})
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMid, mid)
+		})
+	}
+
+	t.Run("no start time", func(t *testing.T) {
+		_, err := c.FindMatchMid(ctx, "Round 1", application.FixtureQuery{MatchID: 1, HomeClub: "Carlton", AwayClub: "Richmond"})
+		assert.Error(t, err)
+	})
+}
+
+func TestFindRoundMids(t *testing.T) {
+	c := NewClient("testdata")
+	found, err := c.FindRoundMids(context.Background(), "Round 1", []application.FixtureQuery{
+		{MatchID: 1, HomeClub: "Carlton Blues", AwayClub: "Richmond Tigers", StartTime: startingIn(2025)},
+		{MatchID: 2, HomeClub: "GWS Giants", AwayClub: "Collingwood Magpies", StartTime: startingIn(2025)},
+		{MatchID: 3, HomeClub: "Sydney Swans", AwayClub: "Hawthorn Hawks", StartTime: startingIn(2025)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]application.FixtureMatch{
+		1: {Mid: "2025/1/Carlton/Richmond", Complete: true},
+		2: {Mid: "2025/1/Greater Western Sydney/Collingwood", Complete: true},
+	}, found)
+}
+
+func TestListSeasonFixture(t *testing.T) {
+	fixture, err := NewClient("testdata").ListSeasonFixture(context.Background(), 2025)
+	require.NoError(t, err)
+	require.Len(t, fixture, 3, "one listing per match, not per player")
+
+	melbourne, err := time.LoadLocation("Australia/Melbourne")
+	require.NoError(t, err)
+	assert.Equal(t, application.FixtureListing{
+		RoundName: "Round 1",
+		HomeClub:  "Carlton",
+		AwayClub:  "Richmond",
+		Venue:     "M.C.G.",
+		StartTime: time.Date(2025, 3, 13, 19, 30, 0, 0, melbourne),
+	}, fixture[0])
+	assert.Equal(t, "Qualifying Final", fixture[2].RoundName)
+
+	t.Run("start time in the venue's timezone", func(t *testing.T) {
+		dir := t.TempDir()
+		csv := "Season,Round,Date,Local.start.time,Venue,Home.team,Away.team,First.name,Surname,Playing.for,Kicks,Marks,Handballs,Goals,Behinds,Hit.Outs,Tackles\n" +
+			"2025,2,2025-03-22,945,Perth Stadium,West Coast,Adelaide,Liam,Ryan,West Coast,10,4,5,1,0,,2\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "2025.csv"), []byte(csv), 0o644))
+
+		fixture, err := NewClient(dir).ListSeasonFixture(context.Background(), 2025)
+		require.NoError(t, err)
+		require.Len(t, fixture, 1)
+		assert.Equal(t, time.Date(2025, 3, 22, 1, 45, 0, 0, time.UTC), fixture[0].StartTime.UTC())
+	})
+
+	t.Run("no start time without date columns", func(t *testing.T) {
+		fixture, err := NewClient("testdata").ListSeasonFixture(context.Background(), 2024)
+		require.NoError(t, err)
+		require.Len(t, fixture, 1)
+		assert.True(t, fixture[0].StartTime.IsZero())
+	})
+}
+
+func TestListPlayers(t *testing.T) {
+	players, err := NewClient("testdata").ListPlayers(context.Background(), 2025)
+	require.NoError(t, err)
+	require.Len(t, players, 6)
+	assert.Equal(t, application.ListedPlayer{Name: "Patrick Cripps", Club: "Carlton"}, players[0])
+	assert.Equal(t, application.ListedPlayer{Name: "Tim Taranto", Club: "Richmond"}, players[2])
+
+	t.Run("one listing per player, not per match", func(t *testing.T) {
+		dir := t.TempDir()
+		csv := "Season,Round,Home.team,Away.team,First.name,Surname,Playing.for,Kicks,Marks,Handballs,Goals,Behinds,Hit.Outs,Tackles\n" +
+			"2025,1,Carlton,Richmond,Patrick,Cripps,Carlton,18,4,17,2,1,,5\n" +
+			"2025,2,Carlton,Essendon,Patrick,Cripps,Carlton,20,2,15,1,0,,6\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "2025.csv"), []byte(csv), 0o644))
+
+		players, err := NewClient(dir).ListPlayers(context.Background(), 2025)
+		require.NoError(t, err)
+		assert.Equal(t, []application.ListedPlayer{{Name: "Patrick Cripps", Club: "Carlton"}}, players)
+	})
+}
+
+func TestParseMatch(t *testing.T) {
+	c := NewClient("testdata")
+	ctx := context.Background()
+
+	t.Run("team scores from the score columns", func(t *testing.T) {
+		stats, err := c.ParseMatch(ctx, "2025/1/Carlton/Richmond")
+		require.NoError(t, err)
+		assert.Equal(t, "Carlton", stats.HomeClubName)
+		assert.Equal(t, "Richmond", stats.AwayClubName)
+		assert.Equal(t, 11, stats.HomeTeamGoals)
+		assert.Equal(t, 9, stats.HomeTeamBehinds)
+		assert.Equal(t, 13, stats.AwayTeamGoals)
+		assert.Equal(t, 9, stats.AwayTeamBehinds)
+		require.Len(t, stats.Players, 4)
+		assert.Equal(t, application.PlayerStats{
+			Name: "Patrick Cripps", ClubName: "Carlton",
+			Kicks: 12, Handballs: 18, Marks: 3, Hitouts: 0, Tackles: 7, Goals: 1, Behinds: 1,
+			Extra: domain.ExtraStats{domain.StatBrownlowVotes: 1},
+		}, stats.Players[0])
+		assert.Equal(t, application.PlayerStats{
+			Name: "Toby Nankervis", ClubName: "Richmond",
+			Kicks: 6, Handballs: 7, Marks: 2, Hitouts: 31, Tackles: 3, Goals: 0, Behinds: 1,
+			Extra: domain.ExtraStats{domain.StatBrownlowVotes: 0},
+		}, stats.Players[3])
+	})
+
+	t.Run("team scores summed from players without score columns", func(t *testing.T) {
+		stats, err := c.ParseMatch(ctx, "2024/1/Carlton/Richmond")
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.HomeTeamGoals)
+		assert.Equal(t, 1, stats.HomeTeamBehinds)
+		assert.Equal(t, 3, stats.AwayTeamGoals)
+		assert.Equal(t, 2, stats.AwayTeamBehinds)
+		assert.Nil(t, stats.Players[0].Extra, "an export without extra stat columns has no extra stats")
+	})
+
+	t.Run("unknown match", func(t *testing.T) {
+		_, err := c.ParseMatch(ctx, "2025/2/Carlton/Richmond")
+		assert.Error(t, err)
+	})
+
+	t.Run("malformed mid", func(t *testing.T) {
+		_, err := c.ParseMatch(ctx, "11405")
+		assert.Error(t, err)
+	})
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+	}{
+		{name: "missing column", csv: "Season,Round,Home.team,Away.team,First.name,Surname,Playing.for,Kicks\n2025,1,Carlton,Richmond,Sam,Walsh,Carlton,14\n"},
+		{name: "stat not a number", csv: "Season,Round,Home.team,Away.team,First.name,Surname,Playing.for,Kicks,Marks,Handballs,Goals,Behinds,Hit.Outs,Tackles\n2025,1,Carlton,Richmond,Sam,Walsh,Carlton,fourteen,4,13,0,2,0,4\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "2025.csv"), []byte(tt.csv), 0o644))
+			_, err := NewClient(dir).ParseMatch(context.Background(), "2025/1/Carlton/Richmond")
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("no exports", func(t *testing.T) {
+		_, err := NewClient(t.TempDir()).ParseMatch(context.Background(), "2025/1/Carlton/Richmond")
+		assert.Error(t, err)
+	})
+}
+
+func TestRoundCode(t *testing.T) {
+	for name, want := range map[string]string{
+		"Round 5":       "5",
+		"5":             "5",
+		"R5":            "5",
+		"Round 05":      "5",
+		"Opening Round": "0",
+		"0":             "0",
+		"Grand Final":   "GF",
+		"GF":            "GF",
+		"Semi Final":    "SF",
+	} {
+		assert.Equal(t, want, roundCode(name), name)
+	}
+	for _, name := range []string{"Round 5", "Opening Round", "Grand Final", "Elimination Final"} {
+		assert.Equal(t, name, roundName(roundCode(name)), "round name round-trips")
+	}
+}
@@ -0,0 +1,239 @@
+package afltables
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/domain"
+)
+
+// row is one player's stats in one match.
+type row struct {
+	key                    matchKey
+	homeGoals, homeBehinds int
+	awayGoals, awayBehinds int
+	teamScores             bool // the export has team score columns
+	venue                  string
+	start                  time.Time // zero if the export has no date and start time
+	player                 application.PlayerStats
+}
+
+// Columns of an afltables player stats export, as named in its header once
+// lowercased and stripped of punctuation ("Hit.Outs" is "hitouts"). Columns
+// in extraStatColumns go to the players' extra stats; others, such as
+// disposals, are ignored.
+const (
+	colSeason      = "season"
+	colRound       = "round"
+	colDate        = "date"
+	colStartTime   = "localstarttime"
+	colVenue       = "venue"
+	colHomeTeam    = "hometeam"
+	colAwayTeam    = "awayteam"
+	colHomeGoals   = "homegoals"
+	colHomeBehinds = "homebehinds"
+	colAwayGoals   = "awaygoals"
+	colAwayBehinds = "awaybehinds"
+	colFirstName   = "firstname"
+	colSurname     = "surname"
+	colPlayingFor  = "playingfor"
+	colKicks       = "kicks"
+	colHandballs   = "handballs"
+	colMarks       = "marks"
+	colHitouts     = "hitouts"
+	colTackles     = "tackles"
+	colGoals       = "goals"
+	colBehinds     = "behinds"
+)
+
+var requiredColumns = []string{
+	colSeason, colRound, colHomeTeam, colAwayTeam, colFirstName, colSurname, colPlayingFor,
+	colKicks, colHandballs, colMarks, colHitouts, colTackles, colGoals, colBehinds,
+}
+
+var teamScoreColumns = []string{colHomeGoals, colHomeBehinds, colAwayGoals, colAwayBehinds}
+
+// extraStatColumns maps the export's optional stat columns to the extra
+// stats they hold.
+var extraStatColumns = map[string]string{
+	"rebounds":               domain.StatRebound50s,
+	"inside50s":              domain.StatInside50s,
+	"clearances":             domain.StatClearances,
+	"clangers":               domain.StatClangers,
+	"freesfor":               domain.StatFreesFor,
+	"freesagainst":           domain.StatFreesAgainst,
+	"brownlowvotes":          domain.StatBrownlowVotes,
+	"contestedpossessions":   domain.StatContestedPossessions,
+	"uncontestedpossessions": domain.StatUncontestedPossessions,
+	"contestedmarks":         domain.StatContestedMarks,
+	"marksinside50":          domain.StatMarksInside50,
+	"onepercenters":          domain.StatOnePercenters,
+	"bounces":                domain.StatBounces,
+	"goalassists":            domain.StatGoalAssists,
+	"timeonground":           domain.StatTimeOnGround,
+}
+
+// season reads the rows of every export in the directory played in season.
+func (c *Client) season(season string) ([]row, error) {
+	paths, err := filepath.Glob(filepath.Join(c.dir, "*.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("list afltables exports: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no afltables exports in %s", c.dir)
+	}
+	var rows []row
+	for _, path := range paths {
+		fileRows, err := readFile(path, season)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, fileRows...)
+	}
+	return rows, nil
+}
+
+func readFile(path, season string) ([]row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open afltables export: %w", err)
+	}
+	defer f.Close()
+	rows, err := readRows(f, season)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return rows, nil
+}
+
+// readRows reads the rows of an export played in season.
+func readRows(r io.Reader, season string) ([]row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[columnName(name)] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+	teamScores := true
+	for _, name := range teamScoreColumns {
+		_, ok := cols[name]
+		teamScores = teamScores && ok
+	}
+
+	var rows []row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		cell := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if cell(colSeason) != season {
+			continue
+		}
+		var bad error
+		num := func(name string) int {
+			v := cell(name)
+			if v == "" { // afltables leaves zeros blank
+				return 0
+			}
+			n, err := strconv.Atoi(v)
+			if err != nil && bad == nil {
+				bad = fmt.Errorf("line %d: %s %q is not a number", line, name, v)
+			}
+			return n
+		}
+
+		r := row{
+			key: matchKey{
+				season: season,
+				round:  cell(colRound),
+				home:   cell(colHomeTeam),
+				away:   cell(colAwayTeam),
+			},
+			teamScores: teamScores,
+			player: application.PlayerStats{
+				Name:      cell(colFirstName) + " " + cell(colSurname),
+				ClubName:  cell(colPlayingFor),
+				Kicks:     num(colKicks),
+				Handballs: num(colHandballs),
+				Marks:     num(colMarks),
+				Hitouts:   num(colHitouts),
+				Tackles:   num(colTackles),
+				Goals:     num(colGoals),
+				Behinds:   num(colBehinds),
+			},
+		}
+		for col, stat := range extraStatColumns {
+			if _, ok := cols[col]; !ok {
+				continue
+			}
+			if r.player.Extra == nil {
+				r.player.Extra = make(domain.ExtraStats)
+			}
+			r.player.Extra[stat] = float64(num(col))
+		}
+		if teamScores {
+			r.homeGoals, r.homeBehinds = num(colHomeGoals), num(colHomeBehinds)
+			r.awayGoals, r.awayBehinds = num(colAwayGoals), num(colAwayBehinds)
+		}
+		r.venue = cell(colVenue)
+		if date, start := cell(colDate), cell(colStartTime); date != "" && start != "" {
+			r.start, err = startTime(date, start, r.venue)
+			if err != nil && bad == nil {
+				bad = fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		if bad != nil {
+			return nil, bad
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// startTime returns the time a match starts from its date ("2025-03-13")
+// and local start time ("1930"), read in the timezone of its venue.
+func startTime(date, start, venue string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02 1504", date+" "+fmt.Sprintf("%04s", start), venueLocation(venue))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("start %q %q is not a date and time", date, start)
+	}
+	return t, nil
+}
+
+// columnName returns a header cell lowercased and stripped of punctuation.
+func columnName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,97 @@
+// Package fixturefile reads a season's fixture from a CSV file, for a season
+// no source lists yet or to correct one that does.
+//
+// The file's header row names its columns: round, home and away, and
+// optionally venue and start. Clubs are written as in afl.club or by one of
+// their usual codes or nicknames. start is an RFC 3339 time
+// ("2026-03-12T19:30:00+11:00"), or empty if the match isn't scheduled yet.
+//
+//	round,home,away,venue,start
+//	Round 1,Carlton,Richmond,MCG,2026-03-12T19:30:00+11:00
+package fixturefile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"xffl/services/afl/internal/application"
+)
+
+const (
+	colRound = "round"
+	colHome  = "home"
+	colAway  = "away"
+	colVenue = "venue"
+	colStart = "start"
+)
+
+var requiredColumns = []string{colRound, colHome, colAway}
+
+// ReadFile reads the fixture in the CSV file at path.
+func ReadFile(path string) ([]application.FixtureListing, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open fixture file: %w", err)
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads a fixture CSV, in the order it lists the matches.
+func Read(r io.Reader) ([]application.FixtureListing, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var fixture []application.FixtureListing
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		cell := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		listing := application.FixtureListing{
+			RoundName: cell(colRound),
+			HomeClub:  cell(colHome),
+			AwayClub:  cell(colAway),
+			Venue:     cell(colVenue),
+		}
+		if listing.RoundName == "" || listing.HomeClub == "" || listing.AwayClub == "" {
+			return nil, fmt.Errorf("line %d: round, home and away are required", line)
+		}
+		if start := cell(colStart); start != "" {
+			if listing.StartTime, err = time.Parse(time.RFC3339, start); err != nil {
+				return nil, fmt.Errorf("line %d: start %q is not an RFC 3339 time", line, start)
+			}
+		}
+		fixture = append(fixture, listing)
+	}
+	return fixture, nil
+}
@@ -0,0 +1,46 @@
+package fixturefile
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/afl/internal/application"
+)
+
+func TestRead(t *testing.T) {
+	fixture, err := Read(strings.NewReader(
+		"Round,Home,Away,Venue,Start\n" +
+			"Round 1,Carlton,Richmond,MCG,2026-03-12T19:30:00+11:00\n" +
+			"Round 1, GWS , Collingwood,,\n"))
+	require.NoError(t, err)
+	require.Len(t, fixture, 2)
+	first := fixture[0]
+	assert.Equal(t, "Round 1", first.RoundName)
+	assert.Equal(t, "Carlton", first.HomeClub)
+	assert.Equal(t, "Richmond", first.AwayClub)
+	assert.Equal(t, "MCG", first.Venue)
+	assert.True(t, first.StartTime.Equal(time.Date(2026, 3, 12, 8, 30, 0, 0, time.UTC)), first.StartTime)
+	assert.Equal(t, application.FixtureListing{RoundName: "Round 1", HomeClub: "GWS", AwayClub: "Collingwood"}, fixture[1],
+		"venue and start are optional")
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+	}{
+		{name: "missing column", csv: "round,home\nRound 1,Carlton\n"},
+		{name: "missing club", csv: "round,home,away\nRound 1,Carlton,\n"},
+		{name: "start not a time", csv: "round,home,away,start\nRound 1,Carlton,Richmond,Thu 7:30pm\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.csv))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,73 +1,162 @@
 package footywire
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"log/slog"
-	"net/http"
+	"maps"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/net/html"
 
 	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/domain"
+	"xffl/services/afl/internal/infrastructure/sourcehttp"
 )
 
+var fixtureScoreRE = regexp.MustCompile(`\d+\s*-\s*\d+`)
+
+// roundHeadingRE matches the heading row of a round in the fixture list.
+var roundHeadingRE = regexp.MustCompile(`(?i)^(opening round|round \d+|(elimination|qualifying|semi|preliminary|grand) final)$`)
+
+// Source is the key FootyWire's match and player mappings are stored under.
+const Source = "footywire"
+
+// DefaultBaseURL is FootyWire's AFL section, which every page path is
+// relative to.
+const DefaultBaseURL = "https://www.footywire.com/afl/footy"
+
 const (
-	baseURL      = "https://www.footywire.com/afl/footy"
 	statsPathFmt = "/ft_match_statistics?mid=%s"
 	fixturePath  = "/ft_match_list"
+	// advancedStatsPathFmt is the match's advanced statistics page, with the
+	// stats the basic page lacks: contested possessions, time on ground, …
+	advancedStatsPathFmt = "/ft_match_statistics?mid=%s&advv=Y"
+	// seasonFixturePathFmt is a season's fixture list; fixturePath is the
+	// current season's.
+	seasonFixturePathFmt = "/ft_match_list?year=%d"
+)
+
+// How long cached pages are used before they're revalidated. Fixture lists
+// change as results come in. A match page is only kept once a fixture list
+// has shown the match's score; until then every import revalidates it.
+const (
+	fixtureTTL    = 10 * time.Minute
+	finalMatchTTL = 7 * 24 * time.Hour
 )
 
 // FootywireClient fetches and parses AFL match data from FootyWire.
 // It implements application.StatsParser and application.FixtureDiscovery.
 type FootywireClient struct {
-	http *http.Client
+	baseURL string
+	http    *sourcehttp.Client
+	// finalMids holds the mids a fetched fixture list showed a score for.
+	finalMids sync.Map
 }
 
-func NewFootywireClient() *FootywireClient {
-	return &FootywireClient{http: &http.Client{}}
+// NewFootywireClient returns a client fetching pages from baseURL, or
+// DefaultBaseURL when it is empty, through client, or one with
+// sourcehttp.DefaultConfig when it is nil. Tests and local runs point it at
+// a stand-in server or give client an httpreplay transport.
+func NewFootywireClient(baseURL string, client *sourcehttp.Client) *FootywireClient {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if client == nil {
+		client = sourcehttp.New(sourcehttp.DefaultConfig())
+	}
+	return &FootywireClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    client,
+	}
 }
 
-// ParseMatch fetches the match statistics page for the given mid and returns parsed stats.
+// ParseMatch fetches the match statistics page for the given mid and returns parsed stats,
+// with the extra stats from the advanced statistics page when it can be read.
 func (c *FootywireClient) ParseMatch(ctx context.Context, mid string) (application.MatchStats, error) {
-	url := baseURL + fmt.Sprintf(statsPathFmt, mid)
-	body, err := c.fetch(ctx, url)
+	var ttl time.Duration
+	if _, final := c.finalMids.Load(mid); final {
+		ttl = finalMatchTTL
+	}
+	url := c.baseURL + fmt.Sprintf(statsPathFmt, mid)
+	body, err := c.fetch(ctx, url, ttl)
 	if err != nil {
 		return application.MatchStats{}, fmt.Errorf("fetch match stats page: %w", err)
 	}
-	defer body.Close()
-	return ParseMatchStatsHTML(ctx, body)
+	stats, err := ParseMatchStatsHTML(ctx, body)
+	if err != nil {
+		return application.MatchStats{}, err
+	}
+
+	// The core stats are on the basic page, so a missing advanced page
+	// costs only extra stats.
+	advanced, err := c.fetch(ctx, c.baseURL+fmt.Sprintf(advancedStatsPathFmt, mid), ttl)
+	if err != nil {
+		slog.WarnContext(ctx, "advanced match stats unavailable", slog.String("mid", mid), slog.Any("error", err))
+		return stats, nil
+	}
+	withAdvanced, err := AddAdvancedStatsHTML(ctx, advanced, stats)
+	if err != nil {
+		slog.WarnContext(ctx, "advanced match stats unreadable", slog.String("mid", mid), slog.Any("error", err))
+		return stats, nil
+	}
+	return withAdvanced, nil
 }
 
 // FindMatchMid scrapes the fixture list to find the FootyWire match ID for the given
-// round and clubs. Returns an error if no matching match is found.
-func (c *FootywireClient) FindMatchMid(ctx context.Context, roundName, homeClub, awayClub string) (string, error) {
-	url := baseURL + fixturePath
-	body, err := c.fetch(ctx, url)
+// round and clubs. Returns an error if no matching match is found. The fixture
+// list is the current season's, so the match's start time isn't needed.
+func (c *FootywireClient) FindMatchMid(ctx context.Context, roundName string, match application.FixtureQuery) (string, error) {
+	url := c.baseURL + fixturePath
+	body, err := c.fetch(ctx, url, fixtureTTL)
 	if err != nil {
 		return "", fmt.Errorf("fetch fixture list: %w", err)
 	}
-	defer body.Close()
-	return ParseFixtureMid(ctx, body, roundName, homeClub, awayClub)
+	return ParseFixtureMid(ctx, body, roundName, match.HomeClub, match.AwayClub)
 }
 
-func (c *FootywireClient) fetch(ctx context.Context, url string) (io.ReadCloser, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// FindRoundMids scrapes the fixture list once and finds each of matches in it,
+// noting which already show a final score.
+func (c *FootywireClient) FindRoundMids(ctx context.Context, roundName string, matches []application.FixtureQuery) (map[int]application.FixtureMatch, error) {
+	url := c.baseURL + fixturePath
+	body, err := c.fetch(ctx, url, fixtureTTL)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fetch fixture list: %w", err)
 	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; xffl-stats-importer/1.0)")
-	resp, err := c.http.Do(req)
+	found, err := ParseFixtureMatches(ctx, body, roundName, matches)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		resp.Body.Close()
-		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	for _, fm := range found {
+		if fm.Complete {
+			c.finalMids.Store(fm.Mid, true)
+		}
+	}
+	return found, nil
+}
+
+// ListSeasonFixture scrapes year's fixture list.
+func (c *FootywireClient) ListSeasonFixture(ctx context.Context, year int) ([]application.FixtureListing, error) {
+	url := c.baseURL + fmt.Sprintf(seasonFixturePathFmt, year)
+	body, err := c.fetch(ctx, url, fixtureTTL)
+	if err != nil {
+		return nil, fmt.Errorf("fetch fixture list: %w", err)
+	}
+	return ParseSeasonFixture(ctx, body, year)
+}
+
+func (c *FootywireClient) fetch(ctx context.Context, url string, ttl time.Duration) (io.Reader, error) {
+	body, err := c.http.Get(ctx, url, ttl)
+	if err != nil {
+		return nil, err
 	}
-	return resp.Body, nil
+	return bytes.NewReader(body), nil
 }
 
 // ParseMatchStatsHTML parses the FootyWire match statistics HTML page.
@@ -92,41 +181,92 @@ func ParseMatchStatsHTML(ctx context.Context, r io.Reader) (application.MatchSta
 	if scoreTable == nil {
 		return application.MatchStats{}, fmt.Errorf("could not find score summary table (Team|Q1..Final)")
 	}
-	homeClub, awayClub, homeGoals, homeBehinds, awayGoals, awayBehinds, err := parseScoreTable(scoreTable)
+	home, away, err := parseScoreTable(scoreTable)
 	if err != nil {
 		return application.MatchStats{}, fmt.Errorf("parse score table: %w", err)
 	}
 	slog.DebugContext(ctx, "score parsed",
-		slog.String("home", homeClub), slog.Int("homeGoals", homeGoals), slog.Int("homeBehinds", homeBehinds),
-		slog.String("away", awayClub), slog.Int("awayGoals", awayGoals), slog.Int("awayBehinds", awayBehinds),
+		slog.String("home", home.club), slog.Int("homeGoals", home.goals), slog.Int("homeBehinds", home.behinds),
+		slog.String("away", away.club), slog.Int("awayGoals", away.goals), slog.Int("awayBehinds", away.behinds),
 	)
 
-	tables := findStatsTables(doc)
+	tables := findStatsTables(doc, basicStatsHeader)
 	slog.DebugContext(ctx, "player stats tables found", slog.Int("count", len(tables)))
 	if len(tables) < 2 {
 		return application.MatchStats{}, fmt.Errorf("expected 2 player stats tables, found %d", len(tables))
 	}
 
-	homePlayers, err := parsePlayerRows(tables[0], homeClub)
+	homePlayers, err := parsePlayerRows(tables[0], home.club, basicStatsHeader)
 	if err != nil {
 		return application.MatchStats{}, fmt.Errorf("parse home player stats: %w", err)
 	}
-	awayPlayers, err := parsePlayerRows(tables[1], awayClub)
+	awayPlayers, err := parsePlayerRows(tables[1], away.club, basicStatsHeader)
 	if err != nil {
 		return application.MatchStats{}, fmt.Errorf("parse away player stats: %w", err)
 	}
 
 	return application.MatchStats{
-		HomeClubName:    homeClub,
-		HomeTeamGoals:   homeGoals,
-		HomeTeamBehinds: homeBehinds,
-		AwayClubName:    awayClub,
-		AwayTeamGoals:   awayGoals,
-		AwayTeamBehinds: awayBehinds,
+		HomeClubName:    home.club,
+		HomeTeamGoals:   home.goals,
+		HomeTeamBehinds: home.behinds,
+		HomeQuarters:    home.quarters,
+		AwayClubName:    away.club,
+		AwayTeamGoals:   away.goals,
+		AwayTeamBehinds: away.behinds,
+		AwayQuarters:    away.quarters,
 		Players:         append(homePlayers, awayPlayers...),
 	}, nil
 }
 
+// AddAdvancedStatsHTML parses FootyWire's advanced match statistics page and
+// adds its stats to the extra stats of stats' players.
+//
+// The page has the same layout as the basic one, with a player stats table
+// per club, home first, whose columns are the advanced stats (CP, UP, DE%,
+// TOG%, …). Players are matched by club and name as the basic page shows
+// them; a player only on the advanced page is left out.
+func AddAdvancedStatsHTML(ctx context.Context, r io.Reader, stats application.MatchStats) (application.MatchStats, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return application.MatchStats{}, fmt.Errorf("parse HTML: %w", err)
+	}
+
+	tables := findStatsTables(doc, advancedStatsHeader)
+	if len(tables) < 2 {
+		return application.MatchStats{}, fmt.Errorf("expected 2 advanced player stats tables, found %d", len(tables))
+	}
+	type player struct{ club, name string }
+	advanced := make(map[player]domain.ExtraStats)
+	for i, club := range []string{stats.HomeClubName, stats.AwayClubName} {
+		rows, err := parsePlayerRows(tables[i], club, advancedStatsHeader)
+		if err != nil {
+			return application.MatchStats{}, fmt.Errorf("parse advanced player stats: %w", err)
+		}
+		for _, ps := range rows {
+			advanced[player{club, ps.Name}] = ps.Extra
+		}
+	}
+
+	players := make([]application.PlayerStats, len(stats.Players))
+	for i, ps := range stats.Players {
+		extra, ok := advanced[player{ps.ClubName, ps.Name}]
+		if !ok {
+			slog.DebugContext(ctx, "player missing from advanced stats", slog.String("club", ps.ClubName), slog.String("name", ps.Name))
+			players[i] = ps
+			continue
+		}
+		merged := maps.Clone(ps.Extra)
+		if merged == nil {
+			merged = make(domain.ExtraStats, len(extra))
+		}
+		maps.Copy(merged, extra)
+		ps.Extra = merged
+		players[i] = ps
+	}
+	stats.Players = players
+	return stats, nil
+}
+
 // ParseFixtureMid parses the FootyWire fixture list page and returns the mid
 // for the match identified by roundName, homeClub and awayClub.
 //
@@ -146,6 +286,110 @@ func ParseFixtureMid(ctx context.Context, r io.Reader, roundName, homeClub, away
 	return mid, nil
 }
 
+// ParseFixtureMatches parses the FootyWire fixture list page and finds each of
+// matches in it, as ParseFixtureMid does for one. A match whose row shows a
+// score ("95-80") is complete. Matches not found are left out of the result.
+func ParseFixtureMatches(ctx context.Context, r io.Reader, roundName string, matches []application.FixtureQuery) (map[int]application.FixtureMatch, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse HTML: %w", err)
+	}
+
+	found := make(map[int]application.FixtureMatch, len(matches))
+	for _, m := range matches {
+		link := findFixtureLink(ctx, doc, roundName, m.HomeClub, m.AwayClub)
+		if link == nil {
+			continue
+		}
+		found[m.MatchID] = application.FixtureMatch{
+			Mid:      extractMid(attrVal(link, "href")),
+			Complete: fixtureScoreRE.MatchString(nearestRowText(link)),
+		}
+	}
+	return found, nil
+}
+
+// ParseSeasonFixture parses the FootyWire fixture list page for year into
+// every match it lists.
+//
+// Each round is headed by a row whose only cell is the round's name. Each
+// match row has a date cell ("Thu 12 Mar 7:30pm"), a cell with the two
+// teams' links separated by "v", home first, and then a venue cell. Rows
+// whose teams aren't known yet, such as finals, are left out. Times are read
+// as Melbourne time, as FootyWire shows them.
+func ParseSeasonFixture(ctx context.Context, r io.Reader, year int) ([]application.FixtureListing, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse HTML: %w", err)
+	}
+	melbourne, err := time.LoadLocation("Australia/Melbourne")
+	if err != nil {
+		return nil, fmt.Errorf("load Melbourne time: %w", err)
+	}
+
+	var fixture []application.FixtureListing
+	round := ""
+	for _, row := range allRows(doc) {
+		cells := rowCells(row)
+		if len(cells) == 1 {
+			if text := strings.TrimSpace(textContent(cells[0])); roundHeadingRE.MatchString(text) {
+				round = text
+			}
+			continue
+		}
+		if round == "" {
+			continue
+		}
+		teamsIdx := -1
+		var teams []string
+		for i, cell := range cells {
+			if teams = teamLinks(cell); len(teams) == 2 {
+				teamsIdx = i
+				break
+			}
+		}
+		if teamsIdx < 0 {
+			continue
+		}
+
+		listing := application.FixtureListing{RoundName: round, HomeClub: teams[0], AwayClub: teams[1]}
+		if teamsIdx+1 < len(cells) {
+			listing.Venue = strings.TrimSpace(textContent(cells[teamsIdx+1]))
+		}
+		if teamsIdx > 0 {
+			date := strings.Join(strings.Fields(textContent(cells[0])), " ")
+			start, err := time.ParseInLocation("Mon 2 Jan 3:04pm 2006", fmt.Sprintf("%s %d", date, year), melbourne)
+			if err != nil {
+				slog.DebugContext(ctx, "fixture row without a start time", slog.String("round", round), slog.String("date", date))
+			} else {
+				listing.StartTime = start
+			}
+		}
+		fixture = append(fixture, listing)
+	}
+	if len(fixture) == 0 {
+		return nil, fmt.Errorf("no matches found in the %d fixture list", year)
+	}
+	return fixture, nil
+}
+
+// teamLinks returns the text of the team links ("th-carlton-blues") in n.
+func teamLinks(n *html.Node) []string {
+	var teams []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" && strings.Contains(attrVal(n, "href"), "th-") {
+			teams = append(teams, strings.TrimSpace(textContent(n)))
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return teams
+}
+
 // ---- internal parsing helpers ----
 
 // findScoreTable finds the Team|Q1|Q2|Q3|Q4|Final summary table.
@@ -186,33 +430,52 @@ func isScoreTable(table *html.Node) bool {
 	return hasTeam && hasFinal
 }
 
-// parseScoreTable extracts home/away club names and final-quarter goals.behinds.
-func parseScoreTable(table *html.Node) (homeClub, awayClub string, homeGoals, homeBehinds, awayGoals, awayBehinds int, err error) {
+// scoreLine is one club's row of the score table.
+type scoreLine struct {
+	club     string
+	goals    int
+	behinds  int
+	quarters []domain.QuarterScore
+}
+
+// scoreQuarterColumns are the score table's quarter headers, in order.
+var scoreQuarterColumns = []string{"Q1", "Q2", "Q3", "Q4"}
+
+// parseScoreTable extracts the home and away club names, their final-quarter
+// goals.behinds and the goals.behinds at the end of each quarter.
+func parseScoreTable(table *html.Node) (home, away scoreLine, err error) {
 	rows := tableRows(table)
 	if len(rows) < 3 {
-		err = fmt.Errorf("score table has %d rows, need at least 3", len(rows))
-		return
+		return home, away, fmt.Errorf("score table has %d rows, need at least 3", len(rows))
 	}
 	colIdx := buildColIndex(rowCells(rows[0]))
-	q4Idx, ok := colIdx["Q4"]
-	if !ok {
-		err = fmt.Errorf("score table missing Q4 column")
-		return
+	if _, ok := colIdx["Q4"]; !ok {
+		return home, away, fmt.Errorf("score table missing Q4 column")
 	}
-	parseCells := func(row *html.Node) (club string, goals, behinds int) {
+	parseCells := func(row *html.Node) (line scoreLine) {
 		cells := rowCells(row)
 		if len(cells) == 0 {
 			return
 		}
-		club = strings.TrimSpace(textContent(cells[0]))
-		if q4Idx < len(cells) {
-			goals, behinds = parseGoalsBehinds(strings.TrimSpace(textContent(cells[q4Idx])))
+		line.club = strings.TrimSpace(textContent(cells[0]))
+		for i, header := range scoreQuarterColumns {
+			idx, ok := colIdx[header]
+			if !ok || idx >= len(cells) {
+				continue
+			}
+			text := strings.TrimSpace(textContent(cells[idx]))
+			if text == "" {
+				continue
+			}
+			goals, behinds := parseGoalsBehinds(text)
+			line.quarters = append(line.quarters, domain.QuarterScore{Quarter: i + 1, Goals: goals, Behinds: behinds})
+			if header == "Q4" {
+				line.goals, line.behinds = goals, behinds
+			}
 		}
 		return
 	}
-	homeClub, homeGoals, homeBehinds = parseCells(rows[1])
-	awayClub, awayGoals, awayBehinds = parseCells(rows[2])
-	return
+	return parseCells(rows[1]), parseCells(rows[2]), nil
 }
 
 // parseGoalsBehinds parses "9.6" → (9, 6). Returns zeros on bad input.
@@ -225,14 +488,53 @@ func parseGoalsBehinds(s string) (goals, behinds int) {
 	return
 }
 
+// Headers identifying player stats tables: "K" (kicks) on the basic page and
+// "CP" (contested possessions) on the advanced one.
+const (
+	basicStatsHeader    = "K"
+	advancedStatsHeader = "CP"
+)
+
+// extraStatColumns maps FootyWire's column headers to the extra stats they
+// hold. The basic page has the first group, the advanced page the second;
+// goal assists are on both. Disposals are left out, being kicks plus handballs.
+var extraStatColumns = map[string]string{
+	"GA":  domain.StatGoalAssists,
+	"I50": domain.StatInside50s,
+	"CL":  domain.StatClearances,
+	"CG":  domain.StatClangers,
+	"R50": domain.StatRebound50s,
+	"FF":  domain.StatFreesFor,
+	"FA":  domain.StatFreesAgainst,
+	"AF":  domain.StatFantasyPoints,
+	"SC":  domain.StatSupercoachPoints,
+
+	"CP":   domain.StatContestedPossessions,
+	"UP":   domain.StatUncontestedPossessions,
+	"ED":   domain.StatEffectiveDisposals,
+	"DE%":  domain.StatDisposalEfficiency,
+	"CM":   domain.StatContestedMarks,
+	"MI5":  domain.StatMarksInside50,
+	"1%":   domain.StatOnePercenters,
+	"BO":   domain.StatBounces,
+	"CCL":  domain.StatCentreClearances,
+	"SCL":  domain.StatStoppageClearances,
+	"SI":   domain.StatScoreInvolvements,
+	"MG":   domain.StatMetresGained,
+	"TO":   domain.StatTurnovers,
+	"ITC":  domain.StatIntercepts,
+	"T5":   domain.StatTacklesInside50,
+	"TOG%": domain.StatTimeOnGround,
+}
+
 // findStatsTables returns all <table> nodes whose own rows (not in nested tables)
-// contain a cell with text exactly "K" (Kicks column header).
-func findStatsTables(doc *html.Node) []*html.Node {
+// contain a cell with text exactly header.
+func findStatsTables(doc *html.Node, header string) []*html.Node {
 	var tables []*html.Node
 	var walk func(*html.Node)
 	walk = func(n *html.Node) {
 		if n.Type == html.ElementNode && n.Data == "table" {
-			if isStatsTable(n) {
+			if isStatsTable(n, header) {
 				tables = append(tables, n)
 			}
 		}
@@ -245,12 +547,12 @@ func findStatsTables(doc *html.Node) []*html.Node {
 }
 
 // isStatsTable returns true when one of the table's own cells (not in nested tables)
-// has text exactly "K". Uses tableRows so outer layout tables that merely contain
+// has text exactly header. Uses tableRows so outer layout tables that merely contain
 // a stats table inside a cell are not matched.
-func isStatsTable(table *html.Node) bool {
+func isStatsTable(table *html.Node, header string) bool {
 	for _, row := range tableRows(table) {
 		for _, cell := range rowCells(row) {
-			if strings.TrimSpace(textContent(cell)) == "K" {
+			if strings.TrimSpace(textContent(cell)) == header {
 				return true
 			}
 		}
@@ -259,16 +561,17 @@ func isStatsTable(table *html.Node) bool {
 }
 
 // parsePlayerRows extracts player stats from a player stats table.
-// The table must have a header row containing "K"; club name is injected from
-// the score table rather than parsed from a heading row.
-func parsePlayerRows(table *html.Node, clubName string) ([]application.PlayerStats, error) {
+// The table must have a header row containing header; club name is injected from
+// the score table rather than parsed from a heading row. Columns in
+// extraStatColumns go to the players' extra stats.
+func parsePlayerRows(table *html.Node, clubName, header string) ([]application.PlayerStats, error) {
 	rows := tableRows(table)
 
 	var colIdx map[string]int
 	var dataRows []*html.Node
 	for i, row := range rows {
 		idx := buildColIndex(rowCells(row))
-		if _, ok := idx["K"]; ok {
+		if _, ok := idx[header]; ok {
 			colIdx = idx
 			dataRows = rows[i+1:]
 			break
@@ -305,11 +608,34 @@ func parsePlayerRows(table *html.Node, clubName string) ([]application.PlayerSta
 			Tackles:   cellInt(cells, colIdx, "T"),
 			Goals:     cellInt(cells, colIdx, "G"),
 			Behinds:   cellInt(cells, colIdx, "B"),
+			Extra:     extraStats(cells, colIdx),
 		})
 	}
 	return players, nil
 }
 
+// extraStats returns the extra stats in a player row, or nil if the table
+// has none of their columns. A blank or unreadable cell is left out.
+func extraStats(cells []*html.Node, colIdx map[string]int) domain.ExtraStats {
+	var extra domain.ExtraStats
+	for col, stat := range extraStatColumns {
+		i, ok := colIdx[col]
+		if !ok || i >= len(cells) {
+			continue
+		}
+		text := strings.TrimSuffix(strings.TrimSpace(textContent(cells[i])), "%")
+		v, err := strconv.ParseFloat(strings.ReplaceAll(text, ",", ""), 64)
+		if err != nil {
+			continue
+		}
+		if extra == nil {
+			extra = make(domain.ExtraStats)
+		}
+		extra[stat] = v
+	}
+	return extra
+}
+
 // canonicalNameFromCell extracts the canonical player name from a player profile link
 // in the name cell. FootyWire renders these as:
 //
@@ -421,6 +747,15 @@ func matchesClub(rowText, normClub string) bool {
 // round-based disambiguation is not applied. Club-name matching is sufficient for
 // the regular season where each matchup occurs at most twice.
 func findMidInFixture(ctx context.Context, doc *html.Node, roundName, homeClub, awayClub string) string {
+	link := findFixtureLink(ctx, doc, roundName, homeClub, awayClub)
+	if link == nil {
+		return ""
+	}
+	return extractMid(attrVal(link, "href"))
+}
+
+// findFixtureLink returns the stats link of the fixture row for the match.
+func findFixtureLink(ctx context.Context, doc *html.Node, roundName, homeClub, awayClub string) *html.Node {
 	normHome := normStr(homeClub)
 	normAway := normStr(awayClub)
 
@@ -430,8 +765,8 @@ func findMidInFixture(ctx context.Context, doc *html.Node, roundName, homeClub,
 		slog.String("away", awayClub), slog.String("normAway", normAway),
 	)
 
-	var walk func(*html.Node) string
-	walk = func(n *html.Node) string {
+	var walk func(*html.Node) *html.Node
+	walk = func(n *html.Node) *html.Node {
 		if n.Type == html.ElementNode {
 			if n.Data == "a" {
 				href := attrVal(n, "href")
@@ -446,20 +781,19 @@ func findMidInFixture(ctx context.Context, doc *html.Node, roundName, homeClub,
 						slog.Bool("awayMatch", awayMatch),
 					)
 					if homeMatch && awayMatch {
-						mid := extractMid(href)
-						slog.DebugContext(ctx, "fixture match found", slog.String("mid", mid))
-						return mid
+						slog.DebugContext(ctx, "fixture match found", slog.String("mid", extractMid(href)))
+						return n
 					}
 				}
 			}
 		}
 
 		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			if mid := walk(c); mid != "" {
-				return mid
+			if link := walk(c); link != nil {
+				return link
 			}
 		}
-		return ""
+		return nil
 	}
 
 	return walk(doc)
@@ -515,6 +849,23 @@ func tableRows(table *html.Node) []*html.Node {
 	return rows
 }
 
+// allRows returns every <tr> in n in document order, including those of
+// tables nested in other tables' cells.
+func allRows(n *html.Node) []*html.Node {
+	var rows []*html.Node
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "tr" {
+			rows = append(rows, n)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return rows
+}
+
 func rowCells(row *html.Node) []*html.Node {
 	var cells []*html.Node
 	for c := row.FirstChild; c != nil; c = c.NextSibling {
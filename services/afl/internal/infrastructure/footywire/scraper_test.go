@@ -4,13 +4,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"context"
 
 	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/domain"
+	"xffl/services/afl/internal/infrastructure/httpreplay"
+	"xffl/services/afl/internal/infrastructure/sourcehttp"
 )
 
 // ---- ParseMatchStatsHTML ----
@@ -35,6 +40,21 @@ func TestParseMatchStatsHTML_ParsesTwoClubs(t *testing.T) {
 		assert.Equal(t, 7, stats.AwayTeamBehinds)
 	})
 
+	t.Run("quarter-by-quarter scores", func(t *testing.T) {
+		assert.Equal(t, []domain.QuarterScore{
+			{Quarter: 1, Goals: 4, Behinds: 3},
+			{Quarter: 2, Goals: 8, Behinds: 5},
+			{Quarter: 3, Goals: 11, Behinds: 7},
+			{Quarter: 4, Goals: 14, Behinds: 9},
+		}, stats.HomeQuarters)
+		assert.Equal(t, []domain.QuarterScore{
+			{Quarter: 1, Goals: 2, Behinds: 2},
+			{Quarter: 2, Goals: 5, Behinds: 4},
+			{Quarter: 3, Goals: 7, Behinds: 5},
+			{Quarter: 4, Goals: 10, Behinds: 7},
+		}, stats.AwayQuarters)
+	})
+
 	t.Run("home players parsed with correct stats", func(t *testing.T) {
 		carltonPlayers := playersForClub(stats.Players, "Carlton")
 		require.Len(t, carltonPlayers, 3)
@@ -68,6 +88,56 @@ func TestParseMatchStatsHTML_ParsesTwoClubs(t *testing.T) {
 	})
 }
 
+func TestParseMatchStatsHTML_ExtraStats(t *testing.T) {
+	ctx := context.Background()
+	f, err := os.Open("testdata/match_stats_full.html")
+	require.NoError(t, err)
+	defer f.Close()
+
+	stats, err := ParseMatchStatsHTML(ctx, f)
+	require.NoError(t, err)
+
+	t.Run("basic page columns beyond the core stats are extra stats", func(t *testing.T) {
+		cripps := stats.Players[0]
+		assert.Equal(t, 15, cripps.Kicks)
+		assert.Equal(t, domain.ExtraStats{
+			domain.StatGoalAssists: 1, domain.StatInside50s: 6, domain.StatClearances: 9,
+			domain.StatClangers: 3, domain.StatRebound50s: 1, domain.StatFreesFor: 4,
+			domain.StatFreesAgainst: 2, domain.StatFantasyPoints: 118, domain.StatSupercoachPoints: 132,
+		}, cripps.Extra)
+	})
+
+	t.Run("blank cells are left out", func(t *testing.T) {
+		cotchin := playersForClub(stats.Players, "Richmond")[1]
+		assert.NotContains(t, cotchin.Extra, domain.StatGoalAssists)
+		assert.Equal(t, float64(5), cotchin.Extra[domain.StatClearances])
+	})
+
+	adv, err := os.Open("testdata/match_stats_advanced.html")
+	require.NoError(t, err)
+	defer adv.Close()
+	withAdvanced, err := AddAdvancedStatsHTML(ctx, adv, stats)
+	require.NoError(t, err)
+
+	t.Run("advanced page stats are added", func(t *testing.T) {
+		cripps := withAdvanced.Players[0]
+		assert.Equal(t, float64(14), cripps.Extra[domain.StatContestedPossessions])
+		assert.Equal(t, 65.2, cripps.Extra[domain.StatDisposalEfficiency])
+		assert.Equal(t, float64(86), cripps.Extra[domain.StatTimeOnGround])
+		assert.Equal(t, float64(118), cripps.Extra[domain.StatFantasyPoints], "basic page stats are kept")
+		assert.Equal(t, float64(1021), withAdvanced.Players[1].Extra[domain.StatMetresGained])
+	})
+
+	t.Run("players missing from the advanced page keep their stats", func(t *testing.T) {
+		cotchin := playersForClub(withAdvanced.Players, "Richmond")[1]
+		assert.Equal(t, stats.Players[3], cotchin)
+	})
+
+	t.Run("the parsed stats are left unchanged", func(t *testing.T) {
+		assert.NotContains(t, stats.Players[0].Extra, domain.StatContestedPossessions)
+	})
+}
+
 // ---- ParseFixtureMid ----
 
 func TestParseFixtureMid_FindsCorrectMid(t *testing.T) {
@@ -118,29 +188,81 @@ func TestParseFixtureMid_FindsCorrectMid(t *testing.T) {
 	}
 }
 
+func TestParseFixtureMatches(t *testing.T) {
+	f, err := os.Open("testdata/fixture_list.html")
+	require.NoError(t, err)
+	defer f.Close()
+
+	found, err := ParseFixtureMatches(context.Background(), f, "Round 5", []application.FixtureQuery{
+		{MatchID: 1, HomeClub: "Carlton", AwayClub: "Richmond"},
+		{MatchID: 2, HomeClub: "Brisbane Lions", AwayClub: "Greater Western Sydney"},
+		{MatchID: 3, HomeClub: "Carlton", AwayClub: "Geelong"},
+		{MatchID: 4, HomeClub: "Nonexistent FC", AwayClub: "Phantom United"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]application.FixtureMatch{
+		1: {Mid: "11405"},
+		2: {Mid: "11406"},
+		3: {Mid: "11401", Complete: true},
+	}, found)
+}
+
 // ---- FootywireClient HTTP integration (mocked) ----
 
+func TestParseSeasonFixture(t *testing.T) {
+	f, err := os.Open("testdata/season_fixture.html")
+	require.NoError(t, err)
+	defer f.Close()
+
+	fixture, err := ParseSeasonFixture(context.Background(), f, 2026)
+	require.NoError(t, err)
+	require.Len(t, fixture, 3, "the finals row without teams is left out")
+
+	melbourne, err := time.LoadLocation("Australia/Melbourne")
+	require.NoError(t, err)
+	assert.Equal(t, application.FixtureListing{
+		RoundName: "Opening Round",
+		HomeClub:  "Sydney",
+		AwayClub:  "Carlton",
+		Venue:     "SCG",
+		StartTime: time.Date(2026, 3, 5, 19, 30, 0, 0, melbourne),
+	}, fixture[0])
+	assert.Equal(t, application.FixtureListing{
+		RoundName: "Round 1",
+		HomeClub:  "GWS",
+		AwayClub:  "Collingwood",
+		Venue:     "Engie Stadium",
+		StartTime: time.Date(2026, 3, 14, 16, 35, 0, 0, melbourne),
+	}, fixture[2])
+}
+
+// testHTTPClient fetches through transport, caching in cacheDir when it's
+// set, without the delays between requests and retries sources get.
+func testHTTPClient(transport http.RoundTripper, cacheDir string) *sourcehttp.Client {
+	return sourcehttp.New(sourcehttp.Config{Timeout: 5 * time.Second, Transport: transport, CacheDir: cacheDir})
+}
+
 func TestFootywireClient_ParseMatch_UsesHTTPServer(t *testing.T) {
 	statsHTML, err := os.ReadFile("testdata/match_stats.html")
 	require.NoError(t, err)
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Contains(t, r.URL.RawQuery, "mid=11405")
+		assert.Equal(t, "/afl/footy/ft_match_statistics", r.URL.Path)
+		assert.Equal(t, "11405", r.URL.Query().Get("mid"))
+		if r.URL.Query().Get("advv") != "" {
+			http.NotFound(w, r)
+			return
+		}
 		w.Header().Set("Content-Type", "text/html")
 		w.Write(statsHTML)
 	}))
 	defer srv.Close()
 
-	client := &FootywireClient{http: srv.Client()}
-	// Temporarily override baseURL for testing by calling the inner parse directly.
-	resp, err := srv.Client().Get(srv.URL + "?mid=11405")
-	require.NoError(t, err)
-	defer resp.Body.Close()
-
-	stats, err := ParseMatchStatsHTML(context.Background(), resp.Body)
-	require.NoError(t, err)
+	client := NewFootywireClient(srv.URL+"/afl/footy/", testHTTPClient(nil, ""))
+	stats, err := client.ParseMatch(context.Background(), "11405")
+	require.NoError(t, err, "a missing advanced page costs only the extra stats")
 	assert.Equal(t, "Carlton", stats.HomeClubName)
-	_ = client // ensures client is used
+	assert.Len(t, stats.Players, 5)
 }
 
 func TestFootywireClient_FindMatchMid_UsesHTTPServer(t *testing.T) {
@@ -148,26 +270,118 @@ func TestFootywireClient_FindMatchMid_UsesHTTPServer(t *testing.T) {
 	require.NoError(t, err)
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/afl/footy/ft_match_list", r.URL.Path)
 		w.Header().Set("Content-Type", "text/html")
 		w.Write(fixtureHTML)
 	}))
 	defer srv.Close()
 
-	// Call ParseFixtureMid directly with the served HTML.
-	resp, err := srv.Client().Get(srv.URL)
+	client := NewFootywireClient(srv.URL+"/afl/footy", testHTTPClient(nil, ""))
+	mid, err := client.FindMatchMid(context.Background(), "Round 5", application.FixtureQuery{HomeClub: "Carlton", AwayClub: "Richmond"})
 	require.NoError(t, err)
-	defer resp.Body.Close()
+	assert.Equal(t, "11405", mid)
+}
 
-	mid, err := ParseFixtureMid(context.Background(), resp.Body, "Round 5", "Carlton", "Richmond")
+func TestFootywireClient_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := NewFootywireClient(srv.URL, testHTTPClient(nil, "")).ParseMatch(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status 404")
+}
+
+func TestFootywireClient_CachesFinalMatchPages(t *testing.T) {
+	requests := map[string]int{}
+	var mu sync.Mutex
+	replay := httpreplay.Handler("testdata/corpus")
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.RequestURI()]++
+		mu.Unlock()
+		replay.ServeHTTP(w, r)
+	}))
+	defer standIn.Close()
+	count := func(uri string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests[uri]
+	}
+
+	ctx := context.Background()
+	client := NewFootywireClient(standIn.URL+"/afl/footy", testHTTPClient(nil, t.TempDir()))
+	const matchPage = "/afl/footy/ft_match_statistics?mid=11402"
+
+	_, err := client.ParseMatch(ctx, "11402")
 	require.NoError(t, err)
-	assert.Equal(t, "11405", mid)
+	_, err = client.ParseMatch(ctx, "11402")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count(matchPage), "a match not known to be final is fetched every time")
+
+	for range 2 {
+		_, err = client.FindRoundMids(ctx, "Round 1", []application.FixtureQuery{{MatchID: 1, HomeClub: "Carlton", AwayClub: "Richmond"}})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, count("/afl/footy/ft_match_list"), "the fixture list is cached")
+
+	_, err = client.ParseMatch(ctx, "11402")
+	require.NoError(t, err)
+	_, err = client.ParseMatch(ctx, "11402")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count(matchPage), "once the fixture shows its score, the match page is cached")
+}
+
+// ---- Replay corpus ----
+
+// TestFootywireClient_Replay runs the client against the pages in
+// testdata/corpus, both replayed in-process and served by a stand-in server.
+// The corpus is hand-written until it is recorded (see its README), so this
+// covers the replay wiring, not FootyWire's real markup.
+func TestFootywireClient_Replay(t *testing.T) {
+	standIn := httptest.NewServer(httpreplay.Handler("testdata/corpus"))
+	defer standIn.Close()
 
+	clients := map[string]*FootywireClient{
+		"replayer": NewFootywireClient("", testHTTPClient(httpreplay.NewReplayer("testdata/corpus"), "")),
+		"stand-in": NewFootywireClient(standIn.URL+"/afl/footy", testHTTPClient(nil, "")),
+	}
+	for name, client := range clients {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			found, err := client.FindRoundMids(ctx, "Round 1", []application.FixtureQuery{
+				{MatchID: 1, HomeClub: "Carlton", AwayClub: "Richmond"},
+				{MatchID: 2, HomeClub: "Melbourne", AwayClub: "Collingwood"},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, map[int]application.FixtureMatch{
+				1: {Mid: "11402", Complete: true},
+				2: {Mid: "11403"},
+			}, found)
+
+			stats, err := client.ParseMatch(ctx, "11402")
+			require.NoError(t, err)
+			assert.Equal(t, "Carlton", stats.HomeClubName)
+			assert.Equal(t, 14, stats.HomeTeamGoals)
+			assert.Len(t, stats.HomeQuarters, 4)
+			cripps := playersForClub(stats.Players, "Carlton")[0]
+			assert.Equal(t, "Patrick Cripps", cripps.Name)
+			assert.Equal(t, 14.0, cripps.Extra[domain.StatContestedPossessions], "extra stats come from the advanced page")
+
+			fixture, err := client.ListSeasonFixture(ctx, 2026)
+			require.NoError(t, err)
+			assert.Len(t, fixture, 3)
+
+			_, err = client.ParseMatch(ctx, "99999")
+			assert.Error(t, err, "pages not in the corpus are not found")
+		})
+	}
 }
 
-// ---- LevenshteinResolver ----
+// ---- NameResolver ----
 
-func TestLevenshteinResolver_HyphenatedSurnameAbbreviation(t *testing.T) {
-	resolver := NewLevenshteinResolver()
+func TestNameResolver_HyphenatedSurnameAbbreviation(t *testing.T) {
+	resolver := NewNameResolver()
 	candidates := []application.PlayerCandidate{
 		{PlayerSeasonID: 1, Name: "Jamarra Ugle-Hagan"},
 		{PlayerSeasonID: 2, Name: "Nick Holman"},
@@ -0,0 +1,130 @@
+// Package httpreplay records HTTP responses to a directory and plays them
+// back, so adapters that scrape a website can run against a committed corpus
+// of its pages with no network.
+//
+// Each response body is stored in its own file, named after the request's
+// path and query by FileName. A Recorder writes the files as real requests
+// are made, a Replayer answers requests from them, and Handler serves them
+// over HTTP as a stand-in for the site.
+package httpreplay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var fileNameReplacer = strings.NewReplacer("/", "_", "?", "__", "&", "_", "=", "-")
+
+// FileName returns the name of the file u's response is stored in, e.g.
+// "afl_footy_ft_match_statistics__mid-11402.html" for
+// "/afl/footy/ft_match_statistics?mid=11402". Query parameters are sorted, so
+// their order in u doesn't matter. The pages recorded are HTML, hence the
+// extension.
+func FileName(u *url.URL) string {
+	name := strings.Trim(u.Path, "/")
+	if q := u.Query(); len(q) > 0 {
+		name += "?" + q.Encode()
+	}
+	return fileNameReplacer.Replace(name) + ".html"
+}
+
+// Recorder is an http.RoundTripper that stores the body of every 200 response
+// from next in dir.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+}
+
+// NewRecorder returns a Recorder storing responses in dir, creating it if
+// needed. next defaults to http.DefaultTransport.
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, next: next}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("record %s: %w", req.URL, err)
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, FileName(req.URL)), body, 0o644); err != nil {
+		return nil, fmt.Errorf("record %s: %w", req.URL, err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Replayer is an http.RoundTripper answering requests from the responses
+// stored in dir, with 404 Not Found for those that weren't recorded. It never
+// touches the network.
+type Replayer struct {
+	dir string
+}
+
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{dir: dir}
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	status := http.StatusOK
+	body, err := read(r.dir, req.URL)
+	if errors.Is(err, fs.ErrNotExist) {
+		status = http.StatusNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/html; charset=utf-8"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Handler serves the responses stored in dir, standing in for the site they
+// were recorded from.
+func Handler(dir string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := read(dir, req.URL)
+		if errors.Is(err, fs.ErrNotExist) {
+			http.NotFound(w, req)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(body)
+	})
+}
+
+func read(dir string, u *url.URL) ([]byte, error) {
+	return os.ReadFile(filepath.Join(dir, FileName(u)))
+}
@@ -0,0 +1,91 @@
+package httpreplay
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileName(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com/afl/footy/ft_match_list", "afl_footy_ft_match_list.html"},
+		{"https://example.com/afl/footy/ft_match_list?year=2026", "afl_footy_ft_match_list__year-2026.html"},
+		{"https://example.com/afl/footy/ft_match_statistics?mid=11402&advv=Y", "afl_footy_ft_match_statistics__advv-Y_mid-11402.html"},
+		{"http://localhost:8089/afl/footy/ft_match_statistics?advv=Y&mid=11402", "afl_footy_ft_match_statistics__advv-Y_mid-11402.html"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, FileName(u))
+		})
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, "<p>"+r.URL.Query().Get("mid")+"</p>")
+	}))
+	defer site.Close()
+	dir := filepath.Join(t.TempDir(), "corpus")
+
+	recording := &http.Client{Transport: NewRecorder(dir, nil)}
+	status, body := get(t, recording, site.URL+"/page?mid=1")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "<p>1</p>", body, "the recorder passes the response through")
+	status, _ = get(t, recording, site.URL+"/missing")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	stored, err := os.ReadFile(filepath.Join(dir, "page__mid-1.html"))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>1</p>", string(stored))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "only 200 responses are recorded")
+
+	site.Close()
+	replaying := &http.Client{Transport: NewReplayer(dir)}
+	status, body = get(t, replaying, site.URL+"/page?mid=1")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "<p>1</p>", body)
+	status, _ = get(t, replaying, site.URL+"/page?mid=2")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestHandler(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "afl_footy_ft_match_list.html"), []byte("<table></table>"), 0o644))
+	standIn := httptest.NewServer(Handler(dir))
+	defer standIn.Close()
+
+	status, body := get(t, standIn.Client(), standIn.URL+"/afl/footy/ft_match_list")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "<table></table>", body)
+
+	status, _ = get(t, standIn.Client(), standIn.URL+"/afl/footy/ft_match_list?year=2025")
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,85 @@
+// Package playerlist reads each club's player list for a season from a CSV
+// file, such as the registrations clubs announce before the season.
+//
+// The file's header row names its columns: club and player. Clubs are written
+// as in afl.club or by one of their usual codes or nicknames.
+//
+//	club,player
+//	Carlton,Patrick Cripps
+//	Richmond,Tim Taranto
+package playerlist
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"xffl/services/afl/internal/application"
+)
+
+const (
+	colClub   = "club"
+	colPlayer = "player"
+)
+
+var requiredColumns = []string{colClub, colPlayer}
+
+// ReadFile reads the player list in the CSV file at path.
+func ReadFile(path string) ([]application.ListedPlayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open player list: %w", err)
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads a player list CSV, in the order it lists the players.
+func Read(r io.Reader) ([]application.ListedPlayer, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var players []application.ListedPlayer
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		cell := func(name string) string {
+			if i := cols[name]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		p := application.ListedPlayer{Name: cell(colPlayer), Club: cell(colClub)}
+		if p.Name == "" && p.Club == "" {
+			continue // a blank line between clubs
+		}
+		if p.Name == "" || p.Club == "" {
+			return nil, fmt.Errorf("line %d: club and player are required", line)
+		}
+		players = append(players, p)
+	}
+	return players, nil
+}
@@ -0,0 +1,41 @@
+package playerlist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/afl/internal/application"
+)
+
+func TestRead(t *testing.T) {
+	players, err := Read(strings.NewReader(
+		"Club,Player\n" +
+			"Carlton,Patrick Cripps\n" +
+			",\n" +
+			" Tigers , Tim Taranto \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []application.ListedPlayer{
+		{Name: "Patrick Cripps", Club: "Carlton"},
+		{Name: "Tim Taranto", Club: "Tigers"},
+	}, players)
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+	}{
+		{name: "missing column", csv: "club,name\nCarlton,Patrick Cripps\n"},
+		{name: "missing player", csv: "club,player\nCarlton,\n"},
+		{name: "missing club", csv: "club,player\n,Patrick Cripps\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.csv))
+			assert.Error(t, err)
+		})
+	}
+}
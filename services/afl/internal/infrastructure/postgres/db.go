@@ -36,10 +36,18 @@ func (db *DB) WithTx(ctx context.Context, fn func(repos application.WriteRepos)
 
 	txQ := sqlcgen.New(tx)
 	repos := application.WriteRepos{
+		Seasons:       NewSeasonRepository(txQ),
+		Rounds:        NewRoundRepository(txQ, db.pool),
+		ClubSeasons:   NewClubSeasonRepository(txQ),
+		Matches:       NewMatchRepository(txQ),
 		Players:       NewPlayerRepository(txQ),
 		PlayerSeasons: NewPlayerSeasonRepository(txQ),
 		PlayerMatches: NewPlayerMatchRepository(txQ),
 		ClubMatches:   NewClubMatchRepository(txQ),
+		Availability:  NewAvailabilityRepository(txQ),
+		StatsPreviews: NewDataopsStatsPreviewRepository(txQ),
+		PlayerSources: NewDataopsPlayerSourceRepository(txQ),
+		Proposals:     NewDataopsPlayerProposalRepository(txQ),
 	}
 
 	if err := fn(repos); err != nil {
@@ -2,12 +2,14 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"xffl/services/afl/internal/application"
 	"xffl/services/afl/internal/domain"
 	"xffl/services/afl/internal/infrastructure/postgres/sqlcgen"
 )
@@ -110,6 +112,28 @@ func (r *SeasonRepository) FindByID(ctx context.Context, id int) (domain.Season,
 	return domain.Season{ID: int(row.ID), Name: row.Name, LeagueID: int(row.LeagueID)}, nil
 }
 
+func (r *SeasonRepository) FindByName(ctx context.Context, name string) (domain.Season, bool, error) {
+	row, err := r.q.FindSeasonByName(ctx, name)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return domain.Season{}, false, nil
+		}
+		return domain.Season{}, false, err
+	}
+	return domain.Season{ID: int(row.ID), Name: row.Name, LeagueID: int(row.LeagueID)}, true, nil
+}
+
+func (r *SeasonRepository) Create(ctx context.Context, leagueName, name string) (domain.Season, error) {
+	row, err := r.q.InsertSeason(ctx, sqlcgen.InsertSeasonParams{Name: name, LeagueName: leagueName})
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return domain.Season{}, fmt.Errorf("no league named %q", leagueName)
+		}
+		return domain.Season{}, err
+	}
+	return domain.Season{ID: int(row.ID), Name: row.Name, LeagueID: int(row.LeagueID)}, nil
+}
+
 // --- Round ---
 
 type RoundRepository struct {
@@ -141,6 +165,14 @@ func (r *RoundRepository) FindByID(ctx context.Context, id int) (domain.Round, e
 	return domain.Round{ID: int(row.ID), Name: row.Name, SeasonID: int(row.SeasonID)}, nil
 }
 
+func (r *RoundRepository) Create(ctx context.Context, seasonID int, name string) (domain.Round, error) {
+	row, err := r.q.InsertRound(ctx, sqlcgen.InsertRoundParams{SeasonID: int32(seasonID), Name: name})
+	if err != nil {
+		return domain.Round{}, err
+	}
+	return domain.Round{ID: int(row.ID), Name: row.Name, SeasonID: int(row.SeasonID)}, nil
+}
+
 // findNeighbours returns at most two rows: the most recently started round
 // (first_match_dt <= asOf) and the first upcoming round (first_match_dt > asOf).
 const findNeighbours = `
@@ -219,6 +251,22 @@ func (r *MatchRepository) FindByRoundID(ctx context.Context, roundID int) ([]dom
 	return out, nil
 }
 
+func (r *MatchRepository) FindUnfinishedStartedBetween(ctx context.Context, after, before time.Time) ([]domain.Match, error) {
+	rows, err := r.q.FindUnfinishedMatchesStartedBetween(ctx, sqlcgen.FindUnfinishedMatchesStartedBetweenParams{
+		StartedAfter:  pgtype.Timestamptz{Time: after, Valid: true},
+		StartedBefore: pgtype.Timestamptz{Time: before, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Match, len(rows))
+	for i, row := range rows {
+		out[i] = matchFromRow(row.ID, row.RoundID, row.HomeClubMatchID, row.AwayClubMatchID,
+			row.Venue, row.StartDt, row.DrvResult, row.DataStatus)
+	}
+	return out, nil
+}
+
 func (r *MatchRepository) FindByID(ctx context.Context, id int) (domain.Match, error) {
 	row, err := r.q.FindMatchByID(ctx, int32(id))
 	if err != nil {
@@ -287,6 +335,78 @@ func (r *MatchRepository) FindFinalBySeasonID(ctx context.Context, seasonID int)
 	return out, nil
 }
 
+func (r *MatchRepository) FindBySeasonID(ctx context.Context, seasonID int) ([]domain.Match, error) {
+	rows, err := r.q.FindMatchesBySeasonID(ctx, int32(seasonID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Match, len(rows))
+	for i, row := range rows {
+		out[i] = domain.Match{
+			ID:         int(row.ID),
+			RoundID:    int(row.RoundID),
+			Home:       domain.ClubMatch{ID: int(row.HomeClubMatchID), MatchID: int(row.ID), ClubSeasonID: int(row.HomeClubSeasonID)},
+			Away:       domain.ClubMatch{ID: int(row.AwayClubMatchID), MatchID: int(row.ID), ClubSeasonID: int(row.AwayClubSeasonID)},
+			Venue:      row.Venue,
+			StartTime:  row.StartDt.Time,
+			DataStatus: domain.MatchDataStatus(row.DataStatus),
+		}
+	}
+	return out, nil
+}
+
+// Create inserts the match and then its club matches, so it must run in a
+// transaction.
+func (r *MatchRepository) Create(ctx context.Context, m domain.Match) (domain.Match, error) {
+	venue, startDt := matchSchedule(m)
+	id, err := r.q.InsertMatch(ctx, sqlcgen.InsertMatchParams{RoundID: int32(m.RoundID), Venue: venue, StartDt: startDt})
+	if err != nil {
+		return domain.Match{}, err
+	}
+	m.ID = int(id)
+	m.DataStatus = domain.MatchDataNoData
+	for _, side := range []struct {
+		name string
+		cm   *domain.ClubMatch
+	}{{"home", &m.Home}, {"away", &m.Away}} {
+		cmID, err := r.q.InsertClubMatch(ctx, sqlcgen.InsertClubMatchParams{
+			MatchID:      id,
+			ClubSeasonID: int32(side.cm.ClubSeasonID),
+			Side:         side.name,
+		})
+		if err != nil {
+			return domain.Match{}, fmt.Errorf("insert %s club_match: %w", side.name, err)
+		}
+		side.cm.ID = int(cmID)
+		side.cm.MatchID = m.ID
+	}
+	return m, nil
+}
+
+func (r *MatchRepository) UpdateSchedule(ctx context.Context, m domain.Match) error {
+	venue, startDt := matchSchedule(m)
+	return r.q.UpdateMatchSchedule(ctx, sqlcgen.UpdateMatchScheduleParams{
+		ID:      int32(m.ID),
+		RoundID: int32(m.RoundID),
+		Venue:   venue,
+		StartDt: startDt,
+	})
+}
+
+func (r *MatchRepository) SwapSides(ctx context.Context, matchID int) error {
+	return r.q.SwapClubMatchSides(ctx, int32(matchID))
+}
+
+// matchSchedule returns a match's venue and start time as stored: NULL when
+// empty or zero.
+func matchSchedule(m domain.Match) (*string, pgtype.Timestamptz) {
+	var venue *string
+	if m.Venue != "" {
+		venue = &m.Venue
+	}
+	return venue, pgtype.Timestamptz{Time: m.StartTime, Valid: !m.StartTime.IsZero()}
+}
+
 func (r *MatchRepository) FindByIDWithDetails(ctx context.Context, id int) (domain.Match, error) {
 	match, err := r.FindByID(ctx, id)
 	if err != nil {
@@ -408,6 +528,14 @@ func (r *ClubSeasonRepository) Update(ctx context.Context, cs domain.ClubSeason)
 	})
 }
 
+func (r *ClubSeasonRepository) Create(ctx context.Context, clubID, seasonID int) (domain.ClubSeason, error) {
+	row, err := r.q.UpsertClubSeason(ctx, sqlcgen.UpsertClubSeasonParams{ClubID: int32(clubID), SeasonID: int32(seasonID)})
+	if err != nil {
+		return domain.ClubSeason{}, err
+	}
+	return domain.ClubSeason{ID: int(row.ID), ClubID: int(row.ClubID), SeasonID: int(row.SeasonID)}, nil
+}
+
 // --- ClubMatch ---
 
 type ClubMatchRepository struct{ q *sqlcgen.Queries }
@@ -472,6 +600,41 @@ func (r *ClubMatchRepository) UpdateRushedBehinds(ctx context.Context, id int, r
 	})
 }
 
+func (r *ClubMatchRepository) FindQuarterScores(ctx context.Context, clubMatchIDs []int) (map[int][]domain.QuarterScore, error) {
+	int32IDs := make([]int32, len(clubMatchIDs))
+	for i, id := range clubMatchIDs {
+		int32IDs[i] = int32(id)
+	}
+	rows, err := r.q.FindClubMatchQuartersByClubMatchIDs(ctx, int32IDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int][]domain.QuarterScore)
+	for _, row := range rows {
+		id := int(row.ClubMatchID)
+		out[id] = append(out[id], domain.QuarterScore{
+			Quarter: int(row.Quarter),
+			Goals:   int(row.Goals),
+			Behinds: int(row.Behinds),
+		})
+	}
+	return out, nil
+}
+
+func (r *ClubMatchRepository) SetQuarterScores(ctx context.Context, clubMatchID int, quarters []domain.QuarterScore) error {
+	for _, q := range quarters {
+		if err := r.q.UpsertClubMatchQuarter(ctx, sqlcgen.UpsertClubMatchQuarterParams{
+			ClubMatchID: int32(clubMatchID),
+			Quarter:     int32(q.Quarter),
+			Goals:       int32(q.Goals),
+			Behinds:     int32(q.Behinds),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // --- Player ---
 
 type PlayerRepository struct{ q *sqlcgen.Queries }
@@ -488,6 +651,10 @@ func (r *PlayerRepository) Create(ctx context.Context, name string) (domain.Play
 	return domain.Player{ID: int(row.ID), Name: row.Name}, nil
 }
 
+func (r *PlayerRepository) Delete(ctx context.Context, id int) error {
+	return r.q.DeletePlayer(ctx, int32(id))
+}
+
 func (r *PlayerRepository) FindByID(ctx context.Context, id int) (domain.Player, error) {
 	row, err := r.q.FindPlayerByID(ctx, int32(id))
 	if err != nil {
@@ -528,6 +695,21 @@ func (r *PlayerRepository) FindByIDsWithClub(ctx context.Context, ids []int) ([]
 	return players, nil
 }
 
+func (r *PlayerRepository) FindDuplicateCandidates(ctx context.Context) ([]domain.PlayerPair, error) {
+	rows, err := r.q.FindPlayerDuplicateCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pairs := make([]domain.PlayerPair, len(rows))
+	for i, row := range rows {
+		pairs[i] = domain.PlayerPair{
+			Player: domain.Player{ID: int(row.PlayerID), Name: row.PlayerName},
+			Other:  domain.Player{ID: int(row.OtherPlayerID), Name: row.OtherPlayerName},
+		}
+	}
+	return pairs, nil
+}
+
 func (r *PlayerRepository) Search(ctx context.Context, query string) ([]domain.Player, error) {
 	rows, err := r.q.SearchPlayersByName(ctx, &query)
 	if err != nil {
@@ -555,6 +737,10 @@ func (r *PlayerMatchRepository) FindByClubMatchID(ctx context.Context, clubMatch
 	}
 	out := make([]domain.PlayerMatch, len(rows))
 	for i, row := range rows {
+		extra, err := toExtraStats(row.ExtraStats)
+		if err != nil {
+			return nil, err
+		}
 		out[i] = domain.PlayerMatch{
 			ID:              int(row.ID),
 			ClubMatchID:     int(row.ClubMatchID),
@@ -567,6 +753,7 @@ func (r *PlayerMatchRepository) FindByClubMatchID(ctx context.Context, clubMatch
 			Tackles:         derefOr(row.Tackles),
 			Goals:           derefOr(row.Goals),
 			Behinds:         derefOr(row.Behinds),
+			Extra:           extra,
 		}
 	}
 	return out, nil
@@ -577,6 +764,10 @@ func (r *PlayerMatchRepository) FindByID(ctx context.Context, id int) (domain.Pl
 	if err != nil {
 		return domain.PlayerMatch{}, err
 	}
+	extra, err := toExtraStats(row.ExtraStats)
+	if err != nil {
+		return domain.PlayerMatch{}, err
+	}
 	return domain.PlayerMatch{
 		ID:              int(row.ID),
 		ClubMatchID:     int(row.ClubMatchID),
@@ -589,6 +780,7 @@ func (r *PlayerMatchRepository) FindByID(ctx context.Context, id int) (domain.Pl
 		Tackles:         derefOr(row.Tackles),
 		Goals:           derefOr(row.Goals),
 		Behinds:         derefOr(row.Behinds),
+		Extra:           extra,
 	}, nil
 }
 
@@ -603,6 +795,10 @@ func (r *PlayerMatchRepository) FindByIDs(ctx context.Context, ids []int) ([]dom
 	}
 	out := make([]domain.PlayerMatch, len(rows))
 	for i, row := range rows {
+		extra, err := toExtraStats(row.ExtraStats)
+		if err != nil {
+			return nil, err
+		}
 		out[i] = domain.PlayerMatch{
 			ID:              int(row.ID),
 			ClubMatchID:     int(row.ClubMatchID),
@@ -615,6 +811,7 @@ func (r *PlayerMatchRepository) FindByIDs(ctx context.Context, ids []int) ([]dom
 			Tackles:         derefOr(row.Tackles),
 			Goals:           derefOr(row.Goals),
 			Behinds:         derefOr(row.Behinds),
+			Extra:           extra,
 		}
 	}
 	return out, nil
@@ -627,6 +824,10 @@ func (r *PlayerMatchRepository) FindByPlayerSeasonID(ctx context.Context, player
 	}
 	out := make([]domain.PlayerMatch, len(rows))
 	for i, row := range rows {
+		extra, err := toExtraStats(row.ExtraStats)
+		if err != nil {
+			return nil, err
+		}
 		out[i] = domain.PlayerMatch{
 			ID:              int(row.ID),
 			ClubMatchID:     int(row.ClubMatchID),
@@ -639,6 +840,7 @@ func (r *PlayerMatchRepository) FindByPlayerSeasonID(ctx context.Context, player
 			Tackles:         derefOr(row.Tackles),
 			Goals:           derefOr(row.Goals),
 			Behinds:         derefOr(row.Behinds),
+			Extra:           extra,
 		}
 	}
 	return out, nil
@@ -658,6 +860,10 @@ func (r *PlayerMatchRepository) FindByPlayerSeasonIDsAndRoundID(ctx context.Cont
 	}
 	out := make([]domain.PlayerMatch, len(rows))
 	for i, row := range rows {
+		extra, err := toExtraStats(row.ExtraStats)
+		if err != nil {
+			return nil, err
+		}
 		out[i] = domain.PlayerMatch{
 			ID:              int(row.ID),
 			ClubMatchID:     int(row.ClubMatchID),
@@ -670,12 +876,28 @@ func (r *PlayerMatchRepository) FindByPlayerSeasonIDsAndRoundID(ctx context.Cont
 			Tackles:         derefOr(row.Tackles),
 			Goals:           derefOr(row.Goals),
 			Behinds:         derefOr(row.Behinds),
+			Extra:           extra,
 		}
 	}
 	return out, nil
 }
 
+func (r *PlayerMatchRepository) Delete(ctx context.Context, id int) error {
+	return r.q.DeletePlayerMatch(ctx, int32(id))
+}
+
+func (r *PlayerMatchRepository) ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error {
+	return r.q.ReassignPlayerMatches(ctx, sqlcgen.ReassignPlayerMatchesParams{
+		ToPlayerSeasonID:   int32(toPlayerSeasonID),
+		FromPlayerSeasonID: int32(fromPlayerSeasonID),
+	})
+}
+
 func (r *PlayerMatchRepository) Upsert(ctx context.Context, params domain.UpsertPlayerMatchParams) (domain.PlayerMatch, error) {
+	extraJSON, err := fromExtraStats(params.Extra)
+	if err != nil {
+		return domain.PlayerMatch{}, err
+	}
 	row, err := r.q.UpsertPlayerMatch(ctx, sqlcgen.UpsertPlayerMatchParams{
 		ClubMatchID:    int32(params.ClubMatchID),
 		PlayerSeasonID: int32(params.PlayerSeasonID),
@@ -686,10 +908,15 @@ func (r *PlayerMatchRepository) Upsert(ctx context.Context, params domain.Upsert
 		Tackles:        intToInt32Ptr(params.Tackles),
 		Goals:          intToInt32Ptr(params.Goals),
 		Behinds:        intToInt32Ptr(params.Behinds),
+		ExtraStats:     extraJSON,
 	})
 	if err != nil {
 		return domain.PlayerMatch{}, err
 	}
+	extra, err := toExtraStats(row.ExtraStats)
+	if err != nil {
+		return domain.PlayerMatch{}, err
+	}
 	return domain.PlayerMatch{
 		ID:             int(row.ID),
 		ClubMatchID:    int(row.ClubMatchID),
@@ -701,9 +928,104 @@ func (r *PlayerMatchRepository) Upsert(ctx context.Context, params domain.Upsert
 		Tackles:        derefOr(row.Tackles),
 		Goals:          derefOr(row.Goals),
 		Behinds:        derefOr(row.Behinds),
+		Extra:          extra,
 	}, nil
 }
 
+// toExtraStats decodes a player_match.extra_stats value.
+func toExtraStats(b []byte) (domain.ExtraStats, error) {
+	var extra domain.ExtraStats
+	if err := json.Unmarshal(b, &extra); err != nil {
+		return nil, fmt.Errorf("decode extra stats: %w", err)
+	}
+	return extra, nil
+}
+
+// fromExtraStats encodes extra stats to merge into player_match.extra_stats;
+// none encode as an empty object, which leaves the stored ones unchanged.
+func fromExtraStats(extra domain.ExtraStats) ([]byte, error) {
+	if extra == nil {
+		extra = domain.ExtraStats{}
+	}
+	b, err := json.Marshal(extra)
+	if err != nil {
+		return nil, fmt.Errorf("encode extra stats: %w", err)
+	}
+	return b, nil
+}
+
+// --- PlayerAvailability ---
+
+type AvailabilityRepository struct{ q *sqlcgen.Queries }
+
+func NewAvailabilityRepository(q *sqlcgen.Queries) *AvailabilityRepository {
+	return &AvailabilityRepository{q: q}
+}
+
+// toAvailability maps any of the identical player_availability row types.
+func toAvailability(row sqlcgen.FindPlayerAvailabilityByRoundIDRow) domain.PlayerAvailability {
+	return domain.PlayerAvailability{
+		ID:             int(row.ID),
+		PlayerSeasonID: int(row.PlayerSeasonID),
+		RoundID:        int(row.RoundID),
+		Status:         domain.AvailabilityStatus(row.Status),
+		ExpectedReturn: row.ExpectedReturn,
+		Source:         row.Source,
+	}
+}
+
+func (r *AvailabilityRepository) Upsert(ctx context.Context, a domain.PlayerAvailability) (domain.PlayerAvailability, error) {
+	row, err := r.q.UpsertPlayerAvailability(ctx, sqlcgen.UpsertPlayerAvailabilityParams{
+		PlayerSeasonID: int32(a.PlayerSeasonID),
+		RoundID:        int32(a.RoundID),
+		Status:         string(a.Status),
+		ExpectedReturn: a.ExpectedReturn,
+		Source:         a.Source,
+	})
+	if err != nil {
+		return domain.PlayerAvailability{}, err
+	}
+	return toAvailability(sqlcgen.FindPlayerAvailabilityByRoundIDRow(row)), nil
+}
+
+func (r *AvailabilityRepository) FindByRoundID(ctx context.Context, roundID int) ([]domain.PlayerAvailability, error) {
+	rows, err := r.q.FindPlayerAvailabilityByRoundID(ctx, int32(roundID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.PlayerAvailability, len(rows))
+	for i, row := range rows {
+		out[i] = toAvailability(row)
+	}
+	return out, nil
+}
+
+func (r *AvailabilityRepository) FindByPlayerSeasonIDsAndRoundID(ctx context.Context, playerSeasonIDs []int, roundID int) ([]domain.PlayerAvailability, error) {
+	int32IDs := make([]int32, len(playerSeasonIDs))
+	for i, id := range playerSeasonIDs {
+		int32IDs[i] = int32(id)
+	}
+	rows, err := r.q.FindPlayerAvailabilityBySeasonIDsAndRoundID(ctx, sqlcgen.FindPlayerAvailabilityBySeasonIDsAndRoundIDParams{
+		PlayerSeasonIds: int32IDs,
+		RoundID:         int32(roundID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.PlayerAvailability, len(rows))
+	for i, row := range rows {
+		out[i] = toAvailability(sqlcgen.FindPlayerAvailabilityByRoundIDRow(row))
+	}
+	return out, nil
+}
+
+func (r *AvailabilityRepository) ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error {
+	return r.q.ReassignPlayerAvailability(ctx, sqlcgen.ReassignPlayerAvailabilityParams{
+		ToPlayerSeasonID:   int32(toPlayerSeasonID),
+		FromPlayerSeasonID: int32(fromPlayerSeasonID),
+	})
+}
+
 // --- PlayerSeason ---
 
 type PlayerSeasonRepository struct{ q *sqlcgen.Queries }
@@ -828,6 +1150,35 @@ func (r *PlayerSeasonRepository) FindLatestByPlayerID(ctx context.Context, playe
 	return ps, true, nil
 }
 
+func (r *PlayerSeasonRepository) FindByPlayerID(ctx context.Context, playerID int) ([]domain.PlayerSeason, error) {
+	rows, err := r.q.FindPlayerSeasonsByPlayerID(ctx, int32(playerID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.PlayerSeason, len(rows))
+	for i, row := range rows {
+		out[i] = domain.PlayerSeason{
+			ID:           int(row.ID),
+			PlayerID:     int(row.PlayerID),
+			ClubSeasonID: int(row.ClubSeasonID),
+			FromRoundID:  int32PtrToIntPtr(row.FromRoundID),
+			ToRoundID:    int32PtrToIntPtr(row.ToRoundID),
+		}
+	}
+	return out, nil
+}
+
+func (r *PlayerSeasonRepository) Reassign(ctx context.Context, id, playerID int) error {
+	return r.q.ReassignPlayerSeason(ctx, sqlcgen.ReassignPlayerSeasonParams{
+		PlayerID: int32(playerID),
+		ID:       int32(id),
+	})
+}
+
+func (r *PlayerSeasonRepository) Delete(ctx context.Context, id int) error {
+	return r.q.DeletePlayerSeason(ctx, int32(id))
+}
+
 // --- DataopsMatchSourceRepository ---
 
 type DataopsMatchSourceRepository struct{ q *sqlcgen.Queries }
@@ -892,3 +1243,374 @@ func (r *DataopsPlayerSourceRepository) Store(ctx context.Context, source, exter
 		PlayerSeasonID: int32(playerSeasonID),
 	})
 }
+
+func (r *DataopsPlayerSourceRepository) ReassignPlayerSeason(ctx context.Context, fromPlayerSeasonID, toPlayerSeasonID int) error {
+	return r.q.ReassignDataopsPlayerSources(ctx, sqlcgen.ReassignDataopsPlayerSourcesParams{
+		ToPlayerSeasonID:   int32(toPlayerSeasonID),
+		FromPlayerSeasonID: int32(fromPlayerSeasonID),
+	})
+}
+
+// --- DataopsStatsDiscrepancyRepository ---
+
+type DataopsStatsDiscrepancyRepository struct {
+	q    *sqlcgen.Queries
+	pool *pgxpool.Pool
+}
+
+func NewDataopsStatsDiscrepancyRepository(q *sqlcgen.Queries, pool *pgxpool.Pool) *DataopsStatsDiscrepancyRepository {
+	return &DataopsStatsDiscrepancyRepository{q: q, pool: pool}
+}
+
+func (r *DataopsStatsDiscrepancyRepository) ReplaceForMatch(ctx context.Context, matchID int, discrepancies []application.StatsDiscrepancy) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	txQ := r.q.WithTx(tx)
+	if err := txQ.DeleteDataopsStatsDiscrepanciesByMatchID(ctx, int32(matchID)); err != nil {
+		return fmt.Errorf("delete discrepancies: %w", err)
+	}
+	for _, d := range discrepancies {
+		var psID *int32
+		if d.PlayerSeasonID != 0 {
+			id := int32(d.PlayerSeasonID)
+			psID = &id
+		}
+		if err := txQ.InsertDataopsStatsDiscrepancy(ctx, sqlcgen.InsertDataopsStatsDiscrepancyParams{
+			MatchID:        int32(matchID),
+			ClubMatchID:    int32(d.ClubMatchID),
+			Kind:           string(d.Kind),
+			PlayerSeasonID: psID,
+			PlayerName:     d.PlayerName,
+			Stat:           d.Stat,
+			Source:         d.Source,
+			Value:          int32(d.Value),
+			OtherSource:    d.OtherSource,
+			OtherValue:     int32(d.OtherValue),
+		}); err != nil {
+			return fmt.Errorf("insert discrepancy: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *DataopsStatsDiscrepancyRepository) FindByMatchID(ctx context.Context, matchID int) ([]application.StatsDiscrepancy, error) {
+	rows, err := r.q.FindDataopsStatsDiscrepanciesByMatchID(ctx, int32(matchID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.StatsDiscrepancy, len(rows))
+	for i, row := range rows {
+		out[i] = application.StatsDiscrepancy{
+			MatchID:     int(row.MatchID),
+			ClubMatchID: int(row.ClubMatchID),
+			Kind:        application.DiscrepancyKind(row.Kind),
+			PlayerName:  row.PlayerName,
+			Stat:        row.Stat,
+			Source:      row.Source,
+			Value:       int(row.Value),
+			OtherSource: row.OtherSource,
+			OtherValue:  int(row.OtherValue),
+		}
+		if row.PlayerSeasonID != nil {
+			out[i].PlayerSeasonID = int(*row.PlayerSeasonID)
+		}
+	}
+	return out, nil
+}
+
+// --- DataopsStatsPreviewRepository ---
+
+type DataopsStatsPreviewRepository struct{ q *sqlcgen.Queries }
+
+func NewDataopsStatsPreviewRepository(q *sqlcgen.Queries) *DataopsStatsPreviewRepository {
+	return &DataopsStatsPreviewRepository{q: q}
+}
+
+// statsPreviewPlan is the JSON stored in dataops_stats_preview.plan:
+// everything in an application.StatsPreview without a column.
+type statsPreviewPlan struct {
+	RoundID          int                      `json:"roundId"`
+	Clubs            []clubStatsPreviewSpec   `json:"clubs"`
+	UnmatchedPlayers []unmatchedAFLPlayerSpec `json:"unmatchedPlayers,omitempty"`
+}
+
+type clubStatsPreviewSpec struct {
+	ClubMatchID      int                   `json:"clubMatchId"`
+	ClubName         string                `json:"clubName"`
+	OldScore         int                   `json:"oldScore"`
+	NewScore         int                   `json:"newScore"`
+	OldRushedBehinds int                   `json:"oldRushedBehinds"`
+	NewRushedBehinds int                   `json:"newRushedBehinds"`
+	Players          []playerStatsDiffSpec `json:"players,omitempty"`
+}
+
+type playerStatsDiffSpec struct {
+	PlayerSeasonID int          `json:"playerSeasonId"`
+	PlayerName     string       `json:"playerName"`
+	Kind           string       `json:"kind"`
+	Old            statLineSpec `json:"old"`
+	New            statLineSpec `json:"new"`
+}
+
+type statLineSpec struct {
+	Kicks     int `json:"kicks"`
+	Handballs int `json:"handballs"`
+	Marks     int `json:"marks"`
+	Hitouts   int `json:"hitouts"`
+	Tackles   int `json:"tackles"`
+	Goals     int `json:"goals"`
+	Behinds   int `json:"behinds"`
+}
+
+type unmatchedAFLPlayerSpec struct {
+	ParsedName  string `json:"parsedName"`
+	ClubMatchID int    `json:"clubMatchId"`
+	Kicks       int    `json:"kicks"`
+	Handballs   int    `json:"handballs"`
+	Marks       int    `json:"marks"`
+	Hitouts     int    `json:"hitouts"`
+	Tackles     int    `json:"tackles"`
+	Goals       int    `json:"goals"`
+	Behinds     int    `json:"behinds"`
+}
+
+func toStatLineSpec(ps application.PlayerStats) statLineSpec {
+	return statLineSpec{Kicks: ps.Kicks, Handballs: ps.Handballs, Marks: ps.Marks, Hitouts: ps.Hitouts,
+		Tackles: ps.Tackles, Goals: ps.Goals, Behinds: ps.Behinds}
+}
+
+func toPlayerStats(s statLineSpec) application.PlayerStats {
+	return application.PlayerStats{Kicks: s.Kicks, Handballs: s.Handballs, Marks: s.Marks, Hitouts: s.Hitouts,
+		Tackles: s.Tackles, Goals: s.Goals, Behinds: s.Behinds}
+}
+
+func toStatsPreviewPlan(p application.StatsPreview) statsPreviewPlan {
+	plan := statsPreviewPlan{
+		RoundID: p.RoundID,
+		Clubs:   make([]clubStatsPreviewSpec, len(p.Clubs)),
+	}
+	for i, club := range p.Clubs {
+		spec := clubStatsPreviewSpec{
+			ClubMatchID:      club.ClubMatchID,
+			ClubName:         club.ClubName,
+			OldScore:         club.OldScore,
+			NewScore:         club.NewScore,
+			OldRushedBehinds: club.OldRushedBehinds,
+			NewRushedBehinds: club.NewRushedBehinds,
+			Players:          make([]playerStatsDiffSpec, len(club.Players)),
+		}
+		for j, d := range club.Players {
+			spec.Players[j] = playerStatsDiffSpec{
+				PlayerSeasonID: d.PlayerSeasonID,
+				PlayerName:     d.PlayerName,
+				Kind:           string(d.Kind),
+				Old:            toStatLineSpec(d.Old),
+				New:            toStatLineSpec(d.New),
+			}
+		}
+		plan.Clubs[i] = spec
+	}
+	for _, u := range p.UnmatchedPlayers {
+		plan.UnmatchedPlayers = append(plan.UnmatchedPlayers, unmatchedAFLPlayerSpec(u))
+	}
+	return plan
+}
+
+func toStatsPreview(id, matchID int32, source string, plan []byte, createdAt, appliedAt pgtype.Timestamptz) (application.StatsPreview, error) {
+	var s statsPreviewPlan
+	if err := json.Unmarshal(plan, &s); err != nil {
+		return application.StatsPreview{}, fmt.Errorf("decode stats preview %d: %w", id, err)
+	}
+	p := application.StatsPreview{
+		ID:        int(id),
+		MatchID:   int(matchID),
+		RoundID:   s.RoundID,
+		Source:    source,
+		Clubs:     make([]application.ClubStatsPreview, len(s.Clubs)),
+		CreatedAt: createdAt.Time,
+	}
+	if appliedAt.Valid {
+		t := appliedAt.Time
+		p.AppliedAt = &t
+	}
+	for i, spec := range s.Clubs {
+		club := application.ClubStatsPreview{
+			ClubMatchID:      spec.ClubMatchID,
+			ClubName:         spec.ClubName,
+			OldScore:         spec.OldScore,
+			NewScore:         spec.NewScore,
+			OldRushedBehinds: spec.OldRushedBehinds,
+			NewRushedBehinds: spec.NewRushedBehinds,
+		}
+		for _, d := range spec.Players {
+			club.Players = append(club.Players, application.PlayerStatsDiff{
+				PlayerSeasonID: d.PlayerSeasonID,
+				PlayerName:     d.PlayerName,
+				Kind:           application.PlayerStatsDiffKind(d.Kind),
+				Old:            toPlayerStats(d.Old),
+				New:            toPlayerStats(d.New),
+			})
+		}
+		p.Clubs[i] = club
+	}
+	for _, u := range s.UnmatchedPlayers {
+		p.UnmatchedPlayers = append(p.UnmatchedPlayers, application.UnmatchedAFLPlayer(u))
+	}
+	return p, nil
+}
+
+func (r *DataopsStatsPreviewRepository) Create(ctx context.Context, preview application.StatsPreview) (application.StatsPreview, error) {
+	plan, err := json.Marshal(toStatsPreviewPlan(preview))
+	if err != nil {
+		return application.StatsPreview{}, fmt.Errorf("encode stats preview: %w", err)
+	}
+	row, err := r.q.CreateDataopsStatsPreview(ctx, sqlcgen.CreateDataopsStatsPreviewParams{
+		MatchID: int32(preview.MatchID),
+		Source:  preview.Source,
+		Plan:    plan,
+	})
+	if err != nil {
+		return application.StatsPreview{}, err
+	}
+	return toStatsPreview(row.ID, row.MatchID, row.Source, row.Plan, row.CreatedAt, row.AppliedAt)
+}
+
+func (r *DataopsStatsPreviewRepository) FindByID(ctx context.Context, id int) (application.StatsPreview, error) {
+	row, err := r.q.FindDataopsStatsPreviewByID(ctx, int32(id))
+	if err != nil {
+		return application.StatsPreview{}, err
+	}
+	return toStatsPreview(row.ID, row.MatchID, row.Source, row.Plan, row.CreatedAt, row.AppliedAt)
+}
+
+func (r *DataopsStatsPreviewRepository) MarkApplied(ctx context.Context, id int) error {
+	n, err := r.q.MarkDataopsStatsPreviewApplied(ctx, int32(id))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return application.ErrStatsPreviewApplied
+	}
+	return nil
+}
+
+// --- DataopsPlayerProposalRepository ---
+
+type DataopsPlayerProposalRepository struct{ q *sqlcgen.Queries }
+
+func NewDataopsPlayerProposalRepository(q *sqlcgen.Queries) *DataopsPlayerProposalRepository {
+	return &DataopsPlayerProposalRepository{q: q}
+}
+
+func toPlayerProposal(row sqlcgen.AflDataopsPlayerProposal) application.PlayerProposal {
+	p := application.PlayerProposal{
+		ID:               int(row.ID),
+		SeasonID:         int(row.SeasonID),
+		Source:           row.Source,
+		Kind:             application.PlayerProposalKind(row.Kind),
+		Status:           application.PlayerProposalStatus(row.Status),
+		ListedName:       row.ListedName,
+		ClubSeasonID:     int(row.ClubSeasonID),
+		ClubName:         row.ClubName,
+		PlayerName:       row.PlayerName,
+		PreviousClubName: row.PreviousClubName,
+		Confidence:       row.Confidence,
+		CreatedAt:        row.CreatedAt.Time,
+	}
+	if row.PlayerID != nil {
+		p.PlayerID = int(*row.PlayerID)
+	}
+	if row.PlayerSeasonID != nil {
+		p.PlayerSeasonID = int(*row.PlayerSeasonID)
+	}
+	if row.DecidedAt.Valid {
+		t := row.DecidedAt.Time
+		p.DecidedAt = &t
+	}
+	return p
+}
+
+// optionalID converts an ID that is 0 when absent to a nullable column.
+func optionalID(id int) *int32 {
+	if id == 0 {
+		return nil
+	}
+	v := int32(id)
+	return &v
+}
+
+// ReplacePending must run in a transaction, so the season is never left
+// without its pending proposals.
+func (r *DataopsPlayerProposalRepository) ReplacePending(ctx context.Context, seasonID int, proposals []application.PlayerProposal) ([]application.PlayerProposal, error) {
+	if err := r.q.DeletePendingDataopsPlayerProposals(ctx, int32(seasonID)); err != nil {
+		return nil, err
+	}
+	out := make([]application.PlayerProposal, len(proposals))
+	for i, p := range proposals {
+		row, err := r.q.InsertDataopsPlayerProposal(ctx, sqlcgen.InsertDataopsPlayerProposalParams{
+			SeasonID:         int32(seasonID),
+			Source:           p.Source,
+			Kind:             string(p.Kind),
+			ListedName:       p.ListedName,
+			ClubSeasonID:     int32(p.ClubSeasonID),
+			ClubName:         p.ClubName,
+			PlayerID:         optionalID(p.PlayerID),
+			PlayerName:       p.PlayerName,
+			PreviousClubName: p.PreviousClubName,
+			Confidence:       p.Confidence,
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.ID, p.SeasonID, p.Status, p.CreatedAt = int(row.ID), seasonID, application.PlayerProposalPending, row.CreatedAt.Time
+		out[i] = p
+	}
+	return out, nil
+}
+
+func (r *DataopsPlayerProposalRepository) FindBySeasonID(ctx context.Context, seasonID int) ([]application.PlayerProposal, error) {
+	rows, err := r.q.FindDataopsPlayerProposalsBySeasonID(ctx, int32(seasonID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.PlayerProposal, len(rows))
+	for i, row := range rows {
+		out[i] = toPlayerProposal(sqlcgen.AflDataopsPlayerProposal(row))
+	}
+	return out, nil
+}
+
+func (r *DataopsPlayerProposalRepository) FindByIDs(ctx context.Context, ids []int) ([]application.PlayerProposal, error) {
+	ids32 := make([]int32, len(ids))
+	for i, id := range ids {
+		ids32[i] = int32(id)
+	}
+	rows, err := r.q.FindDataopsPlayerProposalsByIDs(ctx, ids32)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.PlayerProposal, len(rows))
+	for i, row := range rows {
+		out[i] = toPlayerProposal(sqlcgen.AflDataopsPlayerProposal(row))
+	}
+	return out, nil
+}
+
+func (r *DataopsPlayerProposalRepository) Decide(ctx context.Context, id int, status application.PlayerProposalStatus, playerSeasonID int) error {
+	n, err := r.q.DecideDataopsPlayerProposal(ctx, sqlcgen.DecideDataopsPlayerProposalParams{
+		Status:         string(status),
+		PlayerSeasonID: optionalID(playerSeasonID),
+		ID:             int32(id),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return application.ErrPlayerProposalDecided
+	}
+	return nil
+}
@@ -25,3 +25,14 @@ SELECT m.round_id
 FROM afl.match m
 JOIN afl.club_match cm ON cm.match_id = m.id
 WHERE cm.id = $1 AND cm.deleted_at IS NULL AND m.deleted_at IS NULL;
+
+-- name: InsertClubMatch :one
+INSERT INTO afl.club_match (match_id, club_season_id, side)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: SwapClubMatchSides :exec
+UPDATE afl.club_match
+SET side       = CASE side WHEN 'home' THEN 'away' ELSE 'home' END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE match_id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,15 @@
+-- name: FindClubMatchQuartersByClubMatchIDs :many
+SELECT club_match_id, quarter, goals, behinds
+FROM afl.club_match_quarter
+WHERE club_match_id = ANY(@club_match_ids::int[]) AND deleted_at IS NULL
+ORDER BY club_match_id, quarter;
+
+-- name: UpsertClubMatchQuarter :exec
+INSERT INTO afl.club_match_quarter (club_match_id, quarter, goals, behinds)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (club_match_id, quarter)
+DO UPDATE SET
+    goals = EXCLUDED.goals,
+    behinds = EXCLUDED.behinds,
+    deleted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP;
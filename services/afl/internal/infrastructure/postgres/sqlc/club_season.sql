@@ -24,3 +24,11 @@ SET drv_played             = $2,
     drv_premiership_points = $8,
     updated_at             = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: UpsertClubSeason :one
+INSERT INTO afl.club_season (club_id, season_id)
+VALUES ($1, $2)
+ON CONFLICT (club_id, season_id) DO UPDATE
+SET deleted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING id, club_id, season_id;
@@ -0,0 +1,30 @@
+-- name: DecideDataopsPlayerProposal :execrows
+UPDATE afl.dataops_player_proposal
+SET status = @status, player_season_id = @player_season_id, decided_at = CURRENT_TIMESTAMP
+WHERE id = @id AND status = 'pending';
+
+-- name: DeletePendingDataopsPlayerProposals :exec
+DELETE FROM afl.dataops_player_proposal
+WHERE season_id = $1 AND status = 'pending';
+
+-- name: FindDataopsPlayerProposalsByIDs :many
+SELECT id, created_at, season_id, source, kind, listed_name, club_season_id, club_name,
+       player_id, player_name, previous_club_name, confidence, status, player_season_id, decided_at
+FROM afl.dataops_player_proposal
+WHERE id = ANY(@ids::int[])
+ORDER BY id;
+
+-- name: FindDataopsPlayerProposalsBySeasonID :many
+SELECT id, created_at, season_id, source, kind, listed_name, club_season_id, club_name,
+       player_id, player_name, previous_club_name, confidence, status, player_season_id, decided_at
+FROM afl.dataops_player_proposal
+WHERE season_id = $1
+ORDER BY id;
+
+-- name: InsertDataopsPlayerProposal :one
+INSERT INTO afl.dataops_player_proposal (
+    season_id, source, kind, listed_name, club_season_id, club_name,
+    player_id, player_name, previous_club_name, confidence
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, created_at;
@@ -12,3 +12,9 @@ VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
 ON CONFLICT (source, external_season, external_club, external_player) DO UPDATE
     SET player_season_id = EXCLUDED.player_season_id,
         updated_at       = CURRENT_TIMESTAMP;
+
+-- name: ReassignDataopsPlayerSources :exec
+UPDATE afl.dataops_player_source
+SET player_season_id = @to_player_season_id,
+    updated_at       = CURRENT_TIMESTAMP
+WHERE player_season_id = @from_player_season_id;
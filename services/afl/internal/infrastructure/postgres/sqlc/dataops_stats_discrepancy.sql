@@ -0,0 +1,14 @@
+-- name: DeleteDataopsStatsDiscrepanciesByMatchID :exec
+DELETE FROM afl.dataops_stats_discrepancy
+WHERE match_id = $1;
+
+-- name: InsertDataopsStatsDiscrepancy :exec
+INSERT INTO afl.dataops_stats_discrepancy
+    (match_id, club_match_id, kind, player_season_id, player_name, stat, source, value, other_source, other_value)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: FindDataopsStatsDiscrepanciesByMatchID :many
+SELECT id, match_id, club_match_id, kind, player_season_id, player_name, stat, source, value, other_source, other_value, created_at
+FROM afl.dataops_stats_discrepancy
+WHERE match_id = $1
+ORDER BY id;
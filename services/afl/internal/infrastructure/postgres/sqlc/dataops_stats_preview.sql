@@ -0,0 +1,14 @@
+-- name: CreateDataopsStatsPreview :one
+INSERT INTO afl.dataops_stats_preview (match_id, source, plan)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, match_id, source, plan, applied_at;
+
+-- name: FindDataopsStatsPreviewByID :one
+SELECT id, created_at, match_id, source, plan, applied_at
+FROM afl.dataops_stats_preview
+WHERE id = $1;
+
+-- name: MarkDataopsStatsPreviewApplied :execrows
+UPDATE afl.dataops_stats_preview
+SET applied_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND applied_at IS NULL;
@@ -32,3 +32,30 @@ FROM afl.player
 WHERE name ILIKE '%' || @query || '%' AND deleted_at IS NULL
 ORDER BY name
 LIMIT 20;
+
+-- name: DeletePlayer :exec
+UPDATE afl.player
+SET deleted_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: FindPlayerDuplicateCandidates :many
+WITH p AS (
+    SELECT id, name, lower(regexp_replace(trim(name), '^.*\s', '')) AS surname
+    FROM afl.player
+    WHERE deleted_at IS NULL
+)
+SELECT a.id AS player_id, a.name AS player_name, b.id AS other_player_id, b.name AS other_player_name
+FROM p a
+JOIN p b ON b.surname = a.surname AND b.id > a.id
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM afl.player_match pma
+    JOIN afl.player_season psa ON psa.id = pma.player_season_id
+    JOIN afl.player_match pmb ON pmb.club_match_id = pma.club_match_id
+    JOIN afl.player_season psb ON psb.id = pmb.player_season_id
+    WHERE psa.player_id = a.id AND psb.player_id = b.id
+      AND pma.deleted_at IS NULL AND pmb.deleted_at IS NULL
+      AND psa.deleted_at IS NULL AND psb.deleted_at IS NULL
+)
+ORDER BY a.id, b.id;
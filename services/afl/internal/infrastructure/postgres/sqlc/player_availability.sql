@@ -0,0 +1,37 @@
+-- name: FindPlayerAvailabilityByRoundID :many
+SELECT id, player_season_id, round_id, status, expected_return, source
+FROM afl.player_availability
+WHERE round_id = $1 AND deleted_at IS NULL
+ORDER BY player_season_id;
+
+-- name: FindPlayerAvailabilityBySeasonIDsAndRoundID :many
+SELECT id, player_season_id, round_id, status, expected_return, source
+FROM afl.player_availability
+WHERE player_season_id = ANY(@player_season_ids::int[])
+  AND round_id = @round_id
+  AND deleted_at IS NULL
+ORDER BY player_season_id;
+
+-- name: UpsertPlayerAvailability :one
+INSERT INTO afl.player_availability (player_season_id, round_id, status, expected_return, source)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (player_season_id, round_id)
+DO UPDATE SET
+    status = EXCLUDED.status,
+    expected_return = EXCLUDED.expected_return,
+    source = EXCLUDED.source,
+    deleted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING id, player_season_id, round_id, status, expected_return, source;
+
+-- name: ReassignPlayerAvailability :exec
+UPDATE afl.player_availability a
+SET player_season_id = @to_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE a.player_season_id = @from_player_season_id
+  AND a.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1
+    FROM afl.player_availability b
+    WHERE b.player_season_id = @to_player_season_id AND b.round_id = a.round_id
+  );
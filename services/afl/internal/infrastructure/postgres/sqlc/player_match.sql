@@ -1,6 +1,6 @@
 -- name: FindPlayerMatchesByClubMatchID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -9,7 +9,7 @@ WHERE pm.club_match_id = $1 AND pm.deleted_at IS NULL;
 
 -- name: FindPlayerMatchByID :one
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -18,7 +18,7 @@ WHERE pm.id = $1 AND pm.deleted_at IS NULL;
 
 -- name: FindPlayerMatchesByPlayerSeasonID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -28,7 +28,7 @@ ORDER BY pm.id;
 
 -- name: FindPlayerMatchesByIDs :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -37,7 +37,7 @@ WHERE pm.id = ANY(@ids::int[]) AND pm.deleted_at IS NULL;
 
 -- name: FindPlayerMatchesBySeasonIDsAndRoundID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -47,8 +47,8 @@ WHERE pm.player_season_id = ANY(@player_season_ids::int[])
   AND pm.deleted_at IS NULL;
 
 -- name: UpsertPlayerMatch :one
-INSERT INTO afl.player_match (club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO afl.player_match (club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds, extra_stats)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 ON CONFLICT (player_season_id, club_match_id)
 DO UPDATE SET
     kicks = COALESCE($3, afl.player_match.kicks),
@@ -58,6 +58,17 @@ DO UPDATE SET
     tackles = COALESCE($7, afl.player_match.tackles),
     goals = COALESCE($8, afl.player_match.goals),
     behinds = COALESCE($9, afl.player_match.behinds),
+    extra_stats = afl.player_match.extra_stats || EXCLUDED.extra_stats,
     updated_at = CURRENT_TIMESTAMP
 WHERE afl.player_match.deleted_at IS NULL
-RETURNING id, club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds;
+RETURNING id, club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds, extra_stats;
+
+-- name: DeletePlayerMatch :exec
+DELETE FROM afl.player_match
+WHERE id = $1;
+
+-- name: ReassignPlayerMatches :exec
+UPDATE afl.player_match
+SET player_season_id = @to_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE player_season_id = @from_player_season_id AND deleted_at IS NULL;
@@ -52,3 +52,21 @@ WHERE cs.season_id = @season_id
   AND p.deleted_at IS NULL
   AND (sqlc.narg('name_query')::text IS NULL OR p.name ILIKE '%' || sqlc.narg('name_query') || '%')
 ORDER BY p.name ASC, ps.id ASC;
+
+-- name: FindPlayerSeasonsByPlayerID :many
+SELECT id, player_id, club_season_id, from_round_id, to_round_id
+FROM afl.player_season
+WHERE player_id = $1 AND deleted_at IS NULL
+ORDER BY id;
+
+-- name: ReassignPlayerSeason :exec
+UPDATE afl.player_season
+SET player_id = @player_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND deleted_at IS NULL;
+
+-- name: DeletePlayerSeason :exec
+UPDATE afl.player_season
+SET deleted_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL;
@@ -11,3 +11,8 @@ SELECT id, name, season_id
 FROM afl.round
 WHERE id = $1 AND deleted_at IS NULL;
 
+
+-- name: InsertRound :one
+INSERT INTO afl.round (season_id, name)
+VALUES ($1, $2)
+RETURNING id, name, season_id;
@@ -8,3 +8,17 @@ ORDER BY name;
 SELECT id, name, league_id
 FROM afl.season
 WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: FindSeasonByName :one
+SELECT id, name, league_id
+FROM afl.season
+WHERE name = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1;
+
+-- name: InsertSeason :one
+INSERT INTO afl.season (league_id, name)
+SELECT l.id, @name
+FROM afl.league l
+WHERE l.name = @league_name AND l.deleted_at IS NULL
+RETURNING id, name, league_id;
@@ -90,6 +90,37 @@ func (q *Queries) FindRoundIDByClubMatchID(ctx context.Context, id int32) (int32
 	return round_id, err
 }
 
+const insertClubMatch = `-- name: InsertClubMatch :one
+INSERT INTO afl.club_match (match_id, club_season_id, side)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type InsertClubMatchParams struct {
+	MatchID      int32
+	ClubSeasonID int32
+	Side         string
+}
+
+func (q *Queries) InsertClubMatch(ctx context.Context, arg InsertClubMatchParams) (int32, error) {
+	row := q.db.QueryRow(ctx, insertClubMatch, arg.MatchID, arg.ClubSeasonID, arg.Side)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const swapClubMatchSides = `-- name: SwapClubMatchSides :exec
+UPDATE afl.club_match
+SET side       = CASE side WHEN 'home' THEN 'away' ELSE 'home' END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE match_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SwapClubMatchSides(ctx context.Context, matchID int32) error {
+	_, err := q.db.Exec(ctx, swapClubMatchSides, matchID)
+	return err
+}
+
 const updateClubMatchRushedBehinds = `-- name: UpdateClubMatchRushedBehinds :exec
 UPDATE afl.club_match
 SET rushed_behinds = $2,
@@ -138,3 +138,30 @@ func (q *Queries) UpdateClubSeason(ctx context.Context, arg UpdateClubSeasonPara
 	)
 	return err
 }
+
+const upsertClubSeason = `-- name: UpsertClubSeason :one
+INSERT INTO afl.club_season (club_id, season_id)
+VALUES ($1, $2)
+ON CONFLICT (club_id, season_id) DO UPDATE
+SET deleted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING id, club_id, season_id
+`
+
+type UpsertClubSeasonParams struct {
+	ClubID   int32
+	SeasonID int32
+}
+
+type UpsertClubSeasonRow struct {
+	ID       int32
+	ClubID   int32
+	SeasonID int32
+}
+
+func (q *Queries) UpsertClubSeason(ctx context.Context, arg UpsertClubSeasonParams) (UpsertClubSeasonRow, error) {
+	row := q.db.QueryRow(ctx, upsertClubSeason, arg.ClubID, arg.SeasonID)
+	var i UpsertClubSeasonRow
+	err := row.Scan(&i.ID, &i.ClubID, &i.SeasonID)
+	return i, err
+}
@@ -37,6 +37,23 @@ func (q *Queries) FindDataopsPlayerSource(ctx context.Context, arg FindDataopsPl
 	return player_season_id, err
 }
 
+const reassignDataopsPlayerSources = `-- name: ReassignDataopsPlayerSources :exec
+UPDATE afl.dataops_player_source
+SET player_season_id = $1,
+    updated_at       = CURRENT_TIMESTAMP
+WHERE player_season_id = $2
+`
+
+type ReassignDataopsPlayerSourcesParams struct {
+	ToPlayerSeasonID   int32
+	FromPlayerSeasonID int32
+}
+
+func (q *Queries) ReassignDataopsPlayerSources(ctx context.Context, arg ReassignDataopsPlayerSourcesParams) error {
+	_, err := q.db.Exec(ctx, reassignDataopsPlayerSources, arg.ToPlayerSeasonID, arg.FromPlayerSeasonID)
+	return err
+}
+
 const upsertDataopsPlayerSource = `-- name: UpsertDataopsPlayerSource :exec
 INSERT INTO afl.dataops_player_source (source, external_season, external_club, external_player, player_season_id, updated_at)
 VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
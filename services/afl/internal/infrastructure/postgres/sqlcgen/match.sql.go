@@ -218,6 +218,148 @@ func (q *Queries) FindMatchesByRoundID(ctx context.Context, roundID int32) ([]Fi
 	return items, nil
 }
 
+const findMatchesBySeasonID = `-- name: FindMatchesBySeasonID :many
+SELECT m.id, m.round_id,
+       COALESCE(home.id, 0)             AS home_club_match_id,
+       COALESCE(home.club_season_id, 0) AS home_club_season_id,
+       COALESCE(away.id, 0)             AS away_club_match_id,
+       COALESCE(away.club_season_id, 0) AS away_club_season_id,
+       COALESCE(m.venue, '') AS venue,
+       COALESCE(m.start_dt, '0001-01-01T00:00:00Z'::timestamptz) AS start_dt,
+       m.data_status
+FROM afl.match m
+JOIN afl.round r ON r.id = m.round_id AND r.deleted_at IS NULL
+LEFT JOIN afl.club_match home ON home.match_id = m.id AND home.side = 'home' AND home.deleted_at IS NULL
+LEFT JOIN afl.club_match away ON away.match_id = m.id AND away.side = 'away' AND away.deleted_at IS NULL
+WHERE r.season_id = $1
+  AND m.deleted_at IS NULL
+ORDER BY m.id
+`
+
+type FindMatchesBySeasonIDRow struct {
+	ID               int32
+	RoundID          int32
+	HomeClubMatchID  int32
+	HomeClubSeasonID int32
+	AwayClubMatchID  int32
+	AwayClubSeasonID int32
+	Venue            string
+	StartDt          pgtype.Timestamptz
+	DataStatus       string
+}
+
+func (q *Queries) FindMatchesBySeasonID(ctx context.Context, seasonID int32) ([]FindMatchesBySeasonIDRow, error) {
+	rows, err := q.db.Query(ctx, findMatchesBySeasonID, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindMatchesBySeasonIDRow{}
+	for rows.Next() {
+		var i FindMatchesBySeasonIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoundID,
+			&i.HomeClubMatchID,
+			&i.HomeClubSeasonID,
+			&i.AwayClubMatchID,
+			&i.AwayClubSeasonID,
+			&i.Venue,
+			&i.StartDt,
+			&i.DataStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findUnfinishedMatchesStartedBetween = `-- name: FindUnfinishedMatchesStartedBetween :many
+SELECT m.id, m.round_id,
+       COALESCE(home.id, 0) AS home_club_match_id,
+       COALESCE(away.id, 0) AS away_club_match_id,
+       COALESCE(m.venue, '') AS venue,
+       COALESCE(m.start_dt, '0001-01-01T00:00:00Z'::timestamptz) AS start_dt,
+       COALESCE(m.drv_result, '') AS drv_result,
+       m.data_status
+FROM afl.match m
+LEFT JOIN afl.club_match home ON home.match_id = m.id AND home.side = 'home' AND home.deleted_at IS NULL
+LEFT JOIN afl.club_match away ON away.match_id = m.id AND away.side = 'away' AND away.deleted_at IS NULL
+WHERE m.start_dt >= $1
+  AND m.start_dt <= $2
+  AND m.data_status <> 'final'
+  AND m.deleted_at IS NULL
+ORDER BY m.start_dt, m.id
+`
+
+type FindUnfinishedMatchesStartedBetweenParams struct {
+	StartedAfter  pgtype.Timestamptz
+	StartedBefore pgtype.Timestamptz
+}
+
+type FindUnfinishedMatchesStartedBetweenRow struct {
+	ID              int32
+	RoundID         int32
+	HomeClubMatchID int32
+	AwayClubMatchID int32
+	Venue           string
+	StartDt         pgtype.Timestamptz
+	DrvResult       string
+	DataStatus      string
+}
+
+func (q *Queries) FindUnfinishedMatchesStartedBetween(ctx context.Context, arg FindUnfinishedMatchesStartedBetweenParams) ([]FindUnfinishedMatchesStartedBetweenRow, error) {
+	rows, err := q.db.Query(ctx, findUnfinishedMatchesStartedBetween, arg.StartedAfter, arg.StartedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindUnfinishedMatchesStartedBetweenRow{}
+	for rows.Next() {
+		var i FindUnfinishedMatchesStartedBetweenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoundID,
+			&i.HomeClubMatchID,
+			&i.AwayClubMatchID,
+			&i.Venue,
+			&i.StartDt,
+			&i.DrvResult,
+			&i.DataStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertMatch = `-- name: InsertMatch :one
+INSERT INTO afl.match (round_id, venue, start_dt)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type InsertMatchParams struct {
+	RoundID int32
+	Venue   *string
+	StartDt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertMatch(ctx context.Context, arg InsertMatchParams) (int32, error) {
+	row := q.db.QueryRow(ctx, insertMatch, arg.RoundID, arg.Venue, arg.StartDt)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updateMatchDataStatus = `-- name: UpdateMatchDataStatus :exec
 UPDATE afl.match
 SET data_status = $2,
@@ -251,3 +393,29 @@ func (q *Queries) UpdateMatchResult(ctx context.Context, arg UpdateMatchResultPa
 	_, err := q.db.Exec(ctx, updateMatchResult, arg.ID, arg.DrvResult)
 	return err
 }
+
+const updateMatchSchedule = `-- name: UpdateMatchSchedule :exec
+UPDATE afl.match
+SET round_id   = $2,
+    venue      = $3,
+    start_dt   = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type UpdateMatchScheduleParams struct {
+	ID      int32
+	RoundID int32
+	Venue   *string
+	StartDt pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateMatchSchedule(ctx context.Context, arg UpdateMatchScheduleParams) error {
+	_, err := q.db.Exec(ctx, updateMatchSchedule,
+		arg.ID,
+		arg.RoundID,
+		arg.Venue,
+		arg.StartDt,
+	)
+	return err
+}
@@ -28,6 +28,17 @@ type AflClubMatch struct {
 	DrvPremiershipPoints *int32
 }
 
+type AflClubMatchQuarter struct {
+	ID          int32
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	DeletedAt   pgtype.Timestamptz
+	ClubMatchID int32
+	Quarter     int32
+	Goals       int32
+	Behinds     int32
+}
+
 type AflClubSeason struct {
 	ID                   int32
 	CreatedAt            pgtype.Timestamptz
@@ -52,6 +63,24 @@ type AflDataopsMatchSource struct {
 	UpdatedAt  pgtype.Timestamptz
 }
 
+type AflDataopsPlayerProposal struct {
+	ID               int32
+	CreatedAt        pgtype.Timestamptz
+	SeasonID         int32
+	Source           string
+	Kind             string
+	ListedName       string
+	ClubSeasonID     int32
+	ClubName         string
+	PlayerID         *int32
+	PlayerName       string
+	PreviousClubName string
+	Confidence       float64
+	Status           string
+	PlayerSeasonID   *int32
+	DecidedAt        pgtype.Timestamptz
+}
+
 type AflDataopsPlayerSource struct {
 	Source         string
 	ExternalSeason string
@@ -62,6 +91,30 @@ type AflDataopsPlayerSource struct {
 	UpdatedAt      pgtype.Timestamptz
 }
 
+type AflDataopsStatsDiscrepancy struct {
+	ID             int32
+	MatchID        int32
+	ClubMatchID    int32
+	Kind           string
+	PlayerSeasonID *int32
+	PlayerName     string
+	Stat           string
+	Source         string
+	Value          int32
+	OtherSource    string
+	OtherValue     int32
+	CreatedAt      pgtype.Timestamptz
+}
+
+type AflDataopsStatsPreview struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	MatchID   int32
+	Source    string
+	Plan      []byte
+	AppliedAt pgtype.Timestamptz
+}
+
 type AflLeague struct {
 	ID        int32
 	CreatedAt pgtype.Timestamptz
@@ -92,6 +145,18 @@ type AflPlayer struct {
 	Name      string
 }
 
+type AflPlayerAvailability struct {
+	ID             int32
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	DeletedAt      pgtype.Timestamptz
+	PlayerSeasonID int32
+	RoundID        int32
+	Status         string
+	ExpectedReturn *string
+	Source         string
+}
+
 type AflPlayerMatch struct {
 	ID             int32
 	CreatedAt      pgtype.Timestamptz
@@ -107,6 +172,7 @@ type AflPlayerMatch struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 }
 
 type AflPlayerSeason struct {
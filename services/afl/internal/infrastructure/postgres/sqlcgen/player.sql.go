@@ -9,6 +9,18 @@ import (
 	"context"
 )
 
+const deletePlayer = `-- name: DeletePlayer :exec
+UPDATE afl.player
+SET deleted_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeletePlayer(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deletePlayer, id)
+	return err
+}
+
 const findPlayerByID = `-- name: FindPlayerByID :one
 SELECT id, name
 FROM afl.player
@@ -27,6 +39,60 @@ func (q *Queries) FindPlayerByID(ctx context.Context, id int32) (FindPlayerByIDR
 	return i, err
 }
 
+const findPlayerDuplicateCandidates = `-- name: FindPlayerDuplicateCandidates :many
+WITH p AS (
+    SELECT id, name, lower(regexp_replace(trim(name), '^.*\s', '')) AS surname
+    FROM afl.player
+    WHERE deleted_at IS NULL
+)
+SELECT a.id AS player_id, a.name AS player_name, b.id AS other_player_id, b.name AS other_player_name
+FROM p a
+JOIN p b ON b.surname = a.surname AND b.id > a.id
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM afl.player_match pma
+    JOIN afl.player_season psa ON psa.id = pma.player_season_id
+    JOIN afl.player_match pmb ON pmb.club_match_id = pma.club_match_id
+    JOIN afl.player_season psb ON psb.id = pmb.player_season_id
+    WHERE psa.player_id = a.id AND psb.player_id = b.id
+      AND pma.deleted_at IS NULL AND pmb.deleted_at IS NULL
+      AND psa.deleted_at IS NULL AND psb.deleted_at IS NULL
+)
+ORDER BY a.id, b.id
+`
+
+type FindPlayerDuplicateCandidatesRow struct {
+	PlayerID        int32
+	PlayerName      string
+	OtherPlayerID   int32
+	OtherPlayerName string
+}
+
+func (q *Queries) FindPlayerDuplicateCandidates(ctx context.Context) ([]FindPlayerDuplicateCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, findPlayerDuplicateCandidates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPlayerDuplicateCandidatesRow{}
+	for rows.Next() {
+		var i FindPlayerDuplicateCandidatesRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.OtherPlayerID,
+			&i.OtherPlayerName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findPlayersByIDs = `-- name: FindPlayersByIDs :many
 SELECT id, name
 FROM afl.player
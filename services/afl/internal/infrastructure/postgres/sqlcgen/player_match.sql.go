@@ -9,9 +9,19 @@ import (
 	"context"
 )
 
+const deletePlayerMatch = `-- name: DeletePlayerMatch :exec
+DELETE FROM afl.player_match
+WHERE id = $1
+`
+
+func (q *Queries) DeletePlayerMatch(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deletePlayerMatch, id)
+	return err
+}
+
 const findPlayerMatchByID = `-- name: FindPlayerMatchByID :one
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -30,6 +40,7 @@ type FindPlayerMatchByIDRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 	DataStatus     string
 }
 
@@ -47,6 +58,7 @@ func (q *Queries) FindPlayerMatchByID(ctx context.Context, id int32) (FindPlayer
 		&i.Tackles,
 		&i.Goals,
 		&i.Behinds,
+		&i.ExtraStats,
 		&i.DataStatus,
 	)
 	return i, err
@@ -54,7 +66,7 @@ func (q *Queries) FindPlayerMatchByID(ctx context.Context, id int32) (FindPlayer
 
 const findPlayerMatchesByClubMatchID = `-- name: FindPlayerMatchesByClubMatchID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -73,6 +85,7 @@ type FindPlayerMatchesByClubMatchIDRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 	DataStatus     string
 }
 
@@ -96,6 +109,7 @@ func (q *Queries) FindPlayerMatchesByClubMatchID(ctx context.Context, clubMatchI
 			&i.Tackles,
 			&i.Goals,
 			&i.Behinds,
+			&i.ExtraStats,
 			&i.DataStatus,
 		); err != nil {
 			return nil, err
@@ -110,7 +124,7 @@ func (q *Queries) FindPlayerMatchesByClubMatchID(ctx context.Context, clubMatchI
 
 const findPlayerMatchesByIDs = `-- name: FindPlayerMatchesByIDs :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -129,6 +143,7 @@ type FindPlayerMatchesByIDsRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 	DataStatus     string
 }
 
@@ -152,6 +167,7 @@ func (q *Queries) FindPlayerMatchesByIDs(ctx context.Context, ids []int32) ([]Fi
 			&i.Tackles,
 			&i.Goals,
 			&i.Behinds,
+			&i.ExtraStats,
 			&i.DataStatus,
 		); err != nil {
 			return nil, err
@@ -166,7 +182,7 @@ func (q *Queries) FindPlayerMatchesByIDs(ctx context.Context, ids []int32) ([]Fi
 
 const findPlayerMatchesByPlayerSeasonID = `-- name: FindPlayerMatchesByPlayerSeasonID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -186,6 +202,7 @@ type FindPlayerMatchesByPlayerSeasonIDRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 	DataStatus     string
 }
 
@@ -209,6 +226,7 @@ func (q *Queries) FindPlayerMatchesByPlayerSeasonID(ctx context.Context, playerS
 			&i.Tackles,
 			&i.Goals,
 			&i.Behinds,
+			&i.ExtraStats,
 			&i.DataStatus,
 		); err != nil {
 			return nil, err
@@ -223,7 +241,7 @@ func (q *Queries) FindPlayerMatchesByPlayerSeasonID(ctx context.Context, playerS
 
 const findPlayerMatchesBySeasonIDsAndRoundID = `-- name: FindPlayerMatchesBySeasonIDsAndRoundID :many
 SELECT pm.id, pm.club_match_id, pm.player_season_id,
-       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds,
+       pm.kicks, pm.handballs, pm.marks, pm.hitouts, pm.tackles, pm.goals, pm.behinds, pm.extra_stats,
        m.data_status
 FROM afl.player_match pm
 JOIN afl.club_match cm ON cm.id = pm.club_match_id AND cm.deleted_at IS NULL
@@ -249,6 +267,7 @@ type FindPlayerMatchesBySeasonIDsAndRoundIDRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 	DataStatus     string
 }
 
@@ -272,6 +291,7 @@ func (q *Queries) FindPlayerMatchesBySeasonIDsAndRoundID(ctx context.Context, ar
 			&i.Tackles,
 			&i.Goals,
 			&i.Behinds,
+			&i.ExtraStats,
 			&i.DataStatus,
 		); err != nil {
 			return nil, err
@@ -284,9 +304,26 @@ func (q *Queries) FindPlayerMatchesBySeasonIDsAndRoundID(ctx context.Context, ar
 	return items, nil
 }
 
+const reassignPlayerMatches = `-- name: ReassignPlayerMatches :exec
+UPDATE afl.player_match
+SET player_season_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE player_season_id = $2 AND deleted_at IS NULL
+`
+
+type ReassignPlayerMatchesParams struct {
+	ToPlayerSeasonID   int32
+	FromPlayerSeasonID int32
+}
+
+func (q *Queries) ReassignPlayerMatches(ctx context.Context, arg ReassignPlayerMatchesParams) error {
+	_, err := q.db.Exec(ctx, reassignPlayerMatches, arg.ToPlayerSeasonID, arg.FromPlayerSeasonID)
+	return err
+}
+
 const upsertPlayerMatch = `-- name: UpsertPlayerMatch :one
-INSERT INTO afl.player_match (club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO afl.player_match (club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds, extra_stats)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 ON CONFLICT (player_season_id, club_match_id)
 DO UPDATE SET
     kicks = COALESCE($3, afl.player_match.kicks),
@@ -296,9 +333,10 @@ DO UPDATE SET
     tackles = COALESCE($7, afl.player_match.tackles),
     goals = COALESCE($8, afl.player_match.goals),
     behinds = COALESCE($9, afl.player_match.behinds),
+    extra_stats = afl.player_match.extra_stats || EXCLUDED.extra_stats,
     updated_at = CURRENT_TIMESTAMP
 WHERE afl.player_match.deleted_at IS NULL
-RETURNING id, club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds
+RETURNING id, club_match_id, player_season_id, kicks, handballs, marks, hitouts, tackles, goals, behinds, extra_stats
 `
 
 type UpsertPlayerMatchParams struct {
@@ -311,6 +349,7 @@ type UpsertPlayerMatchParams struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 }
 
 type UpsertPlayerMatchRow struct {
@@ -324,6 +363,7 @@ type UpsertPlayerMatchRow struct {
 	Tackles        *int32
 	Goals          *int32
 	Behinds        *int32
+	ExtraStats     []byte
 }
 
 func (q *Queries) UpsertPlayerMatch(ctx context.Context, arg UpsertPlayerMatchParams) (UpsertPlayerMatchRow, error) {
@@ -337,6 +377,7 @@ func (q *Queries) UpsertPlayerMatch(ctx context.Context, arg UpsertPlayerMatchPa
 		arg.Tackles,
 		arg.Goals,
 		arg.Behinds,
+		arg.ExtraStats,
 	)
 	var i UpsertPlayerMatchRow
 	err := row.Scan(
@@ -350,6 +391,7 @@ func (q *Queries) UpsertPlayerMatch(ctx context.Context, arg UpsertPlayerMatchPa
 		&i.Tackles,
 		&i.Goals,
 		&i.Behinds,
+		&i.ExtraStats,
 	)
 	return i, err
 }
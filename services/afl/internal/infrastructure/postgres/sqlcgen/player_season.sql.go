@@ -9,6 +9,18 @@ import (
 	"context"
 )
 
+const deletePlayerSeason = `-- name: DeletePlayerSeason :exec
+UPDATE afl.player_season
+SET deleted_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeletePlayerSeason(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deletePlayerSeason, id)
+	return err
+}
+
 const findLatestPlayerSeasonByPlayerID = `-- name: FindLatestPlayerSeasonByPlayerID :one
 SELECT ps.id
 FROM afl.player_season ps
@@ -133,6 +145,47 @@ func (q *Queries) FindPlayerSeasonsByIDs(ctx context.Context, ids []int32) ([]Fi
 	return items, nil
 }
 
+const findPlayerSeasonsByPlayerID = `-- name: FindPlayerSeasonsByPlayerID :many
+SELECT id, player_id, club_season_id, from_round_id, to_round_id
+FROM afl.player_season
+WHERE player_id = $1 AND deleted_at IS NULL
+ORDER BY id
+`
+
+type FindPlayerSeasonsByPlayerIDRow struct {
+	ID           int32
+	PlayerID     int32
+	ClubSeasonID int32
+	FromRoundID  *int32
+	ToRoundID    *int32
+}
+
+func (q *Queries) FindPlayerSeasonsByPlayerID(ctx context.Context, playerID int32) ([]FindPlayerSeasonsByPlayerIDRow, error) {
+	rows, err := q.db.Query(ctx, findPlayerSeasonsByPlayerID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPlayerSeasonsByPlayerIDRow{}
+	for rows.Next() {
+		var i FindPlayerSeasonsByPlayerIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.ClubSeasonID,
+			&i.FromRoundID,
+			&i.ToRoundID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findPlayerSeasonsBySeasonID = `-- name: FindPlayerSeasonsBySeasonID :many
 SELECT ps.id
 FROM afl.player_season ps
@@ -236,6 +289,23 @@ func (q *Queries) InsertPlayerSeason(ctx context.Context, arg InsertPlayerSeason
 	return i, err
 }
 
+const reassignPlayerSeason = `-- name: ReassignPlayerSeason :exec
+UPDATE afl.player_season
+SET player_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $2 AND deleted_at IS NULL
+`
+
+type ReassignPlayerSeasonParams struct {
+	PlayerID int32
+	ID       int32
+}
+
+func (q *Queries) ReassignPlayerSeason(ctx context.Context, arg ReassignPlayerSeasonParams) error {
+	_, err := q.db.Exec(ctx, reassignPlayerSeason, arg.PlayerID, arg.ID)
+	return err
+}
+
 const upsertPlayerSeason = `-- name: UpsertPlayerSeason :one
 INSERT INTO afl.player_season (player_id, club_season_id)
 VALUES ($1, $2)
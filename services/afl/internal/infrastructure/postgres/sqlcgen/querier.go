@@ -9,22 +9,38 @@ import (
 )
 
 type Querier interface {
+	CreateDataopsStatsPreview(ctx context.Context, arg CreateDataopsStatsPreviewParams) (CreateDataopsStatsPreviewRow, error)
+	DecideDataopsPlayerProposal(ctx context.Context, arg DecideDataopsPlayerProposalParams) (int64, error)
+	DeleteDataopsStatsDiscrepanciesByMatchID(ctx context.Context, matchID int32) error
+	DeletePendingDataopsPlayerProposals(ctx context.Context, seasonID int32) error
+	DeletePlayer(ctx context.Context, id int32) error
+	DeletePlayerMatch(ctx context.Context, id int32) error
+	DeletePlayerSeason(ctx context.Context, id int32) error
 	FindAllClubs(ctx context.Context) ([]FindAllClubsRow, error)
 	FindAllSeasons(ctx context.Context) ([]FindAllSeasonsRow, error)
 	FindClubByID(ctx context.Context, id int32) (FindClubByIDRow, error)
 	FindClubMatchByID(ctx context.Context, id int32) (FindClubMatchByIDRow, error)
+	FindClubMatchQuartersByClubMatchIDs(ctx context.Context, clubMatchIds []int32) ([]FindClubMatchQuartersByClubMatchIDsRow, error)
 	FindClubMatchesByMatchID(ctx context.Context, matchID int32) ([]FindClubMatchesByMatchIDRow, error)
 	FindClubSeasonByID(ctx context.Context, id int32) (FindClubSeasonByIDRow, error)
 	FindClubSeasonsBySeasonID(ctx context.Context, seasonID int32) ([]FindClubSeasonsBySeasonIDRow, error)
 	FindClubsByIDs(ctx context.Context, ids []int32) ([]FindClubsByIDsRow, error)
 	FindDataopsMatchSourceByMatchID(ctx context.Context, arg FindDataopsMatchSourceByMatchIDParams) (FindDataopsMatchSourceByMatchIDRow, error)
+	FindDataopsPlayerProposalsByIDs(ctx context.Context, ids []int32) ([]FindDataopsPlayerProposalsByIDsRow, error)
+	FindDataopsPlayerProposalsBySeasonID(ctx context.Context, seasonID int32) ([]FindDataopsPlayerProposalsBySeasonIDRow, error)
 	FindDataopsPlayerSource(ctx context.Context, arg FindDataopsPlayerSourceParams) (int32, error)
+	FindDataopsStatsDiscrepanciesByMatchID(ctx context.Context, matchID int32) ([]AflDataopsStatsDiscrepancy, error)
+	FindDataopsStatsPreviewByID(ctx context.Context, id int32) (FindDataopsStatsPreviewByIDRow, error)
 	FindFinalMatchesBySeasonID(ctx context.Context, seasonID int32) ([]FindFinalMatchesBySeasonIDRow, error)
 	FindLatestPlayerSeasonByPlayerID(ctx context.Context, playerID int32) (int32, error)
 	FindMatchByID(ctx context.Context, id int32) (FindMatchByIDRow, error)
 	FindMatchesByIDs(ctx context.Context, ids []int32) ([]FindMatchesByIDsRow, error)
 	FindMatchesByRoundID(ctx context.Context, roundID int32) ([]FindMatchesByRoundIDRow, error)
+	FindMatchesBySeasonID(ctx context.Context, seasonID int32) ([]FindMatchesBySeasonIDRow, error)
+	FindPlayerAvailabilityByRoundID(ctx context.Context, roundID int32) ([]FindPlayerAvailabilityByRoundIDRow, error)
+	FindPlayerAvailabilityBySeasonIDsAndRoundID(ctx context.Context, arg FindPlayerAvailabilityBySeasonIDsAndRoundIDParams) ([]FindPlayerAvailabilityBySeasonIDsAndRoundIDRow, error)
 	FindPlayerByID(ctx context.Context, id int32) (FindPlayerByIDRow, error)
+	FindPlayerDuplicateCandidates(ctx context.Context) ([]FindPlayerDuplicateCandidatesRow, error)
 	FindPlayerMatchByID(ctx context.Context, id int32) (FindPlayerMatchByIDRow, error)
 	FindPlayerMatchesByClubMatchID(ctx context.Context, clubMatchID int32) ([]FindPlayerMatchesByClubMatchIDRow, error)
 	FindPlayerMatchesByIDs(ctx context.Context, ids []int32) ([]FindPlayerMatchesByIDsRow, error)
@@ -33,6 +49,7 @@ type Querier interface {
 	FindPlayerSeasonByID(ctx context.Context, id int32) (FindPlayerSeasonByIDRow, error)
 	FindPlayerSeasonsByClubSeasonIDWithPlayer(ctx context.Context, clubSeasonID int32) ([]FindPlayerSeasonsByClubSeasonIDWithPlayerRow, error)
 	FindPlayerSeasonsByIDs(ctx context.Context, ids []int32) ([]FindPlayerSeasonsByIDsRow, error)
+	FindPlayerSeasonsByPlayerID(ctx context.Context, playerID int32) ([]FindPlayerSeasonsByPlayerIDRow, error)
 	FindPlayerSeasonsBySeasonID(ctx context.Context, arg FindPlayerSeasonsBySeasonIDParams) ([]int32, error)
 	FindPlayersByIDs(ctx context.Context, ids []int32) ([]FindPlayersByIDsRow, error)
 	FindPlayersByIDsWithClub(ctx context.Context, ids []int32) ([]FindPlayersByIDsWithClubRow, error)
@@ -41,16 +58,34 @@ type Querier interface {
 	FindRoundIDByClubMatchID(ctx context.Context, id int32) (int32, error)
 	FindRoundsBySeasonID(ctx context.Context, seasonID int32) ([]FindRoundsBySeasonIDRow, error)
 	FindSeasonByID(ctx context.Context, id int32) (FindSeasonByIDRow, error)
+	FindSeasonByName(ctx context.Context, name string) (FindSeasonByNameRow, error)
+	FindUnfinishedMatchesStartedBetween(ctx context.Context, arg FindUnfinishedMatchesStartedBetweenParams) ([]FindUnfinishedMatchesStartedBetweenRow, error)
+	InsertClubMatch(ctx context.Context, arg InsertClubMatchParams) (int32, error)
+	InsertDataopsPlayerProposal(ctx context.Context, arg InsertDataopsPlayerProposalParams) (InsertDataopsPlayerProposalRow, error)
+	InsertDataopsStatsDiscrepancy(ctx context.Context, arg InsertDataopsStatsDiscrepancyParams) error
+	InsertMatch(ctx context.Context, arg InsertMatchParams) (int32, error)
 	InsertPlayer(ctx context.Context, name string) (InsertPlayerRow, error)
 	InsertPlayerSeason(ctx context.Context, arg InsertPlayerSeasonParams) (InsertPlayerSeasonRow, error)
+	InsertRound(ctx context.Context, arg InsertRoundParams) (InsertRoundRow, error)
+	InsertSeason(ctx context.Context, arg InsertSeasonParams) (InsertSeasonRow, error)
+	MarkDataopsStatsPreviewApplied(ctx context.Context, id int32) (int64, error)
+	ReassignDataopsPlayerSources(ctx context.Context, arg ReassignDataopsPlayerSourcesParams) error
+	ReassignPlayerAvailability(ctx context.Context, arg ReassignPlayerAvailabilityParams) error
+	ReassignPlayerMatches(ctx context.Context, arg ReassignPlayerMatchesParams) error
+	ReassignPlayerSeason(ctx context.Context, arg ReassignPlayerSeasonParams) error
 	SearchPlayersByName(ctx context.Context, query *string) ([]SearchPlayersByNameRow, error)
+	SwapClubMatchSides(ctx context.Context, matchID int32) error
 	UpdateClubMatchRushedBehinds(ctx context.Context, arg UpdateClubMatchRushedBehindsParams) error
 	UpdateClubMatchScore(ctx context.Context, arg UpdateClubMatchScoreParams) error
 	UpdateClubSeason(ctx context.Context, arg UpdateClubSeasonParams) error
 	UpdateMatchDataStatus(ctx context.Context, arg UpdateMatchDataStatusParams) error
 	UpdateMatchResult(ctx context.Context, arg UpdateMatchResultParams) error
+	UpdateMatchSchedule(ctx context.Context, arg UpdateMatchScheduleParams) error
+	UpsertClubMatchQuarter(ctx context.Context, arg UpsertClubMatchQuarterParams) error
+	UpsertClubSeason(ctx context.Context, arg UpsertClubSeasonParams) (UpsertClubSeasonRow, error)
 	UpsertDataopsMatchSource(ctx context.Context, arg UpsertDataopsMatchSourceParams) error
 	UpsertDataopsPlayerSource(ctx context.Context, arg UpsertDataopsPlayerSourceParams) error
+	UpsertPlayerAvailability(ctx context.Context, arg UpsertPlayerAvailabilityParams) (UpsertPlayerAvailabilityRow, error)
 	UpsertPlayerMatch(ctx context.Context, arg UpsertPlayerMatchParams) (UpsertPlayerMatchRow, error)
 	UpsertPlayerSeason(ctx context.Context, arg UpsertPlayerSeasonParams) (UpsertPlayerSeasonRow, error)
 }
@@ -62,3 +62,27 @@ func (q *Queries) FindRoundsBySeasonID(ctx context.Context, seasonID int32) ([]F
 	}
 	return items, nil
 }
+
+const insertRound = `-- name: InsertRound :one
+INSERT INTO afl.round (season_id, name)
+VALUES ($1, $2)
+RETURNING id, name, season_id
+`
+
+type InsertRoundParams struct {
+	SeasonID int32
+	Name     string
+}
+
+type InsertRoundRow struct {
+	ID       int32
+	Name     string
+	SeasonID int32
+}
+
+func (q *Queries) InsertRound(ctx context.Context, arg InsertRoundParams) (InsertRoundRow, error) {
+	row := q.db.QueryRow(ctx, insertRound, arg.SeasonID, arg.Name)
+	var i InsertRoundRow
+	err := row.Scan(&i.ID, &i.Name, &i.SeasonID)
+	return i, err
+}
@@ -60,3 +60,50 @@ func (q *Queries) FindSeasonByID(ctx context.Context, id int32) (FindSeasonByIDR
 	err := row.Scan(&i.ID, &i.Name, &i.LeagueID)
 	return i, err
 }
+
+const findSeasonByName = `-- name: FindSeasonByName :one
+SELECT id, name, league_id
+FROM afl.season
+WHERE name = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1
+`
+
+type FindSeasonByNameRow struct {
+	ID       int32
+	Name     string
+	LeagueID int32
+}
+
+func (q *Queries) FindSeasonByName(ctx context.Context, name string) (FindSeasonByNameRow, error) {
+	row := q.db.QueryRow(ctx, findSeasonByName, name)
+	var i FindSeasonByNameRow
+	err := row.Scan(&i.ID, &i.Name, &i.LeagueID)
+	return i, err
+}
+
+const insertSeason = `-- name: InsertSeason :one
+INSERT INTO afl.season (league_id, name)
+SELECT l.id, $1
+FROM afl.league l
+WHERE l.name = $2 AND l.deleted_at IS NULL
+RETURNING id, name, league_id
+`
+
+type InsertSeasonParams struct {
+	Name       string
+	LeagueName string
+}
+
+type InsertSeasonRow struct {
+	ID       int32
+	Name     string
+	LeagueID int32
+}
+
+func (q *Queries) InsertSeason(ctx context.Context, arg InsertSeasonParams) (InsertSeasonRow, error) {
+	row := q.db.QueryRow(ctx, insertSeason, arg.Name, arg.LeagueName)
+	var i InsertSeasonRow
+	err := row.Scan(&i.ID, &i.Name, &i.LeagueID)
+	return i, err
+}
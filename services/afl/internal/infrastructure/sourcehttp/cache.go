@@ -0,0 +1,92 @@
+package sourcehttp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cacheEntry is a cached response: its body and what's needed to tell how
+// old it is and to revalidate it.
+type cacheEntry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	FetchedAt    time.Time `json:"fetchedAt"`
+	Body         []byte    `json:"-"`
+}
+
+// diskCache keeps each response in dir as two files named by the hash of its
+// URL: the body, and its cacheEntry as JSON.
+type diskCache struct {
+	dir string
+}
+
+func (d *diskCache) paths(url string) (meta, body string) {
+	sum := sha256.Sum256([]byte(url))
+	key := hex.EncodeToString(sum[:])
+	return filepath.Join(d.dir, key+".json"), filepath.Join(d.dir, key+".body")
+}
+
+// load returns url's cached response, or nil if there isn't one.
+func (d *diskCache) load(url string) (*cacheEntry, error) {
+	metaPath, bodyPath := d.paths(url)
+	meta, err := os.ReadFile(metaPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(meta, &entry); err != nil {
+		return nil, err
+	}
+	if entry.URL != url {
+		return nil, nil
+	}
+	if entry.Body, err = os.ReadFile(bodyPath); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// store writes entry, replacing any cached response for its URL. Each file
+// is written to a temporary file and renamed into place, so a reader never
+// sees a half-written one.
+func (d *diskCache) store(entry cacheEntry) error {
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	metaPath, bodyPath := d.paths(entry.URL)
+	if err := writeFileAtomic(bodyPath, entry.Body); err != nil {
+		return err
+	}
+	return writeFileAtomic(metaPath, meta)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,255 @@
+// Package sourcehttp is the outbound HTTP layer for the adapters that fetch
+// AFL data from other sites. It keeps them polite and bounded: requests to
+// each host are spaced out, every attempt has a timeout, 5xx responses,
+// 429s and timeouts are retried with jittered backoff, and responses are
+// cached on disk by URL and revalidated with ETag / Last-Modified once stale.
+package sourcehttp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"xffl/shared/clock"
+)
+
+// Config configures a Client. Zero values turn a feature off; DefaultConfig
+// has the settings sources are fetched with.
+type Config struct {
+	// Timeout bounds each attempt, reading the body included. Zero means no
+	// timeout.
+	Timeout time.Duration
+	// MinInterval is the least time between the starts of two requests to the
+	// same host.
+	MinInterval time.Duration
+	// Retries is how many more times a request is tried after a 5xx, a 429
+	// or a timeout.
+	Retries int
+	// RetryBackoff is the wait before the first retry, doubled for each one
+	// after, plus up to as much again at random.
+	RetryBackoff time.Duration
+	// CacheDir is the directory responses are cached in; empty turns the
+	// cache off.
+	CacheDir string
+	// UserAgent is sent with every request.
+	UserAgent string
+	// Transport sends the requests; nil means http.DefaultTransport.
+	Transport http.RoundTripper
+	// Clock dates cache entries; nil means the real clock.
+	Clock clock.Clock
+}
+
+// DefaultConfig returns the settings data sources are fetched with: a request
+// a second per host, 30 seconds an attempt and three retries. The cache is
+// off until CacheDir is set.
+func DefaultConfig() Config {
+	return Config{
+		Timeout:      30 * time.Second,
+		MinInterval:  time.Second,
+		Retries:      3,
+		RetryBackoff: time.Second,
+		UserAgent:    "Mozilla/5.0 (compatible; xffl-stats-importer/1.0)",
+	}
+}
+
+// StatusError is returned for a response that isn't 200 OK (or 304 Not
+// Modified for a cached page) once retries are used up.
+type StatusError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d from %s", e.StatusCode, e.URL)
+}
+
+// Client fetches pages from data sources. It is safe for concurrent use.
+type Client struct {
+	cfg   Config
+	http  *http.Client
+	cache *diskCache
+	clock clock.Clock
+
+	mu   sync.Mutex
+	next map[string]time.Time // earliest start of the next request, by host
+}
+
+func New(cfg Config) *Client {
+	clk := cfg.Clock
+	if clk == nil {
+		clk = clock.RealClock{}
+	}
+	var cache *diskCache
+	if cfg.CacheDir != "" {
+		cache = &diskCache{dir: cfg.CacheDir}
+	}
+	return &Client{
+		cfg:   cfg,
+		http:  &http.Client{Transport: cfg.Transport},
+		cache: cache,
+		clock: clk,
+		next:  make(map[string]time.Time),
+	}
+}
+
+// Get returns the body of the page at rawURL. A cached copy younger than ttl
+// is returned without a request; an older one is revalidated, and returned
+// again if the source answers 304 Not Modified. A ttl of zero always
+// revalidates.
+func (c *Client) Get(ctx context.Context, rawURL string, ttl time.Duration) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var cached *cacheEntry
+	if c.cache != nil {
+		cached, err = c.cache.load(rawURL)
+		if err != nil {
+			slog.WarnContext(ctx, "unreadable cached response", slog.String("url", rawURL), slog.Any("error", err))
+		}
+		if cached != nil && ttl > 0 && c.clock.Now().Sub(cached.FetchedAt) < ttl {
+			return cached.Body, nil
+		}
+	}
+
+	status, header, body, err := c.fetchWithRetry(ctx, u, cached)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case status == http.StatusNotModified && cached != nil:
+		body = cached.Body
+	case status != http.StatusOK:
+		return nil, &StatusError{StatusCode: status, URL: rawURL}
+	}
+
+	if c.cache != nil {
+		entry := cacheEntry{
+			URL:          rawURL,
+			ETag:         header.Get("ETag"),
+			LastModified: header.Get("Last-Modified"),
+			FetchedAt:    c.clock.Now(),
+			Body:         body,
+		}
+		if status == http.StatusNotModified {
+			entry.ETag, entry.LastModified = cached.ETag, cached.LastModified
+		}
+		if err := c.cache.store(entry); err != nil {
+			slog.WarnContext(ctx, "failed to cache response", slog.String("url", rawURL), slog.Any("error", err))
+		}
+	}
+	return body, nil
+}
+
+// fetchWithRetry requests u until it gets an answer that isn't worth
+// retrying or runs out of retries, returning the last answer or error.
+func (c *Client) fetchWithRetry(ctx context.Context, u *url.URL, cached *cacheEntry) (int, http.Header, []byte, error) {
+	backoff := c.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		status, header, body, err := c.fetch(ctx, u, cached)
+		if !retryable(status, err) || attempt >= c.cfg.Retries || ctx.Err() != nil {
+			return status, header, body, err
+		}
+		wait := backoff + rand.N(backoff+1)
+		slog.WarnContext(ctx, "retrying source request",
+			slog.String("url", u.String()), slog.Int("status", status), slog.Any("error", err),
+			slog.Int("attempt", attempt+1), slog.Duration("wait", wait))
+		if err := sleep(ctx, wait); err != nil {
+			return 0, nil, nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// fetch makes one attempt at u, once the host's rate limit allows, with a
+// conditional request when there's a cached copy.
+func (c *Client) fetch(ctx context.Context, u *url.URL, cached *cacheEntry) (int, http.Header, []byte, error) {
+	if err := c.waitTurn(ctx, u.Host); err != nil {
+		return 0, nil, nil, err
+	}
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if c.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, resp.Header, nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.StatusCode, resp.Header, body, nil
+}
+
+// waitTurn blocks until a request to host may start, keeping requests to it
+// MinInterval apart.
+func (c *Client) waitTurn(ctx context.Context, host string) error {
+	if c.cfg.MinInterval <= 0 {
+		return nil
+	}
+	c.mu.Lock()
+	now := time.Now()
+	start := c.next[host]
+	if start.Before(now) {
+		start = now
+	}
+	c.next[host] = start.Add(c.cfg.MinInterval)
+	c.mu.Unlock()
+	return sleep(ctx, start.Sub(now))
+}
+
+// retryable reports whether an attempt's outcome is worth another try: a
+// server error, being told to slow down, or timing out.
+func retryable(status int, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+	}
+	return status >= 500 || status == http.StatusTooManyRequests
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+package sourcehttp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// movableClock is a clock tests move forward by hand.
+type movableClock struct{ t time.Time }
+
+func (c *movableClock) Now() time.Time { return c.t }
+
+func TestClient_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = io.WriteString(w, "page")
+	}))
+	defer srv.Close()
+
+	c := New(Config{Retries: 3, RetryBackoff: time.Millisecond})
+	body, err := c.Get(context.Background(), srv.URL, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "page", string(body))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_GivesUpAfterRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := New(Config{Retries: 2, RetryBackoff: time.Millisecond})
+	_, err := c.Get(context.Background(), srv.URL, 0)
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, int32(3), calls.Load(), "the first try and two retries")
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	c := New(Config{Retries: 3, RetryBackoff: time.Millisecond})
+	_, err := c.Get(context.Background(), srv.URL, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status 404")
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_TimesOutAndRetries(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	defer close(release)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		_, _ = io.WriteString(w, "page")
+	}))
+	defer srv.Close()
+
+	c := New(Config{Timeout: 50 * time.Millisecond, Retries: 1, RetryBackoff: time.Millisecond})
+	body, err := c.Get(context.Background(), srv.URL, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "page", string(body))
+	assert.Equal(t, int32(2), calls.Load())
+
+	c = New(Config{Timeout: 50 * time.Millisecond})
+	calls.Store(0)
+	_, err = c.Get(context.Background(), srv.URL, 0)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "a hung response times out: %v", err)
+}
+
+func TestClient_SpacesOutRequestsToAHost(t *testing.T) {
+	var starts []time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		starts = append(starts, time.Now())
+	}))
+	defer srv.Close()
+
+	c := New(Config{MinInterval: 50 * time.Millisecond})
+	for range 3 {
+		_, err := c.Get(context.Background(), srv.URL, 0)
+		require.NoError(t, err)
+	}
+	require.Len(t, starts, 3)
+	assert.GreaterOrEqual(t, starts[2].Sub(starts[0]), 100*time.Millisecond)
+}
+
+func TestClient_Cache(t *testing.T) {
+	var calls atomic.Int32
+	var conditional atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		conditional.Store(r.Header.Get("If-None-Match") + "|" + r.Header.Get("If-Modified-Since"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Sat, 14 Mar 2026 10:00:00 GMT")
+		_, _ = io.WriteString(w, "page "+r.URL.Query().Get("mid"))
+	}))
+	defer srv.Close()
+
+	clk := &movableClock{t: time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)}
+	dir := t.TempDir()
+	c := New(Config{CacheDir: dir, Clock: clk})
+	ctx := context.Background()
+
+	body, err := c.Get(ctx, srv.URL+"?mid=1", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "page 1", string(body))
+	assert.Equal(t, "|", conditional.Load(), "nothing cached to revalidate yet")
+
+	t.Run("a fresh copy is served without a request", func(t *testing.T) {
+		clk.t = clk.t.Add(59 * time.Minute)
+		body, err := c.Get(ctx, srv.URL+"?mid=1", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "page 1", string(body))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("a stale copy is revalidated", func(t *testing.T) {
+		clk.t = clk.t.Add(2 * time.Minute)
+		body, err := c.Get(ctx, srv.URL+"?mid=1", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "page 1", string(body))
+		assert.Equal(t, int32(2), calls.Load())
+		assert.Equal(t, `"v1"|Sat, 14 Mar 2026 10:00:00 GMT`, conditional.Load())
+
+		body, err = c.Get(ctx, srv.URL+"?mid=1", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "page 1", string(body))
+		assert.Equal(t, int32(2), calls.Load(), "revalidating made the copy fresh again")
+	})
+
+	t.Run("a zero ttl always revalidates", func(t *testing.T) {
+		_, err := c.Get(ctx, srv.URL+"?mid=1", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("pages are cached by URL and survive a new client", func(t *testing.T) {
+		c := New(Config{CacheDir: dir, Clock: clk})
+		body, err := c.Get(ctx, srv.URL+"?mid=2", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "page 2", string(body))
+		assert.Equal(t, int32(4), calls.Load())
+
+		body, err = c.Get(ctx, srv.URL+"?mid=1", time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "page 1", string(body))
+		assert.Equal(t, int32(4), calls.Load())
+	})
+}
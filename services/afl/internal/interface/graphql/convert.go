@@ -1,8 +1,11 @@
 package graphql
 
 import (
+	"maps"
+	"slices"
 	"strconv"
 
+	"xffl/services/afl/internal/application"
 	"xffl/services/afl/internal/domain"
 )
 
@@ -14,6 +17,18 @@ func fromID(id string) (int, error) {
 	return strconv.Atoi(id)
 }
 
+func fromIDs(ids []string) ([]int, error) {
+	out := make([]int, len(ids))
+	for i, id := range ids {
+		v, err := fromID(id)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
 func toStringPtr(s string) *string {
 	if s == "" {
 		return nil
@@ -21,6 +36,14 @@ func toStringPtr(s string) *string {
 	return &s
 }
 
+// derefString returns *s, or "" if s is nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func convertClub(c domain.Club) *AFLClub {
 	return &AFLClub{
 		ID:   toID(c.ID),
@@ -116,6 +139,41 @@ func convertClubMatch(cm domain.ClubMatch, club domain.Club) *AFLClubMatch {
 	}
 }
 
+func convertQuarterScore(q domain.QuarterScore) *AFLQuarterScore {
+	return &AFLQuarterScore{
+		Quarter: q.Quarter,
+		Goals:   q.Goals,
+		Behinds: q.Behinds,
+		Score:   q.Score(),
+	}
+}
+
+func convertQuarterScores(quarters []domain.QuarterScore) []*AFLQuarterScore {
+	out := make([]*AFLQuarterScore, len(quarters))
+	for i, q := range quarters {
+		out[i] = convertQuarterScore(q)
+	}
+	return out
+}
+
+func convertMatchTimeline(t domain.MatchTimeline) *AFLMatchTimeline {
+	breaks := make([]*AFLQuarterBreak, len(t.Breaks))
+	for i, b := range t.Breaks {
+		breaks[i] = &AFLQuarterBreak{
+			Quarter:       b.Quarter,
+			HomeScore:     convertQuarterScore(b.Home),
+			AwayScore:     convertQuarterScore(b.Away),
+			Margin:        b.Margin,
+			QuarterResult: string(b.QuarterResult()),
+		}
+	}
+	return &AFLMatchTimeline{
+		Breaks:          breaks,
+		ComebackWin:     t.ComebackWin(),
+		ComebackDeficit: t.ComebackDeficit,
+	}
+}
+
 func convertPlayerMatch(pm domain.PlayerMatch, player domain.Player) *AFLPlayerMatch {
 	return &AFLPlayerMatch{
 		ID:             toID(pm.ID),
@@ -132,7 +190,17 @@ func convertPlayerMatch(pm domain.PlayerMatch, player domain.Player) *AFLPlayerM
 		Behinds:        pm.Behinds,
 		Disposals:      pm.Disposals(),
 		Score:          pm.Score(),
+		ExtraStats:     convertExtraStats(pm.Extra),
+	}
+}
+
+// convertExtraStats lists extra stats by name.
+func convertExtraStats(extra domain.ExtraStats) []*AFLStat {
+	out := make([]*AFLStat, 0, len(extra))
+	for _, name := range slices.Sorted(maps.Keys(extra)) {
+		out = append(out, &AFLStat{Name: name, Value: extra[name]})
 	}
+	return out
 }
 
 func convertPlayer(p domain.Player) *AFLPlayer {
@@ -142,6 +210,14 @@ func convertPlayer(p domain.Player) *AFLPlayer {
 	}
 }
 
+func convertPlayerWithLatestSeason(p application.PlayerWithLatestSeason) *AFLPlayer {
+	out := convertPlayer(p.Player)
+	if p.HasLatestSeason {
+		out.LatestPlayerSeason = &AFLPlayerSeason{ID: toID(p.LatestSeasonID)}
+	}
+	return out
+}
+
 func convertPlayers(players []domain.Player) []*AFLPlayer {
 	out := make([]*AFLPlayer, len(players))
 	for i, p := range players {
@@ -149,3 +225,199 @@ func convertPlayers(players []domain.Player) []*AFLPlayer {
 	}
 	return out
 }
+
+func convertAvailability(a domain.PlayerAvailability) *AFLPlayerAvailability {
+	return &AFLPlayerAvailability{
+		ID:             toID(a.ID),
+		PlayerSeasonID: toID(a.PlayerSeasonID),
+		PlayerSeason:   &AFLPlayerSeason{ID: toID(a.PlayerSeasonID)},
+		RoundID:        toID(a.RoundID),
+		Status:         string(a.Status),
+		ExpectedReturn: a.ExpectedReturn,
+		Source:         a.Source,
+	}
+}
+
+func convertAvailabilities(entries []domain.PlayerAvailability) []*AFLPlayerAvailability {
+	out := make([]*AFLPlayerAvailability, len(entries))
+	for i, a := range entries {
+		out[i] = convertAvailability(a)
+	}
+	return out
+}
+
+func convertUnmatchedPlayers(players []application.UnmatchedAFLPlayer) []*UnmatchedAFLPlayer {
+	out := make([]*UnmatchedAFLPlayer, len(players))
+	for i, u := range players {
+		out[i] = &UnmatchedAFLPlayer{
+			ParsedName:  u.ParsedName,
+			ClubMatchID: toID(u.ClubMatchID),
+			Kicks:       u.Kicks,
+			Handballs:   u.Handballs,
+			Marks:       u.Marks,
+			Hitouts:     u.Hitouts,
+			Tackles:     u.Tackles,
+			Goals:       u.Goals,
+			Behinds:     u.Behinds,
+		}
+	}
+	return out
+}
+
+func convertImportResult(result application.ImportAFLStatsResult) *ImportAFLMatchStatsResult {
+	return &ImportAFLMatchStatsResult{
+		MatchID:          toID(result.MatchID),
+		Source:           result.Source,
+		HomeClubName:     result.HomeClubName,
+		AwayClubName:     result.AwayClubName,
+		HomePlayerCount:  result.HomePlayerCount,
+		AwayPlayerCount:  result.AwayPlayerCount,
+		UnmatchedPlayers: convertUnmatchedPlayers(result.UnmatchedPlayers),
+	}
+}
+
+func convertStatsPreview(p application.StatsPreview) *AFLStatsImportPreview {
+	out := &AFLStatsImportPreview{
+		ID:               toID(p.ID),
+		MatchID:          toID(p.MatchID),
+		RoundID:          toID(p.RoundID),
+		Source:           p.Source,
+		ClubMatches:      make([]*AFLClubMatchStatsPreview, len(p.Clubs)),
+		UnmatchedPlayers: convertUnmatchedPlayers(p.UnmatchedPlayers),
+	}
+	if p.AppliedAt != nil {
+		t := p.AppliedAt.UTC().Format("2006-01-02T15:04:05Z")
+		out.AppliedAt = &t
+	}
+	for i, club := range p.Clubs {
+		cm := &AFLClubMatchStatsPreview{
+			ClubMatchID:      toID(club.ClubMatchID),
+			ClubName:         club.ClubName,
+			OldScore:         club.OldScore,
+			NewScore:         club.NewScore,
+			OldRushedBehinds: club.OldRushedBehinds,
+			NewRushedBehinds: club.NewRushedBehinds,
+			Players:          make([]*AFLPlayerStatsDiff, len(club.Players)),
+		}
+		for j, d := range club.Players {
+			diff := &AFLPlayerStatsDiff{
+				PlayerSeasonID: toID(d.PlayerSeasonID),
+				PlayerName:     d.PlayerName,
+				Kind:           AFLPlayerStatsDiffKind(d.Kind),
+				Changes:        []*AFLStatChange{},
+				Kicks:          d.New.Kicks,
+				Handballs:      d.New.Handballs,
+				Marks:          d.New.Marks,
+				Hitouts:        d.New.Hitouts,
+				Tackles:        d.New.Tackles,
+				Goals:          d.New.Goals,
+				Behinds:        d.New.Behinds,
+			}
+			for _, c := range d.Changes() {
+				diff.Changes = append(diff.Changes, &AFLStatChange{Stat: c.Stat, Old: c.Old, New: c.New})
+			}
+			cm.Players[j] = diff
+		}
+		out.ClubMatches[i] = cm
+	}
+	return out
+}
+
+func convertDiscrepancies(discrepancies []application.StatsDiscrepancy) []*AFLStatsDiscrepancy {
+	out := make([]*AFLStatsDiscrepancy, len(discrepancies))
+	for i, d := range discrepancies {
+		out[i] = &AFLStatsDiscrepancy{
+			ClubMatchID: toID(d.ClubMatchID),
+			Kind:        AFLStatsDiscrepancyKind(d.Kind),
+			PlayerName:  toStringPtr(d.PlayerName),
+			Stat:        toStringPtr(d.Stat),
+			Source:      d.Source,
+			Value:       d.Value,
+			OtherSource: d.OtherSource,
+			OtherValue:  d.OtherValue,
+		}
+		if d.PlayerSeasonID != 0 {
+			out[i].PlayerSeasonID = toStringPtr(toID(d.PlayerSeasonID))
+		}
+	}
+	return out
+}
+
+func convertSeasonSetup(result application.SetupAFLSeasonResult) *AFLSeasonSetup {
+	out := &AFLSeasonSetup{
+		SeasonID:           toID(result.SeasonID),
+		SeasonName:         result.SeasonName,
+		SeasonCreated:      result.SeasonCreated,
+		RoundsCreated:      append([]string{}, result.RoundsCreated...),
+		ClubSeasonsCreated: append([]string{}, result.ClubSeasonsCreated...),
+		Matches:            make([]*AFLSeasonSetupMatch, len(result.Matches)),
+	}
+	for i, m := range result.Matches {
+		match := &AFLSeasonSetupMatch{
+			MatchID:      toID(m.MatchID),
+			RoundName:    m.RoundName,
+			HomeClubName: m.HomeClubName,
+			AwayClubName: m.AwayClubName,
+			Venue:        toStringPtr(m.Venue),
+			Status:       AFLSeasonSetupMatchStatus(m.Status),
+			Changes:      append([]string{}, m.Changes...),
+		}
+		if !m.StartTime.IsZero() {
+			t := m.StartTime.UTC().Format("2006-01-02T15:04:05Z")
+			match.StartTime = &t
+		}
+		out.Matches[i] = match
+	}
+	return out
+}
+
+func convertPlayerProposals(proposals []application.PlayerProposal) []*AFLPlayerProposal {
+	out := make([]*AFLPlayerProposal, len(proposals))
+	for i, p := range proposals {
+		proposal := &AFLPlayerProposal{
+			ID:               toID(p.ID),
+			SeasonID:         toID(p.SeasonID),
+			Source:           toStringPtr(p.Source),
+			Kind:             AFLPlayerProposalKind(p.Kind),
+			Status:           AFLPlayerProposalStatus(p.Status),
+			ListedName:       toStringPtr(p.ListedName),
+			ClubSeasonID:     toID(p.ClubSeasonID),
+			ClubName:         p.ClubName,
+			PlayerName:       toStringPtr(p.PlayerName),
+			PreviousClubName: toStringPtr(p.PreviousClubName),
+			Confidence:       p.Confidence,
+			CreatedAt:        p.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+		if p.PlayerID != 0 {
+			proposal.PlayerID = toStringPtr(toID(p.PlayerID))
+		}
+		if p.PlayerSeasonID != 0 {
+			proposal.PlayerSeasonID = toStringPtr(toID(p.PlayerSeasonID))
+		}
+		if p.DecidedAt != nil {
+			t := p.DecidedAt.UTC().Format("2006-01-02T15:04:05Z")
+			proposal.DecidedAt = &t
+		}
+		out[i] = proposal
+	}
+	return out
+}
+
+func convertPlayerMerge(res application.MergeAFLPlayersResult) *AFLPlayerMerge {
+	out := &AFLPlayerMerge{
+		Survivor:             convertPlayer(res.Survivor),
+		DuplicateID:          toID(res.DuplicateID),
+		MovedPlayerSeasonIds: make([]string, len(res.MovedPlayerSeasonIDs)),
+		MergedPlayerSeasons:  make([]*AFLMergedPlayerSeason, 0, len(res.MergedPlayerSeasonIDs)),
+	}
+	for i, id := range res.MovedPlayerSeasonIDs {
+		out.MovedPlayerSeasonIds[i] = toID(id)
+	}
+	for _, duplicate := range slices.Sorted(maps.Keys(res.MergedPlayerSeasonIDs)) {
+		out.MergedPlayerSeasons = append(out.MergedPlayerSeasons, &AFLMergedPlayerSeason{
+			DuplicateID: toID(duplicate),
+			SurvivorID:  toID(res.MergedPlayerSeasonIDs[duplicate]),
+		})
+	}
+	return out
+}
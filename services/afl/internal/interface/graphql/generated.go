@@ -55,10 +55,21 @@ type ComplexityRoot struct {
 		Match         func(childComplexity int) int
 		MatchID       func(childComplexity int) int
 		PlayerMatches func(childComplexity int) int
+		QuarterScores func(childComplexity int) int
 		RushedBehinds func(childComplexity int) int
 		Score         func(childComplexity int) int
 	}
 
+	AFLClubMatchStatsPreview struct {
+		ClubMatchID      func(childComplexity int) int
+		ClubName         func(childComplexity int) int
+		NewRushedBehinds func(childComplexity int) int
+		NewScore         func(childComplexity int) int
+		OldRushedBehinds func(childComplexity int) int
+		OldScore         func(childComplexity int) int
+		Players          func(childComplexity int) int
+	}
+
 	AFLClubSeason struct {
 		Against           func(childComplexity int) int
 		Club              func(childComplexity int) int
@@ -78,14 +89,27 @@ type ComplexityRoot struct {
 	}
 
 	AFLMatch struct {
-		AwayClubMatch func(childComplexity int) int
-		DataStatus    func(childComplexity int) int
-		HomeClubMatch func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Result        func(childComplexity int) int
-		Round         func(childComplexity int) int
-		StartTime     func(childComplexity int) int
-		Venue         func(childComplexity int) int
+		AwayClubMatch      func(childComplexity int) int
+		DataStatus         func(childComplexity int) int
+		HomeClubMatch      func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		Result             func(childComplexity int) int
+		Round              func(childComplexity int) int
+		StartTime          func(childComplexity int) int
+		StatsDiscrepancies func(childComplexity int) int
+		Timeline           func(childComplexity int) int
+		Venue              func(childComplexity int) int
+	}
+
+	AFLMatchTimeline struct {
+		Breaks          func(childComplexity int) int
+		ComebackDeficit func(childComplexity int) int
+		ComebackWin     func(childComplexity int) int
+	}
+
+	AFLMergedPlayerSeason struct {
+		DuplicateID func(childComplexity int) int
+		SurvivorID  func(childComplexity int) int
 	}
 
 	AFLPlayer struct {
@@ -94,11 +118,37 @@ type ComplexityRoot struct {
 		Name               func(childComplexity int) int
 	}
 
+	AFLPlayerAvailability struct {
+		ExpectedReturn func(childComplexity int) int
+		ID             func(childComplexity int) int
+		PlayerSeason   func(childComplexity int) int
+		PlayerSeasonID func(childComplexity int) int
+		RoundID        func(childComplexity int) int
+		Source         func(childComplexity int) int
+		Status         func(childComplexity int) int
+	}
+
+	AFLPlayerDuplicate struct {
+		Confidence func(childComplexity int) int
+		Other      func(childComplexity int) int
+		Player     func(childComplexity int) int
+	}
+
+	AFLPlayerImport struct {
+		DecidedCount  func(childComplexity int) int
+		InSeasonCount func(childComplexity int) int
+		ListedCount   func(childComplexity int) int
+		Proposals     func(childComplexity int) int
+		SeasonID      func(childComplexity int) int
+		SeasonName    func(childComplexity int) int
+	}
+
 	AFLPlayerMatch struct {
 		Behinds        func(childComplexity int) int
 		ClubMatch      func(childComplexity int) int
 		ClubMatchID    func(childComplexity int) int
 		Disposals      func(childComplexity int) int
+		ExtraStats     func(childComplexity int) int
 		Goals          func(childComplexity int) int
 		Handballs      func(childComplexity int) int
 		Hitouts        func(childComplexity int) int
@@ -112,6 +162,31 @@ type ComplexityRoot struct {
 		Tackles        func(childComplexity int) int
 	}
 
+	AFLPlayerMerge struct {
+		DuplicateID          func(childComplexity int) int
+		MergedPlayerSeasons  func(childComplexity int) int
+		MovedPlayerSeasonIds func(childComplexity int) int
+		Survivor             func(childComplexity int) int
+	}
+
+	AFLPlayerProposal struct {
+		ClubName         func(childComplexity int) int
+		ClubSeasonID     func(childComplexity int) int
+		Confidence       func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		DecidedAt        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Kind             func(childComplexity int) int
+		ListedName       func(childComplexity int) int
+		PlayerID         func(childComplexity int) int
+		PlayerName       func(childComplexity int) int
+		PlayerSeasonID   func(childComplexity int) int
+		PreviousClubName func(childComplexity int) int
+		SeasonID         func(childComplexity int) int
+		Source           func(childComplexity int) int
+		Status           func(childComplexity int) int
+	}
+
 	AFLPlayerSeason struct {
 		ClubSeason func(childComplexity int) int
 		ID         func(childComplexity int) int
@@ -124,19 +199,113 @@ type ComplexityRoot struct {
 		PageInfo func(childComplexity int) int
 	}
 
+	AFLPlayerStatsDiff struct {
+		Behinds        func(childComplexity int) int
+		Changes        func(childComplexity int) int
+		Goals          func(childComplexity int) int
+		Handballs      func(childComplexity int) int
+		Hitouts        func(childComplexity int) int
+		Kicks          func(childComplexity int) int
+		Kind           func(childComplexity int) int
+		Marks          func(childComplexity int) int
+		PlayerName     func(childComplexity int) int
+		PlayerSeasonID func(childComplexity int) int
+		Tackles        func(childComplexity int) int
+	}
+
+	AFLQuarterBreak struct {
+		AwayScore     func(childComplexity int) int
+		HomeScore     func(childComplexity int) int
+		Margin        func(childComplexity int) int
+		Quarter       func(childComplexity int) int
+		QuarterResult func(childComplexity int) int
+	}
+
+	AFLQuarterScore struct {
+		Behinds func(childComplexity int) int
+		Goals   func(childComplexity int) int
+		Quarter func(childComplexity int) int
+		Score   func(childComplexity int) int
+	}
+
 	AFLRound struct {
-		ID      func(childComplexity int) int
-		Matches func(childComplexity int) int
-		Name    func(childComplexity int) int
-		Season  func(childComplexity int) int
+		Availability func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Matches      func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Season       func(childComplexity int) int
+	}
+
+	AFLRoundMatchImport struct {
+		AwayClubName func(childComplexity int) int
+		Error        func(childComplexity int) int
+		HomeClubName func(childComplexity int) int
+		MarkedFinal  func(childComplexity int) int
+		MatchID      func(childComplexity int) int
+		Stats        func(childComplexity int) int
+		Status       func(childComplexity int) int
 	}
 
 	AFLSeason struct {
-		ID            func(childComplexity int) int
-		Ladder        func(childComplexity int) int
-		Name          func(childComplexity int) int
-		PlayerSeasons func(childComplexity int, filter *AFLPlayerSeasonFilter, first *int, after *string) int
-		Rounds        func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Ladder          func(childComplexity int) int
+		Name            func(childComplexity int) int
+		PlayerProposals func(childComplexity int, status *AFLPlayerProposalStatus) int
+		PlayerSeasons   func(childComplexity int, filter *AFLPlayerSeasonFilter, first *int, after *string) int
+		Rounds          func(childComplexity int) int
+	}
+
+	AFLSeasonSetup struct {
+		ClubSeasonsCreated func(childComplexity int) int
+		Matches            func(childComplexity int) int
+		RoundsCreated      func(childComplexity int) int
+		SeasonCreated      func(childComplexity int) int
+		SeasonID           func(childComplexity int) int
+		SeasonName         func(childComplexity int) int
+	}
+
+	AFLSeasonSetupMatch struct {
+		AwayClubName func(childComplexity int) int
+		Changes      func(childComplexity int) int
+		HomeClubName func(childComplexity int) int
+		MatchID      func(childComplexity int) int
+		RoundName    func(childComplexity int) int
+		StartTime    func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Venue        func(childComplexity int) int
+	}
+
+	AFLStat struct {
+		Name  func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	AFLStatChange struct {
+		New  func(childComplexity int) int
+		Old  func(childComplexity int) int
+		Stat func(childComplexity int) int
+	}
+
+	AFLStatsDiscrepancy struct {
+		ClubMatchID    func(childComplexity int) int
+		Kind           func(childComplexity int) int
+		OtherSource    func(childComplexity int) int
+		OtherValue     func(childComplexity int) int
+		PlayerName     func(childComplexity int) int
+		PlayerSeasonID func(childComplexity int) int
+		Source         func(childComplexity int) int
+		Stat           func(childComplexity int) int
+		Value          func(childComplexity int) int
+	}
+
+	AFLStatsImportPreview struct {
+		AppliedAt        func(childComplexity int) int
+		ClubMatches      func(childComplexity int) int
+		ID               func(childComplexity int) int
+		MatchID          func(childComplexity int) int
+		RoundID          func(childComplexity int) int
+		Source           func(childComplexity int) int
+		UnmatchedPlayers func(childComplexity int) int
 	}
 
 	Entity struct {
@@ -153,17 +322,33 @@ type ComplexityRoot struct {
 		HomeClubName     func(childComplexity int) int
 		HomePlayerCount  func(childComplexity int) int
 		MatchID          func(childComplexity int) int
+		Source           func(childComplexity int) int
 		UnmatchedPlayers func(childComplexity int) int
 	}
 
+	ImportAFLRoundStatsResult struct {
+		Matches func(childComplexity int) int
+		RoundID func(childComplexity int) int
+	}
+
 	Mutation struct {
-		AddAFLPlayer              func(childComplexity int, input AddAFLPlayerInput) int
-		AddAFLPlayerSeason        func(childComplexity int, input AddAFLPlayerSeasonInput) int
-		ImportAFLMatchStats       func(childComplexity int, matchID string) int
-		MarkAFLMatchStatsComplete func(childComplexity int, matchID string, complete bool) int
-		RecalculateAFLLadder      func(childComplexity int, seasonID string) int
-		ResolveAFLPlayerMatch     func(childComplexity int, input ResolveAFLPlayerMatchInput) int
-		UpdateAFLPlayerMatch      func(childComplexity int, input UpdateAFLPlayerMatchInput) int
+		AcceptAFLPlayerProposals   func(childComplexity int, proposalIds []string) int
+		AddAFLPlayer               func(childComplexity int, input AddAFLPlayerInput) int
+		AddAFLPlayerSeason         func(childComplexity int, input AddAFLPlayerSeasonInput) int
+		ApplyAFLStatsImportPreview func(childComplexity int, previewID string) int
+		ImportAFLMatchStats        func(childComplexity int, matchID string, source *string) int
+		ImportAFLRoundStats        func(childComplexity int, roundID string, markFinal *bool, source *string) int
+		MarkAFLMatchStatsComplete  func(childComplexity int, matchID string, complete bool) int
+		MergeAFLPlayers            func(childComplexity int, survivorID string, duplicateID string) int
+		PreviewAFLMatchStatsImport func(childComplexity int, matchID string, source *string) int
+		ProposeAFLPlayerImport     func(childComplexity int, year int, source *string, players []*AFLListedPlayerInput) int
+		RecalculateAFLLadder       func(childComplexity int, seasonID string) int
+		RejectAFLPlayerProposals   func(childComplexity int, proposalIds []string) int
+		ResolveAFLPlayerMatch      func(childComplexity int, input ResolveAFLPlayerMatchInput) int
+		SetAFLPlayerAvailability   func(childComplexity int, input SetAFLPlayerAvailabilityInput) int
+		SetupAFLSeason             func(childComplexity int, year int, source *string) int
+		UpdateAFLPlayerMatch       func(childComplexity int, input UpdateAFLPlayerMatchInput) int
+		VerifyAFLMatchStats        func(childComplexity int, matchID string, threshold *int) int
 	}
 
 	PageInfo struct {
@@ -173,17 +358,18 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		AflClub            func(childComplexity int, id string) int
-		AflClubs           func(childComplexity int) int
-		AflLiveRound       func(childComplexity int) int
-		AflMatch           func(childComplexity int, id string) int
-		AflPlayerSearch    func(childComplexity int, query string) int
-		AflPlayerSeason    func(childComplexity int, id string) int
-		AflRound           func(childComplexity int, id string) int
-		AflSeason          func(childComplexity int, id string) int
-		AflSeasons         func(childComplexity int) int
-		__resolve__service func(childComplexity int) int
-		__resolve_entities func(childComplexity int, representations []map[string]any) int
+		AflClub             func(childComplexity int, id string) int
+		AflClubs            func(childComplexity int) int
+		AflLiveRound        func(childComplexity int) int
+		AflMatch            func(childComplexity int, id string) int
+		AflPlayerDuplicates func(childComplexity int, minConfidence *float64) int
+		AflPlayerSearch     func(childComplexity int, query string) int
+		AflPlayerSeason     func(childComplexity int, id string) int
+		AflRound            func(childComplexity int, id string) int
+		AflSeason           func(childComplexity int, id string) int
+		AflSeasons          func(childComplexity int) int
+		__resolve__service  func(childComplexity int) int
+		__resolve_entities  func(childComplexity int, representations []map[string]any) int
 	}
 
 	UnmatchedAFLPlayer struct {
@@ -198,6 +384,12 @@ type ComplexityRoot struct {
 		Tackles     func(childComplexity int) int
 	}
 
+	VerifyAFLMatchStatsResult struct {
+		Discrepancies func(childComplexity int) int
+		MatchID       func(childComplexity int) int
+		Sources       func(childComplexity int) int
+	}
+
 	_Service struct {
 		SDL func(childComplexity int) int
 	}
@@ -205,6 +397,7 @@ type ComplexityRoot struct {
 
 type AFLClubMatchResolver interface {
 	PlayerMatches(ctx context.Context, obj *AFLClubMatch) ([]*AFLPlayerMatch, error)
+	QuarterScores(ctx context.Context, obj *AFLClubMatch) ([]*AFLQuarterScore, error)
 
 	Match(ctx context.Context, obj *AFLClubMatch) (*AFLMatch, error)
 }
@@ -215,6 +408,8 @@ type AFLMatchResolver interface {
 	Round(ctx context.Context, obj *AFLMatch) (*AFLRound, error)
 	HomeClubMatch(ctx context.Context, obj *AFLMatch) (*AFLClubMatch, error)
 	AwayClubMatch(ctx context.Context, obj *AFLMatch) (*AFLClubMatch, error)
+	StatsDiscrepancies(ctx context.Context, obj *AFLMatch) ([]*AFLStatsDiscrepancy, error)
+	Timeline(ctx context.Context, obj *AFLMatch) (*AFLMatchTimeline, error)
 }
 type AFLPlayerMatchResolver interface {
 	ClubMatch(ctx context.Context, obj *AFLPlayerMatch) (*AFLClubMatch, error)
@@ -227,11 +422,13 @@ type AFLPlayerSeasonResolver interface {
 type AFLRoundResolver interface {
 	Season(ctx context.Context, obj *AFLRound) (*AFLSeason, error)
 	Matches(ctx context.Context, obj *AFLRound) ([]*AFLMatch, error)
+	Availability(ctx context.Context, obj *AFLRound) ([]*AFLPlayerAvailability, error)
 }
 type AFLSeasonResolver interface {
 	Ladder(ctx context.Context, obj *AFLSeason) ([]*AFLClubSeason, error)
 	Rounds(ctx context.Context, obj *AFLSeason) ([]*AFLRound, error)
 	PlayerSeasons(ctx context.Context, obj *AFLSeason, filter *AFLPlayerSeasonFilter, first *int, after *string) (*AFLPlayerSeasonConnection, error)
+	PlayerProposals(ctx context.Context, obj *AFLSeason, status *AFLPlayerProposalStatus) ([]*AFLPlayerProposal, error)
 }
 type EntityResolver interface {
 	FindAFLPlayerByID(ctx context.Context, id string) (*AFLPlayer, error)
@@ -244,10 +441,20 @@ type MutationResolver interface {
 	AddAFLPlayer(ctx context.Context, input AddAFLPlayerInput) (*AFLPlayerSeason, error)
 	AddAFLPlayerSeason(ctx context.Context, input AddAFLPlayerSeasonInput) (*AFLPlayerSeason, error)
 	UpdateAFLPlayerMatch(ctx context.Context, input UpdateAFLPlayerMatchInput) (*AFLPlayerMatch, error)
-	ImportAFLMatchStats(ctx context.Context, matchID string) (*ImportAFLMatchStatsResult, error)
+	ImportAFLMatchStats(ctx context.Context, matchID string, source *string) (*ImportAFLMatchStatsResult, error)
+	ImportAFLRoundStats(ctx context.Context, roundID string, markFinal *bool, source *string) (*ImportAFLRoundStatsResult, error)
+	VerifyAFLMatchStats(ctx context.Context, matchID string, threshold *int) (*VerifyAFLMatchStatsResult, error)
+	PreviewAFLMatchStatsImport(ctx context.Context, matchID string, source *string) (*AFLStatsImportPreview, error)
+	ApplyAFLStatsImportPreview(ctx context.Context, previewID string) (*AFLStatsImportPreview, error)
+	SetupAFLSeason(ctx context.Context, year int, source *string) (*AFLSeasonSetup, error)
+	ProposeAFLPlayerImport(ctx context.Context, year int, source *string, players []*AFLListedPlayerInput) (*AFLPlayerImport, error)
+	AcceptAFLPlayerProposals(ctx context.Context, proposalIds []string) ([]*AFLPlayerProposal, error)
+	RejectAFLPlayerProposals(ctx context.Context, proposalIds []string) ([]*AFLPlayerProposal, error)
+	MergeAFLPlayers(ctx context.Context, survivorID string, duplicateID string) (*AFLPlayerMerge, error)
 	ResolveAFLPlayerMatch(ctx context.Context, input ResolveAFLPlayerMatchInput) (*AFLPlayerMatch, error)
 	MarkAFLMatchStatsComplete(ctx context.Context, matchID string, complete bool) (*AFLMatch, error)
 	RecalculateAFLLadder(ctx context.Context, seasonID string) (bool, error)
+	SetAFLPlayerAvailability(ctx context.Context, input SetAFLPlayerAvailabilityInput) (*AFLPlayerAvailability, error)
 }
 type QueryResolver interface {
 	AflSeasons(ctx context.Context) ([]*AFLSeason, error)
@@ -259,6 +466,7 @@ type QueryResolver interface {
 	AflPlayerSeason(ctx context.Context, id string) (*AFLPlayerSeason, error)
 	AflLiveRound(ctx context.Context) (*AFLLiveRound, error)
 	AflPlayerSearch(ctx context.Context, query string) ([]*AFLPlayer, error)
+	AflPlayerDuplicates(ctx context.Context, minConfidence *float64) ([]*AFLPlayerDuplicate, error)
 }
 
 type executableSchema graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot]
@@ -324,6 +532,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.AFLClubMatch.PlayerMatches(childComplexity), true
+	case "AFLClubMatch.quarterScores":
+		if e.ComplexityRoot.AFLClubMatch.QuarterScores == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatch.QuarterScores(childComplexity), true
 	case "AFLClubMatch.rushedBehinds":
 		if e.ComplexityRoot.AFLClubMatch.RushedBehinds == nil {
 			break
@@ -337,6 +551,49 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AFLClubMatch.Score(childComplexity), true
 
+	case "AFLClubMatchStatsPreview.clubMatchId":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.ClubMatchID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.ClubMatchID(childComplexity), true
+	case "AFLClubMatchStatsPreview.clubName":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.ClubName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.ClubName(childComplexity), true
+	case "AFLClubMatchStatsPreview.newRushedBehinds":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.NewRushedBehinds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.NewRushedBehinds(childComplexity), true
+	case "AFLClubMatchStatsPreview.newScore":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.NewScore == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.NewScore(childComplexity), true
+	case "AFLClubMatchStatsPreview.oldRushedBehinds":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.OldRushedBehinds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.OldRushedBehinds(childComplexity), true
+	case "AFLClubMatchStatsPreview.oldScore":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.OldScore == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.OldScore(childComplexity), true
+	case "AFLClubMatchStatsPreview.players":
+		if e.ComplexityRoot.AFLClubMatchStatsPreview.Players == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLClubMatchStatsPreview.Players(childComplexity), true
+
 	case "AFLClubSeason.against":
 		if e.ComplexityRoot.AFLClubSeason.Against == nil {
 			break
@@ -453,6 +710,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.AFLMatch.StartTime(childComplexity), true
+	case "AFLMatch.statsDiscrepancies":
+		if e.ComplexityRoot.AFLMatch.StatsDiscrepancies == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMatch.StatsDiscrepancies(childComplexity), true
+	case "AFLMatch.timeline":
+		if e.ComplexityRoot.AFLMatch.Timeline == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMatch.Timeline(childComplexity), true
 	case "AFLMatch.venue":
 		if e.ComplexityRoot.AFLMatch.Venue == nil {
 			break
@@ -460,6 +729,38 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AFLMatch.Venue(childComplexity), true
 
+	case "AFLMatchTimeline.breaks":
+		if e.ComplexityRoot.AFLMatchTimeline.Breaks == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMatchTimeline.Breaks(childComplexity), true
+	case "AFLMatchTimeline.comebackDeficit":
+		if e.ComplexityRoot.AFLMatchTimeline.ComebackDeficit == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMatchTimeline.ComebackDeficit(childComplexity), true
+	case "AFLMatchTimeline.comebackWin":
+		if e.ComplexityRoot.AFLMatchTimeline.ComebackWin == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMatchTimeline.ComebackWin(childComplexity), true
+
+	case "AFLMergedPlayerSeason.duplicateId":
+		if e.ComplexityRoot.AFLMergedPlayerSeason.DuplicateID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMergedPlayerSeason.DuplicateID(childComplexity), true
+	case "AFLMergedPlayerSeason.survivorId":
+		if e.ComplexityRoot.AFLMergedPlayerSeason.SurvivorID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLMergedPlayerSeason.SurvivorID(childComplexity), true
+
 	case "AFLPlayer.id":
 		if e.ComplexityRoot.AFLPlayer.ID == nil {
 			break
@@ -479,6 +780,105 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AFLPlayer.Name(childComplexity), true
 
+	case "AFLPlayerAvailability.expectedReturn":
+		if e.ComplexityRoot.AFLPlayerAvailability.ExpectedReturn == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.ExpectedReturn(childComplexity), true
+	case "AFLPlayerAvailability.id":
+		if e.ComplexityRoot.AFLPlayerAvailability.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.ID(childComplexity), true
+	case "AFLPlayerAvailability.playerSeason":
+		if e.ComplexityRoot.AFLPlayerAvailability.PlayerSeason == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.PlayerSeason(childComplexity), true
+	case "AFLPlayerAvailability.playerSeasonId":
+		if e.ComplexityRoot.AFLPlayerAvailability.PlayerSeasonID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.PlayerSeasonID(childComplexity), true
+	case "AFLPlayerAvailability.roundId":
+		if e.ComplexityRoot.AFLPlayerAvailability.RoundID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.RoundID(childComplexity), true
+	case "AFLPlayerAvailability.source":
+		if e.ComplexityRoot.AFLPlayerAvailability.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.Source(childComplexity), true
+	case "AFLPlayerAvailability.status":
+		if e.ComplexityRoot.AFLPlayerAvailability.Status == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerAvailability.Status(childComplexity), true
+
+	case "AFLPlayerDuplicate.confidence":
+		if e.ComplexityRoot.AFLPlayerDuplicate.Confidence == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerDuplicate.Confidence(childComplexity), true
+	case "AFLPlayerDuplicate.other":
+		if e.ComplexityRoot.AFLPlayerDuplicate.Other == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerDuplicate.Other(childComplexity), true
+	case "AFLPlayerDuplicate.player":
+		if e.ComplexityRoot.AFLPlayerDuplicate.Player == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerDuplicate.Player(childComplexity), true
+
+	case "AFLPlayerImport.decidedCount":
+		if e.ComplexityRoot.AFLPlayerImport.DecidedCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.DecidedCount(childComplexity), true
+	case "AFLPlayerImport.inSeasonCount":
+		if e.ComplexityRoot.AFLPlayerImport.InSeasonCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.InSeasonCount(childComplexity), true
+	case "AFLPlayerImport.listedCount":
+		if e.ComplexityRoot.AFLPlayerImport.ListedCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.ListedCount(childComplexity), true
+	case "AFLPlayerImport.proposals":
+		if e.ComplexityRoot.AFLPlayerImport.Proposals == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.Proposals(childComplexity), true
+	case "AFLPlayerImport.seasonId":
+		if e.ComplexityRoot.AFLPlayerImport.SeasonID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.SeasonID(childComplexity), true
+	case "AFLPlayerImport.seasonName":
+		if e.ComplexityRoot.AFLPlayerImport.SeasonName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerImport.SeasonName(childComplexity), true
+
 	case "AFLPlayerMatch.behinds":
 		if e.ComplexityRoot.AFLPlayerMatch.Behinds == nil {
 			break
@@ -503,6 +903,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.AFLPlayerMatch.Disposals(childComplexity), true
+	case "AFLPlayerMatch.extraStats":
+		if e.ComplexityRoot.AFLPlayerMatch.ExtraStats == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerMatch.ExtraStats(childComplexity), true
 	case "AFLPlayerMatch.goals":
 		if e.ComplexityRoot.AFLPlayerMatch.Goals == nil {
 			break
@@ -570,6 +976,122 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AFLPlayerMatch.Tackles(childComplexity), true
 
+	case "AFLPlayerMerge.duplicateId":
+		if e.ComplexityRoot.AFLPlayerMerge.DuplicateID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerMerge.DuplicateID(childComplexity), true
+	case "AFLPlayerMerge.mergedPlayerSeasons":
+		if e.ComplexityRoot.AFLPlayerMerge.MergedPlayerSeasons == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerMerge.MergedPlayerSeasons(childComplexity), true
+	case "AFLPlayerMerge.movedPlayerSeasonIds":
+		if e.ComplexityRoot.AFLPlayerMerge.MovedPlayerSeasonIds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerMerge.MovedPlayerSeasonIds(childComplexity), true
+	case "AFLPlayerMerge.survivor":
+		if e.ComplexityRoot.AFLPlayerMerge.Survivor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerMerge.Survivor(childComplexity), true
+
+	case "AFLPlayerProposal.clubName":
+		if e.ComplexityRoot.AFLPlayerProposal.ClubName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.ClubName(childComplexity), true
+	case "AFLPlayerProposal.clubSeasonId":
+		if e.ComplexityRoot.AFLPlayerProposal.ClubSeasonID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.ClubSeasonID(childComplexity), true
+	case "AFLPlayerProposal.confidence":
+		if e.ComplexityRoot.AFLPlayerProposal.Confidence == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.Confidence(childComplexity), true
+	case "AFLPlayerProposal.createdAt":
+		if e.ComplexityRoot.AFLPlayerProposal.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.CreatedAt(childComplexity), true
+	case "AFLPlayerProposal.decidedAt":
+		if e.ComplexityRoot.AFLPlayerProposal.DecidedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.DecidedAt(childComplexity), true
+	case "AFLPlayerProposal.id":
+		if e.ComplexityRoot.AFLPlayerProposal.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.ID(childComplexity), true
+	case "AFLPlayerProposal.kind":
+		if e.ComplexityRoot.AFLPlayerProposal.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.Kind(childComplexity), true
+	case "AFLPlayerProposal.listedName":
+		if e.ComplexityRoot.AFLPlayerProposal.ListedName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.ListedName(childComplexity), true
+	case "AFLPlayerProposal.playerId":
+		if e.ComplexityRoot.AFLPlayerProposal.PlayerID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.PlayerID(childComplexity), true
+	case "AFLPlayerProposal.playerName":
+		if e.ComplexityRoot.AFLPlayerProposal.PlayerName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.PlayerName(childComplexity), true
+	case "AFLPlayerProposal.playerSeasonId":
+		if e.ComplexityRoot.AFLPlayerProposal.PlayerSeasonID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.PlayerSeasonID(childComplexity), true
+	case "AFLPlayerProposal.previousClubName":
+		if e.ComplexityRoot.AFLPlayerProposal.PreviousClubName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.PreviousClubName(childComplexity), true
+	case "AFLPlayerProposal.seasonId":
+		if e.ComplexityRoot.AFLPlayerProposal.SeasonID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.SeasonID(childComplexity), true
+	case "AFLPlayerProposal.source":
+		if e.ComplexityRoot.AFLPlayerProposal.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.Source(childComplexity), true
+	case "AFLPlayerProposal.status":
+		if e.ComplexityRoot.AFLPlayerProposal.Status == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AFLPlayerProposal.Status(childComplexity), true
+
 	case "AFLPlayerSeason.clubSeason":
 		if e.ComplexityRoot.AFLPlayerSeason.ClubSeason == nil {
 			break
@@ -608,2646 +1130,2209 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AFLPlayerSeasonConnection.PageInfo(childComplexity), true
 
-	case "AFLRound.id":
-		if e.ComplexityRoot.AFLRound.ID == nil {
+	case "AFLPlayerStatsDiff.behinds":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Behinds == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLRound.ID(childComplexity), true
-	case "AFLRound.matches":
-		if e.ComplexityRoot.AFLRound.Matches == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Behinds(childComplexity), true
+	case "AFLPlayerStatsDiff.changes":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Changes == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLRound.Matches(childComplexity), true
-	case "AFLRound.name":
-		if e.ComplexityRoot.AFLRound.Name == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Changes(childComplexity), true
+	case "AFLPlayerStatsDiff.goals":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Goals == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLRound.Name(childComplexity), true
-	case "AFLRound.season":
-		if e.ComplexityRoot.AFLRound.Season == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Goals(childComplexity), true
+	case "AFLPlayerStatsDiff.handballs":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Handballs == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLRound.Season(childComplexity), true
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Handballs(childComplexity), true
+	case "AFLPlayerStatsDiff.hitouts":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Hitouts == nil {
+			break
+		}
 
-	case "AFLSeason.id":
-		if e.ComplexityRoot.AFLSeason.ID == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Hitouts(childComplexity), true
+	case "AFLPlayerStatsDiff.kicks":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Kicks == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLSeason.ID(childComplexity), true
-	case "AFLSeason.ladder":
-		if e.ComplexityRoot.AFLSeason.Ladder == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Kicks(childComplexity), true
+	case "AFLPlayerStatsDiff.kind":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Kind == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLSeason.Ladder(childComplexity), true
-	case "AFLSeason.name":
-		if e.ComplexityRoot.AFLSeason.Name == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Kind(childComplexity), true
+	case "AFLPlayerStatsDiff.marks":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Marks == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLSeason.Name(childComplexity), true
-	case "AFLSeason.playerSeasons":
-		if e.ComplexityRoot.AFLSeason.PlayerSeasons == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Marks(childComplexity), true
+	case "AFLPlayerStatsDiff.playerName":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.PlayerName == nil {
 			break
 		}
 
-		args, err := ec.field_AFLSeason_playerSeasons_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLPlayerStatsDiff.PlayerName(childComplexity), true
+	case "AFLPlayerStatsDiff.playerSeasonId":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.PlayerSeasonID == nil {
+			break
 		}
 
-		return e.ComplexityRoot.AFLSeason.PlayerSeasons(childComplexity, args["filter"].(*AFLPlayerSeasonFilter), args["first"].(*int), args["after"].(*string)), true
-	case "AFLSeason.rounds":
-		if e.ComplexityRoot.AFLSeason.Rounds == nil {
+		return e.ComplexityRoot.AFLPlayerStatsDiff.PlayerSeasonID(childComplexity), true
+	case "AFLPlayerStatsDiff.tackles":
+		if e.ComplexityRoot.AFLPlayerStatsDiff.Tackles == nil {
 			break
 		}
 
-		return e.ComplexityRoot.AFLSeason.Rounds(childComplexity), true
+		return e.ComplexityRoot.AFLPlayerStatsDiff.Tackles(childComplexity), true
 
-	case "Entity.findAFLPlayerByID":
-		if e.ComplexityRoot.Entity.FindAFLPlayerByID == nil {
+	case "AFLQuarterBreak.awayScore":
+		if e.ComplexityRoot.AFLQuarterBreak.AwayScore == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findAFLPlayerByID_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLQuarterBreak.AwayScore(childComplexity), true
+	case "AFLQuarterBreak.homeScore":
+		if e.ComplexityRoot.AFLQuarterBreak.HomeScore == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Entity.FindAFLPlayerByID(childComplexity, args["id"].(string)), true
-	case "Entity.findAFLPlayerMatchByID":
-		if e.ComplexityRoot.Entity.FindAFLPlayerMatchByID == nil {
+		return e.ComplexityRoot.AFLQuarterBreak.HomeScore(childComplexity), true
+	case "AFLQuarterBreak.margin":
+		if e.ComplexityRoot.AFLQuarterBreak.Margin == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findAFLPlayerMatchByID_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLQuarterBreak.Margin(childComplexity), true
+	case "AFLQuarterBreak.quarter":
+		if e.ComplexityRoot.AFLQuarterBreak.Quarter == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Entity.FindAFLPlayerMatchByID(childComplexity, args["id"].(string)), true
-	case "Entity.findAFLPlayerSeasonByID":
-		if e.ComplexityRoot.Entity.FindAFLPlayerSeasonByID == nil {
+		return e.ComplexityRoot.AFLQuarterBreak.Quarter(childComplexity), true
+	case "AFLQuarterBreak.quarterResult":
+		if e.ComplexityRoot.AFLQuarterBreak.QuarterResult == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findAFLPlayerSeasonByID_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.ComplexityRoot.AFLQuarterBreak.QuarterResult(childComplexity), true
 
-		return e.ComplexityRoot.Entity.FindAFLPlayerSeasonByID(childComplexity, args["id"].(string)), true
-	case "Entity.findAFLRoundByID":
-		if e.ComplexityRoot.Entity.FindAFLRoundByID == nil {
+	case "AFLQuarterScore.behinds":
+		if e.ComplexityRoot.AFLQuarterScore.Behinds == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findAFLRoundByID_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLQuarterScore.Behinds(childComplexity), true
+	case "AFLQuarterScore.goals":
+		if e.ComplexityRoot.AFLQuarterScore.Goals == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Entity.FindAFLRoundByID(childComplexity, args["id"].(string)), true
-	case "Entity.findAFLSeasonByID":
-		if e.ComplexityRoot.Entity.FindAFLSeasonByID == nil {
+		return e.ComplexityRoot.AFLQuarterScore.Goals(childComplexity), true
+	case "AFLQuarterScore.quarter":
+		if e.ComplexityRoot.AFLQuarterScore.Quarter == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findAFLSeasonByID_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLQuarterScore.Quarter(childComplexity), true
+	case "AFLQuarterScore.score":
+		if e.ComplexityRoot.AFLQuarterScore.Score == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Entity.FindAFLSeasonByID(childComplexity, args["id"].(string)), true
+		return e.ComplexityRoot.AFLQuarterScore.Score(childComplexity), true
 
-	case "ImportAFLMatchStatsResult.awayClubName":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.AwayClubName == nil {
+	case "AFLRound.availability":
+		if e.ComplexityRoot.AFLRound.Availability == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.AwayClubName(childComplexity), true
-	case "ImportAFLMatchStatsResult.awayPlayerCount":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.AwayPlayerCount == nil {
+		return e.ComplexityRoot.AFLRound.Availability(childComplexity), true
+	case "AFLRound.id":
+		if e.ComplexityRoot.AFLRound.ID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.AwayPlayerCount(childComplexity), true
-	case "ImportAFLMatchStatsResult.homeClubName":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.HomeClubName == nil {
+		return e.ComplexityRoot.AFLRound.ID(childComplexity), true
+	case "AFLRound.matches":
+		if e.ComplexityRoot.AFLRound.Matches == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.HomeClubName(childComplexity), true
-	case "ImportAFLMatchStatsResult.homePlayerCount":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.HomePlayerCount == nil {
+		return e.ComplexityRoot.AFLRound.Matches(childComplexity), true
+	case "AFLRound.name":
+		if e.ComplexityRoot.AFLRound.Name == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.HomePlayerCount(childComplexity), true
-	case "ImportAFLMatchStatsResult.matchId":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.MatchID == nil {
+		return e.ComplexityRoot.AFLRound.Name(childComplexity), true
+	case "AFLRound.season":
+		if e.ComplexityRoot.AFLRound.Season == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.MatchID(childComplexity), true
-	case "ImportAFLMatchStatsResult.unmatchedPlayers":
-		if e.ComplexityRoot.ImportAFLMatchStatsResult.UnmatchedPlayers == nil {
+		return e.ComplexityRoot.AFLRound.Season(childComplexity), true
+
+	case "AFLRoundMatchImport.awayClubName":
+		if e.ComplexityRoot.AFLRoundMatchImport.AwayClubName == nil {
 			break
 		}
 
-		return e.ComplexityRoot.ImportAFLMatchStatsResult.UnmatchedPlayers(childComplexity), true
+		return e.ComplexityRoot.AFLRoundMatchImport.AwayClubName(childComplexity), true
+	case "AFLRoundMatchImport.error":
+		if e.ComplexityRoot.AFLRoundMatchImport.Error == nil {
+			break
+		}
 
-	case "Mutation.addAFLPlayer":
-		if e.ComplexityRoot.Mutation.AddAFLPlayer == nil {
+		return e.ComplexityRoot.AFLRoundMatchImport.Error(childComplexity), true
+	case "AFLRoundMatchImport.homeClubName":
+		if e.ComplexityRoot.AFLRoundMatchImport.HomeClubName == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addAFLPlayer_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLRoundMatchImport.HomeClubName(childComplexity), true
+	case "AFLRoundMatchImport.markedFinal":
+		if e.ComplexityRoot.AFLRoundMatchImport.MarkedFinal == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Mutation.AddAFLPlayer(childComplexity, args["input"].(AddAFLPlayerInput)), true
-	case "Mutation.addAFLPlayerSeason":
-		if e.ComplexityRoot.Mutation.AddAFLPlayerSeason == nil {
+		return e.ComplexityRoot.AFLRoundMatchImport.MarkedFinal(childComplexity), true
+	case "AFLRoundMatchImport.matchId":
+		if e.ComplexityRoot.AFLRoundMatchImport.MatchID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addAFLPlayerSeason_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLRoundMatchImport.MatchID(childComplexity), true
+	case "AFLRoundMatchImport.stats":
+		if e.ComplexityRoot.AFLRoundMatchImport.Stats == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Mutation.AddAFLPlayerSeason(childComplexity, args["input"].(AddAFLPlayerSeasonInput)), true
-	case "Mutation.importAFLMatchStats":
-		if e.ComplexityRoot.Mutation.ImportAFLMatchStats == nil {
+		return e.ComplexityRoot.AFLRoundMatchImport.Stats(childComplexity), true
+	case "AFLRoundMatchImport.status":
+		if e.ComplexityRoot.AFLRoundMatchImport.Status == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_importAFLMatchStats_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLRoundMatchImport.Status(childComplexity), true
+
+	case "AFLSeason.id":
+		if e.ComplexityRoot.AFLSeason.ID == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Mutation.ImportAFLMatchStats(childComplexity, args["matchId"].(string)), true
-	case "Mutation.markAFLMatchStatsComplete":
-		if e.ComplexityRoot.Mutation.MarkAFLMatchStatsComplete == nil {
+		return e.ComplexityRoot.AFLSeason.ID(childComplexity), true
+	case "AFLSeason.ladder":
+		if e.ComplexityRoot.AFLSeason.Ladder == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_markAFLMatchStatsComplete_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLSeason.Ladder(childComplexity), true
+	case "AFLSeason.name":
+		if e.ComplexityRoot.AFLSeason.Name == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Mutation.MarkAFLMatchStatsComplete(childComplexity, args["matchId"].(string), args["complete"].(bool)), true
-	case "Mutation.recalculateAFLLadder":
-		if e.ComplexityRoot.Mutation.RecalculateAFLLadder == nil {
+		return e.ComplexityRoot.AFLSeason.Name(childComplexity), true
+	case "AFLSeason.playerProposals":
+		if e.ComplexityRoot.AFLSeason.PlayerProposals == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_recalculateAFLLadder_args(ctx, rawArgs)
+		args, err := ec.field_AFLSeason_playerProposals_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Mutation.RecalculateAFLLadder(childComplexity, args["seasonId"].(string)), true
-	case "Mutation.resolveAFLPlayerMatch":
-		if e.ComplexityRoot.Mutation.ResolveAFLPlayerMatch == nil {
+		return e.ComplexityRoot.AFLSeason.PlayerProposals(childComplexity, args["status"].(*AFLPlayerProposalStatus)), true
+	case "AFLSeason.playerSeasons":
+		if e.ComplexityRoot.AFLSeason.PlayerSeasons == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_resolveAFLPlayerMatch_args(ctx, rawArgs)
+		args, err := ec.field_AFLSeason_playerSeasons_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Mutation.ResolveAFLPlayerMatch(childComplexity, args["input"].(ResolveAFLPlayerMatchInput)), true
-	case "Mutation.updateAFLPlayerMatch":
-		if e.ComplexityRoot.Mutation.UpdateAFLPlayerMatch == nil {
+		return e.ComplexityRoot.AFLSeason.PlayerSeasons(childComplexity, args["filter"].(*AFLPlayerSeasonFilter), args["first"].(*int), args["after"].(*string)), true
+	case "AFLSeason.rounds":
+		if e.ComplexityRoot.AFLSeason.Rounds == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateAFLPlayerMatch_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLSeason.Rounds(childComplexity), true
+
+	case "AFLSeasonSetup.clubSeasonsCreated":
+		if e.ComplexityRoot.AFLSeasonSetup.ClubSeasonsCreated == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Mutation.UpdateAFLPlayerMatch(childComplexity, args["input"].(UpdateAFLPlayerMatchInput)), true
+		return e.ComplexityRoot.AFLSeasonSetup.ClubSeasonsCreated(childComplexity), true
+	case "AFLSeasonSetup.matches":
+		if e.ComplexityRoot.AFLSeasonSetup.Matches == nil {
+			break
+		}
 
-	case "PageInfo.endCursor":
-		if e.ComplexityRoot.PageInfo.EndCursor == nil {
+		return e.ComplexityRoot.AFLSeasonSetup.Matches(childComplexity), true
+	case "AFLSeasonSetup.roundsCreated":
+		if e.ComplexityRoot.AFLSeasonSetup.RoundsCreated == nil {
 			break
 		}
 
-		return e.ComplexityRoot.PageInfo.EndCursor(childComplexity), true
-	case "PageInfo.hasNextPage":
-		if e.ComplexityRoot.PageInfo.HasNextPage == nil {
+		return e.ComplexityRoot.AFLSeasonSetup.RoundsCreated(childComplexity), true
+	case "AFLSeasonSetup.seasonCreated":
+		if e.ComplexityRoot.AFLSeasonSetup.SeasonCreated == nil {
 			break
 		}
 
-		return e.ComplexityRoot.PageInfo.HasNextPage(childComplexity), true
-	case "PageInfo.totalCount":
-		if e.ComplexityRoot.PageInfo.TotalCount == nil {
+		return e.ComplexityRoot.AFLSeasonSetup.SeasonCreated(childComplexity), true
+	case "AFLSeasonSetup.seasonId":
+		if e.ComplexityRoot.AFLSeasonSetup.SeasonID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.PageInfo.TotalCount(childComplexity), true
+		return e.ComplexityRoot.AFLSeasonSetup.SeasonID(childComplexity), true
+	case "AFLSeasonSetup.seasonName":
+		if e.ComplexityRoot.AFLSeasonSetup.SeasonName == nil {
+			break
+		}
 
-	case "Query.aflClub":
-		if e.ComplexityRoot.Query.AflClub == nil {
+		return e.ComplexityRoot.AFLSeasonSetup.SeasonName(childComplexity), true
+
+	case "AFLSeasonSetupMatch.awayClubName":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.AwayClubName == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflClub_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLSeasonSetupMatch.AwayClubName(childComplexity), true
+	case "AFLSeasonSetupMatch.changes":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.Changes == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflClub(childComplexity, args["id"].(string)), true
-	case "Query.aflClubs":
-		if e.ComplexityRoot.Query.AflClubs == nil {
+		return e.ComplexityRoot.AFLSeasonSetupMatch.Changes(childComplexity), true
+	case "AFLSeasonSetupMatch.homeClubName":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.HomeClubName == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.AflClubs(childComplexity), true
-	case "Query.aflLiveRound":
-		if e.ComplexityRoot.Query.AflLiveRound == nil {
+		return e.ComplexityRoot.AFLSeasonSetupMatch.HomeClubName(childComplexity), true
+	case "AFLSeasonSetupMatch.matchId":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.MatchID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.AflLiveRound(childComplexity), true
-	case "Query.aflMatch":
-		if e.ComplexityRoot.Query.AflMatch == nil {
+		return e.ComplexityRoot.AFLSeasonSetupMatch.MatchID(childComplexity), true
+	case "AFLSeasonSetupMatch.roundName":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.RoundName == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflMatch_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLSeasonSetupMatch.RoundName(childComplexity), true
+	case "AFLSeasonSetupMatch.startTime":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.StartTime == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflMatch(childComplexity, args["id"].(string)), true
-	case "Query.aflPlayerSearch":
-		if e.ComplexityRoot.Query.AflPlayerSearch == nil {
+		return e.ComplexityRoot.AFLSeasonSetupMatch.StartTime(childComplexity), true
+	case "AFLSeasonSetupMatch.status":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.Status == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflPlayerSearch_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLSeasonSetupMatch.Status(childComplexity), true
+	case "AFLSeasonSetupMatch.venue":
+		if e.ComplexityRoot.AFLSeasonSetupMatch.Venue == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflPlayerSearch(childComplexity, args["query"].(string)), true
-	case "Query.aflPlayerSeason":
-		if e.ComplexityRoot.Query.AflPlayerSeason == nil {
+		return e.ComplexityRoot.AFLSeasonSetupMatch.Venue(childComplexity), true
+
+	case "AFLStat.name":
+		if e.ComplexityRoot.AFLStat.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflPlayerSeason_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLStat.Name(childComplexity), true
+	case "AFLStat.value":
+		if e.ComplexityRoot.AFLStat.Value == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflPlayerSeason(childComplexity, args["id"].(string)), true
-	case "Query.aflRound":
-		if e.ComplexityRoot.Query.AflRound == nil {
+		return e.ComplexityRoot.AFLStat.Value(childComplexity), true
+
+	case "AFLStatChange.new":
+		if e.ComplexityRoot.AFLStatChange.New == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflRound_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLStatChange.New(childComplexity), true
+	case "AFLStatChange.old":
+		if e.ComplexityRoot.AFLStatChange.Old == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflRound(childComplexity, args["id"].(string)), true
-	case "Query.aflSeason":
-		if e.ComplexityRoot.Query.AflSeason == nil {
+		return e.ComplexityRoot.AFLStatChange.Old(childComplexity), true
+	case "AFLStatChange.stat":
+		if e.ComplexityRoot.AFLStatChange.Stat == nil {
 			break
 		}
 
-		args, err := ec.field_Query_aflSeason_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLStatChange.Stat(childComplexity), true
+
+	case "AFLStatsDiscrepancy.clubMatchId":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.ClubMatchID == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.AflSeason(childComplexity, args["id"].(string)), true
-	case "Query.aflSeasons":
-		if e.ComplexityRoot.Query.AflSeasons == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.ClubMatchID(childComplexity), true
+	case "AFLStatsDiscrepancy.kind":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.Kind == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.AflSeasons(childComplexity), true
+		return e.ComplexityRoot.AFLStatsDiscrepancy.Kind(childComplexity), true
+	case "AFLStatsDiscrepancy.otherSource":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.OtherSource == nil {
+			break
+		}
 
-	case "Query._service":
-		if e.ComplexityRoot.Query.__resolve__service == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.OtherSource(childComplexity), true
+	case "AFLStatsDiscrepancy.otherValue":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.OtherValue == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.__resolve__service(childComplexity), true
-	case "Query._entities":
-		if e.ComplexityRoot.Query.__resolve_entities == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.OtherValue(childComplexity), true
+	case "AFLStatsDiscrepancy.playerName":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.PlayerName == nil {
 			break
 		}
 
-		args, err := ec.field_Query__entities_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.AFLStatsDiscrepancy.PlayerName(childComplexity), true
+	case "AFLStatsDiscrepancy.playerSeasonId":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.PlayerSeasonID == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]any)), true
+		return e.ComplexityRoot.AFLStatsDiscrepancy.PlayerSeasonID(childComplexity), true
+	case "AFLStatsDiscrepancy.source":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.Source == nil {
+			break
+		}
 
-	case "UnmatchedAFLPlayer.behinds":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Behinds == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.Source(childComplexity), true
+	case "AFLStatsDiscrepancy.stat":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.Stat == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Behinds(childComplexity), true
-	case "UnmatchedAFLPlayer.clubMatchId":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.ClubMatchID == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.Stat(childComplexity), true
+	case "AFLStatsDiscrepancy.value":
+		if e.ComplexityRoot.AFLStatsDiscrepancy.Value == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.ClubMatchID(childComplexity), true
-	case "UnmatchedAFLPlayer.goals":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Goals == nil {
+		return e.ComplexityRoot.AFLStatsDiscrepancy.Value(childComplexity), true
+
+	case "AFLStatsImportPreview.appliedAt":
+		if e.ComplexityRoot.AFLStatsImportPreview.AppliedAt == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Goals(childComplexity), true
-	case "UnmatchedAFLPlayer.handballs":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Handballs == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.AppliedAt(childComplexity), true
+	case "AFLStatsImportPreview.clubMatches":
+		if e.ComplexityRoot.AFLStatsImportPreview.ClubMatches == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Handballs(childComplexity), true
-	case "UnmatchedAFLPlayer.hitouts":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Hitouts == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.ClubMatches(childComplexity), true
+	case "AFLStatsImportPreview.id":
+		if e.ComplexityRoot.AFLStatsImportPreview.ID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Hitouts(childComplexity), true
-	case "UnmatchedAFLPlayer.kicks":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Kicks == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.ID(childComplexity), true
+	case "AFLStatsImportPreview.matchId":
+		if e.ComplexityRoot.AFLStatsImportPreview.MatchID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Kicks(childComplexity), true
-	case "UnmatchedAFLPlayer.marks":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Marks == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.MatchID(childComplexity), true
+	case "AFLStatsImportPreview.roundId":
+		if e.ComplexityRoot.AFLStatsImportPreview.RoundID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Marks(childComplexity), true
-	case "UnmatchedAFLPlayer.parsedName":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.ParsedName == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.RoundID(childComplexity), true
+	case "AFLStatsImportPreview.source":
+		if e.ComplexityRoot.AFLStatsImportPreview.Source == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.ParsedName(childComplexity), true
-	case "UnmatchedAFLPlayer.tackles":
-		if e.ComplexityRoot.UnmatchedAFLPlayer.Tackles == nil {
+		return e.ComplexityRoot.AFLStatsImportPreview.Source(childComplexity), true
+	case "AFLStatsImportPreview.unmatchedPlayers":
+		if e.ComplexityRoot.AFLStatsImportPreview.UnmatchedPlayers == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UnmatchedAFLPlayer.Tackles(childComplexity), true
+		return e.ComplexityRoot.AFLStatsImportPreview.UnmatchedPlayers(childComplexity), true
 
-	case "_Service.sdl":
-		if e.ComplexityRoot._Service.SDL == nil {
+	case "Entity.findAFLPlayerByID":
+		if e.ComplexityRoot.Entity.FindAFLPlayerByID == nil {
 			break
 		}
 
-		return e.ComplexityRoot._Service.SDL(childComplexity), true
+		args, err := ec.field_Entity_findAFLPlayerByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	}
-	return 0, false
-}
+		return e.ComplexityRoot.Entity.FindAFLPlayerByID(childComplexity, args["id"].(string)), true
+	case "Entity.findAFLPlayerMatchByID":
+		if e.ComplexityRoot.Entity.FindAFLPlayerMatchByID == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputAFLPlayerSeasonFilter,
-		ec.unmarshalInputAddAFLPlayerInput,
-		ec.unmarshalInputAddAFLPlayerSeasonInput,
-		ec.unmarshalInputResolveAFLPlayerMatchInput,
-		ec.unmarshalInputUpdateAFLPlayerMatchInput,
-	)
-	first := true
+		args, err := ec.field_Entity_findAFLPlayerMatchByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
-					result := <-ec.DeferredResults
-					atomic.AddInt32(&ec.PendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.Deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.PendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.ComplexityRoot.Entity.FindAFLPlayerMatchByID(childComplexity, args["id"].(string)), true
+	case "Entity.findAFLPlayerSeasonByID":
+		if e.ComplexityRoot.Entity.FindAFLPlayerSeasonByID == nil {
+			break
+		}
 
-			return &response
+		args, err := ec.field_Entity_findAFLPlayerSeasonByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.ComplexityRoot.Entity.FindAFLPlayerSeasonByID(childComplexity, args["id"].(string)), true
+	case "Entity.findAFLRoundByID":
+		if e.ComplexityRoot.Entity.FindAFLRoundByID == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		args, err := ec.field_Entity_findAFLRoundByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type executionContext struct {
-	*graphql.ExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot]
-}
+		return e.ComplexityRoot.Entity.FindAFLRoundByID(childComplexity, args["id"].(string)), true
+	case "Entity.findAFLSeasonByID":
+		if e.ComplexityRoot.Entity.FindAFLSeasonByID == nil {
+			break
+		}
 
-func newExecutionContext(
-	opCtx *graphql.OperationContext,
-	execSchema *executableSchema,
-	deferredResults chan graphql.DeferredResult,
-) executionContext {
-	return executionContext{
-		ExecutionContextState: graphql.NewExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot](
-			opCtx,
-			(*graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot])(execSchema),
-			parsedSchema,
-			deferredResults,
-		),
-	}
-}
+		args, err := ec.field_Entity_findAFLSeasonByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-var sources = []*ast.Source{
-	{Name: "../../../api/graphql/common.graphqls", Input: `type PageInfo {
-  hasNextPage: Boolean!
-  endCursor: String
-  totalCount: Int
-}
-`, BuiltIn: false},
-	{Name: "../../../api/graphql/mutation.graphqls", Input: `type Mutation {
-  addAFLPlayer(input: AddAFLPlayerInput!): AFLPlayerSeason!
-  addAFLPlayerSeason(input: AddAFLPlayerSeasonInput!): AFLPlayerSeason!
-  updateAFLPlayerMatch(input: UpdateAFLPlayerMatchInput!): AFLPlayerMatch!
+		return e.ComplexityRoot.Entity.FindAFLSeasonByID(childComplexity, args["id"].(string)), true
 
-  importAFLMatchStats(matchId: ID!): ImportAFLMatchStatsResult!
-  resolveAFLPlayerMatch(input: ResolveAFLPlayerMatchInput!): AFLPlayerMatch!
-  markAFLMatchStatsComplete(matchId: ID!, complete: Boolean!): AFLMatch!
+	case "ImportAFLMatchStatsResult.awayClubName":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.AwayClubName == nil {
+			break
+		}
 
-  "Rebuild AFL ladder standings for the given season from all final matches."
-  recalculateAFLLadder(seasonId: ID!): Boolean!
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.AwayClubName(childComplexity), true
+	case "ImportAFLMatchStatsResult.awayPlayerCount":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.AwayPlayerCount == nil {
+			break
+		}
 
-input AddAFLPlayerInput {
-  name: String!
-  clubSeasonId: ID!
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.AwayPlayerCount(childComplexity), true
+	case "ImportAFLMatchStatsResult.homeClubName":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.HomeClubName == nil {
+			break
+		}
 
-input AddAFLPlayerSeasonInput {
-  playerId: ID!
-  clubSeasonId: ID!
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.HomeClubName(childComplexity), true
+	case "ImportAFLMatchStatsResult.homePlayerCount":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.HomePlayerCount == nil {
+			break
+		}
 
-input UpdateAFLPlayerMatchInput {
-  playerSeasonId: ID!
-  clubMatchId: ID!
-  status: String
-  kicks: Int
-  handballs: Int
-  marks: Int
-  hitouts: Int
-  tackles: Int
-  goals: Int
-  behinds: Int
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.HomePlayerCount(childComplexity), true
+	case "ImportAFLMatchStatsResult.matchId":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.MatchID == nil {
+			break
+		}
 
-"---- Import flow ----"
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.MatchID(childComplexity), true
+	case "ImportAFLMatchStatsResult.source":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.Source == nil {
+			break
+		}
 
-type ImportAFLMatchStatsResult {
-  matchId: ID!
-  homeClubName: String!
-  awayClubName: String!
-  homePlayerCount: Int!
-  awayPlayerCount: Int!
-  unmatchedPlayers: [UnmatchedAFLPlayer!]!
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.Source(childComplexity), true
+	case "ImportAFLMatchStatsResult.unmatchedPlayers":
+		if e.ComplexityRoot.ImportAFLMatchStatsResult.UnmatchedPlayers == nil {
+			break
+		}
 
-type UnmatchedAFLPlayer {
-  parsedName: String!
-  clubMatchId: ID!
-  kicks: Int!
-  handballs: Int!
-  marks: Int!
-  hitouts: Int!
-  tackles: Int!
-  goals: Int!
-  behinds: Int!
-}
+		return e.ComplexityRoot.ImportAFLMatchStatsResult.UnmatchedPlayers(childComplexity), true
 
-input ResolveAFLPlayerMatchInput {
-  clubMatchId: ID!
-  playerSeasonId: ID!
-  kicks: Int!
-  handballs: Int!
-  marks: Int!
-  hitouts: Int!
-  tackles: Int!
-  goals: Int!
-  behinds: Int!
-  parsedName: String
-}
-`, BuiltIn: false},
-	{Name: "../../../api/graphql/query.graphqls", Input: `type Query {
-  aflSeasons: [AFLSeason!]!
-  aflSeason(id: ID!): AFLSeason!
-  aflRound(id: ID!): AFLRound
-  aflMatch(id: ID!): AFLMatch
+	case "ImportAFLRoundStatsResult.matches":
+		if e.ComplexityRoot.ImportAFLRoundStatsResult.Matches == nil {
+			break
+		}
 
-  aflClubs: [AFLClub!]!
-  aflClub(id: ID!): AFLClub!
+		return e.ComplexityRoot.ImportAFLRoundStatsResult.Matches(childComplexity), true
+	case "ImportAFLRoundStatsResult.roundId":
+		if e.ComplexityRoot.ImportAFLRoundStatsResult.RoundID == nil {
+			break
+		}
 
-  aflPlayerSeason(id: ID!): AFLPlayerSeason
+		return e.ComplexityRoot.ImportAFLRoundStatsResult.RoundID(childComplexity), true
 
-  aflLiveRound: AFLLiveRound
-  aflPlayerSearch(query: String!): [AFLPlayer!]!
-}
+	case "Mutation.acceptAFLPlayerProposals":
+		if e.ComplexityRoot.Mutation.AcceptAFLPlayerProposals == nil {
+			break
+		}
 
-type AFLSeason @key(fields: "id") {
-  id: ID!
-  name: String!
-  ladder: [AFLClubSeason!]!
-  rounds: [AFLRound!]!
-  playerSeasons(filter: AFLPlayerSeasonFilter, first: Int, after: String): AFLPlayerSeasonConnection!
-}
+		args, err := ec.field_Mutation_acceptAFLPlayerProposals_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-input AFLPlayerSeasonFilter {
-  query: String
-}
+		return e.ComplexityRoot.Mutation.AcceptAFLPlayerProposals(childComplexity, args["proposalIds"].([]string)), true
+	case "Mutation.addAFLPlayer":
+		if e.ComplexityRoot.Mutation.AddAFLPlayer == nil {
+			break
+		}
 
-type AFLPlayerSeasonConnection {
-  nodes: [AFLPlayerSeason!]!
-  pageInfo: PageInfo!
-}
+		args, err := ec.field_Mutation_addAFLPlayer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AFLRound @key(fields: "id") {
-  id: ID!
-  name: String!
-  season: AFLSeason!
-  matches: [AFLMatch!]!
-}
+		return e.ComplexityRoot.Mutation.AddAFLPlayer(childComplexity, args["input"].(AddAFLPlayerInput)), true
+	case "Mutation.addAFLPlayerSeason":
+		if e.ComplexityRoot.Mutation.AddAFLPlayerSeason == nil {
+			break
+		}
 
-type AFLMatch {
-  id: ID!
-  venue: String
-  startTime: String
-  result: String
-  dataStatus: String!
-  round: AFLRound!
-  homeClubMatch: AFLClubMatch
-  awayClubMatch: AFLClubMatch
-}
+		args, err := ec.field_Mutation_addAFLPlayerSeason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AFLClub {
-  id: ID!
-  name: String!
-}
+		return e.ComplexityRoot.Mutation.AddAFLPlayerSeason(childComplexity, args["input"].(AddAFLPlayerSeasonInput)), true
+	case "Mutation.applyAFLStatsImportPreview":
+		if e.ComplexityRoot.Mutation.ApplyAFLStatsImportPreview == nil {
+			break
+		}
 
-type AFLClubSeason {
-  id: ID!
-  club: AFLClub!
-  season: AFLSeason!
-  played: Int!
-  won: Int!
-  lost: Int!
-  drawn: Int!
-  for: Int!
-  against: Int!
-  premiershipPoints: Int!
-}
+		args, err := ec.field_Mutation_applyAFLStatsImportPreview_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AFLClubMatch {
-  id: ID!
-  clubSeasonId: ID!
-  club: AFLClub!
-  rushedBehinds: Int!
-  score: Int!
-  playerMatches: [AFLPlayerMatch!]!
-  matchId: ID!
-  match: AFLMatch
-}
+		return e.ComplexityRoot.Mutation.ApplyAFLStatsImportPreview(childComplexity, args["previewId"].(string)), true
+	case "Mutation.importAFLMatchStats":
+		if e.ComplexityRoot.Mutation.ImportAFLMatchStats == nil {
+			break
+		}
 
-type AFLPlayer @key(fields: "id") {
-  id: ID!
-  name: String!
-  latestPlayerSeason: AFLPlayerSeason
-}
+		args, err := ec.field_Mutation_importAFLMatchStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AFLPlayerSeason @key(fields: "id") {
-  id: ID!
-  player: AFLPlayer!
-  clubSeason: AFLClubSeason!
-  matches: [AFLPlayerMatch!]!
-}
+		return e.ComplexityRoot.Mutation.ImportAFLMatchStats(childComplexity, args["matchId"].(string), args["source"].(*string)), true
+	case "Mutation.importAFLRoundStats":
+		if e.ComplexityRoot.Mutation.ImportAFLRoundStats == nil {
+			break
+		}
 
-type AFLPlayerMatch @key(fields: "id") {
-  id: ID!
-  playerSeasonId: ID!
-  clubMatchId: ID!
-  clubMatch: AFLClubMatch
-  player: AFLPlayer!
-  status: String!
-  kicks: Int!
-  handballs: Int!
-  marks: Int!
-  hitouts: Int!
-  tackles: Int!
-  goals: Int!
-  behinds: Int!
-  disposals: Int!
-  score: Int!
-}
+		args, err := ec.field_Mutation_importAFLRoundStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AFLLiveRound {
-  round: AFLRound!
-  startDate: String!
-}
+		return e.ComplexityRoot.Mutation.ImportAFLRoundStats(childComplexity, args["roundId"].(string), args["markFinal"].(*bool), args["source"].(*string)), true
+	case "Mutation.markAFLMatchStatsComplete":
+		if e.ComplexityRoot.Mutation.MarkAFLMatchStatsComplete == nil {
+			break
+		}
 
-`, BuiltIn: false},
-	{Name: "../../../federation/directives.graphql", Input: `
-	directive @authenticated on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM
-	directive @composeDirective(name: String!) repeatable on SCHEMA
-	directive @extends on OBJECT | INTERFACE
-	directive @external on OBJECT | FIELD_DEFINITION
-	directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE
-	directive @inaccessible on
-	  | ARGUMENT_DEFINITION
-	  | ENUM
-	  | ENUM_VALUE
-	  | FIELD_DEFINITION
-	  | INPUT_FIELD_DEFINITION
-	  | INPUT_OBJECT
-	  | INTERFACE
-	  | OBJECT
-	  | SCALAR
-	  | UNION
-	directive @interfaceObject on OBJECT
-	directive @link(import: [String!], url: String!) repeatable on SCHEMA
-	directive @override(from: String!, label: String) on FIELD_DEFINITION
-	directive @policy(policies: [[federation__Policy!]!]!) on
-	  | FIELD_DEFINITION
-	  | OBJECT
-	  | INTERFACE
-	  | SCALAR
-	  | ENUM
-	directive @provides(fields: FieldSet!) on FIELD_DEFINITION
-	directive @requires(fields: FieldSet!) on FIELD_DEFINITION
-	directive @requiresScopes(scopes: [[federation__Scope!]!]!) on
-	  | FIELD_DEFINITION
-	  | OBJECT
-	  | INTERFACE
-	  | SCALAR
-	  | ENUM
-	directive @shareable repeatable on FIELD_DEFINITION | OBJECT
-	directive @tag(name: String!) repeatable on
-	  | ARGUMENT_DEFINITION
-	  | ENUM
-	  | ENUM_VALUE
-	  | FIELD_DEFINITION
-	  | INPUT_FIELD_DEFINITION
-	  | INPUT_OBJECT
-	  | INTERFACE
-	  | OBJECT
-	  | SCALAR
-	  | UNION
-	scalar _Any
-	scalar FieldSet
-	scalar federation__Policy
-	scalar federation__Scope
-`, BuiltIn: true},
-	{Name: "../../../federation/entity.graphql", Input: `
-# a union of all types that use the @key directive
-union _Entity = AFLPlayer | AFLPlayerMatch | AFLPlayerSeason | AFLRound | AFLSeason
+		args, err := ec.field_Mutation_markAFLMatchStatsComplete_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# fake type to build resolver interfaces for users to implement
-type Entity {
-	findAFLPlayerByID(id: ID!,): AFLPlayer!
-	findAFLPlayerMatchByID(id: ID!,): AFLPlayerMatch!
-	findAFLPlayerSeasonByID(id: ID!,): AFLPlayerSeason!
-	findAFLRoundByID(id: ID!,): AFLRound!
-	findAFLSeasonByID(id: ID!,): AFLSeason!
-}
+		return e.ComplexityRoot.Mutation.MarkAFLMatchStatsComplete(childComplexity, args["matchId"].(string), args["complete"].(bool)), true
+	case "Mutation.mergeAFLPlayers":
+		if e.ComplexityRoot.Mutation.MergeAFLPlayers == nil {
+			break
+		}
 
-type _Service {
-  sdl: String
-}
+		args, err := ec.field_Mutation_mergeAFLPlayers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-extend type Query {
-  _entities(representations: [_Any!]!): [_Entity]!
-  _service: _Service!
-}
-`, BuiltIn: true},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.ComplexityRoot.Mutation.MergeAFLPlayers(childComplexity, args["survivorId"].(string), args["duplicateId"].(string)), true
+	case "Mutation.previewAFLMatchStatsImport":
+		if e.ComplexityRoot.Mutation.PreviewAFLMatchStatsImport == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		args, err := ec.field_Mutation_previewAFLMatchStatsImport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.ComplexityRoot.Mutation.PreviewAFLMatchStatsImport(childComplexity, args["matchId"].(string), args["source"].(*string)), true
+	case "Mutation.proposeAFLPlayerImport":
+		if e.ComplexityRoot.Mutation.ProposeAFLPlayerImport == nil {
+			break
+		}
 
-func (ec *executionContext) field_AFLSeason_playerSeasons_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOAFLPlayerSeasonFilter2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonFilter)
-	if err != nil {
-		return nil, err
-	}
-	args["filter"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["first"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["after"] = arg2
-	return args, nil
-}
+		args, err := ec.field_Mutation_proposeAFLPlayerImport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Entity_findAFLPlayerByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.ProposeAFLPlayerImport(childComplexity, args["year"].(int), args["source"].(*string), args["players"].([]*AFLListedPlayerInput)), true
+	case "Mutation.recalculateAFLLadder":
+		if e.ComplexityRoot.Mutation.RecalculateAFLLadder == nil {
+			break
+		}
 
-func (ec *executionContext) field_Entity_findAFLPlayerMatchByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_recalculateAFLLadder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Entity_findAFLPlayerSeasonByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.RecalculateAFLLadder(childComplexity, args["seasonId"].(string)), true
+	case "Mutation.rejectAFLPlayerProposals":
+		if e.ComplexityRoot.Mutation.RejectAFLPlayerProposals == nil {
+			break
+		}
 
-func (ec *executionContext) field_Entity_findAFLRoundByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_rejectAFLPlayerProposals_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Entity_findAFLSeasonByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.RejectAFLPlayerProposals(childComplexity, args["proposalIds"].([]string)), true
+	case "Mutation.resolveAFLPlayerMatch":
+		if e.ComplexityRoot.Mutation.ResolveAFLPlayerMatch == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_addAFLPlayerSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddAFLPlayerSeasonInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAddAFLPlayerSeasonInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_resolveAFLPlayerMatch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Mutation_addAFLPlayer_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddAFLPlayerInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAddAFLPlayerInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.ResolveAFLPlayerMatch(childComplexity, args["input"].(ResolveAFLPlayerMatchInput)), true
+	case "Mutation.setAFLPlayerAvailability":
+		if e.ComplexityRoot.Mutation.SetAFLPlayerAvailability == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_importAFLMatchStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["matchId"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_setAFLPlayerAvailability_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Mutation_markAFLMatchStatsComplete_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["matchId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "complete", ec.unmarshalNBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["complete"] = arg1
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.SetAFLPlayerAvailability(childComplexity, args["input"].(SetAFLPlayerAvailabilityInput)), true
+	case "Mutation.setupAFLSeason":
+		if e.ComplexityRoot.Mutation.SetupAFLSeason == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_recalculateAFLLadder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "seasonId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["seasonId"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_setupAFLSeason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Mutation_resolveAFLPlayerMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNResolveAFLPlayerMatchInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐResolveAFLPlayerMatchInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.SetupAFLSeason(childComplexity, args["year"].(int), args["source"].(*string)), true
+	case "Mutation.updateAFLPlayerMatch":
+		if e.ComplexityRoot.Mutation.UpdateAFLPlayerMatch == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_updateAFLPlayerMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateAFLPlayerMatchInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUpdateAFLPlayerMatchInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_updateAFLPlayerMatch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.UpdateAFLPlayerMatch(childComplexity, args["input"].(UpdateAFLPlayerMatchInput)), true
+	case "Mutation.verifyAFLMatchStats":
+		if e.ComplexityRoot.Mutation.VerifyAFLMatchStats == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query__entities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "representations", ec.unmarshalN_Any2ᚕmapᚄ)
-	if err != nil {
-		return nil, err
-	}
-	args["representations"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_verifyAFLMatchStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_aflClub_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Mutation.VerifyAFLMatchStats(childComplexity, args["matchId"].(string), args["threshold"].(*int)), true
 
-func (ec *executionContext) field_Query_aflMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+	case "PageInfo.endCursor":
+		if e.ComplexityRoot.PageInfo.EndCursor == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_aflPlayerSearch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["query"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.PageInfo.EndCursor(childComplexity), true
+	case "PageInfo.hasNextPage":
+		if e.ComplexityRoot.PageInfo.HasNextPage == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_aflPlayerSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.PageInfo.HasNextPage(childComplexity), true
+	case "PageInfo.totalCount":
+		if e.ComplexityRoot.PageInfo.TotalCount == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_aflRound_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.PageInfo.TotalCount(childComplexity), true
 
-func (ec *executionContext) field_Query_aflSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+	case "Query.aflClub":
+		if e.ComplexityRoot.Query.AflClub == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Query_aflClub_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Query.AflClub(childComplexity, args["id"].(string)), true
+	case "Query.aflClubs":
+		if e.ComplexityRoot.Query.AflClubs == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Query.AflClubs(childComplexity), true
+	case "Query.aflLiveRound":
+		if e.ComplexityRoot.Query.AflLiveRound == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.Query.AflLiveRound(childComplexity), true
+	case "Query.aflMatch":
+		if e.ComplexityRoot.Query.AflMatch == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		args, err := ec.field_Query_aflMatch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.ComplexityRoot.Query.AflMatch(childComplexity, args["id"].(string)), true
+	case "Query.aflPlayerDuplicates":
+		if e.ComplexityRoot.Query.AflPlayerDuplicates == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		args, err := ec.field_Query_aflPlayerDuplicates_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.ComplexityRoot.Query.AflPlayerDuplicates(childComplexity, args["minConfidence"].(*float64)), true
+	case "Query.aflPlayerSearch":
+		if e.ComplexityRoot.Query.AflPlayerSearch == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClub_id(ctx context.Context, field graphql.CollectedField, obj *AFLClub) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClub_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_aflPlayerSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AFLClub_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClub",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.Query.AflPlayerSearch(childComplexity, args["query"].(string)), true
+	case "Query.aflPlayerSeason":
+		if e.ComplexityRoot.Query.AflPlayerSeason == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClub_name(ctx context.Context, field graphql.CollectedField, obj *AFLClub) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClub_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_aflPlayerSeason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AFLClub_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClub",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.Query.AflPlayerSeason(childComplexity, args["id"].(string)), true
+	case "Query.aflRound":
+		if e.ComplexityRoot.Query.AflRound == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClubMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_aflRound_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AFLClubMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.Query.AflRound(childComplexity, args["id"].(string)), true
+	case "Query.aflSeason":
+		if e.ComplexityRoot.Query.AflSeason == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClubMatch_clubSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_clubSeasonId,
-		func(ctx context.Context) (any, error) {
-			return obj.ClubSeasonID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_aflSeason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AFLClubMatch_clubSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.Query.AflSeason(childComplexity, args["id"].(string)), true
+	case "Query.aflSeasons":
+		if e.ComplexityRoot.Query.AflSeasons == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClubMatch_club(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_club,
-		func(ctx context.Context) (any, error) {
-			return obj.Club, nil
-		},
-		nil,
-		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
-		true,
-		true,
-	)
-}
+		return e.ComplexityRoot.Query.AflSeasons(childComplexity), true
 
-func (ec *executionContext) fieldContext_AFLClubMatch_club(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClub_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLClub_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
-		},
-	}
-	return fc, nil
-}
+	case "Query._service":
+		if e.ComplexityRoot.Query.__resolve__service == nil {
+			break
+		}
 
-func (ec *executionContext) _AFLClubMatch_rushedBehinds(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_rushedBehinds,
-		func(ctx context.Context) (any, error) {
-			return obj.RushedBehinds, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.ComplexityRoot.Query.__resolve__service(childComplexity), true
+	case "Query._entities":
+		if e.ComplexityRoot.Query.__resolve_entities == nil {
+			break
+		}
+
+		args, err := ec.field_Query__entities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]any)), true
+
+	case "UnmatchedAFLPlayer.behinds":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Behinds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Behinds(childComplexity), true
+	case "UnmatchedAFLPlayer.clubMatchId":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.ClubMatchID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.ClubMatchID(childComplexity), true
+	case "UnmatchedAFLPlayer.goals":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Goals == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Goals(childComplexity), true
+	case "UnmatchedAFLPlayer.handballs":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Handballs == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Handballs(childComplexity), true
+	case "UnmatchedAFLPlayer.hitouts":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Hitouts == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Hitouts(childComplexity), true
+	case "UnmatchedAFLPlayer.kicks":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Kicks == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Kicks(childComplexity), true
+	case "UnmatchedAFLPlayer.marks":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Marks == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Marks(childComplexity), true
+	case "UnmatchedAFLPlayer.parsedName":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.ParsedName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.ParsedName(childComplexity), true
+	case "UnmatchedAFLPlayer.tackles":
+		if e.ComplexityRoot.UnmatchedAFLPlayer.Tackles == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UnmatchedAFLPlayer.Tackles(childComplexity), true
+
+	case "VerifyAFLMatchStatsResult.discrepancies":
+		if e.ComplexityRoot.VerifyAFLMatchStatsResult.Discrepancies == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VerifyAFLMatchStatsResult.Discrepancies(childComplexity), true
+	case "VerifyAFLMatchStatsResult.matchId":
+		if e.ComplexityRoot.VerifyAFLMatchStatsResult.MatchID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VerifyAFLMatchStatsResult.MatchID(childComplexity), true
+	case "VerifyAFLMatchStatsResult.sources":
+		if e.ComplexityRoot.VerifyAFLMatchStatsResult.Sources == nil {
+			break
+		}
+
+		return e.ComplexityRoot.VerifyAFLMatchStatsResult.Sources(childComplexity), true
+
+	case "_Service.sdl":
+		if e.ComplexityRoot._Service.SDL == nil {
+			break
+		}
+
+		return e.ComplexityRoot._Service.SDL(childComplexity), true
 
-func (ec *executionContext) fieldContext_AFLClubMatch_rushedBehinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
 	}
-	return fc, nil
+	return 0, false
 }
 
-func (ec *executionContext) _AFLClubMatch_score(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_score,
-		func(ctx context.Context) (any, error) {
-			return obj.Score, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAFLListedPlayerInput,
+		ec.unmarshalInputAFLPlayerSeasonFilter,
+		ec.unmarshalInputAddAFLPlayerInput,
+		ec.unmarshalInputAddAFLPlayerSeasonInput,
+		ec.unmarshalInputResolveAFLPlayerMatchInput,
+		ec.unmarshalInputSetAFLPlayerAvailabilityInput,
+		ec.unmarshalInputUpdateAFLPlayerMatchInput,
 	)
-}
+	first := true
 
-func (ec *executionContext) fieldContext_AFLClubMatch_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
+					result := <-ec.DeferredResults
+					atomic.AddInt32(&ec.PendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.Deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.PendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	return fc, nil
 }
 
-func (ec *executionContext) _AFLClubMatch_playerMatches(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_playerMatches,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLClubMatch().PlayerMatches(ctx, obj)
-		},
-		nil,
-		ec.marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatchᚄ,
-		true,
-		true,
-	)
+type executionContext struct {
+	*graphql.ExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot]
 }
 
-func (ec *executionContext) fieldContext_AFLClubMatch_playerMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
-			case "playerSeasonId":
-				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
-			case "clubMatchId":
-				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
-			case "clubMatch":
-				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
-			case "status":
-				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
-			case "kicks":
-				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
-			case "handballs":
-				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
-			case "marks":
-				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
-			case "hitouts":
-				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
-			case "tackles":
-				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
-			case "goals":
-				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
-			case "behinds":
-				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
-			case "disposals":
-				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
-		},
+func newExecutionContext(
+	opCtx *graphql.OperationContext,
+	execSchema *executableSchema,
+	deferredResults chan graphql.DeferredResult,
+) executionContext {
+	return executionContext{
+		ExecutionContextState: graphql.NewExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot](
+			opCtx,
+			(*graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot])(execSchema),
+			parsedSchema,
+			deferredResults,
+		),
 	}
-	return fc, nil
 }
 
-func (ec *executionContext) _AFLClubMatch_matchId(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_matchId,
-		func(ctx context.Context) (any, error) {
-			return obj.MatchID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+var sources = []*ast.Source{
+	{Name: "../../../api/graphql/common.graphqls", Input: `type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: String
+  totalCount: Int
 }
+`, BuiltIn: false},
+	{Name: "../../../api/graphql/mutation.graphqls", Input: `type Mutation {
+  "Add an AFL player to a club's season squad."
+  addAFLPlayer(input: AddAFLPlayerInput!): AFLPlayerSeason!
 
-func (ec *executionContext) fieldContext_AFLClubMatch_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+  "Add an additional season record for an existing AFL player."
+  addAFLPlayerSeason(input: AddAFLPlayerSeasonInput!): AFLPlayerSeason!
+
+  "Update stats for an AFL player match."
+  updateAFLPlayerMatch(input: UpdateAFLPlayerMatchInput!): AFLPlayerMatch!
+
+  "Import player stats for a match from an external source (footywire by default, or afltables). Returns a result including any unmatched players."
+  importAFLMatchStats(matchId: ID!, source: String): ImportAFLMatchStatsResult!
+
+  "Import player stats for every match in a round from one fetch of the fixture list. Matches already final are skipped, and a match that fails is reported without failing the rest. With markFinal, a match the source shows complete and with every player matched is marked final."
+  importAFLRoundStats(roundId: ID!, markFinal: Boolean, source: String): ImportAFLRoundStatsResult!
+
+  "Compare a match's stats across every source that has it, flagging player stats that differ by more than threshold (0 if omitted), players only one source lists, and stored stats that don't reproduce a source's team score. The discrepancies replace those found last time, and are listed on the match."
+  verifyAFLMatchStats(matchId: ID!, threshold: Int): VerifyAFLMatchStatsResult!
+
+  "Work out what importing a match's stats would change without writing them: each player's stats old → new, players with no stored stats, stored players the source no longer lists, and each club's score. Nothing is written until the preview is applied. Resolve unmatched players first, as a stored player whose name no longer resolves shows as removed."
+  previewAFLMatchStatsImport(matchId: ID!, source: String): AFLStatsImportPreview!
+
+  "Write a previewed stats import and publish the changed stat lines. Fails if the match's stored stats changed after the preview, or the preview was already applied. A final match stays final, and its result and the ladder are recalculated."
+  applyAFLStatsImportPreview(previewId: ID!): AFLStatsImportPreview!
+
+  "Create or update an AFL season from its fixture, listed from source (footywire by default, or afltables): the season, its rounds, a club season for each club, and each match with its venue and start time. Re-running it applies fixture changes: a match that moved round or was rescheduled, a new venue, or home and away swapped. Stored matches the fixture no longer lists are reported as unlisted, not deleted."
+  setupAFLSeason(year: Int!, source: String): AFLSeasonSetup!
+
+  "Compare each club's player list for a season with the stored players, and propose new players, continuing players (a new player season, at the same or a new club) and retirements for review. The list is read from source (afltables; footywire has no player lists) unless players are given. Replaces the season's pending proposals; listed players already in the season, and proposals already decided, aren't proposed. The season must be set up first."
+  proposeAFLPlayerImport(year: Int!, source: String, players: [AFLListedPlayerInput!]): AFLPlayerImport!
+
+  "Write pending player proposals, in one transaction: new players are added, and new and continuing players are given a player season at their listed club."
+  acceptAFLPlayerProposals(proposalIds: [ID!]!): [AFLPlayerProposal!]!
+
+  "Reject pending player proposals without writing them; they aren't proposed again."
+  rejectAFLPlayerProposals(proposalIds: [ID!]!): [AFLPlayerProposal!]!
+
+  "Merge a duplicate player into survivor in one transaction: the duplicate's player seasons become the survivor's, and at a club season the survivor already has, the duplicate's player matches, availability and source names move to the survivor's player season. The duplicate is then deleted, and FFL rewrites its references to them via AFL.PlayerMerged. Fails if both players have stats for the same match."
+  mergeAFLPlayers(survivorId: ID!, duplicateId: ID!): AFLPlayerMerge!
+
+  "Manually link an unmatched player from a stats import to a player season."
+  resolveAFLPlayerMatch(input: ResolveAFLPlayerMatchInput!): AFLPlayerMatch!
+
+  "Mark a match's stats as complete (final) or revert to partial."
+  markAFLMatchStatsComplete(matchId: ID!, complete: Boolean!): AFLMatch!
+
+  "Rebuild AFL ladder standings for the given season from all final matches."
+  recalculateAFLLadder(seasonId: ID!): Boolean!
+
+  "Record a player's availability for a round, replacing any earlier entry. FFL is notified via AFL.MatchUpdated."
+  setAFLPlayerAvailability(input: SetAFLPlayerAvailabilityInput!): AFLPlayerAvailability!
 }
 
-func (ec *executionContext) _AFLClubMatch_match(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubMatch_match,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLClubMatch().Match(ctx, obj)
-		},
-		nil,
-		ec.marshalOAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
-		true,
-		false,
-	)
+input AddAFLPlayerInput {
+  name: String!
+  clubSeasonId: ID!
 }
 
-func (ec *executionContext) fieldContext_AFLClubMatch_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLMatch_id(ctx, field)
-			case "venue":
-				return ec.fieldContext_AFLMatch_venue(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AFLMatch_startTime(ctx, field)
-			case "result":
-				return ec.fieldContext_AFLMatch_result(ctx, field)
-			case "dataStatus":
-				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
-			case "round":
-				return ec.fieldContext_AFLMatch_round(ctx, field)
-			case "homeClubMatch":
-				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
-			case "awayClubMatch":
-				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
-		},
-	}
-	return fc, nil
+input AddAFLPlayerSeasonInput {
+  playerId: ID!
+  clubSeasonId: ID!
 }
 
-func (ec *executionContext) _AFLClubSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+input UpdateAFLPlayerMatchInput {
+  playerSeasonId: ID!
+  clubMatchId: ID!
+  kicks: Int
+  handballs: Int
+  marks: Int
+  hitouts: Int
+  tackles: Int
+  goals: Int
+  behinds: Int
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+type UnmatchedAFLPlayer {
+  parsedName: String!
+  clubMatchId: ID!
+  kicks: Int!
+  handballs: Int!
+  marks: Int!
+  hitouts: Int!
+  tackles: Int!
+  goals: Int!
+  behinds: Int!
 }
 
-func (ec *executionContext) _AFLClubSeason_club(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_club,
-		func(ctx context.Context) (any, error) {
-			return obj.Club, nil
-		},
-		nil,
-		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
-		true,
-		true,
-	)
+type ImportAFLMatchStatsResult {
+  matchId: ID!
+  "The source the stats were imported from; pass it back when resolving unmatched players."
+  source: String!
+  homeClubName: String!
+  awayClubName: String!
+  homePlayerCount: Int!
+  awayPlayerCount: Int!
+  unmatchedPlayers: [UnmatchedAFLPlayer!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_club(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClub_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLClub_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
-		},
-	}
-	return fc, nil
+enum AFLRoundMatchImportStatus {
+  imported
+  skipped
+  failed
 }
 
-func (ec *executionContext) _AFLClubSeason_season(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_season,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLClubSeason().Season(ctx, obj)
-		},
-		nil,
-		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
-		true,
-		true,
-	)
+type AFLRoundMatchImport {
+  matchId: ID!
+  homeClubName: String!
+  awayClubName: String!
+  status: AFLRoundMatchImportStatus!
+  "Player counts and unmatched players; null unless imported."
+  stats: ImportAFLMatchStatsResult
+  markedFinal: Boolean!
+  "Why the match failed, or why an imported match wasn't marked final."
+  error: String
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_season(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLSeason_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLSeason_name(ctx, field)
-			case "ladder":
-				return ec.fieldContext_AFLSeason_ladder(ctx, field)
-			case "rounds":
-				return ec.fieldContext_AFLSeason_rounds(ctx, field)
-			case "playerSeasons":
-				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
-		},
-	}
-	return fc, nil
+type ImportAFLRoundStatsResult {
+  roundId: ID!
+  matches: [AFLRoundMatchImport!]!
 }
 
-func (ec *executionContext) _AFLClubSeason_played(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_played,
-		func(ctx context.Context) (any, error) {
-			return obj.Played, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type VerifyAFLMatchStatsResult {
+  matchId: ID!
+  "The sources compared; the first is the one the others were compared with."
+  sources: [String!]!
+  discrepancies: [AFLStatsDiscrepancy!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_played(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLStatsImportPreview {
+  id: ID!
+  matchId: ID!
+  roundId: ID!
+  source: String!
+  "Home then away."
+  clubMatches: [AFLClubMatchStatsPreview!]!
+  unmatchedPlayers: [UnmatchedAFLPlayer!]!
+  "Null until the preview is applied."
+  appliedAt: String
 }
 
-func (ec *executionContext) _AFLClubSeason_won(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_won,
-		func(ctx context.Context) (any, error) {
-			return obj.Won, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLClubMatchStatsPreview {
+  clubMatchId: ID!
+  clubName: String!
+  oldScore: Int!
+  newScore: Int!
+  oldRushedBehinds: Int!
+  newRushedBehinds: Int!
+  "Only players whose stats change."
+  players: [AFLPlayerStatsDiff!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_won(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+enum AFLPlayerStatsDiffKind {
+  "The source lists the player and no stats are stored for them."
+  added
+  changed
+  "Stats are stored for the player and the source doesn't list them."
+  removed
 }
 
-func (ec *executionContext) _AFLClubSeason_lost(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_lost,
-		func(ctx context.Context) (any, error) {
-			return obj.Lost, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLPlayerStatsDiff {
+  playerSeasonId: ID!
+  playerName: String!
+  kind: AFLPlayerStatsDiffKind!
+  "The stats that change; for an added or removed player, every stat that isn't zero."
+  changes: [AFLStatChange!]!
+  "The new stat line, as fflScoreImpact takes it; all zero for a removed player."
+  kicks: Int!
+  handballs: Int!
+  marks: Int!
+  hitouts: Int!
+  tackles: Int!
+  goals: Int!
+  behinds: Int!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_lost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLStatChange {
+  "kicks, handballs, marks, hitouts, tackles, goals or behinds"
+  stat: String!
+  old: Int!
+  new: Int!
 }
 
-func (ec *executionContext) _AFLClubSeason_drawn(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_drawn,
-		func(ctx context.Context) (any, error) {
-			return obj.Drawn, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLSeasonSetup {
+  seasonId: ID!
+  seasonName: String!
+  seasonCreated: Boolean!
+  roundsCreated: [String!]!
+  "Names of the clubs given a club season."
+  clubSeasonsCreated: [String!]!
+  "Fixture order, then unlisted matches."
+  matches: [AFLSeasonSetupMatch!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_drawn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+enum AFLSeasonSetupMatchStatus {
+  created
+  updated
+  unchanged
+  "Stored but no longer in the fixture; left as it is."
+  unlisted
 }
 
-func (ec *executionContext) _AFLClubSeason_for(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_for,
-		func(ctx context.Context) (any, error) {
-			return obj.For, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLSeasonSetupMatch {
+  matchId: ID!
+  roundName: String!
+  homeClubName: String!
+  awayClubName: String!
+  venue: String
+  startTime: String
+  status: AFLSeasonSetupMatchStatus!
+  "What an update changed, e.g. \"venue: MCG → Marvel Stadium\"."
+  changes: [String!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_for(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+input AFLListedPlayerInput {
+  "As in afl.club, or one of its usual codes or nicknames."
+  club: String!
+  name: String!
 }
 
-func (ec *executionContext) _AFLClubSeason_against(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_against,
-		func(ctx context.Context) (any, error) {
-			return obj.Against, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLPlayerImport {
+  seasonId: ID!
+  seasonName: String!
+  "Distinct players on the list."
+  listedCount: Int!
+  "Listed players who already have a player season in the season."
+  inSeasonCount: Int!
+  "Listed players and retirements decided in an earlier review."
+  decidedCount: Int!
+  proposals: [AFLPlayerProposal!]!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_against(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLPlayerMerge {
+  survivor: AFLPlayer!
+  duplicateId: ID!
+  "The duplicate's player seasons now the survivor's."
+  movedPlayerSeasonIds: [ID!]!
+  "The duplicate's player seasons merged into the survivor's at the same club season."
+  mergedPlayerSeasons: [AFLMergedPlayerSeason!]!
 }
 
-func (ec *executionContext) _AFLClubSeason_premiershipPoints(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLClubSeason_premiershipPoints,
-		func(ctx context.Context) (any, error) {
-			return obj.PremiershipPoints, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AFLMergedPlayerSeason {
+  duplicateId: ID!
+  survivorId: ID!
 }
 
-func (ec *executionContext) fieldContext_AFLClubSeason_premiershipPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLClubSeason",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+input ResolveAFLPlayerMatchInput {
+  clubMatchId: ID!
+  playerSeasonId: ID!
+  kicks: Int!
+  handballs: Int!
+  marks: Int!
+  hitouts: Int!
+  tackles: Int!
+  goals: Int!
+  behinds: Int!
+  parsedName: String
+  "The source parsedName comes from; footywire if omitted."
+  source: String
 }
 
-func (ec *executionContext) _AFLLiveRound_round(ctx context.Context, field graphql.CollectedField, obj *AFLLiveRound) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLLiveRound_round,
-		func(ctx context.Context) (any, error) {
-			return obj.Round, nil
-		},
-		nil,
-		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
-		true,
-		true,
-	)
+input SetAFLPlayerAvailabilityInput {
+  playerSeasonId: ID!
+  roundId: ID!
+  "One of named, emergency, injured, suspended."
+  status: String!
+  "Injured players only."
+  expectedReturn: String
 }
+`, BuiltIn: false},
+	{Name: "../../../api/graphql/query.graphqls", Input: `type Query {
+  aflSeasons: [AFLSeason!]!
+  aflSeason(id: ID!): AFLSeason!
+  aflRound(id: ID!): AFLRound
+  aflMatch(id: ID!): AFLMatch
 
-func (ec *executionContext) fieldContext_AFLLiveRound_round(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLLiveRound",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLRound_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLRound_name(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLRound_season(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLRound_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
-		},
-	}
-	return fc, nil
+  aflClubs: [AFLClub!]!
+  aflClub(id: ID!): AFLClub!
+
+  aflPlayerSeason(id: ID!): AFLPlayerSeason
+
+  aflLiveRound: AFLLiveRound
+  aflPlayerSearch(query: String!): [AFLPlayer!]!
+  "Pairs of players who are likely the same person, likeliest first: players with the same surname who never played in the same match, and whose names are at least minConfidence alike (0.8 if omitted). Merge a pair with mergeAFLPlayers."
+  aflPlayerDuplicates(minConfidence: Float): [AFLPlayerDuplicate!]!
 }
 
-func (ec *executionContext) _AFLLiveRound_startDate(ctx context.Context, field graphql.CollectedField, obj *AFLLiveRound) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLLiveRound_startDate,
-		func(ctx context.Context) (any, error) {
-			return obj.StartDate, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type AFLSeason @key(fields: "id") {
+  id: ID!
+  name: String!
+  ladder: [AFLClubSeason!]!
+  rounds: [AFLRound!]!
+  playerSeasons(filter: AFLPlayerSeasonFilter, first: Int, after: String): AFLPlayerSeasonConnection!
+  "Player import proposals for the season, oldest first; only those with status if given."
+  playerProposals(status: AFLPlayerProposalStatus): [AFLPlayerProposal!]!
 }
 
-func (ec *executionContext) fieldContext_AFLLiveRound_startDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLLiveRound",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum AFLPlayerProposalKind {
+  "Not a stored player; accepting adds them with a player season at the listed club."
+  new
+  "A stored player; accepting gives them a player season at the listed club."
+  continuing
+  "Played last season and isn't listed; accepting writes nothing, and the player isn't proposed again."
+  retiring
 }
 
-func (ec *executionContext) _AFLMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+enum AFLPlayerProposalStatus {
+  pending
+  accepted
+  rejected
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLPlayerProposal {
+  id: ID!
+  seasonId: ID!
+  "The stats source the list came from; null for a list from elsewhere."
+  source: String
+  kind: AFLPlayerProposalKind!
+  status: AFLPlayerProposalStatus!
+  "The name as listed; null for a retirement."
+  listedName: String
+  "The club season the player is listed at, or for a retirement, the one they played last season."
+  clubSeasonId: ID!
+  clubName: String!
+  "The stored player; null for a new player."
+  playerId: ID
+  playerName: String
+  "A continuing player's club in their latest season."
+  previousClubName: String
+  "How confidently a continuing player's name matched, 0–1."
+  confidence: Float!
+  "The player season accepting the proposal created."
+  playerSeasonId: ID
+  createdAt: String!
+  decidedAt: String
 }
 
-func (ec *executionContext) _AFLMatch_venue(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_venue,
-		func(ctx context.Context) (any, error) {
-			return obj.Venue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+input AFLPlayerSeasonFilter {
+  query: String
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLPlayerSeasonConnection {
+  nodes: [AFLPlayerSeason!]!
+  pageInfo: PageInfo!
 }
 
-func (ec *executionContext) _AFLMatch_startTime(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_startTime,
-		func(ctx context.Context) (any, error) {
-			return obj.StartTime, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+type AFLRound @key(fields: "id") {
+  id: ID!
+  name: String!
+  season: AFLSeason!
+  matches: [AFLMatch!]!
+  "Pre-match availability recorded for the round."
+  availability: [AFLPlayerAvailability!]!
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_startTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLMatch {
+  id: ID!
+  venue: String
+  startTime: String
+  result: String
+  dataStatus: String!
+  round: AFLRound!
+  homeClubMatch: AFLClubMatch
+  awayClubMatch: AFLClubMatch
+  "Discrepancies found the last time the match's stats were verified across sources."
+  statsDiscrepancies: [AFLStatsDiscrepancy!]!
+  "How the scoreboard moved break by break; null when quarter scores weren't recorded."
+  timeline: AFLMatchTimeline
 }
 
-func (ec *executionContext) _AFLMatch_result(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_result,
-		func(ctx context.Context) (any, error) {
-			return obj.Result, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+type AFLMatchTimeline {
+  breaks: [AFLQuarterBreak!]!
+  "Whether the winner trailed at quarter, half or three-quarter time; false until the match is over."
+  comebackWin: Boolean!
+  "The most the winner trailed by at a break before the final siren; 0 when they never trailed or the match isn't over."
+  comebackDeficit: Int!
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_result(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLQuarterBreak {
+  quarter: Int!
+  homeScore: AFLQuarterScore!
+  awayScore: AFLQuarterScore!
+  "The home club's lead at the break; negative when the away club leads."
+  margin: Int!
+  "home_win, away_win or draw for the quarter alone."
+  quarterResult: String!
 }
 
-func (ec *executionContext) _AFLMatch_dataStatus(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_dataStatus,
-		func(ctx context.Context) (any, error) {
-			return obj.DataStatus, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type AFLQuarterScore {
+  quarter: Int!
+  goals: Int!
+  behinds: Int!
+  score: Int!
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_dataStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum AFLStatsDiscrepancyKind {
+  "A player's stat differs between the sources."
+  stat
+  "source lists the player and otherSource doesn't."
+  missing_player
+  "The stored stats and rushed behinds don't add up to the club's goals or behinds in source's score table; otherSource is stored."
+  score
 }
 
-func (ec *executionContext) _AFLMatch_round(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_round,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLMatch().Round(ctx, obj)
-		},
-		nil,
-		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
-		true,
-		true,
-	)
+type AFLStatsDiscrepancy {
+  clubMatchId: ID!
+  kind: AFLStatsDiscrepancyKind!
+  "Null for a score, or a player whose name didn't resolve."
+  playerSeasonId: ID
+  playerName: String
+  "kicks, handballs, marks, hitouts, tackles, goals or behinds; null for a missing player."
+  stat: String
+  source: String!
+  value: Int!
+  otherSource: String!
+  otherValue: Int!
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_round(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLRound_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLRound_name(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLRound_season(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLRound_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
-		},
-	}
-	return fc, nil
+type AFLClub {
+  id: ID!
+  name: String!
 }
 
-func (ec *executionContext) _AFLMatch_homeClubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_homeClubMatch,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLMatch().HomeClubMatch(ctx, obj)
-		},
-		nil,
-		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
-		true,
-		false,
-	)
+type AFLClubSeason {
+  id: ID!
+  club: AFLClub!
+  season: AFLSeason!
+  played: Int!
+  won: Int!
+  lost: Int!
+  drawn: Int!
+  for: Int!
+  against: Int!
+  premiershipPoints: Int!
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_homeClubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClubMatch_id(ctx, field)
-			case "clubSeasonId":
-				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
-			case "club":
-				return ec.fieldContext_AFLClubMatch_club(ctx, field)
-			case "rushedBehinds":
-				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLClubMatch_score(ctx, field)
-			case "playerMatches":
-				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
-			case "matchId":
-				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
-			case "match":
-				return ec.fieldContext_AFLClubMatch_match(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
-		},
-	}
-	return fc, nil
+type AFLClubMatch {
+  id: ID!
+  clubSeasonId: ID!
+  club: AFLClub!
+  rushedBehinds: Int!
+  score: Int!
+  playerMatches: [AFLPlayerMatch!]!
+  "The score at the end of each quarter, counting the quarters before it."
+  quarterScores: [AFLQuarterScore!]!
+  matchId: ID!
+  match: AFLMatch
 }
 
-func (ec *executionContext) _AFLMatch_awayClubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLMatch_awayClubMatch,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLMatch().AwayClubMatch(ctx, obj)
-		},
-		nil,
-		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
-		true,
-		false,
-	)
+type AFLPlayer @key(fields: "id") {
+  id: ID!
+  name: String!
+  latestPlayerSeason: AFLPlayerSeason
 }
 
-func (ec *executionContext) fieldContext_AFLMatch_awayClubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClubMatch_id(ctx, field)
-			case "clubSeasonId":
-				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
-			case "club":
-				return ec.fieldContext_AFLClubMatch_club(ctx, field)
-			case "rushedBehinds":
-				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLClubMatch_score(ctx, field)
-			case "playerMatches":
-				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
-			case "matchId":
-				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
-			case "match":
-				return ec.fieldContext_AFLClubMatch_match(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
-		},
-	}
-	return fc, nil
+type AFLPlayerDuplicate {
+  player: AFLPlayer!
+  other: AFLPlayer!
+  "How alike the names are, 0.0–1.0."
+  confidence: Float!
 }
 
-func (ec *executionContext) _AFLPlayer_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayer_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+type AFLPlayerSeason @key(fields: "id") {
+  id: ID!
+  player: AFLPlayer!
+  clubSeason: AFLClubSeason!
+  matches: [AFLPlayerMatch!]!
 }
 
-func (ec *executionContext) fieldContext_AFLPlayer_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayer",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+type AFLPlayerMatch @key(fields: "id") {
+  id: ID!
+  playerSeasonId: ID!
+  clubMatchId: ID!
+  clubMatch: AFLClubMatch
+  player: AFLPlayer!
+  status: String!
+  kicks: Int!
+  handballs: Int!
+  marks: Int!
+  hitouts: Int!
+  tackles: Int!
+  goals: Int!
+  behinds: Int!
+  disposals: Int!
+  score: Int!
+  "Stats beyond those above, by name (contested_possessions, time_on_ground_pct, …), as far as a source has reported them."
+  extraStats: [AFLStat!]!
 }
 
-func (ec *executionContext) _AFLPlayer_name(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayer_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+"A player match stat, by name."
+type AFLStat {
+  name: String!
+  value: Float!
 }
 
-func (ec *executionContext) fieldContext_AFLPlayer_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayer",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+"A player's pre-match availability for a round."
+type AFLPlayerAvailability {
+  id: ID!
+  playerSeasonId: ID!
+  playerSeason: AFLPlayerSeason!
+  roundId: ID!
+  "One of named, emergency, injured, suspended."
+  status: String!
+  "Injured players only, as published (e.g. \"2-3 weeks\", \"TBC\")."
+  expectedReturn: String
+  "Where the entry came from: manual, or the name of a feed."
+  source: String!
 }
 
-func (ec *executionContext) _AFLPlayer_latestPlayerSeason(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayer_latestPlayerSeason,
-		func(ctx context.Context) (any, error) {
-			return obj.LatestPlayerSeason, nil
-		},
-		nil,
-		ec.marshalOAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
-		true,
-		false,
-	)
+type AFLLiveRound {
+  round: AFLRound!
+  startDate: String!
 }
 
-func (ec *executionContext) fieldContext_AFLPlayer_latestPlayerSeason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayer",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
-			case "clubSeason":
-				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
-		},
-	}
-	return fc, nil
-}
+`, BuiltIn: false},
+	{Name: "../../../federation/directives.graphql", Input: `
+	directive @authenticated on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM
+	directive @composeDirective(name: String!) repeatable on SCHEMA
+	directive @extends on OBJECT | INTERFACE
+	directive @external on OBJECT | FIELD_DEFINITION
+	directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE
+	directive @inaccessible on
+	  | ARGUMENT_DEFINITION
+	  | ENUM
+	  | ENUM_VALUE
+	  | FIELD_DEFINITION
+	  | INPUT_FIELD_DEFINITION
+	  | INPUT_OBJECT
+	  | INTERFACE
+	  | OBJECT
+	  | SCALAR
+	  | UNION
+	directive @interfaceObject on OBJECT
+	directive @link(import: [String!], url: String!) repeatable on SCHEMA
+	directive @override(from: String!, label: String) on FIELD_DEFINITION
+	directive @policy(policies: [[federation__Policy!]!]!) on
+	  | FIELD_DEFINITION
+	  | OBJECT
+	  | INTERFACE
+	  | SCALAR
+	  | ENUM
+	directive @provides(fields: FieldSet!) on FIELD_DEFINITION
+	directive @requires(fields: FieldSet!) on FIELD_DEFINITION
+	directive @requiresScopes(scopes: [[federation__Scope!]!]!) on
+	  | FIELD_DEFINITION
+	  | OBJECT
+	  | INTERFACE
+	  | SCALAR
+	  | ENUM
+	directive @shareable repeatable on FIELD_DEFINITION | OBJECT
+	directive @tag(name: String!) repeatable on
+	  | ARGUMENT_DEFINITION
+	  | ENUM
+	  | ENUM_VALUE
+	  | FIELD_DEFINITION
+	  | INPUT_FIELD_DEFINITION
+	  | INPUT_OBJECT
+	  | INTERFACE
+	  | OBJECT
+	  | SCALAR
+	  | UNION
+	scalar _Any
+	scalar FieldSet
+	scalar federation__Policy
+	scalar federation__Scope
+`, BuiltIn: true},
+	{Name: "../../../federation/entity.graphql", Input: `
+# a union of all types that use the @key directive
+union _Entity = AFLPlayer | AFLPlayerMatch | AFLPlayerSeason | AFLRound | AFLSeason
 
-func (ec *executionContext) _AFLPlayerMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+# fake type to build resolver interfaces for users to implement
+type Entity {
+	findAFLPlayerByID(id: ID!,): AFLPlayer!
+	findAFLPlayerMatchByID(id: ID!,): AFLPlayerMatch!
+	findAFLPlayerSeasonByID(id: ID!,): AFLPlayerSeason!
+	findAFLRoundByID(id: ID!,): AFLRound!
+	findAFLSeasonByID(id: ID!,): AFLSeason!
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+type _Service {
+  sdl: String
 }
 
-func (ec *executionContext) _AFLPlayerMatch_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_playerSeasonId,
-		func(ctx context.Context) (any, error) {
-			return obj.PlayerSeasonID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+extend type Query {
+  _entities(representations: [_Any!]!): [_Entity]!
+  _service: _Service!
 }
-
-func (ec *executionContext) fieldContext_AFLPlayerMatch_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+`, BuiltIn: true},
 }
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
-func (ec *executionContext) _AFLPlayerMatch_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_clubMatchId,
-		func(ctx context.Context) (any, error) {
-			return obj.ClubMatchID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+// endregion ************************** generated!.gotpl **************************
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_AFLSeason_playerProposals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOAFLPlayerProposalStatus2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["status"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_clubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_clubMatch,
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLPlayerMatch().ClubMatch(ctx, obj)
-		},
-		nil,
-		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
-		true,
-		false,
-	)
+func (ec *executionContext) field_AFLSeason_playerSeasons_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOAFLPlayerSeasonFilter2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_clubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClubMatch_id(ctx, field)
-			case "clubSeasonId":
-				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
-			case "club":
-				return ec.fieldContext_AFLClubMatch_club(ctx, field)
-			case "rushedBehinds":
-				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLClubMatch_score(ctx, field)
-			case "playerMatches":
-				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
-			case "matchId":
-				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
-			case "match":
-				return ec.fieldContext_AFLClubMatch_match(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
-		},
+func (ec *executionContext) field_Entity_findAFLPlayerByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_player(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_player,
-		func(ctx context.Context) (any, error) {
-			return obj.Player, nil
-		},
-		nil,
-		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Entity_findAFLPlayerMatchByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayer_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLPlayer_name(ctx, field)
-			case "latestPlayerSeason":
-				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
-		},
+func (ec *executionContext) field_Entity_findAFLPlayerSeasonByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_status(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Entity_findAFLRoundByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Entity_findAFLSeasonByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_kicks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_kicks,
-		func(ctx context.Context) (any, error) {
-			return obj.Kicks, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_acceptAFLPlayerProposals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "proposalIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["proposalIds"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_kicks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_addAFLPlayerSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddAFLPlayerSeasonInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAddAFLPlayerSeasonInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_handballs(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_handballs,
-		func(ctx context.Context) (any, error) {
-			return obj.Handballs, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_addAFLPlayer_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddAFLPlayerInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAddAFLPlayerInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_handballs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_applyAFLStatsImportPreview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "previewId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["previewId"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_marks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_marks,
-		func(ctx context.Context) (any, error) {
-			return obj.Marks, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_importAFLMatchStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "source", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["source"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_marks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_importAFLRoundStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "roundId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["roundId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "markFinal", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["markFinal"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "source", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["source"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_hitouts(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_hitouts,
-		func(ctx context.Context) (any, error) {
-			return obj.Hitouts, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_markAFLMatchStatsComplete_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "complete", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["complete"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_hitouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_mergeAFLPlayers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "survivorId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["survivorId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "duplicateId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["duplicateId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_tackles(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_tackles,
-		func(ctx context.Context) (any, error) {
-			return obj.Tackles, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_previewAFLMatchStatsImport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "source", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["source"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_proposeAFLPlayerImport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "year", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["year"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "source", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["source"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "players", ec.unmarshalOAFLListedPlayerInput2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLListedPlayerInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["players"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_goals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_goals,
-		func(ctx context.Context) (any, error) {
-			return obj.Goals, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_recalculateAFLLadder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "seasonId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["seasonId"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_rejectAFLPlayerProposals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "proposalIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["proposalIds"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_behinds(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_behinds,
-		func(ctx context.Context) (any, error) {
-			return obj.Behinds, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_resolveAFLPlayerMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNResolveAFLPlayerMatchInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐResolveAFLPlayerMatchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_setAFLPlayerAvailability_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetAFLPlayerAvailabilityInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐSetAFLPlayerAvailabilityInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_disposals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AFLPlayerMatch_disposals,
-		func(ctx context.Context) (any, error) {
-			return obj.Disposals, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_setupAFLSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "year", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["year"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "source", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["source"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_disposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+func (ec *executionContext) field_Mutation_updateAFLPlayerMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateAFLPlayerMatchInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUpdateAFLPlayerMatchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_verifyAFLMatchStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "threshold", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["threshold"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query__entities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "representations", ec.unmarshalN_Any2ᚕmapᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["representations"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflClub_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflMatch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflPlayerDuplicates_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "minConfidence", ec.unmarshalOFloat2ᚖfloat64)
+	if err != nil {
+		return nil, err
+	}
+	args["minConfidence"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflPlayerSearch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflPlayerSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflRound_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aflSeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AFLClub_id(ctx context.Context, field graphql.CollectedField, obj *AFLClub) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLClub_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLClub_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLClub",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerMatch_score(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClub_name(ctx context.Context, field graphql.CollectedField, obj *AFLClub) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerMatch_score,
+		ec.fieldContext_AFLClub_name,
 		func(ctx context.Context) (any, error) {
-			return obj.Score, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerMatch_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClub_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerMatch",
+		Object:     "AFLClub",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeason_id,
+		ec.fieldContext_AFLClubMatch_id,
 		func(ctx context.Context) (any, error) {
 			return obj.ID, nil
 		},
@@ -3258,9 +3343,9 @@ func (ec *executionContext) _AFLPlayerSeason_id(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeason",
+		Object:     "AFLClubMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3271,102 +3356,136 @@ func (ec *executionContext) fieldContext_AFLPlayerSeason_id(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeason_player(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_clubSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeason_player,
+		ec.fieldContext_AFLClubMatch_clubSeasonId,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLPlayerSeason().Player(ctx, obj)
+			return obj.ClubSeasonID, nil
 		},
 		nil,
-		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeason_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_clubSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeason",
+		Object:     "AFLClubMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayer_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLPlayer_name(ctx, field)
-			case "latestPlayerSeason":
-				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeason_clubSeason(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_club(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeason_clubSeason,
+		ec.fieldContext_AFLClubMatch_club,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLPlayerSeason().ClubSeason(ctx, obj)
+			return obj.Club, nil
 		},
 		nil,
-		ec.marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason,
+		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeason_clubSeason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_club(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeason",
+		Object:     "AFLClubMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_AFLClubSeason_id(ctx, field)
-			case "club":
-				return ec.fieldContext_AFLClubSeason_club(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLClubSeason_season(ctx, field)
-			case "played":
-				return ec.fieldContext_AFLClubSeason_played(ctx, field)
-			case "won":
-				return ec.fieldContext_AFLClubSeason_won(ctx, field)
-			case "lost":
-				return ec.fieldContext_AFLClubSeason_lost(ctx, field)
-			case "drawn":
-				return ec.fieldContext_AFLClubSeason_drawn(ctx, field)
-			case "for":
-				return ec.fieldContext_AFLClubSeason_for(ctx, field)
-			case "against":
-				return ec.fieldContext_AFLClubSeason_against(ctx, field)
-			case "premiershipPoints":
-				return ec.fieldContext_AFLClubSeason_premiershipPoints(ctx, field)
+				return ec.fieldContext_AFLClub_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLClub_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClubSeason", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeason_matches(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_rushedBehinds(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeason_matches,
+		ec.fieldContext_AFLClubMatch_rushedBehinds,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLPlayerSeason().Matches(ctx, obj)
+			return obj.RushedBehinds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLClubMatch_rushedBehinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLClubMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLClubMatch_score(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLClubMatch_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLClubMatch_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLClubMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLClubMatch_playerMatches(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLClubMatch_playerMatches,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLClubMatch().PlayerMatches(ctx, obj)
 		},
 		nil,
 		ec.marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatchᚄ,
@@ -3375,9 +3494,9 @@ func (ec *executionContext) _AFLPlayerSeason_matches(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeason_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_playerMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeason",
+		Object:     "AFLClubMatch",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -3413,6 +3532,8 @@ func (ec *executionContext) fieldContext_AFLPlayerSeason_matches(_ context.Conte
 				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
 			case "score":
 				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+			case "extraStats":
+				return ec.fieldContext_AFLPlayerMatch_extraStats(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
 		},
@@ -3420,795 +3541,641 @@ func (ec *executionContext) fieldContext_AFLPlayerSeason_matches(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeasonConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeasonConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_quarterScores(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeasonConnection_nodes,
+		ec.fieldContext_AFLClubMatch_quarterScores,
 		func(ctx context.Context) (any, error) {
-			return obj.Nodes, nil
+			return ec.Resolvers.AFLClubMatch().QuarterScores(ctx, obj)
 		},
 		nil,
-		ec.marshalNAFLPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonᚄ,
+		ec.marshalNAFLQuarterScore2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScoreᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeasonConnection_nodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_quarterScores(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeasonConnection",
+		Object:     "AFLClubMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
-			case "clubSeason":
-				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			case "quarter":
+				return ec.fieldContext_AFLQuarterScore_quarter(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLQuarterScore_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLQuarterScore_behinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLQuarterScore_score(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLQuarterScore", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLPlayerSeasonConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeasonConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_matchId(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLPlayerSeasonConnection_pageInfo,
+		ec.fieldContext_AFLClubMatch_matchId,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.MatchID, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐPageInfo,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLPlayerSeasonConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLPlayerSeasonConnection",
+		Object:     "AFLClubMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_PageInfo_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLRound_id(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatch_match(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLRound_id,
+		ec.fieldContext_AFLClubMatch_match,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return ec.Resolvers.AFLClubMatch().Match(ctx, obj)
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLRound_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatch_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLRound",
+		Object:     "AFLClubMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLMatch_id(ctx, field)
+			case "venue":
+				return ec.fieldContext_AFLMatch_venue(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AFLMatch_startTime(ctx, field)
+			case "result":
+				return ec.fieldContext_AFLMatch_result(ctx, field)
+			case "dataStatus":
+				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
+			case "round":
+				return ec.fieldContext_AFLMatch_round(ctx, field)
+			case "homeClubMatch":
+				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
+			case "awayClubMatch":
+				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
+			case "statsDiscrepancies":
+				return ec.fieldContext_AFLMatch_statsDiscrepancies(ctx, field)
+			case "timeline":
+				return ec.fieldContext_AFLMatch_timeline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLRound_name(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLRound_name,
+		ec.fieldContext_AFLClubMatchStatsPreview_clubMatchId,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.ClubMatchID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLRound_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLRound",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLRound_season(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_clubName(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLRound_season,
+		ec.fieldContext_AFLClubMatchStatsPreview_clubName,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLRound().Season(ctx, obj)
+			return obj.ClubName, nil
 		},
 		nil,
-		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLRound_season(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_clubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLRound",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLSeason_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLSeason_name(ctx, field)
-			case "ladder":
-				return ec.fieldContext_AFLSeason_ladder(ctx, field)
-			case "rounds":
-				return ec.fieldContext_AFLSeason_rounds(ctx, field)
-			case "playerSeasons":
-				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLRound_matches(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_oldScore(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLRound_matches,
+		ec.fieldContext_AFLClubMatchStatsPreview_oldScore,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLRound().Matches(ctx, obj)
+			return obj.OldScore, nil
 		},
 		nil,
-		ec.marshalNAFLMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLRound_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_oldScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLRound",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLMatch_id(ctx, field)
-			case "venue":
-				return ec.fieldContext_AFLMatch_venue(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AFLMatch_startTime(ctx, field)
-			case "result":
-				return ec.fieldContext_AFLMatch_result(ctx, field)
-			case "dataStatus":
-				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
-			case "round":
-				return ec.fieldContext_AFLMatch_round(ctx, field)
-			case "homeClubMatch":
-				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
-			case "awayClubMatch":
-				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_newScore(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLSeason_id,
+		ec.fieldContext_AFLClubMatchStatsPreview_newScore,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.NewScore, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_newScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLSeason",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLSeason_name(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_oldRushedBehinds(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLSeason_name,
+		ec.fieldContext_AFLClubMatchStatsPreview_oldRushedBehinds,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.OldRushedBehinds, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLSeason_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_oldRushedBehinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLSeason",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLSeason_ladder(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_newRushedBehinds(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLSeason_ladder,
+		ec.fieldContext_AFLClubMatchStatsPreview_newRushedBehinds,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLSeason().Ladder(ctx, obj)
+			return obj.NewRushedBehinds, nil
 		},
 		nil,
-		ec.marshalNAFLClubSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeasonᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLSeason_ladder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_newRushedBehinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLSeason",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClubSeason_id(ctx, field)
-			case "club":
-				return ec.fieldContext_AFLClubSeason_club(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLClubSeason_season(ctx, field)
-			case "played":
-				return ec.fieldContext_AFLClubSeason_played(ctx, field)
-			case "won":
-				return ec.fieldContext_AFLClubSeason_won(ctx, field)
-			case "lost":
-				return ec.fieldContext_AFLClubSeason_lost(ctx, field)
-			case "drawn":
-				return ec.fieldContext_AFLClubSeason_drawn(ctx, field)
-			case "for":
-				return ec.fieldContext_AFLClubSeason_for(ctx, field)
-			case "against":
-				return ec.fieldContext_AFLClubSeason_against(ctx, field)
-			case "premiershipPoints":
-				return ec.fieldContext_AFLClubSeason_premiershipPoints(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClubSeason", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLSeason_rounds(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubMatchStatsPreview_players(ctx context.Context, field graphql.CollectedField, obj *AFLClubMatchStatsPreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLSeason_rounds,
+		ec.fieldContext_AFLClubMatchStatsPreview_players,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.AFLSeason().Rounds(ctx, obj)
+			return obj.Players, nil
 		},
 		nil,
-		ec.marshalNAFLRound2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundᚄ,
+		ec.marshalNAFLPlayerStatsDiff2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiffᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLSeason_rounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubMatchStatsPreview_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLSeason",
+		Object:     "AFLClubMatchStatsPreview",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLRound_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLRound_name(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLRound_season(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerStatsDiff_playerSeasonId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLPlayerStatsDiff_playerName(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLPlayerStatsDiff_kind(ctx, field)
+			case "changes":
+				return ec.fieldContext_AFLPlayerStatsDiff_changes(ctx, field)
+			case "kicks":
+				return ec.fieldContext_AFLPlayerStatsDiff_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_AFLPlayerStatsDiff_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_AFLPlayerStatsDiff_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_AFLPlayerStatsDiff_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_AFLPlayerStatsDiff_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLPlayerStatsDiff_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLPlayerStatsDiff_behinds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerStatsDiff", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AFLSeason_playerSeasons(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AFLSeason_playerSeasons,
+		ec.fieldContext_AFLClubSeason_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.AFLSeason().PlayerSeasons(ctx, obj, fc.Args["filter"].(*AFLPlayerSeasonFilter), fc.Args["first"].(*int), fc.Args["after"].(*string))
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerSeasonConnection2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AFLSeason_playerSeasons(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AFLSeason",
+		Object:     "AFLClubSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "nodes":
-				return ec.fieldContext_AFLPlayerSeasonConnection_nodes(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_AFLPlayerSeasonConnection_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeasonConnection", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_AFLSeason_playerSeasons_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findAFLPlayerByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_club(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Entity_findAFLPlayerByID,
+		ec.fieldContext_AFLClubSeason_club,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Entity().FindAFLPlayerByID(ctx, fc.Args["id"].(string))
+			return obj.Club, nil
 		},
 		nil,
-		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
+		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Entity_findAFLPlayerByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_club(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AFLClubSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_AFLPlayer_id(ctx, field)
+				return ec.fieldContext_AFLClub_id(ctx, field)
 			case "name":
-				return ec.fieldContext_AFLPlayer_name(ctx, field)
-			case "latestPlayerSeason":
-				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+				return ec.fieldContext_AFLClub_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findAFLPlayerByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findAFLPlayerMatchByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_season(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Entity_findAFLPlayerMatchByID,
+		ec.fieldContext_AFLClubSeason_season,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Entity().FindAFLPlayerMatchByID(ctx, fc.Args["id"].(string))
+			return ec.Resolvers.AFLClubSeason().Season(ctx, obj)
 		},
 		nil,
-		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
+		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Entity_findAFLPlayerMatchByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_season(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AFLClubSeason",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
-			case "playerSeasonId":
-				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
-			case "clubMatchId":
-				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
-			case "clubMatch":
-				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
-			case "status":
-				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
-			case "kicks":
-				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
-			case "handballs":
-				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
-			case "marks":
-				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
-			case "hitouts":
-				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
-			case "tackles":
-				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
-			case "goals":
-				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
-			case "behinds":
-				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
-			case "disposals":
-				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+				return ec.fieldContext_AFLSeason_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLSeason_name(ctx, field)
+			case "ladder":
+				return ec.fieldContext_AFLSeason_ladder(ctx, field)
+			case "rounds":
+				return ec.fieldContext_AFLSeason_rounds(ctx, field)
+			case "playerSeasons":
+				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "playerProposals":
+				return ec.fieldContext_AFLSeason_playerProposals(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findAFLPlayerMatchByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findAFLPlayerSeasonByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_played(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Entity_findAFLPlayerSeasonByID,
+		ec.fieldContext_AFLClubSeason_played,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Entity().FindAFLPlayerSeasonByID(ctx, fc.Args["id"].(string))
+			return obj.Played, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Entity_findAFLPlayerSeasonByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_played(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AFLClubSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
-			case "clubSeason":
-				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findAFLPlayerSeasonByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findAFLRoundByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_won(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Entity_findAFLRoundByID,
+		ec.fieldContext_AFLClubSeason_won,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Entity().FindAFLRoundByID(ctx, fc.Args["id"].(string))
+			return obj.Won, nil
 		},
 		nil,
-		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Entity_findAFLRoundByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_won(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AFLClubSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLRound_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLRound_name(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLRound_season(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLRound_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findAFLRoundByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findAFLSeasonByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_lost(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Entity_findAFLSeasonByID,
+		ec.fieldContext_AFLClubSeason_lost,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Entity().FindAFLSeasonByID(ctx, fc.Args["id"].(string))
+			return obj.Lost, nil
 		},
 		nil,
-		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Entity_findAFLSeasonByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_lost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AFLClubSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLSeason_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLSeason_name(ctx, field)
-			case "ladder":
-				return ec.fieldContext_AFLSeason_ladder(ctx, field)
-			case "rounds":
-				return ec.fieldContext_AFLSeason_rounds(ctx, field)
-			case "playerSeasons":
-				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findAFLSeasonByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_matchId(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_drawn(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_matchId,
+		ec.fieldContext_AFLClubSeason_drawn,
 		func(ctx context.Context) (any, error) {
-			return obj.MatchID, nil
+			return obj.Drawn, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_drawn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLClubSeason",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_homeClubName(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_for(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_homeClubName,
+		ec.fieldContext_AFLClubSeason_for,
 		func(ctx context.Context) (any, error) {
-			return obj.HomeClubName, nil
+			return obj.For, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_homeClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_for(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLClubSeason",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_awayClubName(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_against(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_awayClubName,
+		ec.fieldContext_AFLClubSeason_against,
 		func(ctx context.Context) (any, error) {
-			return obj.AwayClubName, nil
+			return obj.Against, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_awayClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_against(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLClubSeason",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_homePlayerCount(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLClubSeason_premiershipPoints(ctx context.Context, field graphql.CollectedField, obj *AFLClubSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_homePlayerCount,
+		ec.fieldContext_AFLClubSeason_premiershipPoints,
 		func(ctx context.Context) (any, error) {
-			return obj.HomePlayerCount, nil
+			return obj.PremiershipPoints, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -4217,9 +4184,9 @@ func (ec *executionContext) _ImportAFLMatchStatsResult_homePlayerCount(ctx conte
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_homePlayerCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLClubSeason_premiershipPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLClubSeason",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4230,869 +4197,669 @@ func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_homePlayerCou
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_awayPlayerCount(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLLiveRound_round(ctx context.Context, field graphql.CollectedField, obj *AFLLiveRound) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_awayPlayerCount,
+		ec.fieldContext_AFLLiveRound_round,
 		func(ctx context.Context) (any, error) {
-			return obj.AwayPlayerCount, nil
+			return obj.Round, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_awayPlayerCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLLiveRound_round(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLLiveRound",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLRound_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLRound_name(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLRound_season(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "availability":
+				return ec.fieldContext_AFLRound_availability(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImportAFLMatchStatsResult_unmatchedPlayers(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLLiveRound_startDate(ctx context.Context, field graphql.CollectedField, obj *AFLLiveRound) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers,
+		ec.fieldContext_AFLLiveRound_startDate,
 		func(ctx context.Context) (any, error) {
-			return obj.UnmatchedPlayers, nil
+			return obj.StartDate, nil
 		},
 		nil,
-		ec.marshalNUnmatchedAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUnmatchedAFLPlayerᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLLiveRound_startDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImportAFLMatchStatsResult",
+		Object:     "AFLLiveRound",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "parsedName":
-				return ec.fieldContext_UnmatchedAFLPlayer_parsedName(ctx, field)
-			case "clubMatchId":
-				return ec.fieldContext_UnmatchedAFLPlayer_clubMatchId(ctx, field)
-			case "kicks":
-				return ec.fieldContext_UnmatchedAFLPlayer_kicks(ctx, field)
-			case "handballs":
-				return ec.fieldContext_UnmatchedAFLPlayer_handballs(ctx, field)
-			case "marks":
-				return ec.fieldContext_UnmatchedAFLPlayer_marks(ctx, field)
-			case "hitouts":
-				return ec.fieldContext_UnmatchedAFLPlayer_hitouts(ctx, field)
-			case "tackles":
-				return ec.fieldContext_UnmatchedAFLPlayer_tackles(ctx, field)
-			case "goals":
-				return ec.fieldContext_UnmatchedAFLPlayer_goals(ctx, field)
-			case "behinds":
-				return ec.fieldContext_UnmatchedAFLPlayer_behinds(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UnmatchedAFLPlayer", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addAFLPlayer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addAFLPlayer,
+		ec.fieldContext_AFLMatch_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().AddAFLPlayer(ctx, fc.Args["input"].(AddAFLPlayerInput))
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addAFLPlayer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
-			case "clubSeason":
-				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addAFLPlayer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addAFLPlayerSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_venue(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addAFLPlayerSeason,
+		ec.fieldContext_AFLMatch_venue,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().AddAFLPlayerSeason(ctx, fc.Args["input"].(AddAFLPlayerSeasonInput))
+			return obj.Venue, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addAFLPlayerSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
-			case "clubSeason":
-				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addAFLPlayerSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_startTime(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateAFLPlayerMatch,
+		ec.fieldContext_AFLMatch_startTime,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().UpdateAFLPlayerMatch(ctx, fc.Args["input"].(UpdateAFLPlayerMatchInput))
+			return obj.StartTime, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_startTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
-			case "playerSeasonId":
-				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
-			case "clubMatchId":
-				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
-			case "clubMatch":
-				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
-			case "status":
-				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
-			case "kicks":
-				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
-			case "handballs":
-				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
-			case "marks":
-				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
-			case "hitouts":
-				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
-			case "tackles":
-				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
-			case "goals":
-				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
-			case "behinds":
-				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
-			case "disposals":
-				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateAFLPlayerMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_importAFLMatchStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_result(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_importAFLMatchStats,
+		ec.fieldContext_AFLMatch_result,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().ImportAFLMatchStats(ctx, fc.Args["matchId"].(string))
+			return obj.Result, nil
 		},
 		nil,
-		ec.marshalNImportAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLMatchStatsResult,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_importAFLMatchStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_result(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "matchId":
-				return ec.fieldContext_ImportAFLMatchStatsResult_matchId(ctx, field)
-			case "homeClubName":
-				return ec.fieldContext_ImportAFLMatchStatsResult_homeClubName(ctx, field)
-			case "awayClubName":
-				return ec.fieldContext_ImportAFLMatchStatsResult_awayClubName(ctx, field)
-			case "homePlayerCount":
-				return ec.fieldContext_ImportAFLMatchStatsResult_homePlayerCount(ctx, field)
-			case "awayPlayerCount":
-				return ec.fieldContext_ImportAFLMatchStatsResult_awayPlayerCount(ctx, field)
-			case "unmatchedPlayers":
-				return ec.fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImportAFLMatchStatsResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_importAFLMatchStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_resolveAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_dataStatus(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_resolveAFLPlayerMatch,
+		ec.fieldContext_AFLMatch_dataStatus,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().ResolveAFLPlayerMatch(ctx, fc.Args["input"].(ResolveAFLPlayerMatchInput))
+			return obj.DataStatus, nil
 		},
 		nil,
-		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_resolveAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_dataStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
-			case "playerSeasonId":
-				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
-			case "clubMatchId":
-				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
-			case "clubMatch":
-				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
-			case "player":
-				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
-			case "status":
-				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
-			case "kicks":
-				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
-			case "handballs":
-				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
-			case "marks":
-				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
-			case "hitouts":
-				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
-			case "tackles":
-				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
-			case "goals":
-				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
-			case "behinds":
-				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
-			case "disposals":
-				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
-			case "score":
-				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_resolveAFLPlayerMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_markAFLMatchStatsComplete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_round(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_markAFLMatchStatsComplete,
+		ec.fieldContext_AFLMatch_round,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().MarkAFLMatchStatsComplete(ctx, fc.Args["matchId"].(string), fc.Args["complete"].(bool))
+			return ec.Resolvers.AFLMatch().Round(ctx, obj)
 		},
 		nil,
-		ec.marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
+		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_markAFLMatchStatsComplete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_round(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_AFLMatch_id(ctx, field)
-			case "venue":
-				return ec.fieldContext_AFLMatch_venue(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AFLMatch_startTime(ctx, field)
-			case "result":
-				return ec.fieldContext_AFLMatch_result(ctx, field)
-			case "dataStatus":
-				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
-			case "round":
-				return ec.fieldContext_AFLMatch_round(ctx, field)
-			case "homeClubMatch":
-				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
-			case "awayClubMatch":
-				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
+				return ec.fieldContext_AFLRound_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLRound_name(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLRound_season(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "availability":
+				return ec.fieldContext_AFLRound_availability(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_markAFLMatchStatsComplete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_recalculateAFLLadder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_homeClubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_recalculateAFLLadder,
+		ec.fieldContext_AFLMatch_homeClubMatch,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().RecalculateAFLLadder(ctx, fc.Args["seasonId"].(string))
+			return ec.Resolvers.AFLMatch().HomeClubMatch(ctx, obj)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_recalculateAFLLadder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_homeClubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "AFLMatch",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_recalculateAFLLadder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClubMatch_id(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
+			case "club":
+				return ec.fieldContext_AFLClubMatch_club(ctx, field)
+			case "rushedBehinds":
+				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLClubMatch_score(ctx, field)
+			case "playerMatches":
+				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
+			case "quarterScores":
+				return ec.fieldContext_AFLClubMatch_quarterScores(ctx, field)
+			case "matchId":
+				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
+			case "match":
+				return ec.fieldContext_AFLClubMatch_match(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
+		},
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_awayClubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_hasNextPage,
+		ec.fieldContext_AFLMatch_awayClubMatch,
 		func(ctx context.Context) (any, error) {
-			return obj.HasNextPage, nil
+			return ec.Resolvers.AFLMatch().AwayClubMatch(ctx, obj)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_awayClubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClubMatch_id(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
+			case "club":
+				return ec.fieldContext_AFLClubMatch_club(ctx, field)
+			case "rushedBehinds":
+				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLClubMatch_score(ctx, field)
+			case "playerMatches":
+				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
+			case "quarterScores":
+				return ec.fieldContext_AFLClubMatch_quarterScores(ctx, field)
+			case "matchId":
+				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
+			case "match":
+				return ec.fieldContext_AFLClubMatch_match(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_statsDiscrepancies(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_endCursor,
+		ec.fieldContext_AFLMatch_statsDiscrepancies,
 		func(ctx context.Context) (any, error) {
-			return obj.EndCursor, nil
+			return ec.Resolvers.AFLMatch().StatsDiscrepancies(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNAFLStatsDiscrepancy2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_statsDiscrepancies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "clubMatchId":
+				return ec.fieldContext_AFLStatsDiscrepancy_clubMatchId(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLStatsDiscrepancy_kind(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLStatsDiscrepancy_playerSeasonId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLStatsDiscrepancy_playerName(ctx, field)
+			case "stat":
+				return ec.fieldContext_AFLStatsDiscrepancy_stat(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLStatsDiscrepancy_source(ctx, field)
+			case "value":
+				return ec.fieldContext_AFLStatsDiscrepancy_value(ctx, field)
+			case "otherSource":
+				return ec.fieldContext_AFLStatsDiscrepancy_otherSource(ctx, field)
+			case "otherValue":
+				return ec.fieldContext_AFLStatsDiscrepancy_otherValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStatsDiscrepancy", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_totalCount(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatch_timeline(ctx context.Context, field graphql.CollectedField, obj *AFLMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_totalCount,
+		ec.fieldContext_AFLMatch_timeline,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
+			return ec.Resolvers.AFLMatch().Timeline(ctx, obj)
 		},
 		nil,
-		ec.marshalOInt2ᚖint,
+		ec.marshalOAFLMatchTimeline2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchTimeline,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatch_timeline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AFLMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "breaks":
+				return ec.fieldContext_AFLMatchTimeline_breaks(ctx, field)
+			case "comebackWin":
+				return ec.fieldContext_AFLMatchTimeline_comebackWin(ctx, field)
+			case "comebackDeficit":
+				return ec.fieldContext_AFLMatchTimeline_comebackDeficit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMatchTimeline", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflSeasons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatchTimeline_breaks(ctx context.Context, field graphql.CollectedField, obj *AFLMatchTimeline) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflSeasons,
+		ec.fieldContext_AFLMatchTimeline_breaks,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().AflSeasons(ctx)
+			return obj.Breaks, nil
 		},
 		nil,
-		ec.marshalNAFLSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonᚄ,
+		ec.marshalNAFLQuarterBreak2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterBreakᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflSeasons(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatchTimeline_breaks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLMatchTimeline",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLSeason_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLSeason_name(ctx, field)
-			case "ladder":
-				return ec.fieldContext_AFLSeason_ladder(ctx, field)
-			case "rounds":
-				return ec.fieldContext_AFLSeason_rounds(ctx, field)
-			case "playerSeasons":
-				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "quarter":
+				return ec.fieldContext_AFLQuarterBreak_quarter(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_AFLQuarterBreak_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_AFLQuarterBreak_awayScore(ctx, field)
+			case "margin":
+				return ec.fieldContext_AFLQuarterBreak_margin(ctx, field)
+			case "quarterResult":
+				return ec.fieldContext_AFLQuarterBreak_quarterResult(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLQuarterBreak", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatchTimeline_comebackWin(ctx context.Context, field graphql.CollectedField, obj *AFLMatchTimeline) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflSeason,
+		ec.fieldContext_AFLMatchTimeline_comebackWin,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflSeason(ctx, fc.Args["id"].(string))
+			return obj.ComebackWin, nil
 		},
 		nil,
-		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatchTimeline_comebackWin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLMatchTimeline",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLSeason_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLSeason_name(ctx, field)
-			case "ladder":
-				return ec.fieldContext_AFLSeason_ladder(ctx, field)
-			case "rounds":
-				return ec.fieldContext_AFLSeason_rounds(ctx, field)
-			case "playerSeasons":
-				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMatchTimeline_comebackDeficit(ctx context.Context, field graphql.CollectedField, obj *AFLMatchTimeline) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflRound,
+		ec.fieldContext_AFLMatchTimeline_comebackDeficit,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflRound(ctx, fc.Args["id"].(string))
+			return obj.ComebackDeficit, nil
 		},
 		nil,
-		ec.marshalOAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflRound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMatchTimeline_comebackDeficit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLMatchTimeline",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLRound_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLRound_name(ctx, field)
-			case "season":
-				return ec.fieldContext_AFLRound_season(ctx, field)
-			case "matches":
-				return ec.fieldContext_AFLRound_matches(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflRound_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMergedPlayerSeason_duplicateId(ctx context.Context, field graphql.CollectedField, obj *AFLMergedPlayerSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflMatch,
+		ec.fieldContext_AFLMergedPlayerSeason_duplicateId,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflMatch(ctx, fc.Args["id"].(string))
+			return obj.DuplicateID, nil
 		},
 		nil,
-		ec.marshalOAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMergedPlayerSeason_duplicateId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLMergedPlayerSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLMatch_id(ctx, field)
-			case "venue":
-				return ec.fieldContext_AFLMatch_venue(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AFLMatch_startTime(ctx, field)
-			case "result":
-				return ec.fieldContext_AFLMatch_result(ctx, field)
-			case "dataStatus":
-				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
-			case "round":
-				return ec.fieldContext_AFLMatch_round(ctx, field)
-			case "homeClubMatch":
-				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
-			case "awayClubMatch":
-				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflClubs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLMergedPlayerSeason_survivorId(ctx context.Context, field graphql.CollectedField, obj *AFLMergedPlayerSeason) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflClubs,
+		ec.fieldContext_AFLMergedPlayerSeason_survivorId,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().AflClubs(ctx)
+			return obj.SurvivorID, nil
 		},
 		nil,
-		ec.marshalNAFLClub2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubᚄ,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflClubs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLMergedPlayerSeason_survivorId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLMergedPlayerSeason",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClub_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLClub_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflClub(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayer_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflClub,
+		ec.fieldContext_AFLPlayer_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflClub(ctx, fc.Args["id"].(string))
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflClub(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayer_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayer",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLClub_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLClub_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflClub_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayer_name(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayer_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayer_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflPlayerSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayer_latestPlayerSeason(ctx context.Context, field graphql.CollectedField, obj *AFLPlayer) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflPlayerSeason,
+		ec.fieldContext_AFLPlayer_latestPlayerSeason,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflPlayerSeason(ctx, fc.Args["id"].(string))
+			return obj.LatestPlayerSeason, nil
 		},
 		nil,
 		ec.marshalOAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
@@ -5101,12 +4868,12 @@ func (ec *executionContext) _Query_aflPlayerSeason(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflPlayerSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayer_latestPlayerSeason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayer",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -5121,294 +4888,201 @@ func (ec *executionContext) fieldContext_Query_aflPlayerSeason(ctx context.Conte
 			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflPlayerSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflLiveRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflLiveRound,
+		ec.fieldContext_AFLPlayerAvailability_id,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().AflLiveRound(ctx)
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOAFLLiveRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLLiveRound,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflLiveRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "round":
-				return ec.fieldContext_AFLLiveRound_round(ctx, field)
-			case "startDate":
-				return ec.fieldContext_AFLLiveRound_startDate(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLLiveRound", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aflPlayerSearch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aflPlayerSearch,
+		ec.fieldContext_AFLPlayerAvailability_playerSeasonId,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AflPlayerSearch(ctx, fc.Args["query"].(string))
+			return obj.PlayerSeasonID, nil
 		},
 		nil,
-		ec.marshalNAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerᚄ,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aflPlayerSearch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AFLPlayer_id(ctx, field)
-			case "name":
-				return ec.fieldContext_AFLPlayer_name(ctx, field)
-			case "latestPlayerSeason":
-				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aflPlayerSearch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_playerSeason(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query__entities,
+		ec.fieldContext_AFLPlayerAvailability_playerSeason,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]any)), nil
+			return obj.PlayerSeason, nil
 		},
 		nil,
-		ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity,
+		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_playerSeason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type _Entity does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_roundId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query__service,
+		ec.fieldContext_AFLPlayerAvailability_roundId,
 		func(ctx context.Context) (any, error) {
-			return ec.__resolve__service(ctx)
+			return obj.RoundID, nil
 		},
 		nil,
-		ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query__service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_roundId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "sdl":
-				return ec.fieldContext__Service_sdl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_status(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_AFLPlayerAvailability_status,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.IntrospectType(fc.Args["name"].(string))
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_expectedReturn(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_AFLPlayerAvailability_expectedReturn,
 		func(ctx context.Context) (any, error) {
-			return ec.IntrospectSchema()
+			return obj.ExpectedReturn, nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_expectedReturn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_parsedName(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerAvailability_source(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerAvailability) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_parsedName,
+		ec.fieldContext_AFLPlayerAvailability_source,
 		func(ctx context.Context) (any, error) {
-			return obj.ParsedName, nil
+			return obj.Source, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -5417,9 +5091,9 @@ func (ec *executionContext) _UnmatchedAFLPlayer_parsedName(ctx context.Context,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_parsedName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerAvailability_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerAvailability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5430,159 +5104,175 @@ func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_parsedName(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerDuplicate_player(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerDuplicate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_clubMatchId,
+		ec.fieldContext_AFLPlayerDuplicate_player,
 		func(ctx context.Context) (any, error) {
-			return obj.ClubMatchID, nil
+			return obj.Player, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerDuplicate_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerDuplicate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_kicks(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerDuplicate_other(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerDuplicate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_kicks,
+		ec.fieldContext_AFLPlayerDuplicate_other,
 		func(ctx context.Context) (any, error) {
-			return obj.Kicks, nil
+			return obj.Other, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_kicks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerDuplicate_other(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerDuplicate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_handballs(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerDuplicate_confidence(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerDuplicate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_handballs,
+		ec.fieldContext_AFLPlayerDuplicate_confidence,
 		func(ctx context.Context) (any, error) {
-			return obj.Handballs, nil
+			return obj.Confidence, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_handballs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerDuplicate_confidence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerDuplicate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_marks(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_seasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_marks,
+		ec.fieldContext_AFLPlayerImport_seasonId,
 		func(ctx context.Context) (any, error) {
-			return obj.Marks, nil
+			return obj.SeasonID, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_marks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_seasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_hitouts(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_seasonName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_hitouts,
+		ec.fieldContext_AFLPlayerImport_seasonName,
 		func(ctx context.Context) (any, error) {
-			return obj.Hitouts, nil
+			return obj.SeasonName, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_hitouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_seasonName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_tackles(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_listedCount(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_tackles,
+		ec.fieldContext_AFLPlayerImport_listedCount,
 		func(ctx context.Context) (any, error) {
-			return obj.Tackles, nil
+			return obj.ListedCount, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -5591,9 +5281,9 @@ func (ec *executionContext) _UnmatchedAFLPlayer_tackles(ctx context.Context, fie
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_listedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5604,14 +5294,14 @@ func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_tackles(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_goals(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_inSeasonCount(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_goals,
+		ec.fieldContext_AFLPlayerImport_inSeasonCount,
 		func(ctx context.Context) (any, error) {
-			return obj.Goals, nil
+			return obj.InSeasonCount, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -5620,9 +5310,9 @@ func (ec *executionContext) _UnmatchedAFLPlayer_goals(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_inSeasonCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5633,14 +5323,14 @@ func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_goals(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _UnmatchedAFLPlayer_behinds(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_decidedCount(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UnmatchedAFLPlayer_behinds,
+		ec.fieldContext_AFLPlayerImport_decidedCount,
 		func(ctx context.Context) (any, error) {
-			return obj.Behinds, nil
+			return obj.DecidedCount, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -5649,9 +5339,9 @@ func (ec *executionContext) _UnmatchedAFLPlayer_behinds(ctx context.Context, fie
 	)
 }
 
-func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_decidedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UnmatchedAFLPlayer",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5662,213 +5352,248 @@ func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_behinds(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.CollectedField, obj *fedruntime.Service) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerImport_proposals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerImport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext__Service_sdl,
+		ec.fieldContext_AFLPlayerImport_proposals,
 		func(ctx context.Context) (any, error) {
-			return obj.SDL, nil
+			return obj.Proposals, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNAFLPlayerProposal2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext__Service_sdl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerImport_proposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "_Service",
+		Object:     "AFLPlayerImport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerProposal_id(ctx, field)
+			case "seasonId":
+				return ec.fieldContext_AFLPlayerProposal_seasonId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerProposal_source(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLPlayerProposal_kind(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerProposal_status(ctx, field)
+			case "listedName":
+				return ec.fieldContext_AFLPlayerProposal_listedName(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_clubSeasonId(ctx, field)
+			case "clubName":
+				return ec.fieldContext_AFLPlayerProposal_clubName(ctx, field)
+			case "playerId":
+				return ec.fieldContext_AFLPlayerProposal_playerId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLPlayerProposal_playerName(ctx, field)
+			case "previousClubName":
+				return ec.fieldContext_AFLPlayerProposal_previousClubName(ctx, field)
+			case "confidence":
+				return ec.fieldContext_AFLPlayerProposal_confidence(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_playerSeasonId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AFLPlayerProposal_createdAt(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_AFLPlayerProposal_decidedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerProposal", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_name,
+		ec.fieldContext_AFLPlayerMatch_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_description,
+		ec.fieldContext_AFLPlayerMatch_playerSeasonId,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.PlayerSeasonID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_isRepeatable,
+		ec.fieldContext_AFLPlayerMatch_clubMatchId,
 		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
+			return obj.ClubMatchID, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_clubMatch(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_locations,
+		ec.fieldContext_AFLPlayerMatch_clubMatch,
 		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
+			return ec.Resolvers.AFLPlayerMatch().ClubMatch(ctx, obj)
 		},
 		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
+		ec.marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatch,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_clubMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClubMatch_id(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLClubMatch_clubSeasonId(ctx, field)
+			case "club":
+				return ec.fieldContext_AFLClubMatch_club(ctx, field)
+			case "rushedBehinds":
+				return ec.fieldContext_AFLClubMatch_rushedBehinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLClubMatch_score(ctx, field)
+			case "playerMatches":
+				return ec.fieldContext_AFLClubMatch_playerMatches(ctx, field)
+			case "quarterScores":
+				return ec.fieldContext_AFLClubMatch_quarterScores(ctx, field)
+			case "matchId":
+				return ec.fieldContext_AFLClubMatch_matchId(ctx, field)
+			case "match":
+				return ec.fieldContext_AFLClubMatch_match(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatch", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_player(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_args,
+		ec.fieldContext_AFLPlayerMatch_player,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.Player, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
 			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_status(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_AFLPlayerMatch_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Status, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -5877,9 +5602,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5890,522 +5615,498 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_kicks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_AFLPlayerMatch_kicks,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Kicks, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_kicks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_handballs(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_AFLPlayerMatch_handballs,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.Handballs, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_handballs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_marks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_AFLPlayerMatch_marks,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Marks, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_marks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_hitouts(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_AFLPlayerMatch_hitouts,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Hitouts, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_hitouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_tackles(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_AFLPlayerMatch_tackles,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Tackles, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_goals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_AFLPlayerMatch_goals,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.Goals, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_behinds(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_AFLPlayerMatch_behinds,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.Behinds, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_disposals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_AFLPlayerMatch_disposals,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.Disposals, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_disposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_score(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_AFLPlayerMatch_score,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Score, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMatch_extraStats(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMatch) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_AFLPlayerMatch_extraStats,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.ExtraStats, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNAFLStat2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMatch_extraStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerMatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_AFLStat_name(ctx, field)
+			case "value":
+				return ec.fieldContext_AFLStat_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStat", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMerge_survivor(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMerge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_AFLPlayerMerge_survivor,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Survivor, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMerge_survivor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerMerge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMerge_duplicateId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMerge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_AFLPlayerMerge_duplicateId,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.DuplicateID, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMerge_duplicateId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerMerge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMerge_movedPlayerSeasonIds(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMerge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_AFLPlayerMerge_movedPlayerSeasonIds,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return obj.MovedPlayerSeasonIds, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2ᚕstringᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMerge_movedPlayerSeasonIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerMerge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerMerge_mergedPlayerSeasons(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerMerge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_AFLPlayerMerge_mergedPlayerSeasons,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.MergedPlayerSeasons, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNAFLMergedPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMergedPlayerSeasonᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerMerge_mergedPlayerSeasons(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerMerge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "duplicateId":
+				return ec.fieldContext_AFLMergedPlayerSeason_duplicateId(ctx, field)
+			case "survivorId":
+				return ec.fieldContext_AFLMergedPlayerSeason_survivorId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMergedPlayerSeason", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_AFLPlayerProposal_id,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_seasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_AFLPlayerProposal_seasonId,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.SeasonID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerProposal_seasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerProposal_source(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerProposal_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -6414,11 +6115,11 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6427,296 +6128,188 @@ func (ec *executionContext) fieldContext___Schema_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_kind(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_AFLPlayerProposal_kind,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			return obj.Kind, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNAFLPlayerProposalKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalKind,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type AFLPlayerProposalKind does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_status(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_AFLPlayerProposal_status,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNAFLPlayerProposalStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type AFLPlayerProposalStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_listedName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_AFLPlayerProposal_listedName,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			return obj.ListedName, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_listedName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_clubSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_AFLPlayerProposal_clubSeasonId,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			return obj.ClubSeasonID, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_clubSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_clubName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_AFLPlayerProposal_clubName,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			return obj.ClubName, nil
 		},
 		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_clubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_playerId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_AFLPlayerProposal_playerId,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return obj.PlayerID, nil
 		},
 		nil,
-		ec.marshalN__TypeKind2string,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_playerId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_playerName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_AFLPlayerProposal_playerName,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return obj.PlayerName, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -6725,11 +6318,11 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AFLPlayerProposal_playerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "AFLPlayerProposal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6738,14 +6331,14 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _AFLPlayerProposal_previousClubName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_AFLPlayerProposal_previousClubName,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.PreviousClubName, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -6754,746 +6347,8924 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) fieldContext_AFLPlayerProposal_previousClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerProposal_confidence(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerProposal_confidence,
+		func(ctx context.Context) (any, error) {
+			return obj.Confidence, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerProposal_confidence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerProposal_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerProposal_playerSeasonId,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSeasonID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerProposal_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerProposal_createdAt(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerProposal_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerProposal_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerProposal_decidedAt(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerProposal_decidedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DecidedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerProposal_decidedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeason_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeason_player(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeason_player,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLPlayerSeason().Player(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeason_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeason_clubSeason(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeason_clubSeason,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLPlayerSeason().ClubSeason(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeason_clubSeason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClubSeason_id(ctx, field)
+			case "club":
+				return ec.fieldContext_AFLClubSeason_club(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLClubSeason_season(ctx, field)
+			case "played":
+				return ec.fieldContext_AFLClubSeason_played(ctx, field)
+			case "won":
+				return ec.fieldContext_AFLClubSeason_won(ctx, field)
+			case "lost":
+				return ec.fieldContext_AFLClubSeason_lost(ctx, field)
+			case "drawn":
+				return ec.fieldContext_AFLClubSeason_drawn(ctx, field)
+			case "for":
+				return ec.fieldContext_AFLClubSeason_for(ctx, field)
+			case "against":
+				return ec.fieldContext_AFLClubSeason_against(ctx, field)
+			case "premiershipPoints":
+				return ec.fieldContext_AFLClubSeason_premiershipPoints(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubSeason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeason_matches(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeason_matches,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLPlayerSeason().Matches(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatchᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeason_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
+			case "clubMatch":
+				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
+			case "kicks":
+				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
+			case "disposals":
+				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+			case "extraStats":
+				return ec.fieldContext_AFLPlayerMatch_extraStats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeasonConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeasonConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeasonConnection_nodes,
+		func(ctx context.Context) (any, error) {
+			return obj.Nodes, nil
+		},
+		nil,
+		ec.marshalNAFLPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeasonConnection_nodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeasonConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerSeasonConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerSeasonConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerSeasonConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerSeasonConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerSeasonConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_PageInfo_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_playerSeasonId,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSeasonID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_playerName(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_playerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_playerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_kind(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNAFLPlayerStatsDiffKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiffKind,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AFLPlayerStatsDiffKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_changes(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_changes,
+		func(ctx context.Context) (any, error) {
+			return obj.Changes, nil
+		},
+		nil,
+		ec.marshalNAFLStatChange2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatChangeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stat":
+				return ec.fieldContext_AFLStatChange_stat(ctx, field)
+			case "old":
+				return ec.fieldContext_AFLStatChange_old(ctx, field)
+			case "new":
+				return ec.fieldContext_AFLStatChange_new(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStatChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_kicks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_kicks,
+		func(ctx context.Context) (any, error) {
+			return obj.Kicks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_kicks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_handballs(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_handballs,
+		func(ctx context.Context) (any, error) {
+			return obj.Handballs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_handballs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_marks(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_marks,
+		func(ctx context.Context) (any, error) {
+			return obj.Marks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_marks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_hitouts(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_hitouts,
+		func(ctx context.Context) (any, error) {
+			return obj.Hitouts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_hitouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_tackles(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_tackles,
+		func(ctx context.Context) (any, error) {
+			return obj.Tackles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_goals(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_goals,
+		func(ctx context.Context) (any, error) {
+			return obj.Goals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLPlayerStatsDiff_behinds(ctx context.Context, field graphql.CollectedField, obj *AFLPlayerStatsDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLPlayerStatsDiff_behinds,
+		func(ctx context.Context) (any, error) {
+			return obj.Behinds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLPlayerStatsDiff_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLPlayerStatsDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterBreak_quarter(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterBreak) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterBreak_quarter,
+		func(ctx context.Context) (any, error) {
+			return obj.Quarter, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterBreak_quarter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterBreak",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterBreak_homeScore(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterBreak) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterBreak_homeScore,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeScore, nil
+		},
+		nil,
+		ec.marshalNAFLQuarterScore2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScore,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterBreak_homeScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterBreak",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "quarter":
+				return ec.fieldContext_AFLQuarterScore_quarter(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLQuarterScore_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLQuarterScore_behinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLQuarterScore_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLQuarterScore", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterBreak_awayScore(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterBreak) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterBreak_awayScore,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayScore, nil
+		},
+		nil,
+		ec.marshalNAFLQuarterScore2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScore,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterBreak_awayScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterBreak",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "quarter":
+				return ec.fieldContext_AFLQuarterScore_quarter(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLQuarterScore_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLQuarterScore_behinds(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLQuarterScore_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLQuarterScore", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterBreak_margin(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterBreak) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterBreak_margin,
+		func(ctx context.Context) (any, error) {
+			return obj.Margin, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterBreak_margin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterBreak",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterBreak_quarterResult(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterBreak) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterBreak_quarterResult,
+		func(ctx context.Context) (any, error) {
+			return obj.QuarterResult, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterBreak_quarterResult(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterBreak",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterScore_quarter(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterScore_quarter,
+		func(ctx context.Context) (any, error) {
+			return obj.Quarter, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterScore_quarter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterScore_goals(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterScore_goals,
+		func(ctx context.Context) (any, error) {
+			return obj.Goals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterScore_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterScore_behinds(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterScore_behinds,
+		func(ctx context.Context) (any, error) {
+			return obj.Behinds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterScore_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLQuarterScore_score(ctx context.Context, field graphql.CollectedField, obj *AFLQuarterScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLQuarterScore_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLQuarterScore_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLQuarterScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRound_id(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRound_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRound_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRound_name(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRound_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRound_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRound_season(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRound_season,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLRound().Season(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRound_season(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRound",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLSeason_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLSeason_name(ctx, field)
+			case "ladder":
+				return ec.fieldContext_AFLSeason_ladder(ctx, field)
+			case "rounds":
+				return ec.fieldContext_AFLSeason_rounds(ctx, field)
+			case "playerSeasons":
+				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "playerProposals":
+				return ec.fieldContext_AFLSeason_playerProposals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRound_matches(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRound_matches,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLRound().Matches(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRound_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRound",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLMatch_id(ctx, field)
+			case "venue":
+				return ec.fieldContext_AFLMatch_venue(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AFLMatch_startTime(ctx, field)
+			case "result":
+				return ec.fieldContext_AFLMatch_result(ctx, field)
+			case "dataStatus":
+				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
+			case "round":
+				return ec.fieldContext_AFLMatch_round(ctx, field)
+			case "homeClubMatch":
+				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
+			case "awayClubMatch":
+				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
+			case "statsDiscrepancies":
+				return ec.fieldContext_AFLMatch_statsDiscrepancies(ctx, field)
+			case "timeline":
+				return ec.fieldContext_AFLMatch_timeline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRound_availability(ctx context.Context, field graphql.CollectedField, obj *AFLRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRound_availability,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLRound().Availability(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLPlayerAvailability2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailabilityᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRound_availability(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRound",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerAvailability_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerAvailability_playerSeasonId(ctx, field)
+			case "playerSeason":
+				return ec.fieldContext_AFLPlayerAvailability_playerSeason(ctx, field)
+			case "roundId":
+				return ec.fieldContext_AFLPlayerAvailability_roundId(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerAvailability_status(ctx, field)
+			case "expectedReturn":
+				return ec.fieldContext_AFLPlayerAvailability_expectedReturn(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerAvailability_source(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerAvailability", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_matchId(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_homeClubName(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_homeClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_homeClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_awayClubName(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_awayClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_awayClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_status(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNAFLRoundMatchImportStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImportStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AFLRoundMatchImportStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_stats(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_stats,
+		func(ctx context.Context) (any, error) {
+			return obj.Stats, nil
+		},
+		nil,
+		ec.marshalOImportAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLMatchStatsResult,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_ImportAFLMatchStatsResult_matchId(ctx, field)
+			case "source":
+				return ec.fieldContext_ImportAFLMatchStatsResult_source(ctx, field)
+			case "homeClubName":
+				return ec.fieldContext_ImportAFLMatchStatsResult_homeClubName(ctx, field)
+			case "awayClubName":
+				return ec.fieldContext_ImportAFLMatchStatsResult_awayClubName(ctx, field)
+			case "homePlayerCount":
+				return ec.fieldContext_ImportAFLMatchStatsResult_homePlayerCount(ctx, field)
+			case "awayPlayerCount":
+				return ec.fieldContext_ImportAFLMatchStatsResult_awayPlayerCount(ctx, field)
+			case "unmatchedPlayers":
+				return ec.fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImportAFLMatchStatsResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_markedFinal(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_markedFinal,
+		func(ctx context.Context) (any, error) {
+			return obj.MarkedFinal, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_markedFinal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLRoundMatchImport_error(ctx context.Context, field graphql.CollectedField, obj *AFLRoundMatchImport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLRoundMatchImport_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLRoundMatchImport_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLRoundMatchImport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_id(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_name(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_ladder(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_ladder,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLSeason().Ladder(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLClubSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeasonᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_ladder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClubSeason_id(ctx, field)
+			case "club":
+				return ec.fieldContext_AFLClubSeason_club(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLClubSeason_season(ctx, field)
+			case "played":
+				return ec.fieldContext_AFLClubSeason_played(ctx, field)
+			case "won":
+				return ec.fieldContext_AFLClubSeason_won(ctx, field)
+			case "lost":
+				return ec.fieldContext_AFLClubSeason_lost(ctx, field)
+			case "drawn":
+				return ec.fieldContext_AFLClubSeason_drawn(ctx, field)
+			case "for":
+				return ec.fieldContext_AFLClubSeason_for(ctx, field)
+			case "against":
+				return ec.fieldContext_AFLClubSeason_against(ctx, field)
+			case "premiershipPoints":
+				return ec.fieldContext_AFLClubSeason_premiershipPoints(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubSeason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_rounds(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_rounds,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.AFLSeason().Rounds(ctx, obj)
+		},
+		nil,
+		ec.marshalNAFLRound2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_rounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLRound_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLRound_name(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLRound_season(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "availability":
+				return ec.fieldContext_AFLRound_availability(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_playerSeasons(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_playerSeasons,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.AFLSeason().PlayerSeasons(ctx, obj, fc.Args["filter"].(*AFLPlayerSeasonFilter), fc.Args["first"].(*int), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNAFLPlayerSeasonConnection2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_playerSeasons(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nodes":
+				return ec.fieldContext_AFLPlayerSeasonConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AFLPlayerSeasonConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeasonConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_AFLSeason_playerSeasons_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeason_playerProposals(ctx context.Context, field graphql.CollectedField, obj *AFLSeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeason_playerProposals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.AFLSeason().PlayerProposals(ctx, obj, fc.Args["status"].(*AFLPlayerProposalStatus))
+		},
+		nil,
+		ec.marshalNAFLPlayerProposal2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeason_playerProposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerProposal_id(ctx, field)
+			case "seasonId":
+				return ec.fieldContext_AFLPlayerProposal_seasonId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerProposal_source(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLPlayerProposal_kind(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerProposal_status(ctx, field)
+			case "listedName":
+				return ec.fieldContext_AFLPlayerProposal_listedName(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_clubSeasonId(ctx, field)
+			case "clubName":
+				return ec.fieldContext_AFLPlayerProposal_clubName(ctx, field)
+			case "playerId":
+				return ec.fieldContext_AFLPlayerProposal_playerId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLPlayerProposal_playerName(ctx, field)
+			case "previousClubName":
+				return ec.fieldContext_AFLPlayerProposal_previousClubName(ctx, field)
+			case "confidence":
+				return ec.fieldContext_AFLPlayerProposal_confidence(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_playerSeasonId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AFLPlayerProposal_createdAt(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_AFLPlayerProposal_decidedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerProposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_AFLSeason_playerProposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_seasonId(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_seasonId,
+		func(ctx context.Context) (any, error) {
+			return obj.SeasonID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_seasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_seasonName(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_seasonName,
+		func(ctx context.Context) (any, error) {
+			return obj.SeasonName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_seasonName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_seasonCreated(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_seasonCreated,
+		func(ctx context.Context) (any, error) {
+			return obj.SeasonCreated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_seasonCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_roundsCreated(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_roundsCreated,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundsCreated, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_roundsCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_clubSeasonsCreated(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_clubSeasonsCreated,
+		func(ctx context.Context) (any, error) {
+			return obj.ClubSeasonsCreated, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_clubSeasonsCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetup_matches(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetup_matches,
+		func(ctx context.Context) (any, error) {
+			return obj.Matches, nil
+		},
+		nil,
+		ec.marshalNAFLSeasonSetupMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatchᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetup_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_AFLSeasonSetupMatch_matchId(ctx, field)
+			case "roundName":
+				return ec.fieldContext_AFLSeasonSetupMatch_roundName(ctx, field)
+			case "homeClubName":
+				return ec.fieldContext_AFLSeasonSetupMatch_homeClubName(ctx, field)
+			case "awayClubName":
+				return ec.fieldContext_AFLSeasonSetupMatch_awayClubName(ctx, field)
+			case "venue":
+				return ec.fieldContext_AFLSeasonSetupMatch_venue(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AFLSeasonSetupMatch_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLSeasonSetupMatch_status(ctx, field)
+			case "changes":
+				return ec.fieldContext_AFLSeasonSetupMatch_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeasonSetupMatch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_matchId(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_roundName(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_roundName,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_roundName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_homeClubName(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_homeClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_homeClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_awayClubName(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_awayClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_awayClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_venue(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_venue,
+		func(ctx context.Context) (any, error) {
+			return obj.Venue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_startTime(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_startTime,
+		func(ctx context.Context) (any, error) {
+			return obj.StartTime, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_startTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_status(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNAFLSeasonSetupMatchStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatchStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AFLSeasonSetupMatchStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLSeasonSetupMatch_changes(ctx context.Context, field graphql.CollectedField, obj *AFLSeasonSetupMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLSeasonSetupMatch_changes,
+		func(ctx context.Context) (any, error) {
+			return obj.Changes, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLSeasonSetupMatch_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLSeasonSetupMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStat_name(ctx context.Context, field graphql.CollectedField, obj *AFLStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStat_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStat_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStat_value(ctx context.Context, field graphql.CollectedField, obj *AFLStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStat_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStat_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatChange_stat(ctx context.Context, field graphql.CollectedField, obj *AFLStatChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatChange_stat,
+		func(ctx context.Context) (any, error) {
+			return obj.Stat, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatChange_stat(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatChange_old(ctx context.Context, field graphql.CollectedField, obj *AFLStatChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatChange_old,
+		func(ctx context.Context) (any, error) {
+			return obj.Old, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatChange_old(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatChange_new(ctx context.Context, field graphql.CollectedField, obj *AFLStatChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatChange_new,
+		func(ctx context.Context) (any, error) {
+			return obj.New, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatChange_new(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_clubMatchId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClubMatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_kind(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNAFLStatsDiscrepancyKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyKind,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AFLStatsDiscrepancyKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_playerSeasonId(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_playerSeasonId,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSeasonID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_playerSeasonId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_playerName(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_playerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_playerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_stat(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_stat,
+		func(ctx context.Context) (any, error) {
+			return obj.Stat, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_stat(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_source(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_value(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_otherSource(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_otherSource,
+		func(ctx context.Context) (any, error) {
+			return obj.OtherSource, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_otherSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsDiscrepancy_otherValue(ctx context.Context, field graphql.CollectedField, obj *AFLStatsDiscrepancy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsDiscrepancy_otherValue,
+		func(ctx context.Context) (any, error) {
+			return obj.OtherValue, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsDiscrepancy_otherValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsDiscrepancy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_id(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_matchId(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_roundId(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_roundId,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_roundId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_source(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_clubMatches(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_clubMatches,
+		func(ctx context.Context) (any, error) {
+			return obj.ClubMatches, nil
+		},
+		nil,
+		ec.marshalNAFLClubMatchStatsPreview2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatchStatsPreviewᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_clubMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "clubMatchId":
+				return ec.fieldContext_AFLClubMatchStatsPreview_clubMatchId(ctx, field)
+			case "clubName":
+				return ec.fieldContext_AFLClubMatchStatsPreview_clubName(ctx, field)
+			case "oldScore":
+				return ec.fieldContext_AFLClubMatchStatsPreview_oldScore(ctx, field)
+			case "newScore":
+				return ec.fieldContext_AFLClubMatchStatsPreview_newScore(ctx, field)
+			case "oldRushedBehinds":
+				return ec.fieldContext_AFLClubMatchStatsPreview_oldRushedBehinds(ctx, field)
+			case "newRushedBehinds":
+				return ec.fieldContext_AFLClubMatchStatsPreview_newRushedBehinds(ctx, field)
+			case "players":
+				return ec.fieldContext_AFLClubMatchStatsPreview_players(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClubMatchStatsPreview", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_unmatchedPlayers(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_unmatchedPlayers,
+		func(ctx context.Context) (any, error) {
+			return obj.UnmatchedPlayers, nil
+		},
+		nil,
+		ec.marshalNUnmatchedAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUnmatchedAFLPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_unmatchedPlayers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "parsedName":
+				return ec.fieldContext_UnmatchedAFLPlayer_parsedName(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_UnmatchedAFLPlayer_clubMatchId(ctx, field)
+			case "kicks":
+				return ec.fieldContext_UnmatchedAFLPlayer_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_UnmatchedAFLPlayer_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_UnmatchedAFLPlayer_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_UnmatchedAFLPlayer_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_UnmatchedAFLPlayer_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_UnmatchedAFLPlayer_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_UnmatchedAFLPlayer_behinds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UnmatchedAFLPlayer", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AFLStatsImportPreview_appliedAt(ctx context.Context, field graphql.CollectedField, obj *AFLStatsImportPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AFLStatsImportPreview_appliedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.AppliedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AFLStatsImportPreview_appliedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AFLStatsImportPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findAFLPlayerByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findAFLPlayerByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Entity().FindAFLPlayerByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findAFLPlayerByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findAFLPlayerByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findAFLPlayerMatchByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findAFLPlayerMatchByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Entity().FindAFLPlayerMatchByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findAFLPlayerMatchByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
+			case "clubMatch":
+				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
+			case "kicks":
+				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
+			case "disposals":
+				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+			case "extraStats":
+				return ec.fieldContext_AFLPlayerMatch_extraStats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findAFLPlayerMatchByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findAFLPlayerSeasonByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findAFLPlayerSeasonByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Entity().FindAFLPlayerSeasonByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findAFLPlayerSeasonByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findAFLPlayerSeasonByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findAFLRoundByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findAFLRoundByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Entity().FindAFLRoundByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findAFLRoundByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLRound_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLRound_name(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLRound_season(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "availability":
+				return ec.fieldContext_AFLRound_availability(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findAFLRoundByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findAFLSeasonByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findAFLSeasonByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Entity().FindAFLSeasonByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findAFLSeasonByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLSeason_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLSeason_name(ctx, field)
+			case "ladder":
+				return ec.fieldContext_AFLSeason_ladder(ctx, field)
+			case "rounds":
+				return ec.fieldContext_AFLSeason_rounds(ctx, field)
+			case "playerSeasons":
+				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "playerProposals":
+				return ec.fieldContext_AFLSeason_playerProposals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findAFLSeasonByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_matchId(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_source(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_homeClubName(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_homeClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_homeClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_awayClubName(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_awayClubName,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayClubName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_awayClubName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_homePlayerCount(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_homePlayerCount,
+		func(ctx context.Context) (any, error) {
+			return obj.HomePlayerCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_homePlayerCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_awayPlayerCount(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_awayPlayerCount,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayPlayerCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_awayPlayerCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult_unmatchedPlayers(ctx context.Context, field graphql.CollectedField, obj *ImportAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers,
+		func(ctx context.Context) (any, error) {
+			return obj.UnmatchedPlayers, nil
+		},
+		nil,
+		ec.marshalNUnmatchedAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUnmatchedAFLPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "parsedName":
+				return ec.fieldContext_UnmatchedAFLPlayer_parsedName(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_UnmatchedAFLPlayer_clubMatchId(ctx, field)
+			case "kicks":
+				return ec.fieldContext_UnmatchedAFLPlayer_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_UnmatchedAFLPlayer_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_UnmatchedAFLPlayer_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_UnmatchedAFLPlayer_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_UnmatchedAFLPlayer_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_UnmatchedAFLPlayer_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_UnmatchedAFLPlayer_behinds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UnmatchedAFLPlayer", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLRoundStatsResult_roundId(ctx context.Context, field graphql.CollectedField, obj *ImportAFLRoundStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLRoundStatsResult_roundId,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLRoundStatsResult_roundId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLRoundStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImportAFLRoundStatsResult_matches(ctx context.Context, field graphql.CollectedField, obj *ImportAFLRoundStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImportAFLRoundStatsResult_matches,
+		func(ctx context.Context) (any, error) {
+			return obj.Matches, nil
+		},
+		nil,
+		ec.marshalNAFLRoundMatchImport2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImportᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImportAFLRoundStatsResult_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportAFLRoundStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_AFLRoundMatchImport_matchId(ctx, field)
+			case "homeClubName":
+				return ec.fieldContext_AFLRoundMatchImport_homeClubName(ctx, field)
+			case "awayClubName":
+				return ec.fieldContext_AFLRoundMatchImport_awayClubName(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLRoundMatchImport_status(ctx, field)
+			case "stats":
+				return ec.fieldContext_AFLRoundMatchImport_stats(ctx, field)
+			case "markedFinal":
+				return ec.fieldContext_AFLRoundMatchImport_markedFinal(ctx, field)
+			case "error":
+				return ec.fieldContext_AFLRoundMatchImport_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLRoundMatchImport", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addAFLPlayer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addAFLPlayer,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().AddAFLPlayer(ctx, fc.Args["input"].(AddAFLPlayerInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addAFLPlayer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addAFLPlayer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addAFLPlayerSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addAFLPlayerSeason,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().AddAFLPlayerSeason(ctx, fc.Args["input"].(AddAFLPlayerSeasonInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addAFLPlayerSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addAFLPlayerSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateAFLPlayerMatch,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().UpdateAFLPlayerMatch(ctx, fc.Args["input"].(UpdateAFLPlayerMatchInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
+			case "clubMatch":
+				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
+			case "kicks":
+				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
+			case "disposals":
+				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+			case "extraStats":
+				return ec.fieldContext_AFLPlayerMatch_extraStats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateAFLPlayerMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_importAFLMatchStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_importAFLMatchStats,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().ImportAFLMatchStats(ctx, fc.Args["matchId"].(string), fc.Args["source"].(*string))
+		},
+		nil,
+		ec.marshalNImportAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLMatchStatsResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_importAFLMatchStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_ImportAFLMatchStatsResult_matchId(ctx, field)
+			case "source":
+				return ec.fieldContext_ImportAFLMatchStatsResult_source(ctx, field)
+			case "homeClubName":
+				return ec.fieldContext_ImportAFLMatchStatsResult_homeClubName(ctx, field)
+			case "awayClubName":
+				return ec.fieldContext_ImportAFLMatchStatsResult_awayClubName(ctx, field)
+			case "homePlayerCount":
+				return ec.fieldContext_ImportAFLMatchStatsResult_homePlayerCount(ctx, field)
+			case "awayPlayerCount":
+				return ec.fieldContext_ImportAFLMatchStatsResult_awayPlayerCount(ctx, field)
+			case "unmatchedPlayers":
+				return ec.fieldContext_ImportAFLMatchStatsResult_unmatchedPlayers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImportAFLMatchStatsResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_importAFLMatchStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_importAFLRoundStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_importAFLRoundStats,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().ImportAFLRoundStats(ctx, fc.Args["roundId"].(string), fc.Args["markFinal"].(*bool), fc.Args["source"].(*string))
+		},
+		nil,
+		ec.marshalNImportAFLRoundStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLRoundStatsResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_importAFLRoundStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "roundId":
+				return ec.fieldContext_ImportAFLRoundStatsResult_roundId(ctx, field)
+			case "matches":
+				return ec.fieldContext_ImportAFLRoundStatsResult_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImportAFLRoundStatsResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_importAFLRoundStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_verifyAFLMatchStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_verifyAFLMatchStats,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().VerifyAFLMatchStats(ctx, fc.Args["matchId"].(string), fc.Args["threshold"].(*int))
+		},
+		nil,
+		ec.marshalNVerifyAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐVerifyAFLMatchStatsResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_verifyAFLMatchStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_VerifyAFLMatchStatsResult_matchId(ctx, field)
+			case "sources":
+				return ec.fieldContext_VerifyAFLMatchStatsResult_sources(ctx, field)
+			case "discrepancies":
+				return ec.fieldContext_VerifyAFLMatchStatsResult_discrepancies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VerifyAFLMatchStatsResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_verifyAFLMatchStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_previewAFLMatchStatsImport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_previewAFLMatchStatsImport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().PreviewAFLMatchStatsImport(ctx, fc.Args["matchId"].(string), fc.Args["source"].(*string))
+		},
+		nil,
+		ec.marshalNAFLStatsImportPreview2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsImportPreview,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_previewAFLMatchStatsImport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLStatsImportPreview_id(ctx, field)
+			case "matchId":
+				return ec.fieldContext_AFLStatsImportPreview_matchId(ctx, field)
+			case "roundId":
+				return ec.fieldContext_AFLStatsImportPreview_roundId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLStatsImportPreview_source(ctx, field)
+			case "clubMatches":
+				return ec.fieldContext_AFLStatsImportPreview_clubMatches(ctx, field)
+			case "unmatchedPlayers":
+				return ec.fieldContext_AFLStatsImportPreview_unmatchedPlayers(ctx, field)
+			case "appliedAt":
+				return ec.fieldContext_AFLStatsImportPreview_appliedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStatsImportPreview", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_previewAFLMatchStatsImport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_applyAFLStatsImportPreview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_applyAFLStatsImportPreview,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().ApplyAFLStatsImportPreview(ctx, fc.Args["previewId"].(string))
+		},
+		nil,
+		ec.marshalNAFLStatsImportPreview2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsImportPreview,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_applyAFLStatsImportPreview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLStatsImportPreview_id(ctx, field)
+			case "matchId":
+				return ec.fieldContext_AFLStatsImportPreview_matchId(ctx, field)
+			case "roundId":
+				return ec.fieldContext_AFLStatsImportPreview_roundId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLStatsImportPreview_source(ctx, field)
+			case "clubMatches":
+				return ec.fieldContext_AFLStatsImportPreview_clubMatches(ctx, field)
+			case "unmatchedPlayers":
+				return ec.fieldContext_AFLStatsImportPreview_unmatchedPlayers(ctx, field)
+			case "appliedAt":
+				return ec.fieldContext_AFLStatsImportPreview_appliedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStatsImportPreview", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_applyAFLStatsImportPreview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setupAFLSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setupAFLSeason,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().SetupAFLSeason(ctx, fc.Args["year"].(int), fc.Args["source"].(*string))
+		},
+		nil,
+		ec.marshalNAFLSeasonSetup2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetup,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setupAFLSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "seasonId":
+				return ec.fieldContext_AFLSeasonSetup_seasonId(ctx, field)
+			case "seasonName":
+				return ec.fieldContext_AFLSeasonSetup_seasonName(ctx, field)
+			case "seasonCreated":
+				return ec.fieldContext_AFLSeasonSetup_seasonCreated(ctx, field)
+			case "roundsCreated":
+				return ec.fieldContext_AFLSeasonSetup_roundsCreated(ctx, field)
+			case "clubSeasonsCreated":
+				return ec.fieldContext_AFLSeasonSetup_clubSeasonsCreated(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLSeasonSetup_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeasonSetup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setupAFLSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_proposeAFLPlayerImport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_proposeAFLPlayerImport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().ProposeAFLPlayerImport(ctx, fc.Args["year"].(int), fc.Args["source"].(*string), fc.Args["players"].([]*AFLListedPlayerInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerImport2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerImport,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_proposeAFLPlayerImport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "seasonId":
+				return ec.fieldContext_AFLPlayerImport_seasonId(ctx, field)
+			case "seasonName":
+				return ec.fieldContext_AFLPlayerImport_seasonName(ctx, field)
+			case "listedCount":
+				return ec.fieldContext_AFLPlayerImport_listedCount(ctx, field)
+			case "inSeasonCount":
+				return ec.fieldContext_AFLPlayerImport_inSeasonCount(ctx, field)
+			case "decidedCount":
+				return ec.fieldContext_AFLPlayerImport_decidedCount(ctx, field)
+			case "proposals":
+				return ec.fieldContext_AFLPlayerImport_proposals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerImport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_proposeAFLPlayerImport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acceptAFLPlayerProposals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acceptAFLPlayerProposals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().AcceptAFLPlayerProposals(ctx, fc.Args["proposalIds"].([]string))
+		},
+		nil,
+		ec.marshalNAFLPlayerProposal2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acceptAFLPlayerProposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerProposal_id(ctx, field)
+			case "seasonId":
+				return ec.fieldContext_AFLPlayerProposal_seasonId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerProposal_source(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLPlayerProposal_kind(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerProposal_status(ctx, field)
+			case "listedName":
+				return ec.fieldContext_AFLPlayerProposal_listedName(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_clubSeasonId(ctx, field)
+			case "clubName":
+				return ec.fieldContext_AFLPlayerProposal_clubName(ctx, field)
+			case "playerId":
+				return ec.fieldContext_AFLPlayerProposal_playerId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLPlayerProposal_playerName(ctx, field)
+			case "previousClubName":
+				return ec.fieldContext_AFLPlayerProposal_previousClubName(ctx, field)
+			case "confidence":
+				return ec.fieldContext_AFLPlayerProposal_confidence(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_playerSeasonId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AFLPlayerProposal_createdAt(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_AFLPlayerProposal_decidedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerProposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acceptAFLPlayerProposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_rejectAFLPlayerProposals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_rejectAFLPlayerProposals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RejectAFLPlayerProposals(ctx, fc.Args["proposalIds"].([]string))
+		},
+		nil,
+		ec.marshalNAFLPlayerProposal2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_rejectAFLPlayerProposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerProposal_id(ctx, field)
+			case "seasonId":
+				return ec.fieldContext_AFLPlayerProposal_seasonId(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerProposal_source(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLPlayerProposal_kind(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerProposal_status(ctx, field)
+			case "listedName":
+				return ec.fieldContext_AFLPlayerProposal_listedName(ctx, field)
+			case "clubSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_clubSeasonId(ctx, field)
+			case "clubName":
+				return ec.fieldContext_AFLPlayerProposal_clubName(ctx, field)
+			case "playerId":
+				return ec.fieldContext_AFLPlayerProposal_playerId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLPlayerProposal_playerName(ctx, field)
+			case "previousClubName":
+				return ec.fieldContext_AFLPlayerProposal_previousClubName(ctx, field)
+			case "confidence":
+				return ec.fieldContext_AFLPlayerProposal_confidence(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerProposal_playerSeasonId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AFLPlayerProposal_createdAt(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_AFLPlayerProposal_decidedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerProposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rejectAFLPlayerProposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_mergeAFLPlayers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_mergeAFLPlayers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().MergeAFLPlayers(ctx, fc.Args["survivorId"].(string), fc.Args["duplicateId"].(string))
+		},
+		nil,
+		ec.marshalNAFLPlayerMerge2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMerge,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_mergeAFLPlayers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "survivor":
+				return ec.fieldContext_AFLPlayerMerge_survivor(ctx, field)
+			case "duplicateId":
+				return ec.fieldContext_AFLPlayerMerge_duplicateId(ctx, field)
+			case "movedPlayerSeasonIds":
+				return ec.fieldContext_AFLPlayerMerge_movedPlayerSeasonIds(ctx, field)
+			case "mergedPlayerSeasons":
+				return ec.fieldContext_AFLPlayerMerge_mergedPlayerSeasons(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMerge", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_mergeAFLPlayers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_resolveAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_resolveAFLPlayerMatch,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().ResolveAFLPlayerMatch(ctx, fc.Args["input"].(ResolveAFLPlayerMatchInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resolveAFLPlayerMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerMatch_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerMatch_playerSeasonId(ctx, field)
+			case "clubMatchId":
+				return ec.fieldContext_AFLPlayerMatch_clubMatchId(ctx, field)
+			case "clubMatch":
+				return ec.fieldContext_AFLPlayerMatch_clubMatch(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerMatch_player(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerMatch_status(ctx, field)
+			case "kicks":
+				return ec.fieldContext_AFLPlayerMatch_kicks(ctx, field)
+			case "handballs":
+				return ec.fieldContext_AFLPlayerMatch_handballs(ctx, field)
+			case "marks":
+				return ec.fieldContext_AFLPlayerMatch_marks(ctx, field)
+			case "hitouts":
+				return ec.fieldContext_AFLPlayerMatch_hitouts(ctx, field)
+			case "tackles":
+				return ec.fieldContext_AFLPlayerMatch_tackles(ctx, field)
+			case "goals":
+				return ec.fieldContext_AFLPlayerMatch_goals(ctx, field)
+			case "behinds":
+				return ec.fieldContext_AFLPlayerMatch_behinds(ctx, field)
+			case "disposals":
+				return ec.fieldContext_AFLPlayerMatch_disposals(ctx, field)
+			case "score":
+				return ec.fieldContext_AFLPlayerMatch_score(ctx, field)
+			case "extraStats":
+				return ec.fieldContext_AFLPlayerMatch_extraStats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resolveAFLPlayerMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_markAFLMatchStatsComplete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_markAFLMatchStatsComplete,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().MarkAFLMatchStatsComplete(ctx, fc.Args["matchId"].(string), fc.Args["complete"].(bool))
+		},
+		nil,
+		ec.marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_markAFLMatchStatsComplete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLMatch_id(ctx, field)
+			case "venue":
+				return ec.fieldContext_AFLMatch_venue(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AFLMatch_startTime(ctx, field)
+			case "result":
+				return ec.fieldContext_AFLMatch_result(ctx, field)
+			case "dataStatus":
+				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
+			case "round":
+				return ec.fieldContext_AFLMatch_round(ctx, field)
+			case "homeClubMatch":
+				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
+			case "awayClubMatch":
+				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
+			case "statsDiscrepancies":
+				return ec.fieldContext_AFLMatch_statsDiscrepancies(ctx, field)
+			case "timeline":
+				return ec.fieldContext_AFLMatch_timeline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_markAFLMatchStatsComplete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recalculateAFLLadder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recalculateAFLLadder,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RecalculateAFLLadder(ctx, fc.Args["seasonId"].(string))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recalculateAFLLadder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recalculateAFLLadder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setAFLPlayerAvailability(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setAFLPlayerAvailability,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().SetAFLPlayerAvailability(ctx, fc.Args["input"].(SetAFLPlayerAvailabilityInput))
+		},
+		nil,
+		ec.marshalNAFLPlayerAvailability2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailability,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setAFLPlayerAvailability(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerAvailability_id(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLPlayerAvailability_playerSeasonId(ctx, field)
+			case "playerSeason":
+				return ec.fieldContext_AFLPlayerAvailability_playerSeason(ctx, field)
+			case "roundId":
+				return ec.fieldContext_AFLPlayerAvailability_roundId(ctx, field)
+			case "status":
+				return ec.fieldContext_AFLPlayerAvailability_status(ctx, field)
+			case "expectedReturn":
+				return ec.fieldContext_AFLPlayerAvailability_expectedReturn(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLPlayerAvailability_source(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerAvailability", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setAFLPlayerAvailability_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_totalCount(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflSeasons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflSeasons,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().AflSeasons(ctx)
+		},
+		nil,
+		ec.marshalNAFLSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflSeasons(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLSeason_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLSeason_name(ctx, field)
+			case "ladder":
+				return ec.fieldContext_AFLSeason_ladder(ctx, field)
+			case "rounds":
+				return ec.fieldContext_AFLSeason_rounds(ctx, field)
+			case "playerSeasons":
+				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "playerProposals":
+				return ec.fieldContext_AFLSeason_playerProposals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflSeason,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflSeason(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLSeason_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLSeason_name(ctx, field)
+			case "ladder":
+				return ec.fieldContext_AFLSeason_ladder(ctx, field)
+			case "rounds":
+				return ec.fieldContext_AFLSeason_rounds(ctx, field)
+			case "playerSeasons":
+				return ec.fieldContext_AFLSeason_playerSeasons(ctx, field)
+			case "playerProposals":
+				return ec.fieldContext_AFLSeason_playerProposals(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflRound,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflRound(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflRound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLRound_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLRound_name(ctx, field)
+			case "season":
+				return ec.fieldContext_AFLRound_season(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLRound_matches(ctx, field)
+			case "availability":
+				return ec.fieldContext_AFLRound_availability(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLRound", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflRound_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflMatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflMatch,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflMatch(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflMatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLMatch_id(ctx, field)
+			case "venue":
+				return ec.fieldContext_AFLMatch_venue(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AFLMatch_startTime(ctx, field)
+			case "result":
+				return ec.fieldContext_AFLMatch_result(ctx, field)
+			case "dataStatus":
+				return ec.fieldContext_AFLMatch_dataStatus(ctx, field)
+			case "round":
+				return ec.fieldContext_AFLMatch_round(ctx, field)
+			case "homeClubMatch":
+				return ec.fieldContext_AFLMatch_homeClubMatch(ctx, field)
+			case "awayClubMatch":
+				return ec.fieldContext_AFLMatch_awayClubMatch(ctx, field)
+			case "statsDiscrepancies":
+				return ec.fieldContext_AFLMatch_statsDiscrepancies(ctx, field)
+			case "timeline":
+				return ec.fieldContext_AFLMatch_timeline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLMatch", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflMatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflClubs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflClubs,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().AflClubs(ctx)
+		},
+		nil,
+		ec.marshalNAFLClub2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflClubs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClub_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLClub_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflClub(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflClub,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflClub(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflClub(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLClub_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLClub_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLClub", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflClub_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflPlayerSeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflPlayerSeason,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflPlayerSeason(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflPlayerSeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayerSeason_id(ctx, field)
+			case "player":
+				return ec.fieldContext_AFLPlayerSeason_player(ctx, field)
+			case "clubSeason":
+				return ec.fieldContext_AFLPlayerSeason_clubSeason(ctx, field)
+			case "matches":
+				return ec.fieldContext_AFLPlayerSeason_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerSeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflPlayerSeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflLiveRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflLiveRound,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().AflLiveRound(ctx)
+		},
+		nil,
+		ec.marshalOAFLLiveRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLLiveRound,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflLiveRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "round":
+				return ec.fieldContext_AFLLiveRound_round(ctx, field)
+			case "startDate":
+				return ec.fieldContext_AFLLiveRound_startDate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLLiveRound", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflPlayerSearch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflPlayerSearch,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflPlayerSearch(ctx, fc.Args["query"].(string))
+		},
+		nil,
+		ec.marshalNAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflPlayerSearch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AFLPlayer_id(ctx, field)
+			case "name":
+				return ec.fieldContext_AFLPlayer_name(ctx, field)
+			case "latestPlayerSeason":
+				return ec.fieldContext_AFLPlayer_latestPlayerSeason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayer", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflPlayerSearch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_aflPlayerDuplicates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_aflPlayerDuplicates,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AflPlayerDuplicates(ctx, fc.Args["minConfidence"].(*float64))
+		},
+		nil,
+		ec.marshalNAFLPlayerDuplicate2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerDuplicateᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_aflPlayerDuplicates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "player":
+				return ec.fieldContext_AFLPlayerDuplicate_player(ctx, field)
+			case "other":
+				return ec.fieldContext_AFLPlayerDuplicate_other(ctx, field)
+			case "confidence":
+				return ec.fieldContext_AFLPlayerDuplicate_confidence(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLPlayerDuplicate", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aflPlayerDuplicates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query__entities,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]any)), nil
+		},
+		nil,
+		ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type _Entity does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query__service,
+		func(ctx context.Context) (any, error) {
+			return ec.__resolve__service(ctx)
+		},
+		nil,
+		ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query__service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sdl":
+				return ec.fieldContext__Service_sdl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.IntrospectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.IntrospectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_parsedName(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_parsedName,
+		func(ctx context.Context) (any, error) {
+			return obj.ParsedName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_parsedName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_clubMatchId(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_clubMatchId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClubMatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_clubMatchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_kicks(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_kicks,
+		func(ctx context.Context) (any, error) {
+			return obj.Kicks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_kicks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_handballs(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_handballs,
+		func(ctx context.Context) (any, error) {
+			return obj.Handballs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_handballs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_marks(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_marks,
+		func(ctx context.Context) (any, error) {
+			return obj.Marks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_marks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_hitouts(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_hitouts,
+		func(ctx context.Context) (any, error) {
+			return obj.Hitouts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_hitouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_tackles(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_tackles,
+		func(ctx context.Context) (any, error) {
+			return obj.Tackles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_goals(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_goals,
+		func(ctx context.Context) (any, error) {
+			return obj.Goals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnmatchedAFLPlayer_behinds(ctx context.Context, field graphql.CollectedField, obj *UnmatchedAFLPlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnmatchedAFLPlayer_behinds,
+		func(ctx context.Context) (any, error) {
+			return obj.Behinds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnmatchedAFLPlayer_behinds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnmatchedAFLPlayer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VerifyAFLMatchStatsResult_matchId(ctx context.Context, field graphql.CollectedField, obj *VerifyAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VerifyAFLMatchStatsResult_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VerifyAFLMatchStatsResult_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VerifyAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VerifyAFLMatchStatsResult_sources(ctx context.Context, field graphql.CollectedField, obj *VerifyAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VerifyAFLMatchStatsResult_sources,
+		func(ctx context.Context) (any, error) {
+			return obj.Sources, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VerifyAFLMatchStatsResult_sources(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VerifyAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VerifyAFLMatchStatsResult_discrepancies(ctx context.Context, field graphql.CollectedField, obj *VerifyAFLMatchStatsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VerifyAFLMatchStatsResult_discrepancies,
+		func(ctx context.Context) (any, error) {
+			return obj.Discrepancies, nil
+		},
+		nil,
+		ec.marshalNAFLStatsDiscrepancy2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VerifyAFLMatchStatsResult_discrepancies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VerifyAFLMatchStatsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "clubMatchId":
+				return ec.fieldContext_AFLStatsDiscrepancy_clubMatchId(ctx, field)
+			case "kind":
+				return ec.fieldContext_AFLStatsDiscrepancy_kind(ctx, field)
+			case "playerSeasonId":
+				return ec.fieldContext_AFLStatsDiscrepancy_playerSeasonId(ctx, field)
+			case "playerName":
+				return ec.fieldContext_AFLStatsDiscrepancy_playerName(ctx, field)
+			case "stat":
+				return ec.fieldContext_AFLStatsDiscrepancy_stat(ctx, field)
+			case "source":
+				return ec.fieldContext_AFLStatsDiscrepancy_source(ctx, field)
+			case "value":
+				return ec.fieldContext_AFLStatsDiscrepancy_value(ctx, field)
+			case "otherSource":
+				return ec.fieldContext_AFLStatsDiscrepancy_otherSource(ctx, field)
+			case "otherValue":
+				return ec.fieldContext_AFLStatsDiscrepancy_otherValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AFLStatsDiscrepancy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.CollectedField, obj *fedruntime.Service) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext__Service_sdl,
+		func(ctx context.Context) (any, error) {
+			return obj.SDL, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext__Service_sdl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "_Service",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAFLListedPlayerInput(ctx context.Context, obj any) (AFLListedPlayerInput, error) {
+	var it AFLListedPlayerInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"club", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "club":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("club"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Club = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAFLPlayerSeasonFilter(ctx context.Context, obj any) (AFLPlayerSeasonFilter, error) {
+	var it AFLPlayerSeasonFilter
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"query"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "query":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Query = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddAFLPlayerInput(ctx context.Context, obj any) (AddAFLPlayerInput, error) {
+	var it AddAFLPlayerInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "clubSeasonId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "clubSeasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubSeasonId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClubSeasonID = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddAFLPlayerSeasonInput(ctx context.Context, obj any) (AddAFLPlayerSeasonInput, error) {
+	var it AddAFLPlayerSeasonInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"playerId", "clubSeasonId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "playerId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlayerID = data
+		case "clubSeasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubSeasonId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClubSeasonID = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputResolveAFLPlayerMatchInput(ctx context.Context, obj any) (ResolveAFLPlayerMatchInput, error) {
+	var it ResolveAFLPlayerMatchInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clubMatchId", "playerSeasonId", "kicks", "handballs", "marks", "hitouts", "tackles", "goals", "behinds", "parsedName", "source"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clubMatchId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubMatchId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClubMatchID = data
+		case "playerSeasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerSeasonId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlayerSeasonID = data
+		case "kicks":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kicks"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kicks = data
+		case "handballs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("handballs"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Handballs = data
+		case "marks":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("marks"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Marks = data
+		case "hitouts":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hitouts"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hitouts = data
+		case "tackles":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tackles"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tackles = data
+		case "goals":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("goals"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Goals = data
+		case "behinds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("behinds"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Behinds = data
+		case "parsedName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parsedName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ParsedName = data
+		case "source":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("source"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Source = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetAFLPlayerAvailabilityInput(ctx context.Context, obj any) (SetAFLPlayerAvailabilityInput, error) {
+	var it SetAFLPlayerAvailabilityInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"playerSeasonId", "roundId", "status", "expectedReturn"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "playerSeasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerSeasonId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlayerSeasonID = data
+		case "roundId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RoundID = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "expectedReturn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedReturn"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedReturn = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateAFLPlayerMatchInput(ctx context.Context, obj any) (UpdateAFLPlayerMatchInput, error) {
+	var it UpdateAFLPlayerMatchInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"playerSeasonId", "clubMatchId", "kicks", "handballs", "marks", "hitouts", "tackles", "goals", "behinds"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "playerSeasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerSeasonId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlayerSeasonID = data
+		case "clubMatchId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubMatchId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClubMatchID = data
+		case "kicks":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kicks"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kicks = data
+		case "handballs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("handballs"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Handballs = data
+		case "marks":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("marks"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Marks = data
+		case "hitouts":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hitouts"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hitouts = data
+		case "tackles":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tackles"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tackles = data
+		case "goals":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("goals"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Goals = data
+		case "behinds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("behinds"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Behinds = data
+		}
+	}
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) __Entity(ctx context.Context, sel ast.SelectionSet, obj fedruntime.Entity) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case AFLSeason:
+		return ec._AFLSeason(ctx, sel, &obj)
+	case *AFLSeason:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AFLSeason(ctx, sel, obj)
+	case AFLRound:
+		return ec._AFLRound(ctx, sel, &obj)
+	case *AFLRound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AFLRound(ctx, sel, obj)
+	case AFLPlayerSeason:
+		return ec._AFLPlayerSeason(ctx, sel, &obj)
+	case *AFLPlayerSeason:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AFLPlayerSeason(ctx, sel, obj)
+	case AFLPlayerMatch:
+		return ec._AFLPlayerMatch(ctx, sel, &obj)
+	case *AFLPlayerMatch:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AFLPlayerMatch(ctx, sel, obj)
+	case AFLPlayer:
+		return ec._AFLPlayer(ctx, sel, &obj)
+	case *AFLPlayer:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AFLPlayer(ctx, sel, obj)
+	default:
+		if typedObj, ok := obj.(graphql.Marshaler); ok {
+			return typedObj
+		} else {
+			panic(fmt.Errorf("unexpected type %T; non-generated variants of _Entity must implement graphql.Marshaler", obj))
+		}
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var aFLClubImplementors = []string{"AFLClub"}
+
+func (ec *executionContext) _AFLClub(ctx context.Context, sel ast.SelectionSet, obj *AFLClub) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLClub")
+		case "id":
+			out.Values[i] = ec._AFLClub_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._AFLClub_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLClubMatchImplementors = []string{"AFLClubMatch"}
+
+func (ec *executionContext) _AFLClubMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLClubMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLClubMatch")
+		case "id":
+			out.Values[i] = ec._AFLClubMatch_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "clubSeasonId":
+			out.Values[i] = ec._AFLClubMatch_clubSeasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "club":
+			out.Values[i] = ec._AFLClubMatch_club(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "rushedBehinds":
+			out.Values[i] = ec._AFLClubMatch_rushedBehinds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "score":
+			out.Values[i] = ec._AFLClubMatch_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "playerMatches":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLClubMatch_playerMatches(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "quarterScores":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLClubMatch_quarterScores(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "matchId":
+			out.Values[i] = ec._AFLClubMatch_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "match":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLClubMatch_match(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLClubMatchStatsPreviewImplementors = []string{"AFLClubMatchStatsPreview"}
+
+func (ec *executionContext) _AFLClubMatchStatsPreview(ctx context.Context, sel ast.SelectionSet, obj *AFLClubMatchStatsPreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubMatchStatsPreviewImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLClubMatchStatsPreview")
+		case "clubMatchId":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_clubMatchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clubName":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_clubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oldScore":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_oldScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "newScore":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_newScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oldRushedBehinds":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_oldRushedBehinds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "newRushedBehinds":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_newRushedBehinds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "players":
+			out.Values[i] = ec._AFLClubMatchStatsPreview_players(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLClubSeasonImplementors = []string{"AFLClubSeason"}
+
+func (ec *executionContext) _AFLClubSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLClubSeason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubSeasonImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLClubSeason")
+		case "id":
+			out.Values[i] = ec._AFLClubSeason_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "club":
+			out.Values[i] = ec._AFLClubSeason_club(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "season":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLClubSeason_season(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "played":
+			out.Values[i] = ec._AFLClubSeason_played(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "won":
+			out.Values[i] = ec._AFLClubSeason_won(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lost":
+			out.Values[i] = ec._AFLClubSeason_lost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "drawn":
+			out.Values[i] = ec._AFLClubSeason_drawn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "for":
+			out.Values[i] = ec._AFLClubSeason_for(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "against":
+			out.Values[i] = ec._AFLClubSeason_against(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "premiershipPoints":
+			out.Values[i] = ec._AFLClubSeason_premiershipPoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLLiveRoundImplementors = []string{"AFLLiveRound"}
+
+func (ec *executionContext) _AFLLiveRound(ctx context.Context, sel ast.SelectionSet, obj *AFLLiveRound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLLiveRoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLLiveRound")
+		case "round":
+			out.Values[i] = ec._AFLLiveRound_round(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startDate":
+			out.Values[i] = ec._AFLLiveRound_startDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLMatchImplementors = []string{"AFLMatch"}
+
+func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLMatch")
+		case "id":
+			out.Values[i] = ec._AFLMatch_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "venue":
+			out.Values[i] = ec._AFLMatch_venue(ctx, field, obj)
+		case "startTime":
+			out.Values[i] = ec._AFLMatch_startTime(ctx, field, obj)
+		case "result":
+			out.Values[i] = ec._AFLMatch_result(ctx, field, obj)
+		case "dataStatus":
+			out.Values[i] = ec._AFLMatch_dataStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "round":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLMatch_round(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "homeClubMatch":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLMatch_homeClubMatch(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "awayClubMatch":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLMatch_awayClubMatch(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "statsDiscrepancies":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLMatch_statsDiscrepancies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "timeline":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLMatch_timeline(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+var aFLMatchTimelineImplementors = []string{"AFLMatchTimeline"}
+
+func (ec *executionContext) _AFLMatchTimeline(ctx context.Context, sel ast.SelectionSet, obj *AFLMatchTimeline) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLMatchTimelineImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLMatchTimeline")
+		case "breaks":
+			out.Values[i] = ec._AFLMatchTimeline_breaks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "comebackWin":
+			out.Values[i] = ec._AFLMatchTimeline_comebackWin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "comebackDeficit":
+			out.Values[i] = ec._AFLMatchTimeline_comebackDeficit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var aFLMergedPlayerSeasonImplementors = []string{"AFLMergedPlayerSeason"}
+
+func (ec *executionContext) _AFLMergedPlayerSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLMergedPlayerSeason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLMergedPlayerSeasonImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLMergedPlayerSeason")
+		case "duplicateId":
+			out.Values[i] = ec._AFLMergedPlayerSeason_duplicateId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "survivorId":
+			out.Values[i] = ec._AFLMergedPlayerSeason_survivorId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+var aFLPlayerImplementors = []string{"AFLPlayer", "_Entity"}
+
+func (ec *executionContext) _AFLPlayer(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayer")
+		case "id":
+			out.Values[i] = ec._AFLPlayer_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "name":
+			out.Values[i] = ec._AFLPlayer_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "latestPlayerSeason":
+			out.Values[i] = ec._AFLPlayer_latestPlayerSeason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+var aFLPlayerAvailabilityImplementors = []string{"AFLPlayerAvailability"}
+
+func (ec *executionContext) _AFLPlayerAvailability(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerAvailability) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerAvailabilityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerAvailability")
+		case "id":
+			out.Values[i] = ec._AFLPlayerAvailability_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
+		case "playerSeasonId":
+			out.Values[i] = ec._AFLPlayerAvailability_playerSeasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSeason":
+			out.Values[i] = ec._AFLPlayerAvailability_playerSeason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundId":
+			out.Values[i] = ec._AFLPlayerAvailability_roundId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._AFLPlayerAvailability_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expectedReturn":
+			out.Values[i] = ec._AFLPlayerAvailability_expectedReturn(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._AFLPlayerAvailability_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var aFLPlayerDuplicateImplementors = []string{"AFLPlayerDuplicate"}
+
+func (ec *executionContext) _AFLPlayerDuplicate(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerDuplicate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerDuplicateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerDuplicate")
+		case "player":
+			out.Values[i] = ec._AFLPlayerDuplicate_player(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "other":
+			out.Values[i] = ec._AFLPlayerDuplicate_other(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "confidence":
+			out.Values[i] = ec._AFLPlayerDuplicate_confidence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+var aFLPlayerImportImplementors = []string{"AFLPlayerImport"}
+
+func (ec *executionContext) _AFLPlayerImport(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerImport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerImportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerImport")
+		case "seasonId":
+			out.Values[i] = ec._AFLPlayerImport_seasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "seasonName":
+			out.Values[i] = ec._AFLPlayerImport_seasonName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "listedCount":
+			out.Values[i] = ec._AFLPlayerImport_listedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inSeasonCount":
+			out.Values[i] = ec._AFLPlayerImport_inSeasonCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "decidedCount":
+			out.Values[i] = ec._AFLPlayerImport_decidedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposals":
+			out.Values[i] = ec._AFLPlayerImport_proposals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	return fc, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
+var aFLPlayerMatchImplementors = []string{"AFLPlayerMatch", "_Entity"}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _AFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerMatchImplementors)
 
-func (ec *executionContext) unmarshalInputAFLPlayerSeasonFilter(ctx context.Context, obj any) (AFLPlayerSeasonFilter, error) {
-	var it AFLPlayerSeasonFilter
-	if obj == nil {
-		return it, nil
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerMatch")
+		case "id":
+			out.Values[i] = ec._AFLPlayerMatch_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "playerSeasonId":
+			out.Values[i] = ec._AFLPlayerMatch_playerSeasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "clubMatchId":
+			out.Values[i] = ec._AFLPlayerMatch_clubMatchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "clubMatch":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLPlayerMatch_clubMatch(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "player":
+			out.Values[i] = ec._AFLPlayerMatch_player(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._AFLPlayerMatch_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "kicks":
+			out.Values[i] = ec._AFLPlayerMatch_kicks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "handballs":
+			out.Values[i] = ec._AFLPlayerMatch_handballs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "marks":
+			out.Values[i] = ec._AFLPlayerMatch_marks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "hitouts":
+			out.Values[i] = ec._AFLPlayerMatch_hitouts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tackles":
+			out.Values[i] = ec._AFLPlayerMatch_tackles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "goals":
+			out.Values[i] = ec._AFLPlayerMatch_goals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "behinds":
+			out.Values[i] = ec._AFLPlayerMatch_behinds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "disposals":
+			out.Values[i] = ec._AFLPlayerMatch_disposals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "score":
+			out.Values[i] = ec._AFLPlayerMatch_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "extraStats":
+			out.Values[i] = ec._AFLPlayerMatch_extraStats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"query"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "query":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var aFLPlayerMergeImplementors = []string{"AFLPlayerMerge"}
+
+func (ec *executionContext) _AFLPlayerMerge(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerMerge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerMergeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerMerge")
+		case "survivor":
+			out.Values[i] = ec._AFLPlayerMerge_survivor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Query = data
+		case "duplicateId":
+			out.Values[i] = ec._AFLPlayerMerge_duplicateId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "movedPlayerSeasonIds":
+			out.Values[i] = ec._AFLPlayerMerge_movedPlayerSeasonIds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mergedPlayerSeasons":
+			out.Values[i] = ec._AFLPlayerMerge_mergedPlayerSeasons(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	return it, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) unmarshalInputAddAFLPlayerInput(ctx context.Context, obj any) (AddAFLPlayerInput, error) {
-	var it AddAFLPlayerInput
-	if obj == nil {
-		return it, nil
-	}
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"name", "clubSeasonId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "clubSeasonId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubSeasonId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ClubSeasonID = data
-		}
-	}
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputAddAFLPlayerSeasonInput(ctx context.Context, obj any) (AddAFLPlayerSeasonInput, error) {
-	var it AddAFLPlayerSeasonInput
-	if obj == nil {
-		return it, nil
-	}
+var aFLPlayerProposalImplementors = []string{"AFLPlayerProposal"}
 
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _AFLPlayerProposal(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerProposal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerProposalImplementors)
 
-	fieldsInOrder := [...]string{"playerId", "clubSeasonId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "playerId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerProposal")
+		case "id":
+			out.Values[i] = ec._AFLPlayerProposal_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PlayerID = data
+		case "seasonId":
+			out.Values[i] = ec._AFLPlayerProposal_seasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._AFLPlayerProposal_source(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._AFLPlayerProposal_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._AFLPlayerProposal_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "listedName":
+			out.Values[i] = ec._AFLPlayerProposal_listedName(ctx, field, obj)
 		case "clubSeasonId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubSeasonId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._AFLPlayerProposal_clubSeasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ClubSeasonID = data
+		case "clubName":
+			out.Values[i] = ec._AFLPlayerProposal_clubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerId":
+			out.Values[i] = ec._AFLPlayerProposal_playerId(ctx, field, obj)
+		case "playerName":
+			out.Values[i] = ec._AFLPlayerProposal_playerName(ctx, field, obj)
+		case "previousClubName":
+			out.Values[i] = ec._AFLPlayerProposal_previousClubName(ctx, field, obj)
+		case "confidence":
+			out.Values[i] = ec._AFLPlayerProposal_confidence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSeasonId":
+			out.Values[i] = ec._AFLPlayerProposal_playerSeasonId(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._AFLPlayerProposal_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "decidedAt":
+			out.Values[i] = ec._AFLPlayerProposal_decidedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputResolveAFLPlayerMatchInput(ctx context.Context, obj any) (ResolveAFLPlayerMatchInput, error) {
-	var it ResolveAFLPlayerMatchInput
-	if obj == nil {
-		return it, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"clubMatchId", "playerSeasonId", "kicks", "handballs", "marks", "hitouts", "tackles", "goals", "behinds", "parsedName"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "clubMatchId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubMatchId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ClubMatchID = data
-		case "playerSeasonId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerSeasonId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PlayerSeasonID = data
-		case "kicks":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kicks"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Kicks = data
-		case "handballs":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("handballs"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var aFLPlayerSeasonImplementors = []string{"AFLPlayerSeason", "_Entity"}
+
+func (ec *executionContext) _AFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerSeason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerSeasonImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerSeason")
+		case "id":
+			out.Values[i] = ec._AFLPlayerSeason_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Handballs = data
-		case "marks":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("marks"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "player":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLPlayerSeason_player(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Marks = data
-		case "hitouts":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hitouts"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.Hitouts = data
-		case "tackles":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tackles"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "clubSeason":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLPlayerSeason_clubSeason(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Tackles = data
-		case "goals":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("goals"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.Goals = data
-		case "behinds":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("behinds"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "matches":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLPlayerSeason_matches(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Behinds = data
-		case "parsedName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parsedName"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.ParsedName = data
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputUpdateAFLPlayerMatchInput(ctx context.Context, obj any) (UpdateAFLPlayerMatchInput, error) {
-	var it UpdateAFLPlayerMatchInput
-	if obj == nil {
-		return it, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"playerSeasonId", "clubMatchId", "status", "kicks", "handballs", "marks", "hitouts", "tackles", "goals", "behinds"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "playerSeasonId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerSeasonId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PlayerSeasonID = data
-		case "clubMatchId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clubMatchId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ClubMatchID = data
-		case "kicks":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kicks"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Kicks = data
-		case "handballs":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("handballs"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Handballs = data
-		case "marks":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("marks"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Marks = data
-		case "hitouts":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hitouts"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Hitouts = data
-		case "tackles":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tackles"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Tackles = data
-		case "goals":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("goals"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var aFLPlayerSeasonConnectionImplementors = []string{"AFLPlayerSeasonConnection"}
+
+func (ec *executionContext) _AFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerSeasonConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerSeasonConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerSeasonConnection")
+		case "nodes":
+			out.Values[i] = ec._AFLPlayerSeasonConnection_nodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Goals = data
-		case "behinds":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("behinds"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+		case "pageInfo":
+			out.Values[i] = ec._AFLPlayerSeasonConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Behinds = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	return it, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
+var aFLPlayerStatsDiffImplementors = []string{"AFLPlayerStatsDiff"}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _AFLPlayerStatsDiff(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerStatsDiff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerStatsDiffImplementors)
 
-func (ec *executionContext) __Entity(ctx context.Context, sel ast.SelectionSet, obj fedruntime.Entity) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case AFLSeason:
-		return ec._AFLSeason(ctx, sel, &obj)
-	case *AFLSeason:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AFLSeason(ctx, sel, obj)
-	case AFLRound:
-		return ec._AFLRound(ctx, sel, &obj)
-	case *AFLRound:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AFLRound(ctx, sel, obj)
-	case AFLPlayerSeason:
-		return ec._AFLPlayerSeason(ctx, sel, &obj)
-	case *AFLPlayerSeason:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AFLPlayerSeason(ctx, sel, obj)
-	case AFLPlayerMatch:
-		return ec._AFLPlayerMatch(ctx, sel, &obj)
-	case *AFLPlayerMatch:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AFLPlayerMatch(ctx, sel, obj)
-	case AFLPlayer:
-		return ec._AFLPlayer(ctx, sel, &obj)
-	case *AFLPlayer:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AFLPlayer(ctx, sel, obj)
-	default:
-		if typedObj, ok := obj.(graphql.Marshaler); ok {
-			return typedObj
-		} else {
-			panic(fmt.Errorf("unexpected type %T; non-generated variants of _Entity must implement graphql.Marshaler", obj))
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLPlayerStatsDiff")
+		case "playerSeasonId":
+			out.Values[i] = ec._AFLPlayerStatsDiff_playerSeasonId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerName":
+			out.Values[i] = ec._AFLPlayerStatsDiff_playerName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._AFLPlayerStatsDiff_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._AFLPlayerStatsDiff_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kicks":
+			out.Values[i] = ec._AFLPlayerStatsDiff_kicks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "handballs":
+			out.Values[i] = ec._AFLPlayerStatsDiff_handballs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "marks":
+			out.Values[i] = ec._AFLPlayerStatsDiff_marks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hitouts":
+			out.Values[i] = ec._AFLPlayerStatsDiff_hitouts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tackles":
+			out.Values[i] = ec._AFLPlayerStatsDiff_tackles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "goals":
+			out.Values[i] = ec._AFLPlayerStatsDiff_goals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "behinds":
+			out.Values[i] = ec._AFLPlayerStatsDiff_behinds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-// endregion ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-// region    **************************** object.gotpl ****************************
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-var aFLClubImplementors = []string{"AFLClub"}
+	return out
+}
 
-func (ec *executionContext) _AFLClub(ctx context.Context, sel ast.SelectionSet, obj *AFLClub) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubImplementors)
+var aFLQuarterBreakImplementors = []string{"AFLQuarterBreak"}
+
+func (ec *executionContext) _AFLQuarterBreak(ctx context.Context, sel ast.SelectionSet, obj *AFLQuarterBreak) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLQuarterBreakImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLClub")
-		case "id":
-			out.Values[i] = ec._AFLClub_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("AFLQuarterBreak")
+		case "quarter":
+			out.Values[i] = ec._AFLQuarterBreak_quarter(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._AFLClub_name(ctx, field, obj)
+		case "homeScore":
+			out.Values[i] = ec._AFLQuarterBreak_homeScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "awayScore":
+			out.Values[i] = ec._AFLQuarterBreak_awayScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "margin":
+			out.Values[i] = ec._AFLQuarterBreak_margin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quarterResult":
+			out.Values[i] = ec._AFLQuarterBreak_quarterResult(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7520,43 +15291,82 @@ func (ec *executionContext) _AFLClub(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var aFLClubMatchImplementors = []string{"AFLClubMatch"}
+var aFLQuarterScoreImplementors = []string{"AFLQuarterScore"}
 
-func (ec *executionContext) _AFLClubMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLClubMatch) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubMatchImplementors)
+func (ec *executionContext) _AFLQuarterScore(ctx context.Context, sel ast.SelectionSet, obj *AFLQuarterScore) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLQuarterScoreImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLClubMatch")
-		case "id":
-			out.Values[i] = ec._AFLClubMatch_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("AFLQuarterScore")
+		case "quarter":
+			out.Values[i] = ec._AFLQuarterScore_quarter(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "clubSeasonId":
-			out.Values[i] = ec._AFLClubMatch_clubSeasonId(ctx, field, obj)
+		case "goals":
+			out.Values[i] = ec._AFLQuarterScore_goals(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "club":
-			out.Values[i] = ec._AFLClubMatch_club(ctx, field, obj)
+		case "behinds":
+			out.Values[i] = ec._AFLQuarterScore_behinds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "rushedBehinds":
-			out.Values[i] = ec._AFLClubMatch_rushedBehinds(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._AFLQuarterScore_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLRoundImplementors = []string{"AFLRound", "_Entity"}
+
+func (ec *executionContext) _AFLRound(ctx context.Context, sel ast.SelectionSet, obj *AFLRound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLRoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLRound")
+		case "id":
+			out.Values[i] = ec._AFLRound_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "score":
-			out.Values[i] = ec._AFLClubMatch_score(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._AFLRound_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "playerMatches":
+		case "season":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -7565,7 +15375,7 @@ func (ec *executionContext) _AFLClubMatch(ctx context.Context, sel ast.Selection
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLClubMatch_playerMatches(ctx, field, obj)
+				res = ec._AFLRound_season(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -7592,21 +15402,19 @@ func (ec *executionContext) _AFLClubMatch(ctx context.Context, sel ast.Selection
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "matchId":
-			out.Values[i] = ec._AFLClubMatch_matchId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "match":
+		case "matches":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLClubMatch_match(ctx, field, obj)
+				res = ec._AFLRound_matches(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -7630,51 +15438,7 @@ func (ec *executionContext) _AFLClubMatch(ctx context.Context, sel ast.Selection
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var aFLClubSeasonImplementors = []string{"AFLClubSeason"}
-
-func (ec *executionContext) _AFLClubSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLClubSeason) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLClubSeasonImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLClubSeason")
-		case "id":
-			out.Values[i] = ec._AFLClubSeason_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "club":
-			out.Values[i] = ec._AFLClubSeason_club(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "season":
+		case "availability":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -7683,7 +15447,7 @@ func (ec *executionContext) _AFLClubSeason(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLClubSeason_season(ctx, field, obj)
+				res = ec._AFLRound_availability(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -7710,41 +15474,6 @@ func (ec *executionContext) _AFLClubSeason(ctx context.Context, sel ast.Selectio
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "played":
-			out.Values[i] = ec._AFLClubSeason_played(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "won":
-			out.Values[i] = ec._AFLClubSeason_won(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "lost":
-			out.Values[i] = ec._AFLClubSeason_lost(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "drawn":
-			out.Values[i] = ec._AFLClubSeason_drawn(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "for":
-			out.Values[i] = ec._AFLClubSeason_for(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "against":
-			out.Values[i] = ec._AFLClubSeason_against(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "premiershipPoints":
-			out.Values[i] = ec._AFLClubSeason_premiershipPoints(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7768,27 +15497,46 @@ func (ec *executionContext) _AFLClubSeason(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var aFLLiveRoundImplementors = []string{"AFLLiveRound"}
+var aFLRoundMatchImportImplementors = []string{"AFLRoundMatchImport"}
 
-func (ec *executionContext) _AFLLiveRound(ctx context.Context, sel ast.SelectionSet, obj *AFLLiveRound) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLLiveRoundImplementors)
+func (ec *executionContext) _AFLRoundMatchImport(ctx context.Context, sel ast.SelectionSet, obj *AFLRoundMatchImport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLRoundMatchImportImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLLiveRound")
-		case "round":
-			out.Values[i] = ec._AFLLiveRound_round(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("AFLRoundMatchImport")
+		case "matchId":
+			out.Values[i] = ec._AFLRoundMatchImport_matchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "startDate":
-			out.Values[i] = ec._AFLLiveRound_startDate(ctx, field, obj)
+		case "homeClubName":
+			out.Values[i] = ec._AFLRoundMatchImport_homeClubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "awayClubName":
+			out.Values[i] = ec._AFLRoundMatchImport_awayClubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._AFLRoundMatchImport_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stats":
+			out.Values[i] = ec._AFLRoundMatchImport_stats(ctx, field, obj)
+		case "markedFinal":
+			out.Values[i] = ec._AFLRoundMatchImport_markedFinal(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "error":
+			out.Values[i] = ec._AFLRoundMatchImport_error(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7812,34 +15560,28 @@ func (ec *executionContext) _AFLLiveRound(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var aFLMatchImplementors = []string{"AFLMatch"}
+var aFLSeasonImplementors = []string{"AFLSeason", "_Entity"}
 
-func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLMatch) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLMatchImplementors)
+func (ec *executionContext) _AFLSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLSeason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLSeasonImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLMatch")
+			out.Values[i] = graphql.MarshalString("AFLSeason")
 		case "id":
-			out.Values[i] = ec._AFLMatch_id(ctx, field, obj)
+			out.Values[i] = ec._AFLSeason_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "venue":
-			out.Values[i] = ec._AFLMatch_venue(ctx, field, obj)
-		case "startTime":
-			out.Values[i] = ec._AFLMatch_startTime(ctx, field, obj)
-		case "result":
-			out.Values[i] = ec._AFLMatch_result(ctx, field, obj)
-		case "dataStatus":
-			out.Values[i] = ec._AFLMatch_dataStatus(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._AFLSeason_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "round":
+		case "ladder":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -7848,7 +15590,7 @@ func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLMatch_round(ctx, field, obj)
+				res = ec._AFLSeason_ladder(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -7875,16 +15617,19 @@ func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "homeClubMatch":
+		case "rounds":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLMatch_homeClubMatch(ctx, field, obj)
+				res = ec._AFLSeason_rounds(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -7908,16 +15653,55 @@ func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "awayClubMatch":
+		case "playerSeasons":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLMatch_awayClubMatch(ctx, field, obj)
+				res = ec._AFLSeason_playerSeasons(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "playerProposals":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AFLSeason_playerProposals(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -7964,29 +15748,47 @@ func (ec *executionContext) _AFLMatch(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var aFLPlayerImplementors = []string{"AFLPlayer", "_Entity"}
+var aFLSeasonSetupImplementors = []string{"AFLSeasonSetup"}
 
-func (ec *executionContext) _AFLPlayer(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayer) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerImplementors)
+func (ec *executionContext) _AFLSeasonSetup(ctx context.Context, sel ast.SelectionSet, obj *AFLSeasonSetup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLSeasonSetupImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLPlayer")
-		case "id":
-			out.Values[i] = ec._AFLPlayer_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("AFLSeasonSetup")
+		case "seasonId":
+			out.Values[i] = ec._AFLSeasonSetup_seasonId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._AFLPlayer_name(ctx, field, obj)
+		case "seasonName":
+			out.Values[i] = ec._AFLSeasonSetup_seasonName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "seasonCreated":
+			out.Values[i] = ec._AFLSeasonSetup_seasonCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundsCreated":
+			out.Values[i] = ec._AFLSeasonSetup_roundsCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clubSeasonsCreated":
+			out.Values[i] = ec._AFLSeasonSetup_clubSeasonsCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "matches":
+			out.Values[i] = ec._AFLSeasonSetup_matches(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "latestPlayerSeason":
-			out.Values[i] = ec._AFLPlayer_latestPlayerSeason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8010,120 +15812,280 @@ func (ec *executionContext) _AFLPlayer(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var aFLPlayerMatchImplementors = []string{"AFLPlayerMatch", "_Entity"}
+var aFLSeasonSetupMatchImplementors = []string{"AFLSeasonSetupMatch"}
 
-func (ec *executionContext) _AFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerMatch) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerMatchImplementors)
+func (ec *executionContext) _AFLSeasonSetupMatch(ctx context.Context, sel ast.SelectionSet, obj *AFLSeasonSetupMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLSeasonSetupMatchImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLPlayerMatch")
-		case "id":
-			out.Values[i] = ec._AFLPlayerMatch_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("AFLSeasonSetupMatch")
+		case "matchId":
+			out.Values[i] = ec._AFLSeasonSetupMatch_matchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "playerSeasonId":
-			out.Values[i] = ec._AFLPlayerMatch_playerSeasonId(ctx, field, obj)
+		case "roundName":
+			out.Values[i] = ec._AFLSeasonSetupMatch_roundName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "clubMatchId":
-			out.Values[i] = ec._AFLPlayerMatch_clubMatchId(ctx, field, obj)
+		case "homeClubName":
+			out.Values[i] = ec._AFLSeasonSetupMatch_homeClubName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "clubMatch":
-			field := field
+		case "awayClubName":
+			out.Values[i] = ec._AFLSeasonSetupMatch_awayClubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "venue":
+			out.Values[i] = ec._AFLSeasonSetupMatch_venue(ctx, field, obj)
+		case "startTime":
+			out.Values[i] = ec._AFLSeasonSetupMatch_startTime(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._AFLSeasonSetupMatch_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._AFLSeasonSetupMatch_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._AFLPlayerMatch_clubMatch(ctx, field, obj)
-				return res
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLStatImplementors = []string{"AFLStat"}
+
+func (ec *executionContext) _AFLStat(ctx context.Context, sel ast.SelectionSet, obj *AFLStat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLStatImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLStat")
+		case "name":
+			out.Values[i] = ec._AFLStat_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "value":
+			out.Values[i] = ec._AFLStat_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLStatChangeImplementors = []string{"AFLStatChange"}
+
+func (ec *executionContext) _AFLStatChange(ctx context.Context, sel ast.SelectionSet, obj *AFLStatChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLStatChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLStatChange")
+		case "stat":
+			out.Values[i] = ec._AFLStatChange_stat(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "old":
+			out.Values[i] = ec._AFLStatChange_old(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "new":
+			out.Values[i] = ec._AFLStatChange_new(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "player":
-			out.Values[i] = ec._AFLPlayerMatch_player(ctx, field, obj)
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLStatsDiscrepancyImplementors = []string{"AFLStatsDiscrepancy"}
+
+func (ec *executionContext) _AFLStatsDiscrepancy(ctx context.Context, sel ast.SelectionSet, obj *AFLStatsDiscrepancy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLStatsDiscrepancyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLStatsDiscrepancy")
+		case "clubMatchId":
+			out.Values[i] = ec._AFLStatsDiscrepancy_clubMatchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._AFLPlayerMatch_status(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._AFLStatsDiscrepancy_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "kicks":
-			out.Values[i] = ec._AFLPlayerMatch_kicks(ctx, field, obj)
+		case "playerSeasonId":
+			out.Values[i] = ec._AFLStatsDiscrepancy_playerSeasonId(ctx, field, obj)
+		case "playerName":
+			out.Values[i] = ec._AFLStatsDiscrepancy_playerName(ctx, field, obj)
+		case "stat":
+			out.Values[i] = ec._AFLStatsDiscrepancy_stat(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._AFLStatsDiscrepancy_source(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "handballs":
-			out.Values[i] = ec._AFLPlayerMatch_handballs(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._AFLStatsDiscrepancy_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "marks":
-			out.Values[i] = ec._AFLPlayerMatch_marks(ctx, field, obj)
+		case "otherSource":
+			out.Values[i] = ec._AFLStatsDiscrepancy_otherSource(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "hitouts":
-			out.Values[i] = ec._AFLPlayerMatch_hitouts(ctx, field, obj)
+		case "otherValue":
+			out.Values[i] = ec._AFLStatsDiscrepancy_otherValue(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "tackles":
-			out.Values[i] = ec._AFLPlayerMatch_tackles(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aFLStatsImportPreviewImplementors = []string{"AFLStatsImportPreview"}
+
+func (ec *executionContext) _AFLStatsImportPreview(ctx context.Context, sel ast.SelectionSet, obj *AFLStatsImportPreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aFLStatsImportPreviewImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AFLStatsImportPreview")
+		case "id":
+			out.Values[i] = ec._AFLStatsImportPreview_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "goals":
-			out.Values[i] = ec._AFLPlayerMatch_goals(ctx, field, obj)
+		case "matchId":
+			out.Values[i] = ec._AFLStatsImportPreview_matchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "behinds":
-			out.Values[i] = ec._AFLPlayerMatch_behinds(ctx, field, obj)
+		case "roundId":
+			out.Values[i] = ec._AFLStatsImportPreview_roundId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "disposals":
-			out.Values[i] = ec._AFLPlayerMatch_disposals(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._AFLStatsImportPreview_source(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "score":
-			out.Values[i] = ec._AFLPlayerMatch_score(ctx, field, obj)
+		case "clubMatches":
+			out.Values[i] = ec._AFLStatsImportPreview_clubMatches(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "unmatchedPlayers":
+			out.Values[i] = ec._AFLStatsImportPreview_unmatchedPlayers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "appliedAt":
+			out.Values[i] = ec._AFLStatsImportPreview_appliedAt(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8147,23 +16109,48 @@ func (ec *executionContext) _AFLPlayerMatch(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var aFLPlayerSeasonImplementors = []string{"AFLPlayerSeason", "_Entity"}
+var entityImplementors = []string{"Entity"}
 
-func (ec *executionContext) _AFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerSeason) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerSeasonImplementors)
+func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, entityImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Entity",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLPlayerSeason")
-		case "id":
-			out.Values[i] = ec._AFLPlayerSeason_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+			out.Values[i] = graphql.MarshalString("Entity")
+		case "findAFLPlayerByID":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Entity_findAFLPlayerByID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "player":
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "findAFLPlayerMatchByID":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8172,34 +16159,42 @@ func (ec *executionContext) _AFLPlayerSeason(ctx context.Context, sel ast.Select
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLPlayerSeason_player(ctx, field, obj)
+				res = ec._Entity_findAFLPlayerMatchByID(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "findAFLPlayerSeasonByID":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Entity_findAFLPlayerSeasonByID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+				return res
+			}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "clubSeason":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "findAFLRoundByID":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8208,69 +16203,110 @@ func (ec *executionContext) _AFLPlayerSeason(ctx context.Context, sel ast.Select
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLPlayerSeason_clubSeason(ctx, field, obj)
+				res = ec._Entity_findAFLRoundByID(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "findAFLSeasonByID":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Entity_findAFLSeasonByID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+				return res
+			}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var importAFLMatchStatsResultImplementors = []string{"ImportAFLMatchStatsResult"}
+
+func (ec *executionContext) _ImportAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, obj *ImportAFLMatchStatsResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, importAFLMatchStatsResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImportAFLMatchStatsResult")
+		case "matchId":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "homeClubName":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_homeClubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "matches":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._AFLPlayerSeason_matches(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "awayClubName":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_awayClubName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+		case "homePlayerCount":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_homePlayerCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "awayPlayerCount":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_awayPlayerCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unmatchedPlayers":
+			out.Values[i] = ec._ImportAFLMatchStatsResult_unmatchedPlayers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8294,24 +16330,24 @@ func (ec *executionContext) _AFLPlayerSeason(ctx context.Context, sel ast.Select
 	return out
 }
 
-var aFLPlayerSeasonConnectionImplementors = []string{"AFLPlayerSeasonConnection"}
+var importAFLRoundStatsResultImplementors = []string{"ImportAFLRoundStatsResult"}
 
-func (ec *executionContext) _AFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, obj *AFLPlayerSeasonConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLPlayerSeasonConnectionImplementors)
+func (ec *executionContext) _ImportAFLRoundStatsResult(ctx context.Context, sel ast.SelectionSet, obj *ImportAFLRoundStatsResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, importAFLRoundStatsResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLPlayerSeasonConnection")
-		case "nodes":
-			out.Values[i] = ec._AFLPlayerSeasonConnection_nodes(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ImportAFLRoundStatsResult")
+		case "roundId":
+			out.Values[i] = ec._ImportAFLRoundStatsResult_roundId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._AFLPlayerSeasonConnection_pageInfo(ctx, field, obj)
+		case "matches":
+			out.Values[i] = ec._ImportAFLRoundStatsResult_matches(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8338,99 +16374,187 @@ func (ec *executionContext) _AFLPlayerSeasonConnection(ctx context.Context, sel
 	return out
 }
 
-var aFLRoundImplementors = []string{"AFLRound", "_Entity"}
+var mutationImplementors = []string{"Mutation"}
 
-func (ec *executionContext) _AFLRound(ctx context.Context, sel ast.SelectionSet, obj *AFLRound) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLRoundImplementors)
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLRound")
-		case "id":
-			out.Values[i] = ec._AFLRound_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "addAFLPlayer":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addAFLPlayer(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._AFLRound_name(ctx, field, obj)
+		case "addAFLPlayerSeason":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addAFLPlayerSeason(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "season":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._AFLRound_season(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "updateAFLPlayerMatch":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateAFLPlayerMatch(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+		case "importAFLMatchStats":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_importAFLMatchStats(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "importAFLRoundStats":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_importAFLRoundStats(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "verifyAFLMatchStats":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_verifyAFLMatchStats(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "previewAFLMatchStatsImport":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_previewAFLMatchStatsImport(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "applyAFLStatsImportPreview":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_applyAFLStatsImportPreview(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setupAFLSeason":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setupAFLSeason(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposeAFLPlayerImport":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_proposeAFLPlayerImport(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acceptAFLPlayerProposals":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acceptAFLPlayerProposals(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejectAFLPlayerProposals":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectAFLPlayerProposals(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mergeAFLPlayers":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_mergeAFLPlayers(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resolveAFLPlayerMatch":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resolveAFLPlayerMatch(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "markAFLMatchStatsComplete":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_markAFLMatchStatsComplete(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recalculateAFLLadder":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recalculateAFLLadder(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setAFLPlayerAvailability":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setAFLPlayerAvailability(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "matches":
-			field := field
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._AFLRound_matches(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	return out
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+var pageInfoImplementors = []string{"PageInfo"}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "totalCount":
+			out.Values[i] = ec._PageInfo_totalCount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8454,28 +16578,26 @@ func (ec *executionContext) _AFLRound(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var aFLSeasonImplementors = []string{"AFLSeason", "_Entity"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _AFLSeason(ctx context.Context, sel ast.SelectionSet, obj *AFLSeason) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aFLSeasonImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AFLSeason")
-		case "id":
-			out.Values[i] = ec._AFLSeason_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._AFLSeason_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "ladder":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "aflSeasons":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8484,34 +16606,20 @@ func (ec *executionContext) _AFLSeason(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLSeason_ladder(ctx, field, obj)
+				res = ec._Query_aflSeasons(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "rounds":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflSeason":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8520,34 +16628,58 @@ func (ec *executionContext) _AFLSeason(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLSeason_rounds(ctx, field, obj)
+				res = ec._Query_aflSeason(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflRound":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_aflRound(ctx, field)
+				return res
 			}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "playerSeasons":
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflMatch":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_aflMatch(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflClubs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8556,88 +16688,70 @@ func (ec *executionContext) _AFLSeason(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AFLSeason_playerSeasons(ctx, field, obj)
+				res = ec._Query_aflClubs(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflClub":
+			field := field
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_aflClub(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	return out
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-var entityImplementors = []string{"Entity"}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflPlayerSeason":
+			field := field
 
-func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, entityImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Entity",
-	})
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_aflPlayerSeason(ctx, field)
+				return res
+			}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Entity")
-		case "findAFLPlayerByID":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aflLiveRound":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findAFLPlayerByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_aflLiveRound(ctx, field)
 				return res
 			}
 
@@ -8647,7 +16761,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findAFLPlayerMatchByID":
+		case "aflPlayerSearch":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8656,7 +16770,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findAFLPlayerMatchByID(ctx, field)
+				res = ec._Query_aflPlayerSearch(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -8669,7 +16783,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findAFLPlayerSeasonByID":
+		case "aflPlayerDuplicates":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8678,7 +16792,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findAFLPlayerSeasonByID(ctx, field)
+				res = ec._Query_aflPlayerDuplicates(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -8691,7 +16805,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findAFLRoundByID":
+		case "_entities":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8700,7 +16814,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findAFLRoundByID(ctx, field)
+				res = ec._Query__entities(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -8713,7 +16827,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findAFLSeasonByID":
+		case "_service":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -8722,7 +16836,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findAFLSeasonByID(ctx, field)
+				res = ec._Query__service(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -8735,6 +16849,14 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8758,44 +16880,59 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 	return out
 }
 
-var importAFLMatchStatsResultImplementors = []string{"ImportAFLMatchStatsResult"}
+var unmatchedAFLPlayerImplementors = []string{"UnmatchedAFLPlayer"}
 
-func (ec *executionContext) _ImportAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, obj *ImportAFLMatchStatsResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, importAFLMatchStatsResultImplementors)
+func (ec *executionContext) _UnmatchedAFLPlayer(ctx context.Context, sel ast.SelectionSet, obj *UnmatchedAFLPlayer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unmatchedAFLPlayerImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ImportAFLMatchStatsResult")
-		case "matchId":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_matchId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UnmatchedAFLPlayer")
+		case "parsedName":
+			out.Values[i] = ec._UnmatchedAFLPlayer_parsedName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "homeClubName":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_homeClubName(ctx, field, obj)
+		case "clubMatchId":
+			out.Values[i] = ec._UnmatchedAFLPlayer_clubMatchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "awayClubName":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_awayClubName(ctx, field, obj)
+		case "kicks":
+			out.Values[i] = ec._UnmatchedAFLPlayer_kicks(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "homePlayerCount":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_homePlayerCount(ctx, field, obj)
+		case "handballs":
+			out.Values[i] = ec._UnmatchedAFLPlayer_handballs(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "awayPlayerCount":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_awayPlayerCount(ctx, field, obj)
+		case "marks":
+			out.Values[i] = ec._UnmatchedAFLPlayer_marks(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "unmatchedPlayers":
-			out.Values[i] = ec._ImportAFLMatchStatsResult_unmatchedPlayers(ctx, field, obj)
+		case "hitouts":
+			out.Values[i] = ec._UnmatchedAFLPlayer_hitouts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tackles":
+			out.Values[i] = ec._UnmatchedAFLPlayer_tackles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "goals":
+			out.Values[i] = ec._UnmatchedAFLPlayer_goals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "behinds":
+			out.Values[i] = ec._UnmatchedAFLPlayer_behinds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8822,71 +16959,29 @@ func (ec *executionContext) _ImportAFLMatchStatsResult(ctx context.Context, sel
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var verifyAFLMatchStatsResultImplementors = []string{"VerifyAFLMatchStatsResult"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _VerifyAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, obj *VerifyAFLMatchStatsResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, verifyAFLMatchStatsResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "addAFLPlayer":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addAFLPlayer(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "addAFLPlayerSeason":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addAFLPlayerSeason(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updateAFLPlayerMatch":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateAFLPlayerMatch(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "importAFLMatchStats":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_importAFLMatchStats(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "resolveAFLPlayerMatch":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_resolveAFLPlayerMatch(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("VerifyAFLMatchStatsResult")
+		case "matchId":
+			out.Values[i] = ec._VerifyAFLMatchStatsResult_matchId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "markAFLMatchStatsComplete":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_markAFLMatchStatsComplete(ctx, field)
-			})
+		case "sources":
+			out.Values[i] = ec._VerifyAFLMatchStatsResult_sources(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "recalculateAFLLadder":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_recalculateAFLLadder(ctx, field)
-			})
+		case "discrepancies":
+			out.Values[i] = ec._VerifyAFLMatchStatsResult_discrepancies(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -8913,26 +17008,19 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var pageInfoImplementors = []string{"PageInfo"}
+var _ServiceImplementors = []string{"_Service"}
 
-func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet, obj *fedruntime.Service) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, _ServiceImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PageInfo")
-		case "hasNextPage":
-			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "endCursor":
-			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
-		case "totalCount":
-			out.Values[i] = ec._PageInfo_totalCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("_Service")
+		case "sdl":
+			out.Values[i] = ec.__Service_sdl(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8956,263 +17044,200 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "aflSeasons":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflSeasons(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflSeason":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflSeason(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflRound":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflRound(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflMatch":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflMatch(ctx, field)
-				return res
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflClubs":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflClubs(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflClub":
-			field := field
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflClub(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return out
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflPlayerSeason":
-			field := field
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflPlayerSeason(ctx, field)
-				return res
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflLiveRound":
-			field := field
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflLiveRound(ctx, field)
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "aflPlayerSearch":
-			field := field
+var __FieldImplementors = []string{"__Field"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_aflPlayerSearch(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "_entities":
-			field := field
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query__entities(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "_service":
-			field := field
+var __InputValueImplementors = []string{"__InputValue"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query__service(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9236,62 +17261,97 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var unmatchedAFLPlayerImplementors = []string{"UnmatchedAFLPlayer"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _UnmatchedAFLPlayer(ctx context.Context, sel ast.SelectionSet, obj *UnmatchedAFLPlayer) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, unmatchedAFLPlayerImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("UnmatchedAFLPlayer")
-		case "parsedName":
-			out.Values[i] = ec._UnmatchedAFLPlayer_parsedName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "clubMatchId":
-			out.Values[i] = ec._UnmatchedAFLPlayer_clubMatchId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "kicks":
-			out.Values[i] = ec._UnmatchedAFLPlayer_kicks(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "handballs":
-			out.Values[i] = ec._UnmatchedAFLPlayer_handballs(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "marks":
-			out.Values[i] = ec._UnmatchedAFLPlayer_marks(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "hitouts":
-			out.Values[i] = ec._UnmatchedAFLPlayer_hitouts(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "tackles":
-			out.Values[i] = ec._UnmatchedAFLPlayer_tackles(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "goals":
-			out.Values[i] = ec._UnmatchedAFLPlayer_goals(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "behinds":
-			out.Values[i] = ec._UnmatchedAFLPlayer_behinds(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9301,400 +17361,502 @@ func (ec *executionContext) _UnmatchedAFLPlayer(ctx context.Context, sel ast.Sel
 		return graphql.Null
 	}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAFLClub2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx context.Context, sel ast.SelectionSet, v AFLClub) graphql.Marshaler {
+	return ec._AFLClub(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAFLClub2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLClub) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx context.Context, sel ast.SelectionSet, v *AFLClub) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLClub(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAFLClubMatchStatsPreview2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatchStatsPreviewᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLClubMatchStatsPreview) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLClubMatchStatsPreview2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatchStatsPreview(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLClubMatchStatsPreview2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubMatchStatsPreview(ctx context.Context, sel ast.SelectionSet, v *AFLClubMatchStatsPreview) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLClubMatchStatsPreview(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAFLClubSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx context.Context, sel ast.SelectionSet, v AFLClubSeason) graphql.Marshaler {
+	return ec._AFLClubSeason(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAFLClubSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLClubSeason) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx context.Context, sel ast.SelectionSet, v *AFLClubSeason) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLClubSeason(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAFLListedPlayerInput2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLListedPlayerInput(ctx context.Context, v any) (*AFLListedPlayerInput, error) {
+	res, err := ec.unmarshalInputAFLListedPlayerInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAFLMatch2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx context.Context, sel ast.SelectionSet, v AFLMatch) graphql.Marshaler {
+	return ec._AFLMatch(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAFLMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLMatch) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx context.Context, sel ast.SelectionSet, v *AFLMatch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLMatch(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAFLMergedPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMergedPlayerSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLMergedPlayerSeason) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLMergedPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMergedPlayerSeason(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLMergedPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMergedPlayerSeason(ctx context.Context, sel ast.SelectionSet, v *AFLMergedPlayerSeason) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._AFLMergedPlayerSeason(ctx, sel, v)
 }
 
-var _ServiceImplementors = []string{"_Service"}
+func (ec *executionContext) marshalNAFLPlayer2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx context.Context, sel ast.SelectionSet, v AFLPlayer) graphql.Marshaler {
+	return ec._AFLPlayer(ctx, sel, &v)
+}
 
-func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet, obj *fedruntime.Service) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, _ServiceImplementors)
+func (ec *executionContext) marshalNAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayer) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("_Service")
-		case "sdl":
-			out.Values[i] = ec.__Service_sdl(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx context.Context, sel ast.SelectionSet, v *AFLPlayer) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._AFLPlayer(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNAFLPlayerAvailability2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailability(ctx context.Context, sel ast.SelectionSet, v AFLPlayerAvailability) graphql.Marshaler {
+	return ec._AFLPlayerAvailability(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLPlayerAvailability2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailabilityᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerAvailability) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerAvailability2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailability(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNAFLPlayerAvailability2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerAvailability(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerAvailability) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLPlayerAvailability(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNAFLPlayerDuplicate2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerDuplicateᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerDuplicate) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerDuplicate2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerDuplicate(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLPlayerDuplicate2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerDuplicate(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerDuplicate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._AFLPlayerDuplicate(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNAFLPlayerImport2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerImport(ctx context.Context, sel ast.SelectionSet, v AFLPlayerImport) graphql.Marshaler {
+	return ec._AFLPlayerImport(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLPlayerImport2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerImport(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerImport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._AFLPlayerImport(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNAFLPlayerMatch2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, v AFLPlayerMatch) graphql.Marshaler {
+	return ec._AFLPlayerMatch(ctx, sel, &v)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerMatch) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerMatch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._AFLPlayerMatch(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNAFLPlayerMerge2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMerge(ctx context.Context, sel ast.SelectionSet, v AFLPlayerMerge) graphql.Marshaler {
+	return ec._AFLPlayerMerge(ctx, sel, &v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) marshalNAFLPlayerMerge2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMerge(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerMerge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLPlayerMerge(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNAFLPlayerProposal2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerProposal) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerProposal2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposal(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLPlayerProposal2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposal(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerProposal) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._AFLPlayerProposal(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNAFLPlayerProposalKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalKind(ctx context.Context, v any) (AFLPlayerProposalKind, error) {
+	var res AFLPlayerProposalKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) marshalNAFLPlayerProposalKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalKind(ctx context.Context, sel ast.SelectionSet, v AFLPlayerProposalKind) graphql.Marshaler {
+	return v
+}
 
-	return out
+func (ec *executionContext) unmarshalNAFLPlayerProposalStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus(ctx context.Context, v any) (AFLPlayerProposalStatus, error) {
+	var res AFLPlayerProposalStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNAFLPlayerProposalStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus(ctx context.Context, sel ast.SelectionSet, v AFLPlayerProposalStatus) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNAFLPlayerSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, v AFLPlayerSeason) graphql.Marshaler {
+	return ec._AFLPlayerSeason(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAFLPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerSeason) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerSeason) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._AFLPlayerSeason(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNAFLPlayerSeasonConnection2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, v AFLPlayerSeasonConnection) graphql.Marshaler {
+	return ec._AFLPlayerSeasonConnection(ctx, sel, &v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNAFLPlayerSeasonConnection2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerSeasonConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLPlayerSeasonConnection(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNAFLPlayerStatsDiff2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiffᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerStatsDiff) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLPlayerStatsDiff2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiff(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAFLPlayerStatsDiff2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiff(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerStatsDiff) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._AFLPlayerStatsDiff(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNAFLPlayerStatsDiffKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiffKind(ctx context.Context, v any) (AFLPlayerStatsDiffKind, error) {
+	var res AFLPlayerStatsDiffKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) marshalNAFLPlayerStatsDiffKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerStatsDiffKind(ctx context.Context, sel ast.SelectionSet, v AFLPlayerStatsDiffKind) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNAFLQuarterBreak2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterBreakᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLQuarterBreak) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLQuarterBreak2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterBreak(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAFLQuarterBreak2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterBreak(ctx context.Context, sel ast.SelectionSet, v *AFLQuarterBreak) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._AFLQuarterBreak(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNAFLQuarterScore2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScoreᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLQuarterScore) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAFLQuarterScore2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScore(ctx, sel, v[i])
+	})
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNAFLQuarterScore2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLQuarterScore(ctx context.Context, sel ast.SelectionSet, v *AFLQuarterScore) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLQuarterScore(ctx, sel, v)
+}
 
-func (ec *executionContext) marshalNAFLClub2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx context.Context, sel ast.SelectionSet, v AFLClub) graphql.Marshaler {
-	return ec._AFLClub(ctx, sel, &v)
+func (ec *executionContext) marshalNAFLRound2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx context.Context, sel ast.SelectionSet, v AFLRound) graphql.Marshaler {
+	return ec._AFLRound(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAFLClub2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLClub) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLRound2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLRound) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx, sel, v[i])
+		return ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9706,25 +17868,21 @@ func (ec *executionContext) marshalNAFLClub2ᚕᚖxfflᚋservicesᚋaflᚋintern
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLClub2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClub(ctx context.Context, sel ast.SelectionSet, v *AFLClub) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx context.Context, sel ast.SelectionSet, v *AFLRound) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLClub(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAFLClubSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx context.Context, sel ast.SelectionSet, v AFLClubSeason) graphql.Marshaler {
-	return ec._AFLClubSeason(ctx, sel, &v)
+	return ec._AFLRound(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLClubSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLClubSeason) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLRoundMatchImport2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImportᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLRoundMatchImport) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx, sel, v[i])
+		return ec.marshalNAFLRoundMatchImport2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImport(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9736,25 +17894,35 @@ func (ec *executionContext) marshalNAFLClubSeason2ᚕᚖxfflᚋservicesᚋaflᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLClubSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLClubSeason(ctx context.Context, sel ast.SelectionSet, v *AFLClubSeason) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLRoundMatchImport2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImport(ctx context.Context, sel ast.SelectionSet, v *AFLRoundMatchImport) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLClubSeason(ctx, sel, v)
+	return ec._AFLRoundMatchImport(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLMatch2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx context.Context, sel ast.SelectionSet, v AFLMatch) graphql.Marshaler {
-	return ec._AFLMatch(ctx, sel, &v)
+func (ec *executionContext) unmarshalNAFLRoundMatchImportStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImportStatus(ctx context.Context, v any) (AFLRoundMatchImportStatus, error) {
+	var res AFLRoundMatchImportStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAFLMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLMatch) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLRoundMatchImportStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundMatchImportStatus(ctx context.Context, sel ast.SelectionSet, v AFLRoundMatchImportStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAFLSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx context.Context, sel ast.SelectionSet, v AFLSeason) graphql.Marshaler {
+	return ec._AFLSeason(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAFLSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLSeason) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx, sel, v[i])
+		return ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9766,25 +17934,35 @@ func (ec *executionContext) marshalNAFLMatch2ᚕᚖxfflᚋservicesᚋaflᚋinter
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatch(ctx context.Context, sel ast.SelectionSet, v *AFLMatch) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx context.Context, sel ast.SelectionSet, v *AFLSeason) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLMatch(ctx, sel, v)
+	return ec._AFLSeason(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLPlayer2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx context.Context, sel ast.SelectionSet, v AFLPlayer) graphql.Marshaler {
-	return ec._AFLPlayer(ctx, sel, &v)
+func (ec *executionContext) marshalNAFLSeasonSetup2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetup(ctx context.Context, sel ast.SelectionSet, v AFLSeasonSetup) graphql.Marshaler {
+	return ec._AFLSeasonSetup(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayer) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLSeasonSetup2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetup(ctx context.Context, sel ast.SelectionSet, v *AFLSeasonSetup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AFLSeasonSetup(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAFLSeasonSetupMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLSeasonSetupMatch) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx, sel, v[i])
+		return ec.marshalNAFLSeasonSetupMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatch(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9796,25 +17974,31 @@ func (ec *executionContext) marshalNAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinte
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLPlayer2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayer(ctx context.Context, sel ast.SelectionSet, v *AFLPlayer) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLSeasonSetupMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatch(ctx context.Context, sel ast.SelectionSet, v *AFLSeasonSetupMatch) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLPlayer(ctx, sel, v)
+	return ec._AFLSeasonSetupMatch(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLPlayerMatch2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, v AFLPlayerMatch) graphql.Marshaler {
-	return ec._AFLPlayerMatch(ctx, sel, &v)
+func (ec *executionContext) unmarshalNAFLSeasonSetupMatchStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatchStatus(ctx context.Context, v any) (AFLSeasonSetupMatchStatus, error) {
+	var res AFLSeasonSetupMatchStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerMatch) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLSeasonSetupMatchStatus2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonSetupMatchStatus(ctx context.Context, sel ast.SelectionSet, v AFLSeasonSetupMatchStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAFLStat2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLStat) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx, sel, v[i])
+		return ec.marshalNAFLStat2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStat(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9826,25 +18010,21 @@ func (ec *executionContext) marshalNAFLPlayerMatch2ᚕᚖxfflᚋservicesᚋafl
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLPlayerMatch2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerMatch(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerMatch) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStat2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStat(ctx context.Context, sel ast.SelectionSet, v *AFLStat) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLPlayerMatch(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAFLPlayerSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, v AFLPlayerSeason) graphql.Marshaler {
-	return ec._AFLPlayerSeason(ctx, sel, &v)
+	return ec._AFLStat(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLPlayerSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLPlayerSeason) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStatChange2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLStatChange) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx, sel, v[i])
+		return ec.marshalNAFLStatChange2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatChange(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9856,39 +18036,21 @@ func (ec *executionContext) marshalNAFLPlayerSeason2ᚕᚖxfflᚋservicesᚋafl
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerSeason) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._AFLPlayerSeason(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAFLPlayerSeasonConnection2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, v AFLPlayerSeasonConnection) graphql.Marshaler {
-	return ec._AFLPlayerSeasonConnection(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNAFLPlayerSeasonConnection2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeasonConnection(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerSeasonConnection) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStatChange2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatChange(ctx context.Context, sel ast.SelectionSet, v *AFLStatChange) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLPlayerSeasonConnection(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAFLRound2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx context.Context, sel ast.SelectionSet, v AFLRound) graphql.Marshaler {
-	return ec._AFLRound(ctx, sel, &v)
+	return ec._AFLStatChange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLRound2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRoundᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLRound) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStatsDiscrepancy2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLStatsDiscrepancy) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx, sel, v[i])
+		return ec.marshalNAFLStatsDiscrepancy2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancy(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -9900,44 +18062,38 @@ func (ec *executionContext) marshalNAFLRound2ᚕᚖxfflᚋservicesᚋaflᚋinter
 	return ret
 }
 
-func (ec *executionContext) marshalNAFLRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLRound(ctx context.Context, sel ast.SelectionSet, v *AFLRound) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStatsDiscrepancy2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancy(ctx context.Context, sel ast.SelectionSet, v *AFLStatsDiscrepancy) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLRound(ctx, sel, v)
+	return ec._AFLStatsDiscrepancy(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAFLSeason2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx context.Context, sel ast.SelectionSet, v AFLSeason) graphql.Marshaler {
-	return ec._AFLSeason(ctx, sel, &v)
+func (ec *executionContext) unmarshalNAFLStatsDiscrepancyKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyKind(ctx context.Context, v any) (AFLStatsDiscrepancyKind, error) {
+	var res AFLStatsDiscrepancyKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAFLSeason2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*AFLSeason) graphql.Marshaler {
-	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
-		fc := graphql.GetFieldContext(ctx)
-		fc.Result = &v[i]
-		return ec.marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx, sel, v[i])
-	})
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
+func (ec *executionContext) marshalNAFLStatsDiscrepancyKind2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsDiscrepancyKind(ctx context.Context, sel ast.SelectionSet, v AFLStatsDiscrepancyKind) graphql.Marshaler {
+	return v
+}
 
-	return ret
+func (ec *executionContext) marshalNAFLStatsImportPreview2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsImportPreview(ctx context.Context, sel ast.SelectionSet, v AFLStatsImportPreview) graphql.Marshaler {
+	return ec._AFLStatsImportPreview(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAFLSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLSeason(ctx context.Context, sel ast.SelectionSet, v *AFLSeason) graphql.Marshaler {
+func (ec *executionContext) marshalNAFLStatsImportPreview2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLStatsImportPreview(ctx context.Context, sel ast.SelectionSet, v *AFLStatsImportPreview) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AFLSeason(ctx, sel, v)
+	return ec._AFLStatsImportPreview(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNAddAFLPlayerInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAddAFLPlayerInput(ctx context.Context, v any) (AddAFLPlayerInput, error) {
@@ -9982,6 +18138,22 @@ func (ec *executionContext) marshalNFieldSet2string(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9998,6 +18170,36 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNImportAFLMatchStatsResult2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, v ImportAFLMatchStatsResult) graphql.Marshaler {
 	return ec._ImportAFLMatchStatsResult(ctx, sel, &v)
 }
@@ -10012,6 +18214,20 @@ func (ec *executionContext) marshalNImportAFLMatchStatsResult2ᚖxfflᚋservices
 	return ec._ImportAFLMatchStatsResult(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNImportAFLRoundStatsResult2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLRoundStatsResult(ctx context.Context, sel ast.SelectionSet, v ImportAFLRoundStatsResult) graphql.Marshaler {
+	return ec._ImportAFLRoundStatsResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNImportAFLRoundStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLRoundStatsResult(ctx context.Context, sel ast.SelectionSet, v *ImportAFLRoundStatsResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ImportAFLRoundStatsResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10043,6 +18259,11 @@ func (ec *executionContext) unmarshalNResolveAFLPlayerMatchInput2xfflᚋservices
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNSetAFLPlayerAvailabilityInput2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐSetAFLPlayerAvailabilityInput(ctx context.Context, v any) (SetAFLPlayerAvailabilityInput, error) {
+	res, err := ec.unmarshalInputSetAFLPlayerAvailabilityInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10059,6 +18280,36 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNUnmatchedAFLPlayer2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐUnmatchedAFLPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*UnmatchedAFLPlayer) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -10090,6 +18341,20 @@ func (ec *executionContext) unmarshalNUpdateAFLPlayerMatchInput2xfflᚋservices
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNVerifyAFLMatchStatsResult2xfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐVerifyAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, v VerifyAFLMatchStatsResult) graphql.Marshaler {
+	return ec._VerifyAFLMatchStatsResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNVerifyAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐVerifyAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, v *VerifyAFLMatchStatsResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VerifyAFLMatchStatsResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalN_Any2map(ctx context.Context, v any) (map[string]any, error) {
 	res, err := graphql.UnmarshalMap(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10456,6 +18721,24 @@ func (ec *executionContext) marshalOAFLClubMatch2ᚖxfflᚋservicesᚋaflᚋinte
 	return ec._AFLClubMatch(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOAFLListedPlayerInput2ᚕᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLListedPlayerInputᚄ(ctx context.Context, v any) ([]*AFLListedPlayerInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*AFLListedPlayerInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNAFLListedPlayerInput2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLListedPlayerInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOAFLLiveRound2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLLiveRound(ctx context.Context, sel ast.SelectionSet, v *AFLLiveRound) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10470,6 +18753,29 @@ func (ec *executionContext) marshalOAFLMatch2ᚖxfflᚋservicesᚋaflᚋinternal
 	return ec._AFLMatch(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOAFLMatchTimeline2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLMatchTimeline(ctx context.Context, sel ast.SelectionSet, v *AFLMatchTimeline) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AFLMatchTimeline(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOAFLPlayerProposalStatus2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus(ctx context.Context, v any) (*AFLPlayerProposalStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(AFLPlayerProposalStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOAFLPlayerProposalStatus2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerProposalStatus(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerProposalStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOAFLPlayerSeason2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐAFLPlayerSeason(ctx context.Context, sel ast.SelectionSet, v *AFLPlayerSeason) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10522,6 +18828,48 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOImportAFLMatchStatsResult2ᚖxfflᚋservicesᚋaflᚋinternalᚋinterfaceᚋgraphqlᚐImportAFLMatchStatsResult(ctx context.Context, sel ast.SelectionSet, v *ImportAFLMatchStatsResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ImportAFLMatchStatsResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
 	if v == nil {
 		return nil, nil
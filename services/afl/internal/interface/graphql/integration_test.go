@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,8 +20,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"xffl/contracts/events"
 	"xffl/services/afl/internal/application"
+	"xffl/services/afl/internal/infrastructure/afltables"
 	footywire "xffl/services/afl/internal/infrastructure/footywire"
+	"xffl/services/afl/internal/infrastructure/httpreplay"
+	"xffl/services/afl/internal/infrastructure/sourcehttp"
 	pg "xffl/services/afl/internal/infrastructure/postgres"
 	"xffl/services/afl/internal/infrastructure/postgres/sqlcgen"
 	gql "xffl/services/afl/internal/interface/graphql"
@@ -54,6 +60,7 @@ func setupTestServerWithClock(t *testing.T, pool *pgxpool.Pool, clk clock.Clock)
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewAvailabilityRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -151,6 +158,8 @@ func seedTestData(t *testing.T, pool *pgxpool.Pool) testIDs {
 
 func cleanupTestData(ctx context.Context, t *testing.T, pool *pgxpool.Pool) {
 	tables := []string{
+		"afl.dataops_player_proposal",
+		"afl.player_availability",
 		"afl.player_match",
 		"afl.player_season",
 		"afl.player",
@@ -830,12 +839,26 @@ func (s *stubStatsParser) ParseMatch(_ context.Context, _ string) (application.M
 }
 
 // stubFixtureDiscovery implements application.FixtureDiscovery without hitting FootyWire.
-type stubFixtureDiscovery struct{}
+// fixture is the season fixture it lists.
+type stubFixtureDiscovery struct{ fixture []application.FixtureListing }
 
-func (s *stubFixtureDiscovery) FindMatchMid(_ context.Context, _, _, _ string) (string, error) {
+func (s *stubFixtureDiscovery) FindMatchMid(_ context.Context, _ string, _ application.FixtureQuery) (string, error) {
 	return "test-mid", nil
 }
 
+// FindRoundMids finds every match, complete.
+func (s *stubFixtureDiscovery) FindRoundMids(_ context.Context, _ string, matches []application.FixtureQuery) (map[int]application.FixtureMatch, error) {
+	found := make(map[int]application.FixtureMatch, len(matches))
+	for _, m := range matches {
+		found[m.MatchID] = application.FixtureMatch{Mid: "test-mid", Complete: true}
+	}
+	return found, nil
+}
+
+func (s *stubFixtureDiscovery) ListSeasonFixture(_ context.Context, _ int) ([]application.FixtureListing, error) {
+	return s.fixture, nil
+}
+
 func setupTestServerWithDataOps(t *testing.T, pool *pgxpool.Pool, parser application.StatsParser, discovery application.FixtureDiscovery) *httptest.Server {
 	t.Helper()
 
@@ -851,6 +874,7 @@ func setupTestServerWithDataOps(t *testing.T, pool *pgxpool.Pool, parser applica
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewAvailabilityRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -862,24 +886,28 @@ func setupTestServerWithDataOps(t *testing.T, pool *pgxpool.Pool, parser applica
 		pg.NewRoundRepository(q, pool),
 		memevents.New(),
 	)
-	dataOps := application.NewDataOpsCommands(
+	dataOps := newTestDataOps(pool, parser, discovery, memevents.New())
+	seasonSetup := application.NewSeasonSetupCommands(db, pg.NewClubRepository(q), testStatsSources(parser, discovery))
+	playerImport := application.NewPlayerImportCommands(
 		db,
-		pg.NewMatchRepository(q),
-		pg.NewClubMatchRepository(q),
-		pg.NewClubSeasonRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewClubRepository(q),
-		pg.NewRoundRepository(q, pool),
+		pg.NewClubSeasonRepository(q),
+		pg.NewPlayerRepository(q),
 		pg.NewPlayerSeasonRepository(q),
-		pg.NewPlayerMatchRepository(q),
-		pg.NewDataopsMatchSourceRepository(q),
 		pg.NewDataopsPlayerSourceRepository(q),
-		parser,
-		discovery,
-		footywire.NewLevenshteinResolver(),
-		memevents.New(),
+		pg.NewDataopsPlayerProposalRepository(q),
+		testStatsSources(parser, discovery),
+		footywire.NewNameResolver(),
 	)
 
-	resolver := &gql.Resolver{Queries: queries, Commands: commands, DataOps: dataOps}
+	resolver := &gql.Resolver{
+		Queries:      queries,
+		Commands:     commands,
+		DataOps:      dataOps,
+		SeasonSetup:  seasonSetup,
+		PlayerImport: playerImport,
+	}
 	srv := gqlhandler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
 
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -889,7 +917,42 @@ func setupTestServerWithDataOps(t *testing.T, pool *pgxpool.Pool, parser applica
 	return httptest.NewServer(h)
 }
 
+// newTestDataOps imports from parser and discovery as FootyWire, and from the
+// afltables exports in testdata/afltables.
+func newTestDataOps(pool *pgxpool.Pool, parser application.StatsParser, discovery application.FixtureDiscovery, dispatcher *memevents.Dispatcher) *application.DataOpsCommands {
+	q := sqlcgen.New(pool)
+	return application.NewDataOpsCommands(
+		pg.NewDB(pool),
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubRepository(q),
+		pg.NewRoundRepository(q, pool),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewAvailabilityRepository(q),
+		pg.NewDataopsMatchSourceRepository(q),
+		pg.NewDataopsPlayerSourceRepository(q),
+		pg.NewDataopsStatsDiscrepancyRepository(q, pool),
+		pg.NewDataopsStatsPreviewRepository(q),
+		testStatsSources(parser, discovery),
+		footywire.NewNameResolver(),
+		dispatcher,
+	)
+}
+
+// testStatsSources offers parser and discovery as FootyWire, and the
+// afltables exports in testdata/afltables.
+func testStatsSources(parser application.StatsParser, discovery application.FixtureDiscovery) map[string]application.StatsSource {
+	afltablesClient := afltables.NewClient("testdata/afltables")
+	return map[string]application.StatsSource{
+		footywire.Source: {Parser: parser, Discovery: discovery},
+		afltables.Source: {Parser: afltablesClient, Discovery: afltablesClient, Players: afltablesClient},
+	}
+}
+
 type dataOpsTestIDs struct {
+	roundID         int
 	matchID         int
 	homeClubMatchID int
 	awayClubMatchID int
@@ -901,7 +964,7 @@ func seedDataOpsTestData(t *testing.T, pool *pgxpool.Pool) dataOpsTestIDs {
 	cleanupTestData(ctx, t, pool)
 
 	var ids dataOpsTestIDs
-	var leagueID, seasonID, roundID int
+	var leagueID, seasonID int
 	var carltonID, richmondID int
 	var carltonSeasonID, richmondSeasonID int
 
@@ -912,7 +975,7 @@ func seedDataOpsTestData(t *testing.T, pool *pgxpool.Pool) dataOpsTestIDs {
 		leagueID).Scan(&seasonID))
 	require.NoError(t, pool.QueryRow(ctx,
 		"INSERT INTO afl.round (name, season_id) VALUES ('Round 1', $1) RETURNING id",
-		seasonID).Scan(&roundID))
+		seasonID).Scan(&ids.roundID))
 
 	require.NoError(t, pool.QueryRow(ctx,
 		"INSERT INTO afl.club (name) VALUES ('Carlton') RETURNING id").Scan(&carltonID))
@@ -930,7 +993,7 @@ func seedDataOpsTestData(t *testing.T, pool *pgxpool.Pool) dataOpsTestIDs {
 
 	require.NoError(t, pool.QueryRow(ctx,
 		"INSERT INTO afl.match (round_id, venue, start_dt) VALUES ($1, 'MCG', '2025-05-01 19:30:00') RETURNING id",
-		roundID).Scan(&ids.matchID))
+		ids.roundID).Scan(&ids.matchID))
 	require.NoError(t, pool.QueryRow(ctx,
 		"INSERT INTO afl.club_match (match_id, club_season_id, drv_score, rushed_behinds, side) VALUES ($1, $2, 0, 0, 'home') RETURNING id",
 		ids.matchID, carltonSeasonID).Scan(&ids.homeClubMatchID))
@@ -991,31 +1054,830 @@ func TestImportAFLMatchStats(t *testing.T) {
 			} `json:"unmatchedPlayers"`
 		} `json:"importAFLMatchStats"`
 	}
-	require.NoError(t, json.Unmarshal(result.Data, &data))
-	imp := data.ImportAFLMatchStats
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+	imp := data.ImportAFLMatchStats
+
+	t.Run("all home players matched and written", func(t *testing.T) {
+		assert.Equal(t, 2, imp.HomePlayerCount) // Patrick Cripps + Sam Walsh
+	})
+	t.Run("all away players matched and written", func(t *testing.T) {
+		assert.Equal(t, 2, imp.AwayPlayerCount) // Dustin Martin + Trent Cotchin
+	})
+	t.Run("no unmatched players", func(t *testing.T) {
+		assert.Empty(t, imp.UnmatchedPlayers)
+	})
+	t.Run("match data status set to partial", func(t *testing.T) {
+		statusResult := execQuery(t, server, fmt.Sprintf(`{
+			aflMatch(id: "%d") { dataStatus }
+		}`, ids.matchID))
+		require.Empty(t, statusResult.Errors)
+
+		var statusData struct {
+			AflMatch struct {
+				DataStatus string `json:"dataStatus"`
+			} `json:"aflMatch"`
+		}
+		require.NoError(t, json.Unmarshal(statusResult.Data, &statusData))
+		assert.Equal(t, "partial", statusData.AflMatch.DataStatus)
+	})
+}
+
+func TestImportAFLMatchStats_AFLTables(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+	ctx := context.Background()
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation {
+		importAFLMatchStats(matchId: "%d", source: "afltables") {
+			source homePlayerCount awayPlayerCount
+			unmatchedPlayers { parsedName clubMatchId kicks handballs marks hitouts tackles goals behinds }
+		}
+	}`, ids.matchID))
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		ImportAFLMatchStats struct {
+			Source           string `json:"source"`
+			HomePlayerCount  int    `json:"homePlayerCount"`
+			AwayPlayerCount  int    `json:"awayPlayerCount"`
+			UnmatchedPlayers []struct {
+				ParsedName  string `json:"parsedName"`
+				ClubMatchID string `json:"clubMatchId"`
+				Kicks       int    `json:"kicks"`
+				Handballs   int    `json:"handballs"`
+				Marks       int    `json:"marks"`
+				Hitouts     int    `json:"hitouts"`
+				Tackles     int    `json:"tackles"`
+				Goals       int    `json:"goals"`
+				Behinds     int    `json:"behinds"`
+			} `json:"unmatchedPlayers"`
+		} `json:"importAFLMatchStats"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+	imp := data.ImportAFLMatchStats
+
+	t.Run("imports from the afltables export", func(t *testing.T) {
+		assert.Equal(t, "afltables", imp.Source)
+		assert.Equal(t, 2, imp.HomePlayerCount)
+		assert.Equal(t, 2, imp.AwayPlayerCount)
+
+		var kicks, handballs, tackles int
+		require.NoError(t, pool.QueryRow(ctx, `
+			SELECT pm.kicks, pm.handballs, pm.tackles
+			FROM afl.player_match pm
+			JOIN afl.player_season ps ON ps.id = pm.player_season_id
+			JOIN afl.player p ON p.id = ps.player_id
+			WHERE p.name = 'Patrick Cripps'`).Scan(&kicks, &handballs, &tackles))
+		assert.Equal(t, []int{16, 8, 5}, []int{kicks, handballs, tackles})
+	})
+
+	t.Run("match mapping stored under afltables", func(t *testing.T) {
+		var externalID string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT external_id FROM afl.dataops_match_source WHERE source = 'afltables' AND match_id = $1",
+			ids.matchID).Scan(&externalID))
+		assert.Equal(t, "2025/1/Carlton/Richmond", externalID)
+	})
+
+	t.Run("player mapping stored under afltables", func(t *testing.T) {
+		require.Len(t, imp.UnmatchedPlayers, 1)
+		u := imp.UnmatchedPlayers[0]
+		assert.Equal(t, "Zac Williams", u.ParsedName)
+
+		var playerSeasonID int
+		require.NoError(t, pool.QueryRow(ctx, `
+			SELECT ps.id FROM afl.player_season ps JOIN afl.player p ON p.id = ps.player_id
+			WHERE p.name = 'Sam Walsh'`).Scan(&playerSeasonID))
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			resolveAFLPlayerMatch(input: {
+				clubMatchId: "%s", playerSeasonId: "%d", parsedName: "%s", source: "afltables",
+				kicks: %d, handballs: %d, marks: %d, hitouts: %d, tackles: %d, goals: %d, behinds: %d
+			}) { id }
+		}`, u.ClubMatchID, playerSeasonID, u.ParsedName,
+			u.Kicks, u.Handballs, u.Marks, u.Hitouts, u.Tackles, u.Goals, u.Behinds))
+		require.Empty(t, result.Errors)
+
+		var mapped int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT player_season_id FROM afl.dataops_player_source WHERE source = 'afltables' AND external_player = 'Zac Williams'").Scan(&mapped))
+		assert.Equal(t, playerSeasonID, mapped)
+	})
+
+	t.Run("unknown source", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			importAFLMatchStats(matchId: "%d", source: "champion-data") { matchId }
+		}`, ids.matchID))
+		require.NotEmpty(t, result.Errors)
+		assert.Contains(t, result.Errors[0].Message, "unknown stats source")
+	})
+}
+
+func TestImportAFLMatchStats_ExtraStats(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond_extra.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	type stat struct {
+		Name  string  `json:"name"`
+		Value float64 `json:"value"`
+	}
+	playerStats := func(t *testing.T) map[string][]stat {
+		t.Helper()
+		result := execQuery(t, server, fmt.Sprintf(`{
+			aflMatch(id: "%d") {
+				homeClubMatch { playerMatches { kicks player { name } extraStats { name value } } }
+				awayClubMatch { playerMatches { kicks player { name } extraStats { name value } } }
+			}
+		}`, ids.matchID))
+		require.Empty(t, result.Errors)
+		type clubMatch struct {
+			PlayerMatches []struct {
+				Player     struct{ Name string } `json:"player"`
+				ExtraStats []stat                `json:"extraStats"`
+			} `json:"playerMatches"`
+		}
+		var data struct {
+			AflMatch struct {
+				HomeClubMatch clubMatch `json:"homeClubMatch"`
+				AwayClubMatch clubMatch `json:"awayClubMatch"`
+			} `json:"aflMatch"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		out := map[string][]stat{}
+		for _, cm := range []clubMatch{data.AflMatch.HomeClubMatch, data.AflMatch.AwayClubMatch} {
+			for _, pm := range cm.PlayerMatches {
+				out[pm.Player.Name] = pm.ExtraStats
+			}
+		}
+		return out
+	}
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation { importAFLMatchStats(matchId: "%d") { matchId } }`, ids.matchID))
+	require.Empty(t, result.Errors)
+
+	t.Run("extra stats are stored and listed by name", func(t *testing.T) {
+		stats := playerStats(t)
+		assert.Equal(t, []stat{{"contested_possessions", 14}, {"time_on_ground_pct", 86}}, stats["Patrick Cripps"])
+		assert.Empty(t, stats["Dustin Martin"], "the source has no extra stats for Richmond")
+	})
+
+	t.Run("a source without them keeps the stored extra stats", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			importAFLMatchStats(matchId: "%d", source: "afltables") { matchId }
+		}`, ids.matchID))
+		require.Empty(t, result.Errors)
+
+		var kicks int
+		require.NoError(t, pool.QueryRow(context.Background(), `
+			SELECT pm.kicks FROM afl.player_match pm
+			JOIN afl.player_season ps ON ps.id = pm.player_season_id
+			JOIN afl.player p ON p.id = ps.player_id
+			WHERE p.name = 'Patrick Cripps'`).Scan(&kicks))
+		assert.Equal(t, 16, kicks, "core stats come from the latest import")
+		assert.Equal(t, []stat{{"contested_possessions", 14}, {"time_on_ground_pct", 86}}, playerStats(t)["Patrick Cripps"])
+	})
+}
+
+func TestImportAFLMatchStats_QuarterScores(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	type quarterScore struct {
+		Quarter int `json:"quarter"`
+		Goals   int `json:"goals"`
+		Behinds int `json:"behinds"`
+		Score   int `json:"score"`
+	}
+	type matchData struct {
+		AflMatch struct {
+			HomeClubMatch struct {
+				QuarterScores []quarterScore `json:"quarterScores"`
+			} `json:"homeClubMatch"`
+			Timeline *struct {
+				Breaks []struct {
+					Quarter       int          `json:"quarter"`
+					HomeScore     quarterScore `json:"homeScore"`
+					AwayScore     quarterScore `json:"awayScore"`
+					Margin        int          `json:"margin"`
+					QuarterResult string       `json:"quarterResult"`
+				} `json:"breaks"`
+				ComebackWin     bool `json:"comebackWin"`
+				ComebackDeficit int  `json:"comebackDeficit"`
+			} `json:"timeline"`
+		} `json:"aflMatch"`
+	}
+	query := fmt.Sprintf(`{
+		aflMatch(id: "%d") {
+			homeClubMatch { quarterScores { quarter goals behinds score } }
+			timeline {
+				breaks { quarter homeScore { score } awayScore { score } margin quarterResult }
+				comebackWin
+				comebackDeficit
+			}
+		}
+	}`, ids.matchID)
+
+	t.Run("no timeline before quarter scores are recorded", func(t *testing.T) {
+		result := execQuery(t, server, query)
+		require.Empty(t, result.Errors)
+		var data matchData
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		assert.Empty(t, data.AflMatch.HomeClubMatch.QuarterScores)
+		assert.Nil(t, data.AflMatch.Timeline)
+	})
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation { importAFLMatchStats(matchId: "%d") { matchId } }`, ids.matchID))
+	require.Empty(t, result.Errors)
+
+	t.Run("import stores each quarter's score", func(t *testing.T) {
+		result := execQuery(t, server, query)
+		require.Empty(t, result.Errors)
+		var data matchData
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		assert.Equal(t, []quarterScore{
+			{Quarter: 1, Goals: 4, Behinds: 3, Score: 27},
+			{Quarter: 2, Goals: 8, Behinds: 5, Score: 53},
+			{Quarter: 3, Goals: 11, Behinds: 7, Score: 73},
+			{Quarter: 4, Goals: 14, Behinds: 9, Score: 93},
+		}, data.AflMatch.HomeClubMatch.QuarterScores)
+
+		tl := data.AflMatch.Timeline
+		require.NotNil(t, tl)
+		require.Len(t, tl.Breaks, 4)
+		var margins []int
+		var results []string
+		for _, b := range tl.Breaks {
+			margins = append(margins, b.Margin)
+			results = append(results, b.QuarterResult)
+		}
+		assert.Equal(t, []int{13, 19, 26, 26}, margins)
+		assert.Equal(t, []string{"home_win", "home_win", "home_win", "draw"}, results)
+		assert.Equal(t, 67, tl.Breaks[3].AwayScore.Score)
+		assert.False(t, tl.ComebackWin, "Carlton led at every break")
+		assert.Zero(t, tl.ComebackDeficit)
+	})
+
+	t.Run("re-importing keeps one score per quarter", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation { importAFLMatchStats(matchId: "%d") { matchId } }`, ids.matchID))
+		require.Empty(t, result.Errors)
+
+		var count int
+		require.NoError(t, pool.QueryRow(context.Background(),
+			"SELECT count(*) FROM afl.club_match_quarter WHERE club_match_id = $1", ids.homeClubMatchID).Scan(&count))
+		assert.Equal(t, 4, count)
+	})
+}
+
+func TestVerifyAFLMatchStats(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation { importAFLMatchStats(matchId: "%d") { matchId } }`, ids.matchID))
+	require.Empty(t, result.Errors)
+
+	type discrepancy struct {
+		ClubMatchID    string  `json:"clubMatchId"`
+		Kind           string  `json:"kind"`
+		PlayerSeasonID *string `json:"playerSeasonId"`
+		PlayerName     *string `json:"playerName"`
+		Stat           *string `json:"stat"`
+		Source         string  `json:"source"`
+		Value          int     `json:"value"`
+		OtherSource    string  `json:"otherSource"`
+		OtherValue     int     `json:"otherValue"`
+	}
+	const fields = `clubMatchId kind playerSeasonId playerName stat source value otherSource otherValue`
+	verify := func(t *testing.T, threshold int) ([]string, []discrepancy) {
+		t.Helper()
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			verifyAFLMatchStats(matchId: "%d", threshold: %d) { sources discrepancies { %s } }
+		}`, ids.matchID, threshold, fields))
+		require.Empty(t, result.Errors)
+		var data struct {
+			VerifyAFLMatchStats struct {
+				Sources       []string      `json:"sources"`
+				Discrepancies []discrepancy `json:"discrepancies"`
+			} `json:"verifyAFLMatchStats"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		return data.VerifyAFLMatchStats.Sources, data.VerifyAFLMatchStats.Discrepancies
+	}
+	str := func(s string) *string { return &s }
+	home, away := fmt.Sprint(ids.homeClubMatchID), fmt.Sprint(ids.awayClubMatchID)
+
+	t.Run("flags differences above the threshold", func(t *testing.T) {
+		sources, found := verify(t, 1)
+		assert.Equal(t, []string{"footywire", "afltables"}, sources)
+		require.Len(t, found, 6)
+
+		// Cripps' kicks differ by one, within the threshold; Zac Williams
+		// isn't on FootyWire's page, and doesn't resolve to a player.
+		assert.Equal(t, discrepancy{ClubMatchID: home, Kind: "missing_player", PlayerName: str("Zac Williams"),
+			Source: "afltables", OtherSource: "footywire"}, found[0])
+		// The fixture lists two players a side, so the stored goals fall
+		// short of both sources' score tables; the rushed behinds make up
+		// the behinds.
+		assert.Equal(t, discrepancy{ClubMatchID: home, Kind: "score", Stat: str("goals"),
+			Source: "footywire", Value: 14, OtherSource: "stored", OtherValue: 2}, found[1])
+		assert.Equal(t, discrepancy{ClubMatchID: home, Kind: "score", Stat: str("goals"),
+			Source: "afltables", Value: 14, OtherSource: "stored", OtherValue: 2}, found[2])
+
+		martin := found[3]
+		assert.Equal(t, "stat", martin.Kind)
+		assert.Equal(t, away, martin.ClubMatchID)
+		assert.NotNil(t, martin.PlayerSeasonID)
+		assert.Equal(t, str("Dustin Martin"), martin.PlayerName)
+		assert.Equal(t, str("tackles"), martin.Stat)
+		assert.Equal(t, []any{"footywire", 3, "afltables", 5},
+			[]any{martin.Source, martin.Value, martin.OtherSource, martin.OtherValue})
+
+		assert.Equal(t, "score", found[4].Kind)
+		assert.Equal(t, "score", found[5].Kind)
+	})
+
+	t.Run("replaces the stored discrepancies", func(t *testing.T) {
+		_, found := verify(t, 0)
+		require.Len(t, found, 7)
+		assert.Equal(t, str("Patrick Cripps"), found[0].PlayerName)
+		assert.Equal(t, str("kicks"), found[0].Stat)
+
+		result := execQuery(t, server, fmt.Sprintf(`{ aflMatch(id: "%d") { statsDiscrepancies { %s } } }`, ids.matchID, fields))
+		require.Empty(t, result.Errors)
+		var data struct {
+			AFLMatch struct {
+				StatsDiscrepancies []discrepancy `json:"statsDiscrepancies"`
+			} `json:"aflMatch"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		assert.Equal(t, found, data.AFLMatch.StatsDiscrepancies)
+	})
+
+	t.Run("needs two sources", func(t *testing.T) {
+		server := setupTestServerWithDataOps(t, pool,
+			&stubStatsParser{path: "testdata/missing.html"},
+			&stubFixtureDiscovery{},
+		)
+		defer server.Close()
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			verifyAFLMatchStats(matchId: "%d") { matchId }
+		}`, ids.matchID))
+		require.NotEmpty(t, result.Errors)
+		assert.Contains(t, result.Errors[0].Message, "two sources")
+	})
+}
+
+func TestPreviewAFLMatchStatsImport(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	ctx := context.Background()
+
+	dispatcher := memevents.New()
+	var playerUpdates []events.AflPlayerMatchUpdatedPayload
+	var matchUpdates []events.AflMatchUpdatedPayload
+	dispatcher.Subscribe(events.AflPlayerMatchUpdated, func(_ context.Context, payload []byte) error {
+		var p events.AflPlayerMatchUpdatedPayload
+		require.NoError(t, json.Unmarshal(payload, &p))
+		playerUpdates = append(playerUpdates, p)
+		return nil
+	})
+	dispatcher.Subscribe(events.AflMatchUpdated, func(_ context.Context, payload []byte) error {
+		var p events.AflMatchUpdatedPayload
+		require.NoError(t, json.Unmarshal(payload, &p))
+		matchUpdates = append(matchUpdates, p)
+		return nil
+	})
+	dataOps := newTestDataOps(pool, &stubStatsParser{path: "testdata/carlton_vs_richmond.html"}, &stubFixtureDiscovery{}, dispatcher)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	_, err := dataOps.ImportAFLStats(ctx, ids.matchID, "")
+	require.NoError(t, err)
+	_, err = dataOps.MarkMatchStatsFinal(ctx, ids.matchID, true)
+	require.NoError(t, err)
+
+	// A Carlton player with stored stats that neither source lists.
+	var weiteringID int
+	require.NoError(t, pool.QueryRow(ctx, `
+		WITH p AS (INSERT INTO afl.player (name) VALUES ('Jacob Weitering') RETURNING id)
+		INSERT INTO afl.player_season (player_id, club_season_id)
+		SELECT p.id, cm.club_season_id FROM p, afl.club_match cm WHERE cm.id = $1
+		RETURNING id`, ids.homeClubMatchID).Scan(&weiteringID))
+	_, err = pool.Exec(ctx,
+		"INSERT INTO afl.player_match (club_match_id, player_season_id, kicks, goals) VALUES ($1, $2, 10, 1)",
+		ids.homeClubMatchID, weiteringID)
+	require.NoError(t, err)
+
+	storedStats := func(name string) (kicks, tackles int) {
+		require.NoError(t, pool.QueryRow(ctx, `
+			SELECT pm.kicks, pm.tackles
+			FROM afl.player_match pm
+			JOIN afl.player_season ps ON ps.id = pm.player_season_id
+			JOIN afl.player p ON p.id = ps.player_id
+			WHERE p.name = $1`, name).Scan(&kicks, &tackles))
+		return kicks, tackles
+	}
+
+	var previewID string
+	t.Run("preview lists changes without writing them", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			previewAFLMatchStatsImport(matchId: "%d", source: "afltables") {
+				id source appliedAt
+				clubMatches {
+					clubMatchId oldScore newScore oldRushedBehinds newRushedBehinds
+					players { playerSeasonId playerName kind changes { stat old new } kicks goals }
+				}
+				unmatchedPlayers { parsedName }
+			}
+		}`, ids.matchID))
+		require.Empty(t, result.Errors)
+
+		type change struct {
+			Stat string `json:"stat"`
+			Old  int    `json:"old"`
+			New  int    `json:"new"`
+		}
+		var data struct {
+			Preview struct {
+				ID          string  `json:"id"`
+				Source      string  `json:"source"`
+				AppliedAt   *string `json:"appliedAt"`
+				ClubMatches []struct {
+					ClubMatchID      string `json:"clubMatchId"`
+					OldScore         int    `json:"oldScore"`
+					NewScore         int    `json:"newScore"`
+					OldRushedBehinds int    `json:"oldRushedBehinds"`
+					NewRushedBehinds int    `json:"newRushedBehinds"`
+					Players          []struct {
+						PlayerSeasonID string   `json:"playerSeasonId"`
+						PlayerName     string   `json:"playerName"`
+						Kind           string   `json:"kind"`
+						Changes        []change `json:"changes"`
+						Kicks          int      `json:"kicks"`
+						Goals          int      `json:"goals"`
+					} `json:"players"`
+				} `json:"clubMatches"`
+				UnmatchedPlayers []struct {
+					ParsedName string `json:"parsedName"`
+				} `json:"unmatchedPlayers"`
+			} `json:"previewAFLMatchStatsImport"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		p := data.Preview
+		previewID = p.ID
+		assert.Equal(t, "afltables", p.Source)
+		assert.Nil(t, p.AppliedAt)
+		require.Len(t, p.ClubMatches, 2)
+
+		// Zac Williams' behind no longer counts as rushed, and Weitering's
+		// goal goes with him.
+		home := p.ClubMatches[0]
+		assert.Equal(t, fmt.Sprint(ids.homeClubMatchID), home.ClubMatchID)
+		assert.Equal(t, []int{27, 20, 8, 7}, []int{home.OldScore, home.NewScore, home.OldRushedBehinds, home.NewRushedBehinds})
+		require.Len(t, home.Players, 2)
+		assert.Equal(t, "Patrick Cripps", home.Players[0].PlayerName)
+		assert.Equal(t, "changed", home.Players[0].Kind)
+		assert.Equal(t, []change{{Stat: "kicks", Old: 15, New: 16}}, home.Players[0].Changes)
+		assert.Equal(t, 16, home.Players[0].Kicks)
+		assert.Equal(t, "Jacob Weitering", home.Players[1].PlayerName)
+		assert.Equal(t, "removed", home.Players[1].Kind)
+		assert.Equal(t, []change{{Stat: "kicks", Old: 10, New: 0}, {Stat: "goals", Old: 1, New: 0}}, home.Players[1].Changes)
+
+		away := p.ClubMatches[1]
+		assert.Equal(t, []int{25, 25}, []int{away.OldScore, away.NewScore})
+		require.Len(t, away.Players, 1)
+		assert.Equal(t, "Dustin Martin", away.Players[0].PlayerName)
+		assert.Equal(t, []change{{Stat: "tackles", Old: 3, New: 5}}, away.Players[0].Changes)
+
+		require.Len(t, p.UnmatchedPlayers, 1)
+		assert.Equal(t, "Zac Williams", p.UnmatchedPlayers[0].ParsedName)
+
+		kicks, _ := storedStats("Patrick Cripps")
+		assert.Equal(t, 15, kicks)
+	})
+
+	t.Run("applying writes the diff and publishes it", func(t *testing.T) {
+		id, err := strconv.Atoi(previewID)
+		require.NoError(t, err)
+		playerUpdates, matchUpdates = nil, nil
+		applied, err := dataOps.ApplyAFLStatsPreview(ctx, id)
+		require.NoError(t, err)
+		assert.NotNil(t, applied.AppliedAt)
+
+		kicks, _ := storedStats("Patrick Cripps")
+		assert.Equal(t, 16, kicks)
+		_, tackles := storedStats("Dustin Martin")
+		assert.Equal(t, 5, tackles)
+
+		var remaining int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT COUNT(*) FROM afl.player_match WHERE player_season_id = $1", weiteringID).Scan(&remaining))
+		assert.Zero(t, remaining)
+
+		var score, rushed int
+		var status string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT drv_score, rushed_behinds FROM afl.club_match WHERE id = $1", ids.homeClubMatchID).Scan(&score, &rushed))
+		require.NoError(t, pool.QueryRow(ctx, "SELECT data_status FROM afl.match WHERE id = $1", ids.matchID).Scan(&status))
+		assert.Equal(t, []int{20, 7}, []int{score, rushed})
+		assert.Equal(t, "final", status)
+
+		require.Len(t, playerUpdates, 3)
+		assert.Equal(t, 16, playerUpdates[0].Kicks)
+		assert.Equal(t, weiteringID, playerUpdates[1].PlayerSeasonID)
+		assert.Zero(t, playerUpdates[1].Kicks)
+		assert.Equal(t, 5, playerUpdates[2].Tackles)
+		require.Len(t, matchUpdates, 1)
+		assert.Equal(t, "final", matchUpdates[0].MatchStatus)
+		assert.Equal(t, "dnp", matchUpdates[0].PlayerSeasonIDStatusMap[weiteringID])
+	})
+
+	t.Run("a preview applies once", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			applyAFLStatsImportPreview(previewId: "%s") { id }
+		}`, previewID))
+		require.NotEmpty(t, result.Errors)
+		assert.Contains(t, result.Errors[0].Message, "already applied")
+	})
+
+	t.Run("a stale preview is refused", func(t *testing.T) {
+		preview, err := dataOps.PreviewAFLStats(ctx, ids.matchID, "footywire")
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			UPDATE afl.player_match SET kicks = 20
+			WHERE player_season_id = (SELECT ps.id FROM afl.player_season ps JOIN afl.player p ON p.id = ps.player_id WHERE p.name = 'Patrick Cripps')`)
+		require.NoError(t, err)
+
+		_, err = dataOps.ApplyAFLStatsPreview(ctx, preview.ID)
+		assert.ErrorIs(t, err, application.ErrStatsPreviewStale)
+		kicks, _ := storedStats("Patrick Cripps")
+		assert.Equal(t, 20, kicks)
+	})
+}
+
+func TestImportAFLRoundStats(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	mutation := fmt.Sprintf(`mutation {
+		importAFLRoundStats(roundId: "%d", markFinal: true) {
+			roundId
+			matches {
+				matchId homeClubName awayClubName status markedFinal error
+				stats { homePlayerCount awayPlayerCount unmatchedPlayers { parsedName } }
+			}
+		}
+	}`, ids.roundID)
+
+	type roundImport struct {
+		ImportAFLRoundStats struct {
+			RoundID string `json:"roundId"`
+			Matches []struct {
+				MatchID      string  `json:"matchId"`
+				HomeClubName string  `json:"homeClubName"`
+				AwayClubName string  `json:"awayClubName"`
+				Status       string  `json:"status"`
+				MarkedFinal  bool    `json:"markedFinal"`
+				Error        *string `json:"error"`
+				Stats        *struct {
+					HomePlayerCount  int `json:"homePlayerCount"`
+					AwayPlayerCount  int `json:"awayPlayerCount"`
+					UnmatchedPlayers []struct {
+						ParsedName string `json:"parsedName"`
+					} `json:"unmatchedPlayers"`
+				} `json:"stats"`
+			} `json:"matches"`
+		} `json:"importAFLRoundStats"`
+	}
+
+	t.Run("imports each match and marks complete ones final", func(t *testing.T) {
+		result := execQuery(t, server, mutation)
+		require.Empty(t, result.Errors)
+		var data roundImport
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+
+		require.Len(t, data.ImportAFLRoundStats.Matches, 1)
+		m := data.ImportAFLRoundStats.Matches[0]
+		assert.Equal(t, fmt.Sprintf("%d", ids.matchID), m.MatchID)
+		assert.Equal(t, "Carlton", m.HomeClubName)
+		assert.Equal(t, "imported", m.Status)
+		assert.Nil(t, m.Error)
+		require.NotNil(t, m.Stats)
+		assert.Equal(t, 2, m.Stats.HomePlayerCount)
+		assert.Equal(t, 2, m.Stats.AwayPlayerCount)
+		assert.Empty(t, m.Stats.UnmatchedPlayers)
+		assert.True(t, m.MarkedFinal)
+
+		var status string
+		require.NoError(t, pool.QueryRow(context.Background(),
+			"SELECT data_status FROM afl.match WHERE id = $1", ids.matchID).Scan(&status))
+		assert.Equal(t, "final", status)
+	})
+
+	t.Run("final matches are skipped", func(t *testing.T) {
+		result := execQuery(t, server, mutation)
+		require.Empty(t, result.Errors)
+		var data roundImport
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+
+		require.Len(t, data.ImportAFLRoundStats.Matches, 1)
+		m := data.ImportAFLRoundStats.Matches[0]
+		assert.Equal(t, "skipped", m.Status)
+		assert.Nil(t, m.Stats)
+		assert.False(t, m.MarkedFinal)
+	})
+
+	t.Run("a match that can't be marked final reports why", func(t *testing.T) {
+		ctx := context.Background()
+		_, err := pool.Exec(ctx, "UPDATE afl.match SET data_status = 'partial' WHERE id = $1", ids.matchID)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			CREATE FUNCTION afl.test_reject_final() RETURNS trigger AS $$
+			BEGIN RAISE EXCEPTION 'final rejected'; END $$ LANGUAGE plpgsql;
+			CREATE TRIGGER test_reject_final BEFORE UPDATE OF data_status ON afl.match
+			FOR EACH ROW WHEN (NEW.data_status = 'final') EXECUTE FUNCTION afl.test_reject_final();`)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, _ = pool.Exec(ctx, `
+				DROP TRIGGER IF EXISTS test_reject_final ON afl.match;
+				DROP FUNCTION IF EXISTS afl.test_reject_final();`)
+		})
+
+		result := execQuery(t, server, mutation)
+		require.Empty(t, result.Errors)
+		var data roundImport
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+
+		require.Len(t, data.ImportAFLRoundStats.Matches, 1)
+		m := data.ImportAFLRoundStats.Matches[0]
+		assert.Equal(t, "imported", m.Status)
+		assert.False(t, m.MarkedFinal)
+		require.NotNil(t, m.Error)
+		assert.Contains(t, *m.Error, "mark final")
+		assert.Contains(t, *m.Error, "final rejected")
+	})
+}
+
+// footywireCorpus is the FootyWire pages the stand-in server serves: for now
+// hand-written stand-ins, not recordings (see its README).
+const footywireCorpus = "../../infrastructure/footywire/testdata/corpus"
+
+// TestImportAFLStats_FootywireStandIn imports through the real FootyWire
+// client, pointed at a local stand-in serving the corpus pages, so the
+// fixture lookup, both stats pages and parsing all run with no network.
+func TestImportAFLStats_FootywireStandIn(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	standIn := httptest.NewServer(httpreplay.Handler(footywireCorpus))
+	defer standIn.Close()
+	client := footywire.NewFootywireClient(standIn.URL+"/afl/footy", sourcehttp.New(sourcehttp.Config{Timeout: 5 * time.Second}))
+	server := setupTestServerWithDataOps(t, pool, client, client)
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("match import finds the mid in the fixture list and imports both pages", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			importAFLMatchStats(matchId: "%d") { homePlayerCount awayPlayerCount unmatchedPlayers { parsedName } }
+		}`, ids.matchID))
+		require.Empty(t, result.Errors)
+		var data struct {
+			ImportAFLMatchStats struct {
+				HomePlayerCount  int               `json:"homePlayerCount"`
+				AwayPlayerCount  int               `json:"awayPlayerCount"`
+				UnmatchedPlayers []json.RawMessage `json:"unmatchedPlayers"`
+			} `json:"importAFLMatchStats"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		assert.Equal(t, 2, data.ImportAFLMatchStats.HomePlayerCount)
+		assert.Equal(t, 2, data.ImportAFLMatchStats.AwayPlayerCount)
+		assert.Empty(t, data.ImportAFLMatchStats.UnmatchedPlayers)
+
+		var mid string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT external_id FROM afl.dataops_match_source WHERE source = $1 AND match_id = $2",
+			footywire.Source, ids.matchID).Scan(&mid))
+		assert.Equal(t, "11402", mid)
+
+		var contested float64
+		require.NoError(t, pool.QueryRow(ctx, `
+			SELECT (pm.extra_stats->>'contested_possessions')::float FROM afl.player_match pm
+			JOIN afl.player_season ps ON ps.id = pm.player_season_id
+			JOIN afl.player p ON p.id = ps.player_id
+			WHERE p.name = 'Patrick Cripps'`).Scan(&contested))
+		assert.Equal(t, 14.0, contested, "extra stats come from the advanced page")
+
+		var quarters int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT count(*) FROM afl.club_match_quarter WHERE club_match_id = $1", ids.homeClubMatchID).Scan(&quarters))
+		assert.Equal(t, 4, quarters)
+	})
+
+	t.Run("round import marks the complete match final", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			importAFLRoundStats(roundId: "%d", markFinal: true) { matches { status markedFinal error } }
+		}`, ids.roundID))
+		require.Empty(t, result.Errors)
+		var data struct {
+			ImportAFLRoundStats struct {
+				Matches []struct {
+					Status      string  `json:"status"`
+					MarkedFinal bool    `json:"markedFinal"`
+					Error       *string `json:"error"`
+				} `json:"matches"`
+			} `json:"importAFLRoundStats"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		require.Len(t, data.ImportAFLRoundStats.Matches, 1)
+		m := data.ImportAFLRoundStats.Matches[0]
+		assert.Nil(t, m.Error)
+		assert.Equal(t, "imported", m.Status)
+		assert.True(t, m.MarkedFinal)
+	})
+}
+
+func TestPollLiveMatches(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	ctx := context.Background()
+
+	dispatcher := memevents.New()
+	var published []events.AflPlayerMatchUpdatedPayload
+	dispatcher.Subscribe(events.AflPlayerMatchUpdated, func(_ context.Context, payload []byte) error {
+		var p events.AflPlayerMatchUpdatedPayload
+		require.NoError(t, json.Unmarshal(payload, &p))
+		published = append(published, p)
+		return nil
+	})
+	dataOps := newTestDataOps(pool, &stubStatsParser{path: "testdata/carlton_vs_richmond.html"}, &stubFixtureDiscovery{}, dispatcher)
+	config := application.LiveStatsConfig{MatchLength: 3 * time.Hour, Grace: time.Hour}
+	poller := func(hour, min int) *application.LiveStatsCommands {
+		now := time.Date(2025, 5, 1, hour, min, 0, 0, time.UTC)
+		return application.NewLiveStatsCommands(clock.FixedClock{T: now}, pg.NewMatchRepository(sqlcgen.New(pool)), dataOps, config)
+	}
+	dataStatus := func() string {
+		var status string
+		require.NoError(t, pool.QueryRow(ctx, "SELECT data_status FROM afl.match WHERE id = $1", ids.matchID).Scan(&status))
+		return status
+	}
 
-	t.Run("all home players matched and written", func(t *testing.T) {
-		assert.Equal(t, 2, imp.HomePlayerCount) // Patrick Cripps + Sam Walsh
+	t.Run("a match not yet started is not polled", func(t *testing.T) {
+		require.NoError(t, poller(19, 0).PollLiveMatches(ctx))
+		assert.Empty(t, published)
+		assert.Equal(t, "no_data", dataStatus())
 	})
-	t.Run("all away players matched and written", func(t *testing.T) {
-		assert.Equal(t, 2, imp.AwayPlayerCount) // Dustin Martin + Trent Cotchin
+
+	t.Run("a match in progress is imported and every line published", func(t *testing.T) {
+		require.NoError(t, poller(20, 30).PollLiveMatches(ctx))
+		assert.Len(t, published, 4)
+		assert.Equal(t, "partial", dataStatus())
 	})
-	t.Run("no unmatched players", func(t *testing.T) {
-		assert.Empty(t, imp.UnmatchedPlayers)
+
+	t.Run("unchanged stats are not published again", func(t *testing.T) {
+		published = nil
+		require.NoError(t, poller(20, 32).PollLiveMatches(ctx))
+		assert.Empty(t, published)
 	})
-	t.Run("match data status set to partial", func(t *testing.T) {
-		statusResult := execQuery(t, server, fmt.Sprintf(`{
-			aflMatch(id: "%d") { dataStatus }
-		}`, ids.matchID))
-		require.Empty(t, statusResult.Errors)
 
-		var statusData struct {
-			AflMatch struct {
-				DataStatus string `json:"dataStatus"`
-			} `json:"aflMatch"`
-		}
-		require.NoError(t, json.Unmarshal(statusResult.Data, &statusData))
-		assert.Equal(t, "partial", statusData.AflMatch.DataStatus)
+	t.Run("polling stops after the expected end plus grace", func(t *testing.T) {
+		_, err := pool.Exec(ctx, "DELETE FROM afl.player_match WHERE club_match_id = $1", ids.homeClubMatchID)
+		require.NoError(t, err)
+		published = nil
+		require.NoError(t, poller(24, 0).PollLiveMatches(ctx))
+		assert.Empty(t, published)
+	})
+
+	t.Run("a final match is not polled", func(t *testing.T) {
+		_, err := pool.Exec(ctx, "UPDATE afl.match SET data_status = 'final' WHERE id = $1", ids.matchID)
+		require.NoError(t, err)
+		require.NoError(t, poller(20, 34).PollLiveMatches(ctx))
+		assert.Empty(t, published)
+		assert.Equal(t, "final", dataStatus())
 	})
 }
 
@@ -1366,6 +2228,7 @@ func setupTestServerWithScoreCommands(t *testing.T, pool *pgxpool.Pool) *httptes
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewAvailabilityRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -1474,3 +2337,640 @@ func TestRecalculateAFLLadder(t *testing.T) {
 		assert.NotEqual(t, 16, ladderData.AflSeason.Ladder[0].PremiershipPoints)
 	})
 }
+
+func setupTestServerWithAvailability(t *testing.T, pool *pgxpool.Pool, dispatcher *memevents.Dispatcher) *httptest.Server {
+	t.Helper()
+
+	q := sqlcgen.New(pool)
+	queries := application.NewQueries(
+		clock.RealClock{},
+		pg.NewClubRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewRoundRepository(q, pool),
+		pg.NewMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewPlayerRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewAvailabilityRepository(q),
+	)
+
+	availability := application.NewAvailabilityCommands(
+		pg.NewDB(pool),
+		pg.NewAvailabilityRepository(q),
+		pg.NewRoundRepository(q, pool),
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		footywire.NewNameResolver(),
+		dispatcher,
+	)
+
+	resolver := &gql.Resolver{Queries: queries, Availability: availability}
+	srv := gqlhandler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := gql.InjectLoaders(r.Context(), gql.NewLoaders(queries))
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	})
+	return httptest.NewServer(h)
+}
+
+func TestSetAFLPlayerAvailability(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+
+	// Availability is a pre-match concern: put the match back before its stats.
+	ctx := context.Background()
+	_, err := pool.Exec(ctx, "UPDATE afl.match SET data_status = 'no_data' WHERE id = $1", ids.matchID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "DELETE FROM afl.player_match WHERE id = $1", ids.playerMatchID)
+	require.NoError(t, err)
+
+	dispatcher := memevents.New()
+	var published []events.AflMatchUpdatedPayload
+	dispatcher.Subscribe(events.AflMatchUpdated, func(_ context.Context, payload []byte) error {
+		var p events.AflMatchUpdatedPayload
+		require.NoError(t, json.Unmarshal(payload, &p))
+		published = append(published, p)
+		return nil
+	})
+
+	server := setupTestServerWithAvailability(t, pool, dispatcher)
+	defer server.Close()
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation {
+		setAFLPlayerAvailability(input: {playerSeasonId: "%d", roundId: "%d", status: "injured", expectedReturn: "2-3 weeks"}) {
+			playerSeasonId roundId status expectedReturn source
+		}
+	}`, ids.playerSeasonID, ids.roundID))
+	require.Empty(t, result.Errors)
+
+	var mutData struct {
+		SetAFLPlayerAvailability struct {
+			PlayerSeasonID string  `json:"playerSeasonId"`
+			RoundID        string  `json:"roundId"`
+			Status         string  `json:"status"`
+			ExpectedReturn *string `json:"expectedReturn"`
+			Source         string  `json:"source"`
+		} `json:"setAFLPlayerAvailability"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &mutData))
+
+	t.Run("returns the recorded entry", func(t *testing.T) {
+		got := mutData.SetAFLPlayerAvailability
+		assert.Equal(t, fmt.Sprintf("%d", ids.playerSeasonID), got.PlayerSeasonID)
+		assert.Equal(t, "injured", got.Status)
+		require.NotNil(t, got.ExpectedReturn)
+		assert.Equal(t, "2-3 weeks", *got.ExpectedReturn)
+		assert.Equal(t, "manual", got.Source)
+	})
+
+	t.Run("publishes the availability in the match status map", func(t *testing.T) {
+		require.Len(t, published, 1)
+		assert.Equal(t, ids.matchID, published[0].MatchID)
+		assert.Equal(t, "no_data", published[0].MatchStatus)
+		assert.Equal(t, map[int]string{ids.playerSeasonID: "injured"}, published[0].PlayerSeasonIDStatusMap)
+	})
+
+	t.Run("a later report replaces the earlier one", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			setAFLPlayerAvailability(input: {playerSeasonId: "%d", roundId: "%d", status: "named"}) { status }
+		}`, ids.playerSeasonID, ids.roundID))
+		require.Empty(t, result.Errors)
+
+		roundResult := execQuery(t, server, fmt.Sprintf(`{
+			aflRound(id: "%d") { availability { playerSeasonId status expectedReturn } }
+		}`, ids.roundID))
+		require.Empty(t, roundResult.Errors)
+
+		var data struct {
+			AflRound struct {
+				Availability []struct {
+					PlayerSeasonID string  `json:"playerSeasonId"`
+					Status         string  `json:"status"`
+					ExpectedReturn *string `json:"expectedReturn"`
+				} `json:"availability"`
+			} `json:"aflRound"`
+		}
+		require.NoError(t, json.Unmarshal(roundResult.Data, &data))
+		require.Len(t, data.AflRound.Availability, 1)
+		assert.Equal(t, "named", data.AflRound.Availability[0].Status)
+		assert.Nil(t, data.AflRound.Availability[0].ExpectedReturn)
+	})
+
+	t.Run("rejects an expected return for a non-injured player", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(`mutation {
+			setAFLPlayerAvailability(input: {playerSeasonId: "%d", roundId: "%d", status: "suspended", expectedReturn: "Round 3"}) { id }
+		}`, ids.playerSeasonID, ids.roundID))
+		require.NotEmpty(t, result.Errors)
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// AFL season setup integration test
+// ════════════════════════════════════════════════════════════════
+
+type seasonSetupResponse struct {
+	SetupAFLSeason struct {
+		SeasonID           string   `json:"seasonId"`
+		SeasonName         string   `json:"seasonName"`
+		SeasonCreated      bool     `json:"seasonCreated"`
+		RoundsCreated      []string `json:"roundsCreated"`
+		ClubSeasonsCreated []string `json:"clubSeasonsCreated"`
+		Matches            []struct {
+			MatchID      string   `json:"matchId"`
+			RoundName    string   `json:"roundName"`
+			HomeClubName string   `json:"homeClubName"`
+			AwayClubName string   `json:"awayClubName"`
+			Venue        *string  `json:"venue"`
+			StartTime    *string  `json:"startTime"`
+			Status       string   `json:"status"`
+			Changes      []string `json:"changes"`
+		} `json:"matches"`
+	} `json:"setupAFLSeason"`
+}
+
+func TestSetupAFLSeason(t *testing.T) {
+	pool := connectDB(t)
+	ctx := context.Background()
+	cleanupTestData(ctx, t, pool)
+	t.Cleanup(func() { cleanupTestData(context.Background(), t, pool) })
+
+	_, err := pool.Exec(ctx, "INSERT INTO afl.league (name) VALUES ('AFL')")
+	require.NoError(t, err)
+	for _, name := range []string{"Carlton Blues", "Richmond Tigers", "Collingwood Magpies", "Essendon Bombers"} {
+		_, err := pool.Exec(ctx, "INSERT INTO afl.club (name) VALUES ($1)", name)
+		require.NoError(t, err)
+	}
+
+	discovery := &stubFixtureDiscovery{fixture: []application.FixtureListing{
+		{RoundName: "Round 1", HomeClub: "Carlton", AwayClub: "Richmond", Venue: "MCG",
+			StartTime: time.Date(2026, 3, 19, 8, 30, 0, 0, time.UTC)},
+		{RoundName: "Round 1", HomeClub: "Collingwood", AwayClub: "Essendon", Venue: "MCG",
+			StartTime: time.Date(2026, 3, 20, 8, 40, 0, 0, time.UTC)},
+		{RoundName: "Round 2", HomeClub: "Richmond", AwayClub: "Collingwood", Venue: "MCG",
+			StartTime: time.Date(2026, 3, 26, 8, 30, 0, 0, time.UTC)},
+	}}
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		discovery,
+	)
+	defer server.Close()
+
+	const mutation = `mutation {
+		setupAFLSeason(year: 2026) {
+			seasonId seasonName seasonCreated roundsCreated clubSeasonsCreated
+			matches { matchId roundName homeClubName awayClubName venue startTime status changes }
+		}
+	}`
+	setup := func() seasonSetupResponse {
+		t.Helper()
+		result := execQuery(t, server, mutation)
+		require.Empty(t, result.Errors)
+		var data seasonSetupResponse
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		return data
+	}
+
+	t.Run("creates the season from the fixture", func(t *testing.T) {
+		got := setup().SetupAFLSeason
+		assert.Equal(t, "AFL 2026", got.SeasonName)
+		assert.True(t, got.SeasonCreated)
+		assert.Equal(t, []string{"Round 1", "Round 2"}, got.RoundsCreated)
+		assert.ElementsMatch(t, []string{"Carlton Blues", "Richmond Tigers", "Collingwood Magpies", "Essendon Bombers"}, got.ClubSeasonsCreated)
+		require.Len(t, got.Matches, 3)
+		for _, m := range got.Matches {
+			assert.Equal(t, "created", m.Status)
+		}
+		assert.Equal(t, "Carlton Blues", got.Matches[0].HomeClubName)
+		require.NotNil(t, got.Matches[0].StartTime)
+		assert.Equal(t, "2026-03-19T08:30:00Z", *got.Matches[0].StartTime)
+
+		var clubMatches int
+		require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM afl.club_match").Scan(&clubMatches))
+		assert.Equal(t, 6, clubMatches)
+	})
+
+	t.Run("a rerun changes nothing", func(t *testing.T) {
+		got := setup().SetupAFLSeason
+		assert.False(t, got.SeasonCreated)
+		assert.Empty(t, got.RoundsCreated)
+		assert.Empty(t, got.ClubSeasonsCreated)
+		require.Len(t, got.Matches, 3)
+		for _, m := range got.Matches {
+			assert.Equal(t, "unchanged", m.Status)
+		}
+	})
+
+	t.Run("applies fixture changes", func(t *testing.T) {
+		first := setup().SetupAFLSeason.Matches
+
+		// Round 1's first match is rescheduled and moved to Marvel Stadium,
+		// the second swaps home and away, and Round 2's match moves to Round 3.
+		discovery.fixture[0].Venue = "Marvel Stadium"
+		discovery.fixture[0].StartTime = time.Date(2026, 3, 19, 9, 0, 0, 0, time.UTC)
+		discovery.fixture[1].HomeClub, discovery.fixture[1].AwayClub = "Essendon", "Collingwood"
+		discovery.fixture[2].RoundName = "Round 3"
+
+		got := setup().SetupAFLSeason
+		assert.Equal(t, []string{"Round 3"}, got.RoundsCreated)
+		require.Len(t, got.Matches, 3)
+		for i, m := range got.Matches {
+			assert.Equal(t, "updated", m.Status)
+			assert.Equal(t, first[i].MatchID, m.MatchID, "match %d keeps its ID", i)
+		}
+		assert.Len(t, got.Matches[0].Changes, 2)
+		assert.Contains(t, got.Matches[0].Changes, "venue: MCG → Marvel Stadium")
+		assert.Equal(t, []string{"home and away swapped"}, got.Matches[1].Changes)
+		assert.Equal(t, []string{"round: Round 2 → Round 3"}, got.Matches[2].Changes)
+
+		var venue, side string
+		require.NoError(t, pool.QueryRow(ctx, "SELECT venue FROM afl.match WHERE id = $1", got.Matches[0].MatchID).Scan(&venue))
+		assert.Equal(t, "Marvel Stadium", venue)
+		require.NoError(t, pool.QueryRow(ctx,
+			`SELECT cm.side FROM afl.club_match cm
+			 JOIN afl.club_season cs ON cs.id = cm.club_season_id
+			 JOIN afl.club c ON c.id = cs.club_id
+			 WHERE cm.match_id = $1 AND c.name = 'Essendon Bombers'`,
+			got.Matches[1].MatchID).Scan(&side))
+		assert.Equal(t, "home", side)
+	})
+
+	t.Run("reports matches dropped from the fixture", func(t *testing.T) {
+		discovery.fixture = discovery.fixture[:2]
+
+		got := setup().SetupAFLSeason
+		require.Len(t, got.Matches, 3)
+		assert.Equal(t, "unlisted", got.Matches[2].Status)
+		assert.Equal(t, "Round 3", got.Matches[2].RoundName)
+
+		var matches int
+		require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM afl.match").Scan(&matches))
+		assert.Equal(t, 3, matches)
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// AFL season player import integration test
+// ════════════════════════════════════════════════════════════════
+
+type playerProposalResponse struct {
+	ID               string  `json:"id"`
+	Kind             string  `json:"kind"`
+	Status           string  `json:"status"`
+	ListedName       *string `json:"listedName"`
+	ClubName         string  `json:"clubName"`
+	PlayerName       *string `json:"playerName"`
+	PreviousClubName *string `json:"previousClubName"`
+	PlayerSeasonID   *string `json:"playerSeasonId"`
+}
+
+// proposalName is the name a proposal is listed under, or for a retirement,
+// the stored player's name.
+func (p playerProposalResponse) proposalName() string {
+	if p.ListedName != nil {
+		return *p.ListedName
+	}
+	return *p.PlayerName
+}
+
+func TestAFLPlayerImport(t *testing.T) {
+	pool := connectDB(t)
+	ctx := context.Background()
+	cleanupTestData(ctx, t, pool)
+	t.Cleanup(func() { cleanupTestData(context.Background(), t, pool) })
+
+	var leagueID, carltonID, richmondID int
+	require.NoError(t, pool.QueryRow(ctx, "INSERT INTO afl.league (name) VALUES ('AFL') RETURNING id").Scan(&leagueID))
+	require.NoError(t, pool.QueryRow(ctx, "INSERT INTO afl.club (name) VALUES ('Carlton Blues') RETURNING id").Scan(&carltonID))
+	require.NoError(t, pool.QueryRow(ctx, "INSERT INTO afl.club (name) VALUES ('Richmond Tigers') RETURNING id").Scan(&richmondID))
+	clubSeasons := map[string]int{} // "2025/Carlton" → club season ID
+	for _, year := range []int{2024, 2025, 2026} {
+		var seasonID int
+		require.NoError(t, pool.QueryRow(ctx,
+			"INSERT INTO afl.season (name, league_id) VALUES ($1, $2) RETURNING id",
+			fmt.Sprintf("AFL %d", year), leagueID).Scan(&seasonID))
+		for club, clubID := range map[string]int{"Carlton": carltonID, "Richmond": richmondID} {
+			var csID int
+			require.NoError(t, pool.QueryRow(ctx,
+				"INSERT INTO afl.club_season (club_id, season_id) VALUES ($1, $2) RETURNING id",
+				clubID, seasonID).Scan(&csID))
+			clubSeasons[fmt.Sprintf("%d/%s", year, club)] = csID
+		}
+	}
+	addPlayer := func(name string, clubSeasonKeys ...string) {
+		var playerID int
+		require.NoError(t, pool.QueryRow(ctx, "INSERT INTO afl.player (name) VALUES ($1) RETURNING id", name).Scan(&playerID))
+		for _, key := range clubSeasonKeys {
+			_, err := pool.Exec(ctx, "INSERT INTO afl.player_season (player_id, club_season_id) VALUES ($1, $2)", playerID, clubSeasons[key])
+			require.NoError(t, err)
+		}
+	}
+	addPlayer("Patrick Cripps", "2024/Carlton", "2025/Carlton")
+	addPlayer("Sam Walsh", "2025/Carlton")
+	addPlayer("Tim Taranto", "2025/Richmond")
+	addPlayer("Dustin Martin", "2025/Richmond")
+	addPlayer("Jack Silvagni", "2024/Carlton") // missed 2025
+
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	const propose = `mutation {
+		proposeAFLPlayerImport(year: 2026, players: [
+			{ club: "Carlton", name: "Patrick Cripps" },
+			{ club: "Blues", name: "Tim Taranto" },
+			{ club: "Carlton", name: "Jack Silvagni" },
+			{ club: "Carlton", name: "Ollie Hollands" },
+			{ club: "Carlton", name: "Patrick Cripps" }
+		]) {
+			seasonId listedCount inSeasonCount decidedCount
+			proposals { id kind status listedName clubName playerName previousClubName playerSeasonId }
+		}
+	}`
+	type importResponse struct {
+		ProposeAFLPlayerImport struct {
+			SeasonID      string                   `json:"seasonId"`
+			ListedCount   int                      `json:"listedCount"`
+			InSeasonCount int                      `json:"inSeasonCount"`
+			DecidedCount  int                      `json:"decidedCount"`
+			Proposals     []playerProposalResponse `json:"proposals"`
+		} `json:"proposeAFLPlayerImport"`
+	}
+	proposeImport := func() importResponse {
+		t.Helper()
+		result := execQuery(t, server, propose)
+		require.Empty(t, result.Errors)
+		var data importResponse
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		return data
+	}
+	decide := func(mutation string, ids ...string) graphqlResponse {
+		t.Helper()
+		quoted := make([]string, len(ids))
+		for i, id := range ids {
+			quoted[i] = strconv.Quote(id)
+		}
+		return execQuery(t, server, fmt.Sprintf(`mutation {
+			%s(proposalIds: [%s]) { id status playerSeasonId }
+		}`, mutation, strings.Join(quoted, ", ")))
+	}
+
+	byName := map[string]playerProposalResponse{}
+	t.Run("proposes new, continuing and retiring players", func(t *testing.T) {
+		got := proposeImport().ProposeAFLPlayerImport
+		assert.Equal(t, 4, got.ListedCount, "Patrick Cripps is listed twice")
+		assert.Zero(t, got.InSeasonCount)
+		assert.Zero(t, got.DecidedCount)
+
+		for _, p := range got.Proposals {
+			assert.Equal(t, "pending", p.Status)
+			byName[p.proposalName()] = p
+		}
+		require.Len(t, byName, 6)
+		assert.Equal(t, "continuing", byName["Patrick Cripps"].Kind)
+		assert.Equal(t, "Carlton Blues", *byName["Patrick Cripps"].PreviousClubName)
+		assert.Equal(t, "continuing", byName["Tim Taranto"].Kind, "moved club")
+		assert.Equal(t, "Carlton Blues", byName["Tim Taranto"].ClubName)
+		assert.Equal(t, "Richmond Tigers", *byName["Tim Taranto"].PreviousClubName)
+		assert.Equal(t, "continuing", byName["Jack Silvagni"].Kind, "returning after a season away")
+		assert.Equal(t, "new", byName["Ollie Hollands"].Kind)
+		assert.Nil(t, byName["Ollie Hollands"].PlayerName)
+		assert.Equal(t, "retiring", byName["Sam Walsh"].Kind)
+		assert.Equal(t, "retiring", byName["Dustin Martin"].Kind)
+		assert.Equal(t, "Richmond Tigers", byName["Dustin Martin"].ClubName)
+
+		var playerSeasons int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT count(*) FROM afl.player_season WHERE club_season_id = ANY($1)",
+			[]int{clubSeasons["2026/Carlton"], clubSeasons["2026/Richmond"]}).Scan(&playerSeasons))
+		assert.Zero(t, playerSeasons, "nothing is written until a proposal is accepted")
+	})
+
+	t.Run("accepting writes the proposals", func(t *testing.T) {
+		result := decide("acceptAFLPlayerProposals",
+			byName["Patrick Cripps"].ID, byName["Tim Taranto"].ID, byName["Ollie Hollands"].ID, byName["Dustin Martin"].ID)
+		require.Empty(t, result.Errors)
+		var data struct {
+			AcceptAFLPlayerProposals []playerProposalResponse `json:"acceptAFLPlayerProposals"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		require.Len(t, data.AcceptAFLPlayerProposals, 4)
+		for _, p := range data.AcceptAFLPlayerProposals {
+			assert.Equal(t, "accepted", p.Status)
+		}
+
+		rows, err := pool.Query(ctx,
+			`SELECT p.name FROM afl.player_season ps JOIN afl.player p ON p.id = ps.player_id
+			 WHERE ps.club_season_id = $1 ORDER BY p.name`, clubSeasons["2026/Carlton"])
+		require.NoError(t, err)
+		var names []string
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []string{"Ollie Hollands", "Patrick Cripps", "Tim Taranto"}, names)
+
+		result = decide("rejectAFLPlayerProposals", byName["Jack Silvagni"].ID)
+		require.Empty(t, result.Errors)
+	})
+
+	t.Run("a decided proposal can't be decided again", func(t *testing.T) {
+		result := decide("rejectAFLPlayerProposals", byName["Patrick Cripps"].ID)
+		require.NotEmpty(t, result.Errors)
+		assert.Contains(t, result.Errors[0].Message, "already decided")
+	})
+
+	t.Run("a rerun proposes only what is undecided", func(t *testing.T) {
+		got := proposeImport().ProposeAFLPlayerImport
+		assert.Equal(t, 3, got.InSeasonCount)
+		assert.Equal(t, 2, got.DecidedCount, "Jack Silvagni rejected and Dustin Martin's retirement accepted")
+		require.Len(t, got.Proposals, 1)
+		assert.Equal(t, "retiring", got.Proposals[0].Kind)
+		assert.Equal(t, "Sam Walsh", *got.Proposals[0].PlayerName)
+
+		result := execQuery(t, server, fmt.Sprintf(`{
+			aflSeason(id: "%s") { playerProposals(status: pending) { id } }
+		}`, got.SeasonID))
+		require.Empty(t, result.Errors)
+		var data struct {
+			AflSeason struct {
+				PlayerProposals []struct{ ID string } `json:"playerProposals"`
+			} `json:"aflSeason"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		require.Len(t, data.AflSeason.PlayerProposals, 1, "the earlier pending proposal was replaced")
+		assert.Equal(t, got.Proposals[0].ID, data.AflSeason.PlayerProposals[0].ID)
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// AFL player merge integration test
+// ════════════════════════════════════════════════════════════════
+
+func TestMergeAFLPlayers(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedDataOpsTestData(t, pool)
+	ctx := context.Background()
+
+	dispatcher := memevents.New()
+	var merged []events.AflPlayerMergedPayload
+	dispatcher.Subscribe(events.AflPlayerMerged, func(_ context.Context, payload []byte) error {
+		var p events.AflPlayerMergedPayload
+		require.NoError(t, json.Unmarshal(payload, &p))
+		merged = append(merged, p)
+		return nil
+	})
+	dataOps := newTestDataOps(pool, &stubStatsParser{path: "testdata/carlton_vs_richmond.html"}, &stubFixtureDiscovery{}, dispatcher)
+	server := setupTestServerWithDataOps(t, pool,
+		&stubStatsParser{path: "testdata/carlton_vs_richmond.html"},
+		&stubFixtureDiscovery{},
+	)
+	defer server.Close()
+
+	playerSeason := func(name string, clubMatchID int) (playerID, playerSeasonID int) {
+		t.Helper()
+		require.NoError(t, pool.QueryRow(ctx, `
+			SELECT p.id, ps.id
+			FROM afl.player p
+			JOIN afl.player_season ps ON ps.player_id = p.id
+			JOIN afl.club_match cm ON cm.club_season_id = ps.club_season_id
+			WHERE p.name = $1 AND cm.id = $2`, name, clubMatchID).Scan(&playerID, &playerSeasonID))
+		return playerID, playerSeasonID
+	}
+
+	// "P Cripps" was added for this season's Carlton stats, and a season
+	// earlier for a club season Patrick Cripps doesn't have.
+	var dupID, dupPSID, earlierPSID int
+	require.NoError(t, pool.QueryRow(ctx, `
+		WITH p AS (INSERT INTO afl.player (name) VALUES ('P Cripps') RETURNING id)
+		INSERT INTO afl.player_season (player_id, club_season_id)
+		SELECT p.id, cm.club_season_id FROM p, afl.club_match cm WHERE cm.id = $1
+		RETURNING player_id, id`, ids.homeClubMatchID).Scan(&dupID, &dupPSID))
+	require.NoError(t, pool.QueryRow(ctx, `
+		WITH s AS (
+			INSERT INTO afl.season (name, league_id)
+			SELECT 'Test 2024', league_id FROM afl.season WHERE name = 'Test 2025'
+			RETURNING id
+		), cs AS (
+			INSERT INTO afl.club_season (club_id, season_id)
+			SELECT c.id, s.id FROM s, afl.club c WHERE c.name = 'Carlton'
+			RETURNING id
+		)
+		INSERT INTO afl.player_season (player_id, club_season_id)
+		SELECT $1, cs.id FROM cs
+		RETURNING id`, dupID).Scan(&earlierPSID))
+	_, err := pool.Exec(ctx,
+		"INSERT INTO afl.player_match (club_match_id, player_season_id, kicks) VALUES ($1, $2, 12)",
+		ids.homeClubMatchID, dupPSID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO afl.dataops_player_source (source, external_season, external_club, external_player, player_season_id)
+		VALUES ('footywire', '2025', 'Carlton', 'P Cripps', $1)`, dupPSID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DELETE FROM afl.dataops_player_source WHERE external_player = 'P Cripps'")
+	})
+
+	crippsID, crippsPSID := playerSeason("Patrick Cripps", ids.homeClubMatchID)
+
+	type duplicatesResponse struct {
+		AflPlayerDuplicates []struct {
+			Player     struct{ ID, Name string } `json:"player"`
+			Other      struct{ ID, Name string } `json:"other"`
+			Confidence float64                   `json:"confidence"`
+		} `json:"aflPlayerDuplicates"`
+	}
+	const duplicatesQuery = `{ aflPlayerDuplicates { player { id name } other { id name } confidence } }`
+
+	t.Run("suggests likely duplicates", func(t *testing.T) {
+		result := execQuery(t, server, duplicatesQuery)
+		require.Empty(t, result.Errors)
+		var data duplicatesResponse
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		require.Len(t, data.AflPlayerDuplicates, 1)
+		d := data.AflPlayerDuplicates[0]
+		assert.Equal(t, "Patrick Cripps", d.Player.Name)
+		assert.Equal(t, "P Cripps", d.Other.Name)
+		assert.GreaterOrEqual(t, d.Confidence, 0.9)
+	})
+
+	t.Run("players who played in the same match aren't merged", func(t *testing.T) {
+		martinID, martinPSID := playerSeason("Dustin Martin", ids.awayClubMatchID)
+		cotchinID, cotchinPSID := playerSeason("Trent Cotchin", ids.awayClubMatchID)
+		for _, psID := range []int{martinPSID, cotchinPSID} {
+			_, err := pool.Exec(ctx,
+				"INSERT INTO afl.player_match (club_match_id, player_season_id, kicks) VALUES ($1, $2, 5)",
+				ids.awayClubMatchID, psID)
+			require.NoError(t, err)
+		}
+
+		_, err := dataOps.MergeAFLPlayers(ctx, martinID, cotchinID)
+		assert.ErrorIs(t, err, application.ErrMergeSharedMatch)
+		var deleted bool
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT deleted_at IS NOT NULL FROM afl.player WHERE id = $1", cotchinID).Scan(&deleted))
+		assert.False(t, deleted)
+		assert.Empty(t, merged)
+	})
+
+	t.Run("a player can't be merged into themselves", func(t *testing.T) {
+		result := execQuery(t, server, fmt.Sprintf(
+			`mutation { mergeAFLPlayers(survivorId: "%d", duplicateId: "%d") { duplicateId } }`, crippsID, crippsID))
+		require.NotEmpty(t, result.Errors)
+	})
+
+	t.Run("merges the duplicate into the survivor", func(t *testing.T) {
+		res, err := dataOps.MergeAFLPlayers(ctx, crippsID, dupID)
+		require.NoError(t, err)
+		assert.Equal(t, crippsID, res.Survivor.ID)
+		assert.Equal(t, []int{earlierPSID}, res.MovedPlayerSeasonIDs)
+		assert.Equal(t, map[int]int{dupPSID: crippsPSID}, res.MergedPlayerSeasonIDs)
+
+		var earlierPlayerID int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT player_id FROM afl.player_season WHERE id = $1", earlierPSID).Scan(&earlierPlayerID))
+		assert.Equal(t, crippsID, earlierPlayerID)
+
+		var kicks int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT kicks FROM afl.player_match WHERE player_season_id = $1 AND club_match_id = $2",
+			crippsPSID, ids.homeClubMatchID).Scan(&kicks))
+		assert.Equal(t, 12, kicks)
+
+		var sourcePSID int
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT player_season_id FROM afl.dataops_player_source WHERE external_player = 'P Cripps'").Scan(&sourcePSID))
+		assert.Equal(t, crippsPSID, sourcePSID)
+
+		var playerDeleted, seasonDeleted bool
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT deleted_at IS NOT NULL FROM afl.player WHERE id = $1", dupID).Scan(&playerDeleted))
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT deleted_at IS NOT NULL FROM afl.player_season WHERE id = $1", dupPSID).Scan(&seasonDeleted))
+		assert.True(t, playerDeleted)
+		assert.True(t, seasonDeleted)
+
+		require.Len(t, merged, 1)
+		assert.Equal(t, events.AflPlayerMergedPayload{
+			SurvivorPlayerID:  crippsID,
+			DuplicatePlayerID: dupID,
+			PlayerSeasonIDs:   map[int]int{dupPSID: crippsPSID},
+		}, merged[0])
+
+		result := execQuery(t, server, duplicatesQuery)
+		require.Empty(t, result.Errors)
+		var data duplicatesResponse
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		assert.Empty(t, data.AflPlayerDuplicates)
+	})
+}
@@ -17,6 +17,9 @@ type Loaders struct {
 	ClubByID               *dataloadgen.Loader[int, *domain.Club]
 	MatchByID              *dataloadgen.Loader[int, *domain.Match]
 	PlayerSeasonByID       *dataloadgen.Loader[int, *domain.PlayerSeason]
+	// QuarterScoresByClubMatchID yields an empty slice, not an error, for a
+	// club match with no recorded quarters.
+	QuarterScoresByClubMatchID *dataloadgen.Loader[int, []domain.QuarterScore]
 }
 
 func NewLoaders(q *application.Queries) *Loaders {
@@ -37,6 +40,19 @@ func NewLoaders(q *application.Queries) *Loaders {
 			m, err := q.GetPlayerSeasonsByIDs(ctx, ids)
 			return mapToSlice(ids, m, err)
 		}),
+		QuarterScoresByClubMatchID: dataloadgen.NewLoader(func(ctx context.Context, ids []int) ([][]domain.QuarterScore, []error) {
+			m, err := q.GetQuarterScoresByClubMatchIDs(ctx, ids)
+			out := make([][]domain.QuarterScore, len(ids))
+			errs := make([]error, len(ids))
+			for i, id := range ids {
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+				out[i] = m[id]
+			}
+			return out, errs
+		}),
 	}
 }
 
@@ -2,6 +2,13 @@
 
 package graphql
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type AFLClub struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -14,8 +21,21 @@ type AFLClubMatch struct {
 	RushedBehinds int               `json:"rushedBehinds"`
 	Score         int               `json:"score"`
 	PlayerMatches []*AFLPlayerMatch `json:"playerMatches"`
-	MatchID       string            `json:"matchId"`
-	Match         *AFLMatch         `json:"match,omitempty"`
+	// The score at the end of each quarter, counting the quarters before it.
+	QuarterScores []*AFLQuarterScore `json:"quarterScores"`
+	MatchID       string             `json:"matchId"`
+	Match         *AFLMatch          `json:"match,omitempty"`
+}
+
+type AFLClubMatchStatsPreview struct {
+	ClubMatchID      string `json:"clubMatchId"`
+	ClubName         string `json:"clubName"`
+	OldScore         int    `json:"oldScore"`
+	NewScore         int    `json:"newScore"`
+	OldRushedBehinds int    `json:"oldRushedBehinds"`
+	NewRushedBehinds int    `json:"newRushedBehinds"`
+	// Only players whose stats change.
+	Players []*AFLPlayerStatsDiff `json:"players"`
 }
 
 type AFLClubSeason struct {
@@ -31,6 +51,12 @@ type AFLClubSeason struct {
 	PremiershipPoints int        `json:"premiershipPoints"`
 }
 
+type AFLListedPlayerInput struct {
+	// As in afl.club, or one of its usual codes or nicknames.
+	Club string `json:"club"`
+	Name string `json:"name"`
+}
+
 type AFLLiveRound struct {
 	Round     *AFLRound `json:"round"`
 	StartDate string    `json:"startDate"`
@@ -45,6 +71,23 @@ type AFLMatch struct {
 	Round         *AFLRound     `json:"round"`
 	HomeClubMatch *AFLClubMatch `json:"homeClubMatch,omitempty"`
 	AwayClubMatch *AFLClubMatch `json:"awayClubMatch,omitempty"`
+	// Discrepancies found the last time the match's stats were verified across sources.
+	StatsDiscrepancies []*AFLStatsDiscrepancy `json:"statsDiscrepancies"`
+	// How the scoreboard moved break by break; null when quarter scores weren't recorded.
+	Timeline *AFLMatchTimeline `json:"timeline,omitempty"`
+}
+
+type AFLMatchTimeline struct {
+	Breaks []*AFLQuarterBreak `json:"breaks"`
+	// Whether the winner trailed at quarter, half or three-quarter time; false until the match is over.
+	ComebackWin bool `json:"comebackWin"`
+	// The most the winner trailed by at a break before the final siren; 0 when they never trailed or the match isn't over.
+	ComebackDeficit int `json:"comebackDeficit"`
+}
+
+type AFLMergedPlayerSeason struct {
+	DuplicateID string `json:"duplicateId"`
+	SurvivorID  string `json:"survivorId"`
 }
 
 type AFLPlayer struct {
@@ -55,6 +98,39 @@ type AFLPlayer struct {
 
 func (AFLPlayer) IsEntity() {}
 
+// A player's pre-match availability for a round.
+type AFLPlayerAvailability struct {
+	ID             string           `json:"id"`
+	PlayerSeasonID string           `json:"playerSeasonId"`
+	PlayerSeason   *AFLPlayerSeason `json:"playerSeason"`
+	RoundID        string           `json:"roundId"`
+	// One of named, emergency, injured, suspended.
+	Status string `json:"status"`
+	// Injured players only, as published (e.g. "2-3 weeks", "TBC").
+	ExpectedReturn *string `json:"expectedReturn,omitempty"`
+	// Where the entry came from: manual, or the name of a feed.
+	Source string `json:"source"`
+}
+
+type AFLPlayerDuplicate struct {
+	Player *AFLPlayer `json:"player"`
+	Other  *AFLPlayer `json:"other"`
+	// How alike the names are, 0.0–1.0.
+	Confidence float64 `json:"confidence"`
+}
+
+type AFLPlayerImport struct {
+	SeasonID   string `json:"seasonId"`
+	SeasonName string `json:"seasonName"`
+	// Distinct players on the list.
+	ListedCount int `json:"listedCount"`
+	// Listed players who already have a player season in the season.
+	InSeasonCount int `json:"inSeasonCount"`
+	// Listed players and retirements decided in an earlier review.
+	DecidedCount int                  `json:"decidedCount"`
+	Proposals    []*AFLPlayerProposal `json:"proposals"`
+}
+
 type AFLPlayerMatch struct {
 	ID             string        `json:"id"`
 	PlayerSeasonID string        `json:"playerSeasonId"`
@@ -71,10 +147,46 @@ type AFLPlayerMatch struct {
 	Behinds        int           `json:"behinds"`
 	Disposals      int           `json:"disposals"`
 	Score          int           `json:"score"`
+	// Stats beyond those above, by name (contested_possessions, time_on_ground_pct, …), as far as a source has reported them.
+	ExtraStats []*AFLStat `json:"extraStats"`
 }
 
 func (AFLPlayerMatch) IsEntity() {}
 
+type AFLPlayerMerge struct {
+	Survivor    *AFLPlayer `json:"survivor"`
+	DuplicateID string     `json:"duplicateId"`
+	// The duplicate's player seasons now the survivor's.
+	MovedPlayerSeasonIds []string `json:"movedPlayerSeasonIds"`
+	// The duplicate's player seasons merged into the survivor's at the same club season.
+	MergedPlayerSeasons []*AFLMergedPlayerSeason `json:"mergedPlayerSeasons"`
+}
+
+type AFLPlayerProposal struct {
+	ID       string `json:"id"`
+	SeasonID string `json:"seasonId"`
+	// The stats source the list came from; null for a list from elsewhere.
+	Source *string                 `json:"source,omitempty"`
+	Kind   AFLPlayerProposalKind   `json:"kind"`
+	Status AFLPlayerProposalStatus `json:"status"`
+	// The name as listed; null for a retirement.
+	ListedName *string `json:"listedName,omitempty"`
+	// The club season the player is listed at, or for a retirement, the one they played last season.
+	ClubSeasonID string `json:"clubSeasonId"`
+	ClubName     string `json:"clubName"`
+	// The stored player; null for a new player.
+	PlayerID   *string `json:"playerId,omitempty"`
+	PlayerName *string `json:"playerName,omitempty"`
+	// A continuing player's club in their latest season.
+	PreviousClubName *string `json:"previousClubName,omitempty"`
+	// How confidently a continuing player's name matched, 0–1.
+	Confidence float64 `json:"confidence"`
+	// The player season accepting the proposal created.
+	PlayerSeasonID *string `json:"playerSeasonId,omitempty"`
+	CreatedAt      string  `json:"createdAt"`
+	DecidedAt      *string `json:"decidedAt,omitempty"`
+}
+
 type AFLPlayerSeason struct {
 	ID         string            `json:"id"`
 	Player     *AFLPlayer        `json:"player"`
@@ -93,25 +205,136 @@ type AFLPlayerSeasonFilter struct {
 	Query *string `json:"query,omitempty"`
 }
 
+type AFLPlayerStatsDiff struct {
+	PlayerSeasonID string                 `json:"playerSeasonId"`
+	PlayerName     string                 `json:"playerName"`
+	Kind           AFLPlayerStatsDiffKind `json:"kind"`
+	// The stats that change; for an added or removed player, every stat that isn't zero.
+	Changes []*AFLStatChange `json:"changes"`
+	// The new stat line, as fflScoreImpact takes it; all zero for a removed player.
+	Kicks     int `json:"kicks"`
+	Handballs int `json:"handballs"`
+	Marks     int `json:"marks"`
+	Hitouts   int `json:"hitouts"`
+	Tackles   int `json:"tackles"`
+	Goals     int `json:"goals"`
+	Behinds   int `json:"behinds"`
+}
+
+type AFLQuarterBreak struct {
+	Quarter   int              `json:"quarter"`
+	HomeScore *AFLQuarterScore `json:"homeScore"`
+	AwayScore *AFLQuarterScore `json:"awayScore"`
+	// The home club's lead at the break; negative when the away club leads.
+	Margin int `json:"margin"`
+	// home_win, away_win or draw for the quarter alone.
+	QuarterResult string `json:"quarterResult"`
+}
+
+type AFLQuarterScore struct {
+	Quarter int `json:"quarter"`
+	Goals   int `json:"goals"`
+	Behinds int `json:"behinds"`
+	Score   int `json:"score"`
+}
+
 type AFLRound struct {
 	ID      string      `json:"id"`
 	Name    string      `json:"name"`
 	Season  *AFLSeason  `json:"season"`
 	Matches []*AFLMatch `json:"matches"`
+	// Pre-match availability recorded for the round.
+	Availability []*AFLPlayerAvailability `json:"availability"`
 }
 
 func (AFLRound) IsEntity() {}
 
+type AFLRoundMatchImport struct {
+	MatchID      string                    `json:"matchId"`
+	HomeClubName string                    `json:"homeClubName"`
+	AwayClubName string                    `json:"awayClubName"`
+	Status       AFLRoundMatchImportStatus `json:"status"`
+	// Player counts and unmatched players; null unless imported.
+	Stats       *ImportAFLMatchStatsResult `json:"stats,omitempty"`
+	MarkedFinal bool                       `json:"markedFinal"`
+	// Why the match failed, or why an imported match wasn't marked final.
+	Error *string `json:"error,omitempty"`
+}
+
 type AFLSeason struct {
 	ID            string                     `json:"id"`
 	Name          string                     `json:"name"`
 	Ladder        []*AFLClubSeason           `json:"ladder"`
 	Rounds        []*AFLRound                `json:"rounds"`
 	PlayerSeasons *AFLPlayerSeasonConnection `json:"playerSeasons"`
+	// Player import proposals for the season, oldest first; only those with status if given.
+	PlayerProposals []*AFLPlayerProposal `json:"playerProposals"`
 }
 
 func (AFLSeason) IsEntity() {}
 
+type AFLSeasonSetup struct {
+	SeasonID      string   `json:"seasonId"`
+	SeasonName    string   `json:"seasonName"`
+	SeasonCreated bool     `json:"seasonCreated"`
+	RoundsCreated []string `json:"roundsCreated"`
+	// Names of the clubs given a club season.
+	ClubSeasonsCreated []string `json:"clubSeasonsCreated"`
+	// Fixture order, then unlisted matches.
+	Matches []*AFLSeasonSetupMatch `json:"matches"`
+}
+
+type AFLSeasonSetupMatch struct {
+	MatchID      string                    `json:"matchId"`
+	RoundName    string                    `json:"roundName"`
+	HomeClubName string                    `json:"homeClubName"`
+	AwayClubName string                    `json:"awayClubName"`
+	Venue        *string                   `json:"venue,omitempty"`
+	StartTime    *string                   `json:"startTime,omitempty"`
+	Status       AFLSeasonSetupMatchStatus `json:"status"`
+	// What an update changed, e.g. "venue: MCG → Marvel Stadium".
+	Changes []string `json:"changes"`
+}
+
+// A player match stat, by name.
+type AFLStat struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+type AFLStatChange struct {
+	// kicks, handballs, marks, hitouts, tackles, goals or behinds
+	Stat string `json:"stat"`
+	Old  int    `json:"old"`
+	New  int    `json:"new"`
+}
+
+type AFLStatsDiscrepancy struct {
+	ClubMatchID string                  `json:"clubMatchId"`
+	Kind        AFLStatsDiscrepancyKind `json:"kind"`
+	// Null for a score, or a player whose name didn't resolve.
+	PlayerSeasonID *string `json:"playerSeasonId,omitempty"`
+	PlayerName     *string `json:"playerName,omitempty"`
+	// kicks, handballs, marks, hitouts, tackles, goals or behinds; null for a missing player.
+	Stat        *string `json:"stat,omitempty"`
+	Source      string  `json:"source"`
+	Value       int     `json:"value"`
+	OtherSource string  `json:"otherSource"`
+	OtherValue  int     `json:"otherValue"`
+}
+
+type AFLStatsImportPreview struct {
+	ID      string `json:"id"`
+	MatchID string `json:"matchId"`
+	RoundID string `json:"roundId"`
+	Source  string `json:"source"`
+	// Home then away.
+	ClubMatches      []*AFLClubMatchStatsPreview `json:"clubMatches"`
+	UnmatchedPlayers []*UnmatchedAFLPlayer       `json:"unmatchedPlayers"`
+	// Null until the preview is applied.
+	AppliedAt *string `json:"appliedAt,omitempty"`
+}
+
 type AddAFLPlayerInput struct {
 	Name         string `json:"name"`
 	ClubSeasonID string `json:"clubSeasonId"`
@@ -122,9 +345,10 @@ type AddAFLPlayerSeasonInput struct {
 	ClubSeasonID string `json:"clubSeasonId"`
 }
 
-// ---- Import flow ----
 type ImportAFLMatchStatsResult struct {
-	MatchID          string                `json:"matchId"`
+	MatchID string `json:"matchId"`
+	// The source the stats were imported from; pass it back when resolving unmatched players.
+	Source           string                `json:"source"`
 	HomeClubName     string                `json:"homeClubName"`
 	AwayClubName     string                `json:"awayClubName"`
 	HomePlayerCount  int                   `json:"homePlayerCount"`
@@ -132,6 +356,11 @@ type ImportAFLMatchStatsResult struct {
 	UnmatchedPlayers []*UnmatchedAFLPlayer `json:"unmatchedPlayers"`
 }
 
+type ImportAFLRoundStatsResult struct {
+	RoundID string                 `json:"roundId"`
+	Matches []*AFLRoundMatchImport `json:"matches"`
+}
+
 type Mutation struct {
 }
 
@@ -155,6 +384,17 @@ type ResolveAFLPlayerMatchInput struct {
 	Goals          int     `json:"goals"`
 	Behinds        int     `json:"behinds"`
 	ParsedName     *string `json:"parsedName,omitempty"`
+	// The source parsedName comes from; footywire if omitted.
+	Source *string `json:"source,omitempty"`
+}
+
+type SetAFLPlayerAvailabilityInput struct {
+	PlayerSeasonID string `json:"playerSeasonId"`
+	RoundID        string `json:"roundId"`
+	// One of named, emergency, injured, suspended.
+	Status string `json:"status"`
+	// Injured players only.
+	ExpectedReturn *string `json:"expectedReturn,omitempty"`
 }
 
 type UnmatchedAFLPlayer struct {
@@ -172,11 +412,371 @@ type UnmatchedAFLPlayer struct {
 type UpdateAFLPlayerMatchInput struct {
 	PlayerSeasonID string `json:"playerSeasonId"`
 	ClubMatchID    string `json:"clubMatchId"`
-	Kicks          *int    `json:"kicks,omitempty"`
-	Handballs      *int    `json:"handballs,omitempty"`
-	Marks          *int    `json:"marks,omitempty"`
-	Hitouts        *int    `json:"hitouts,omitempty"`
-	Tackles        *int    `json:"tackles,omitempty"`
-	Goals          *int    `json:"goals,omitempty"`
-	Behinds        *int    `json:"behinds,omitempty"`
+	Kicks          *int   `json:"kicks,omitempty"`
+	Handballs      *int   `json:"handballs,omitempty"`
+	Marks          *int   `json:"marks,omitempty"`
+	Hitouts        *int   `json:"hitouts,omitempty"`
+	Tackles        *int   `json:"tackles,omitempty"`
+	Goals          *int   `json:"goals,omitempty"`
+	Behinds        *int   `json:"behinds,omitempty"`
+}
+
+type VerifyAFLMatchStatsResult struct {
+	MatchID string `json:"matchId"`
+	// The sources compared; the first is the one the others were compared with.
+	Sources       []string               `json:"sources"`
+	Discrepancies []*AFLStatsDiscrepancy `json:"discrepancies"`
+}
+
+type AFLPlayerProposalKind string
+
+const (
+	// Not a stored player; accepting adds them with a player season at the listed club.
+	AFLPlayerProposalKindNew AFLPlayerProposalKind = "new"
+	// A stored player; accepting gives them a player season at the listed club.
+	AFLPlayerProposalKindContinuing AFLPlayerProposalKind = "continuing"
+	// Played last season and isn't listed; accepting writes nothing, and the player isn't proposed again.
+	AFLPlayerProposalKindRetiring AFLPlayerProposalKind = "retiring"
+)
+
+var AllAFLPlayerProposalKind = []AFLPlayerProposalKind{
+	AFLPlayerProposalKindNew,
+	AFLPlayerProposalKindContinuing,
+	AFLPlayerProposalKindRetiring,
+}
+
+func (e AFLPlayerProposalKind) IsValid() bool {
+	switch e {
+	case AFLPlayerProposalKindNew, AFLPlayerProposalKindContinuing, AFLPlayerProposalKindRetiring:
+		return true
+	}
+	return false
+}
+
+func (e AFLPlayerProposalKind) String() string {
+	return string(e)
+}
+
+func (e *AFLPlayerProposalKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLPlayerProposalKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLPlayerProposalKind", str)
+	}
+	return nil
+}
+
+func (e AFLPlayerProposalKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLPlayerProposalKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLPlayerProposalKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AFLPlayerProposalStatus string
+
+const (
+	AFLPlayerProposalStatusPending  AFLPlayerProposalStatus = "pending"
+	AFLPlayerProposalStatusAccepted AFLPlayerProposalStatus = "accepted"
+	AFLPlayerProposalStatusRejected AFLPlayerProposalStatus = "rejected"
+)
+
+var AllAFLPlayerProposalStatus = []AFLPlayerProposalStatus{
+	AFLPlayerProposalStatusPending,
+	AFLPlayerProposalStatusAccepted,
+	AFLPlayerProposalStatusRejected,
+}
+
+func (e AFLPlayerProposalStatus) IsValid() bool {
+	switch e {
+	case AFLPlayerProposalStatusPending, AFLPlayerProposalStatusAccepted, AFLPlayerProposalStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e AFLPlayerProposalStatus) String() string {
+	return string(e)
+}
+
+func (e *AFLPlayerProposalStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLPlayerProposalStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLPlayerProposalStatus", str)
+	}
+	return nil
+}
+
+func (e AFLPlayerProposalStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLPlayerProposalStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLPlayerProposalStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AFLPlayerStatsDiffKind string
+
+const (
+	// The source lists the player and no stats are stored for them.
+	AFLPlayerStatsDiffKindAdded   AFLPlayerStatsDiffKind = "added"
+	AFLPlayerStatsDiffKindChanged AFLPlayerStatsDiffKind = "changed"
+	// Stats are stored for the player and the source doesn't list them.
+	AFLPlayerStatsDiffKindRemoved AFLPlayerStatsDiffKind = "removed"
+)
+
+var AllAFLPlayerStatsDiffKind = []AFLPlayerStatsDiffKind{
+	AFLPlayerStatsDiffKindAdded,
+	AFLPlayerStatsDiffKindChanged,
+	AFLPlayerStatsDiffKindRemoved,
+}
+
+func (e AFLPlayerStatsDiffKind) IsValid() bool {
+	switch e {
+	case AFLPlayerStatsDiffKindAdded, AFLPlayerStatsDiffKindChanged, AFLPlayerStatsDiffKindRemoved:
+		return true
+	}
+	return false
+}
+
+func (e AFLPlayerStatsDiffKind) String() string {
+	return string(e)
+}
+
+func (e *AFLPlayerStatsDiffKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLPlayerStatsDiffKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLPlayerStatsDiffKind", str)
+	}
+	return nil
+}
+
+func (e AFLPlayerStatsDiffKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLPlayerStatsDiffKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLPlayerStatsDiffKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AFLRoundMatchImportStatus string
+
+const (
+	AFLRoundMatchImportStatusImported AFLRoundMatchImportStatus = "imported"
+	AFLRoundMatchImportStatusSkipped  AFLRoundMatchImportStatus = "skipped"
+	AFLRoundMatchImportStatusFailed   AFLRoundMatchImportStatus = "failed"
+)
+
+var AllAFLRoundMatchImportStatus = []AFLRoundMatchImportStatus{
+	AFLRoundMatchImportStatusImported,
+	AFLRoundMatchImportStatusSkipped,
+	AFLRoundMatchImportStatusFailed,
+}
+
+func (e AFLRoundMatchImportStatus) IsValid() bool {
+	switch e {
+	case AFLRoundMatchImportStatusImported, AFLRoundMatchImportStatusSkipped, AFLRoundMatchImportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e AFLRoundMatchImportStatus) String() string {
+	return string(e)
+}
+
+func (e *AFLRoundMatchImportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLRoundMatchImportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLRoundMatchImportStatus", str)
+	}
+	return nil
+}
+
+func (e AFLRoundMatchImportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLRoundMatchImportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLRoundMatchImportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AFLSeasonSetupMatchStatus string
+
+const (
+	AFLSeasonSetupMatchStatusCreated   AFLSeasonSetupMatchStatus = "created"
+	AFLSeasonSetupMatchStatusUpdated   AFLSeasonSetupMatchStatus = "updated"
+	AFLSeasonSetupMatchStatusUnchanged AFLSeasonSetupMatchStatus = "unchanged"
+	// Stored but no longer in the fixture; left as it is.
+	AFLSeasonSetupMatchStatusUnlisted AFLSeasonSetupMatchStatus = "unlisted"
+)
+
+var AllAFLSeasonSetupMatchStatus = []AFLSeasonSetupMatchStatus{
+	AFLSeasonSetupMatchStatusCreated,
+	AFLSeasonSetupMatchStatusUpdated,
+	AFLSeasonSetupMatchStatusUnchanged,
+	AFLSeasonSetupMatchStatusUnlisted,
+}
+
+func (e AFLSeasonSetupMatchStatus) IsValid() bool {
+	switch e {
+	case AFLSeasonSetupMatchStatusCreated, AFLSeasonSetupMatchStatusUpdated, AFLSeasonSetupMatchStatusUnchanged, AFLSeasonSetupMatchStatusUnlisted:
+		return true
+	}
+	return false
+}
+
+func (e AFLSeasonSetupMatchStatus) String() string {
+	return string(e)
+}
+
+func (e *AFLSeasonSetupMatchStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLSeasonSetupMatchStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLSeasonSetupMatchStatus", str)
+	}
+	return nil
+}
+
+func (e AFLSeasonSetupMatchStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLSeasonSetupMatchStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLSeasonSetupMatchStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AFLStatsDiscrepancyKind string
+
+const (
+	// A player's stat differs between the sources.
+	AFLStatsDiscrepancyKindStat AFLStatsDiscrepancyKind = "stat"
+	// source lists the player and otherSource doesn't.
+	AFLStatsDiscrepancyKindMissingPlayer AFLStatsDiscrepancyKind = "missing_player"
+	// The stored stats and rushed behinds don't add up to the club's goals or behinds in source's score table; otherSource is stored.
+	AFLStatsDiscrepancyKindScore AFLStatsDiscrepancyKind = "score"
+)
+
+var AllAFLStatsDiscrepancyKind = []AFLStatsDiscrepancyKind{
+	AFLStatsDiscrepancyKindStat,
+	AFLStatsDiscrepancyKindMissingPlayer,
+	AFLStatsDiscrepancyKindScore,
+}
+
+func (e AFLStatsDiscrepancyKind) IsValid() bool {
+	switch e {
+	case AFLStatsDiscrepancyKindStat, AFLStatsDiscrepancyKindMissingPlayer, AFLStatsDiscrepancyKindScore:
+		return true
+	}
+	return false
+}
+
+func (e AFLStatsDiscrepancyKind) String() string {
+	return string(e)
+}
+
+func (e *AFLStatsDiscrepancyKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AFLStatsDiscrepancyKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AFLStatsDiscrepancyKind", str)
+	}
+	return nil
+}
+
+func (e AFLStatsDiscrepancyKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AFLStatsDiscrepancyKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AFLStatsDiscrepancyKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
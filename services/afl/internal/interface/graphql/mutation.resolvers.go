@@ -79,39 +79,174 @@ func (r *mutationResolver) UpdateAFLPlayerMatch(ctx context.Context, input Updat
 }
 
 // ImportAFLMatchStats is the resolver for the importAFLMatchStats field.
-func (r *mutationResolver) ImportAFLMatchStats(ctx context.Context, matchID string) (*ImportAFLMatchStatsResult, error) {
+func (r *mutationResolver) ImportAFLMatchStats(ctx context.Context, matchID string, source *string) (*ImportAFLMatchStatsResult, error) {
 	id, err := fromID(matchID)
 	if err != nil {
 		return nil, err
 	}
-	result, err := r.DataOps.ImportAFLStats(ctx, id)
+	result, err := r.DataOps.ImportAFLStats(ctx, id, derefString(source))
 	if err != nil {
 		return nil, err
 	}
-	unmatched := make([]*UnmatchedAFLPlayer, len(result.UnmatchedPlayers))
-	for i, u := range result.UnmatchedPlayers {
-		unmatched[i] = &UnmatchedAFLPlayer{
-			ParsedName:  u.ParsedName,
-			ClubMatchID: toID(u.ClubMatchID),
-			Kicks:       u.Kicks,
-			Handballs:   u.Handballs,
-			Marks:       u.Marks,
-			Hitouts:     u.Hitouts,
-			Tackles:     u.Tackles,
-			Goals:       u.Goals,
-			Behinds:     u.Behinds,
+	return convertImportResult(result), nil
+}
+
+// ImportAFLRoundStats is the resolver for the importAFLRoundStats field.
+func (r *mutationResolver) ImportAFLRoundStats(ctx context.Context, roundID string, markFinal *bool, source *string) (*ImportAFLRoundStatsResult, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.DataOps.ImportAFLRoundStats(ctx, application.ImportAFLRoundStatsParams{
+		RoundID:   id,
+		MarkFinal: markFinal != nil && *markFinal,
+		Source:    derefString(source),
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*AFLRoundMatchImport, len(result.Matches))
+	for i, m := range result.Matches {
+		matches[i] = &AFLRoundMatchImport{
+			MatchID:      toID(m.MatchID),
+			HomeClubName: m.HomeClubName,
+			AwayClubName: m.AwayClubName,
+			Status:       AFLRoundMatchImportStatus(m.Status),
+			MarkedFinal:  m.MarkedFinal,
+			Error:        toStringPtr(m.Error),
+		}
+		if m.Status == application.RoundMatchImported {
+			matches[i].Stats = convertImportResult(m.Stats)
+		}
+	}
+	return &ImportAFLRoundStatsResult{RoundID: toID(result.RoundID), Matches: matches}, nil
+}
+
+// VerifyAFLMatchStats is the resolver for the verifyAFLMatchStats field.
+func (r *mutationResolver) VerifyAFLMatchStats(ctx context.Context, matchID string, threshold *int) (*VerifyAFLMatchStatsResult, error) {
+	id, err := fromID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	params := application.VerifyAFLMatchStatsParams{MatchID: id}
+	if threshold != nil {
+		params.Threshold = *threshold
+	}
+	result, err := r.DataOps.VerifyAFLMatchStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyAFLMatchStatsResult{
+		MatchID:       toID(result.MatchID),
+		Sources:       result.Sources,
+		Discrepancies: convertDiscrepancies(result.Discrepancies),
+	}, nil
+}
+
+// PreviewAFLMatchStatsImport is the resolver for the previewAFLMatchStatsImport field.
+func (r *mutationResolver) PreviewAFLMatchStatsImport(ctx context.Context, matchID string, source *string) (*AFLStatsImportPreview, error) {
+	id, err := fromID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := r.DataOps.PreviewAFLStats(ctx, id, derefString(source))
+	if err != nil {
+		return nil, err
+	}
+	return convertStatsPreview(preview), nil
+}
+
+// ApplyAFLStatsImportPreview is the resolver for the applyAFLStatsImportPreview field.
+func (r *mutationResolver) ApplyAFLStatsImportPreview(ctx context.Context, previewID string) (*AFLStatsImportPreview, error) {
+	id, err := fromID(previewID)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := r.DataOps.ApplyAFLStatsPreview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertStatsPreview(preview), nil
+}
+
+// SetupAFLSeason is the resolver for the setupAFLSeason field.
+func (r *mutationResolver) SetupAFLSeason(ctx context.Context, year int, source *string) (*AFLSeasonSetup, error) {
+	result, err := r.SeasonSetup.SetupAFLSeason(ctx, application.SetupAFLSeasonParams{
+		Year:   year,
+		Source: derefString(source),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertSeasonSetup(result), nil
+}
+
+// ProposeAFLPlayerImport is the resolver for the proposeAFLPlayerImport field.
+func (r *mutationResolver) ProposeAFLPlayerImport(ctx context.Context, year int, source *string, players []*AFLListedPlayerInput) (*AFLPlayerImport, error) {
+	params := application.ProposeAFLPlayerImportParams{Year: year, Source: derefString(source)}
+	if players != nil {
+		params.Players = make([]application.ListedPlayer, len(players))
+		for i, p := range players {
+			params.Players[i] = application.ListedPlayer{Name: p.Name, Club: p.Club}
 		}
 	}
-	return &ImportAFLMatchStatsResult{
-		MatchID:          toID(result.MatchID),
-		HomeClubName:     result.HomeClubName,
-		AwayClubName:     result.AwayClubName,
-		HomePlayerCount:  result.HomePlayerCount,
-		AwayPlayerCount:  result.AwayPlayerCount,
-		UnmatchedPlayers: unmatched,
+	result, err := r.PlayerImport.ProposeAFLPlayerImport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &AFLPlayerImport{
+		SeasonID:      toID(result.SeasonID),
+		SeasonName:    result.SeasonName,
+		ListedCount:   result.Listed,
+		InSeasonCount: result.InSeason,
+		DecidedCount:  result.Decided,
+		Proposals:     convertPlayerProposals(result.Proposals),
 	}, nil
 }
 
+// AcceptAFLPlayerProposals is the resolver for the acceptAFLPlayerProposals field.
+func (r *mutationResolver) AcceptAFLPlayerProposals(ctx context.Context, proposalIds []string) ([]*AFLPlayerProposal, error) {
+	ids, err := fromIDs(proposalIds)
+	if err != nil {
+		return nil, err
+	}
+	proposals, err := r.PlayerImport.AcceptPlayerProposals(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerProposals(proposals), nil
+}
+
+// RejectAFLPlayerProposals is the resolver for the rejectAFLPlayerProposals field.
+func (r *mutationResolver) RejectAFLPlayerProposals(ctx context.Context, proposalIds []string) ([]*AFLPlayerProposal, error) {
+	ids, err := fromIDs(proposalIds)
+	if err != nil {
+		return nil, err
+	}
+	proposals, err := r.PlayerImport.RejectPlayerProposals(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerProposals(proposals), nil
+}
+
+// MergeAFLPlayers is the resolver for the mergeAFLPlayers field.
+func (r *mutationResolver) MergeAFLPlayers(ctx context.Context, survivorID string, duplicateID string) (*AFLPlayerMerge, error) {
+	survivor, err := fromID(survivorID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := fromID(duplicateID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.DataOps.MergeAFLPlayers(ctx, survivor, duplicate)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerMerge(res), nil
+}
+
 // ResolveAFLPlayerMatch is the resolver for the resolveAFLPlayerMatch field.
 func (r *mutationResolver) ResolveAFLPlayerMatch(ctx context.Context, input ResolveAFLPlayerMatchInput) (*AFLPlayerMatch, error) {
 	clubMatchID, err := fromID(input.ClubMatchID)
@@ -134,6 +269,7 @@ func (r *mutationResolver) ResolveAFLPlayerMatch(ctx context.Context, input Reso
 		Goals:          input.Goals,
 		Behinds:        input.Behinds,
 		ParsedName:     input.ParsedName,
+		Source:         derefString(input.Source),
 	}
 
 	pm, err := r.DataOps.ResolveAFLPlayerMatch(ctx, params)
@@ -172,6 +308,28 @@ func (r *mutationResolver) RecalculateAFLLadder(ctx context.Context, seasonID st
 	return true, nil
 }
 
+// SetAFLPlayerAvailability is the resolver for the setAFLPlayerAvailability field.
+func (r *mutationResolver) SetAFLPlayerAvailability(ctx context.Context, input SetAFLPlayerAvailabilityInput) (*AFLPlayerAvailability, error) {
+	psID, err := fromID(input.PlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	roundID, err := fromID(input.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := r.Availability.SetPlayerAvailability(ctx, application.SetPlayerAvailabilityParams{
+		PlayerSeasonID: psID,
+		RoundID:        roundID,
+		Status:         domain.AvailabilityStatus(input.Status),
+		ExpectedReturn: input.ExpectedReturn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertAvailability(a), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -8,6 +8,7 @@ package graphql
 import (
 	"context"
 	"fmt"
+	"xffl/services/afl/internal/application"
 )
 
 // PlayerMatches is the resolver for the playerMatches field.
@@ -32,6 +33,19 @@ func (r *aFLClubMatchResolver) PlayerMatches(ctx context.Context, obj *AFLClubMa
 	return result, nil
 }
 
+// QuarterScores is the resolver for the quarterScores field.
+func (r *aFLClubMatchResolver) QuarterScores(ctx context.Context, obj *AFLClubMatch) ([]*AFLQuarterScore, error) {
+	cmID, err := fromID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	quarters, err := LoadersFromCtx(ctx).QuarterScoresByClubMatchID.Load(ctx, cmID)
+	if err != nil {
+		return nil, err
+	}
+	return convertQuarterScores(quarters), nil
+}
+
 // Match is the resolver for the match field.
 func (r *aFLClubMatchResolver) Match(ctx context.Context, obj *AFLClubMatch) (*AFLMatch, error) {
 	matchID, err := fromID(obj.MatchID)
@@ -127,6 +141,35 @@ func (r *aFLMatchResolver) AwayClubMatch(ctx context.Context, obj *AFLMatch) (*A
 	return convertClubMatch(cm, club), nil
 }
 
+// StatsDiscrepancies is the resolver for the statsDiscrepancies field.
+func (r *aFLMatchResolver) StatsDiscrepancies(ctx context.Context, obj *AFLMatch) ([]*AFLStatsDiscrepancy, error) {
+	matchID, err := fromID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies, err := r.DataOps.StatsDiscrepancies(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return convertDiscrepancies(discrepancies), nil
+}
+
+// Timeline is the resolver for the timeline field.
+func (r *aFLMatchResolver) Timeline(ctx context.Context, obj *AFLMatch) (*AFLMatchTimeline, error) {
+	matchID, err := fromID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	timeline, err := r.Queries.GetMatchTimeline(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeline.Breaks) == 0 {
+		return nil, nil
+	}
+	return convertMatchTimeline(timeline), nil
+}
+
 // ClubMatch is the resolver for the clubMatch field.
 func (r *aFLPlayerMatchResolver) ClubMatch(ctx context.Context, obj *AFLPlayerMatch) (*AFLClubMatch, error) {
 	cmID, err := fromID(obj.ClubMatchID)
@@ -230,6 +273,19 @@ func (r *aFLRoundResolver) Matches(ctx context.Context, obj *AFLRound) ([]*AFLMa
 	return convertMatches(matches), nil
 }
 
+// Availability is the resolver for the availability field.
+func (r *aFLRoundResolver) Availability(ctx context.Context, obj *AFLRound) ([]*AFLPlayerAvailability, error) {
+	roundID, err := fromID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.Queries.GetRoundAvailability(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	return convertAvailabilities(entries), nil
+}
+
 // Ladder is the resolver for the ladder field.
 func (r *aFLSeasonResolver) Ladder(ctx context.Context, obj *AFLSeason) ([]*AFLClubSeason, error) {
 	seasonID, err := fromID(obj.ID)
@@ -293,6 +349,23 @@ func (r *aFLSeasonResolver) PlayerSeasons(ctx context.Context, obj *AFLSeason, f
 	}, nil
 }
 
+// PlayerProposals is the resolver for the playerProposals field.
+func (r *aFLSeasonResolver) PlayerProposals(ctx context.Context, obj *AFLSeason, status *AFLPlayerProposalStatus) ([]*AFLPlayerProposal, error) {
+	seasonID, err := fromID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	var s application.PlayerProposalStatus
+	if status != nil {
+		s = application.PlayerProposalStatus(*status)
+	}
+	proposals, err := r.PlayerImport.PlayerProposals(ctx, seasonID, s)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerProposals(proposals), nil
+}
+
 // AflSeasons is the resolver for the aflSeasons field.
 func (r *queryResolver) AflSeasons(ctx context.Context) ([]*AFLSeason, error) {
 	seasons, err := r.Queries.GetSeasons(ctx)
@@ -399,11 +472,28 @@ func (r *queryResolver) AflPlayerSearch(ctx context.Context, query string) ([]*A
 	}
 	out := make([]*AFLPlayer, len(results))
 	for i, res := range results {
-		p := convertPlayer(res.Player)
-		if res.HasLatestSeason {
-			p.LatestPlayerSeason = &AFLPlayerSeason{ID: toID(res.LatestSeasonID)}
+		out[i] = convertPlayerWithLatestSeason(res)
+	}
+	return out, nil
+}
+
+// AflPlayerDuplicates is the resolver for the aflPlayerDuplicates field.
+func (r *queryResolver) AflPlayerDuplicates(ctx context.Context, minConfidence *float64) ([]*AFLPlayerDuplicate, error) {
+	var conf float64
+	if minConfidence != nil {
+		conf = *minConfidence
+	}
+	duplicates, err := r.Queries.PlayerDuplicates(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*AFLPlayerDuplicate, len(duplicates))
+	for i, d := range duplicates {
+		out[i] = &AFLPlayerDuplicate{
+			Player:     convertPlayerWithLatestSeason(d.Player),
+			Other:      convertPlayerWithLatestSeason(d.Other),
+			Confidence: d.Confidence,
 		}
-		out[i] = p
 	}
 	return out, nil
 }
@@ -440,3 +530,16 @@ type aFLPlayerSeasonResolver struct{ *Resolver }
 type aFLRoundResolver struct{ *Resolver }
 type aFLSeasonResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//    it when you're done.
+//  - You have helper methods in this file. Move them out to keep these resolver files clean.
+/*
+	func (r *Resolver) AFLPlayerAvailability() AFLPlayerAvailabilityResolver {
+	return &aFLPlayerAvailabilityResolver{r}
+}
+type aFLPlayerAvailabilityResolver struct{ *Resolver }
+*/
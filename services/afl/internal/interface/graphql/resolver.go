@@ -4,7 +4,10 @@ import "xffl/services/afl/internal/application"
 
 // Resolver is the dependency injection container for GraphQL resolvers.
 type Resolver struct {
-	Queries  *application.Queries
-	Commands *application.Commands
-	DataOps  *application.DataOpsCommands
+	Queries      *application.Queries
+	Commands     *application.Commands
+	DataOps      *application.DataOpsCommands
+	Availability *application.AvailabilityCommands
+	SeasonSetup  *application.SeasonSetupCommands
+	PlayerImport *application.PlayerImportCommands
 }
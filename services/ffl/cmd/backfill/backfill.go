@@ -0,0 +1,365 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"xffl/services/ffl/internal/application"
+	"xffl/shared/clock"
+)
+
+type backfiller struct {
+	queries    *application.Queries
+	dataOps    *application.DataOpsCommands
+	clock      clock.Clock
+	seasonID   int
+	reviewPath string
+	statePath  string
+	dryRun     bool
+}
+
+// run imports every archived round for year not already recorded as done.
+func (b *backfiller) run(ctx context.Context, dir string, year int) error {
+	if _, err := b.queries.GetSeason(ctx, b.seasonID); err != nil {
+		return fmt.Errorf("find season %d: %w", b.seasonID, err)
+	}
+	archive, err := findArchiveRounds(dir, year)
+	if err != nil {
+		return err
+	}
+	roundIDs, err := b.roundIDs(ctx)
+	if err != nil {
+		return err
+	}
+	state, err := loadState(b.statePath, b.seasonID)
+	if err != nil {
+		return err
+	}
+	review, err := loadReview(b.reviewPath)
+	if err != nil {
+		return err
+	}
+
+	for _, ar := range archive {
+		if _, done := state.Done[ar.Round]; done {
+			slog.InfoContext(ctx, "round already imported", slog.Int("round", ar.Round))
+			continue
+		}
+		roundID, ok := roundIDs[ar.Round]
+		if !ok {
+			return fmt.Errorf("%s: season %d has no round %d", ar.Path, b.seasonID, ar.Round)
+		}
+		imported, err := b.runRound(ctx, ar, roundID, &review)
+		if err != nil {
+			return fmt.Errorf("round %d: %w", ar.Round, err)
+		}
+		if err := review.save(b.reviewPath); err != nil {
+			return err
+		}
+		if !imported {
+			continue
+		}
+		state.Done[ar.Round] = b.clock.Now().UTC()
+		if err := state.save(b.statePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runRound parses one round's thread and, when every low-confidence match
+// has been reviewed and every team matched to a club, imports the round's
+// teams in one transaction. It reports whether the round was imported.
+func (b *backfiller) runRound(ctx context.Context, ar archiveRound, roundID int, review *reviewFile) (bool, error) {
+	thread, err := os.ReadFile(ar.Path)
+	if err != nil {
+		return false, err
+	}
+	parsed, err := b.dataOps.ParseThread(ctx, application.ParseThreadParams{RoundID: roundID, Thread: string(thread), DryRun: b.dryRun})
+	if err != nil {
+		return false, err
+	}
+
+	complete := true
+	var teams []application.ImportRoundTeamsParams
+	for _, t := range parsed.Teams {
+		if t.ClubMatchID == 0 {
+			slog.WarnContext(ctx, "team post not matched to a club; register the club's post format",
+				slog.Int("round", ar.Round), slog.String("author", t.Author), slog.String("format", t.Format))
+			complete = false
+			continue
+		}
+		club, err := b.queries.GetClubForClubSeason(ctx, t.Result.ClubSeasonID)
+		if err != nil {
+			return false, fmt.Errorf("find club for club season %d: %w", t.Result.ClubSeasonID, err)
+		}
+
+		var players []application.ResolvedPlayer
+		for _, rp := range t.Result.ResolvedPlayers {
+			if rp.Confident {
+				players = append(players, rp)
+				continue
+			}
+			key := reviewKey{Round: ar.Round, Club: club.Name, Name: rp.Parsed.Name}
+			row, reviewed := review.rows[key]
+			if !reviewed {
+				row = newReviewRow(key, rp)
+				review.add(row)
+			}
+			switch {
+			case row.PlayerSeasonID == reviewSkip:
+			case row.PlayerSeasonID != "":
+				psID, err := strconv.Atoi(row.PlayerSeasonID)
+				if err != nil {
+					return false, fmt.Errorf("review of %q (%s): player_season_id %q is not a number or %q", key.Name, key.Club, row.PlayerSeasonID, reviewSkip)
+				}
+				rp.PlayerSeasonID = psID
+				players = append(players, rp)
+			default:
+				complete = false
+			}
+		}
+		teams = append(teams, application.ImportRoundTeamsParams{
+			ClubMatchID:     t.ClubMatchID,
+			SubmissionID:    t.Result.SubmissionID,
+			ResolvedPlayers: players,
+		})
+	}
+	if len(parsed.MissingClubMatchIDs) > 0 {
+		slog.WarnContext(ctx, "no team post found for some club matches",
+			slog.Int("round", ar.Round), slog.Any("club_match_ids", parsed.MissingClubMatchIDs))
+	}
+
+	if !complete {
+		slog.InfoContext(ctx, "round needs review", slog.Int("round", ar.Round), slog.String("review", b.reviewPath))
+		return false, nil
+	}
+	if b.dryRun {
+		slog.InfoContext(ctx, "round ready to import", slog.Int("round", ar.Round), slog.Int("teams", len(teams)))
+		return false, nil
+	}
+	if _, err := b.dataOps.ImportThreadTeams(ctx, teams); err != nil {
+		return false, err
+	}
+	slog.InfoContext(ctx, "round imported", slog.Int("round", ar.Round), slog.Int("teams", len(teams)))
+	return true, nil
+}
+
+// roundIDs maps the season's round numbers ("Round 3" → 3) to round IDs.
+func (b *backfiller) roundIDs(ctx context.Context) (map[int]int, error) {
+	rounds, err := b.queries.GetRounds(ctx, b.seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("load rounds: %w", err)
+	}
+	out := make(map[int]int, len(rounds))
+	for _, r := range rounds {
+		m := roundNumberRE.FindStringSubmatch(r.Name)
+		if m == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[1])
+		out[n] = r.ID
+	}
+	return out, nil
+}
+
+var (
+	archiveFileRE = regexp.MustCompile(`^(\d{4})_(\d+)\.txt$`)
+	roundNumberRE = regexp.MustCompile(`(\d+)\s*$`)
+)
+
+// archiveRound is one archived round thread file.
+type archiveRound struct {
+	Round int
+	Path  string
+}
+
+// findArchiveRounds lists the YYYY_R.txt files in dir for year, in round order.
+func findArchiveRounds(dir string, year int) ([]archiveRound, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var out []archiveRound
+	for _, e := range entries {
+		m := archiveFileRE.FindStringSubmatch(e.Name())
+		if e.IsDir() || m == nil || m[1] != strconv.Itoa(year) {
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		out = append(out, archiveRound{Round: n, Path: filepath.Join(dir, e.Name())})
+	}
+	slices.SortFunc(out, func(a, b archiveRound) int { return a.Round - b.Round })
+	return out, nil
+}
+
+// --- state file ---
+
+// backfillState records the rounds of a season already imported.
+type backfillState struct {
+	SeasonID int               `json:"season_id"`
+	Done     map[int]time.Time `json:"done"` // round number → when it was imported
+}
+
+func loadState(path string, seasonID int) (backfillState, error) {
+	state := backfillState{SeasonID: seasonID, Done: make(map[int]time.Time)}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return backfillState{}, err
+	}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return backfillState{}, fmt.Errorf("read %s: %w", path, err)
+	}
+	if state.SeasonID != seasonID {
+		return backfillState{}, fmt.Errorf("%s records season %d, not %d", path, state.SeasonID, seasonID)
+	}
+	if state.Done == nil {
+		state.Done = make(map[int]time.Time)
+	}
+	return state, nil
+}
+
+// save writes the state atomically, so an interrupted run can't lose it.
+func (s backfillState) save(path string) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// --- review CSV ---
+
+// reviewSkip in the player_season_id column leaves the player out of the team.
+const reviewSkip = "skip"
+
+var reviewHeader = []string{"round", "club", "parsed_name", "club_hint", "position", "suggested_name", "suggested_player_season_id", "confidence", "player_season_id"}
+
+type reviewKey struct {
+	Round int
+	Club  string
+	Name  string
+}
+
+// reviewRow is a low-confidence match. PlayerSeasonID is the reviewer's
+// decision: a player season ID, reviewSkip, or empty while undecided.
+type reviewRow struct {
+	reviewKey
+	ClubHint                string
+	Position                string
+	SuggestedName           string
+	SuggestedPlayerSeasonID string
+	Confidence              string
+	PlayerSeasonID          string
+}
+
+func newReviewRow(key reviewKey, rp application.ResolvedPlayer) reviewRow {
+	row := reviewRow{
+		reviewKey:  key,
+		ClubHint:   rp.Parsed.ClubHint,
+		Position:   rp.Parsed.Position,
+		Confidence: strconv.FormatFloat(rp.BestMatch.Confidence, 'f', 2, 64),
+	}
+	if rp.PlayerSeasonID != 0 {
+		row.SuggestedName = rp.BestMatch.Candidate.Name
+		row.SuggestedPlayerSeasonID = strconv.Itoa(rp.PlayerSeasonID)
+	}
+	return row
+}
+
+// reviewFile is the review CSV, kept in the order rows were first written.
+type reviewFile struct {
+	rows  map[reviewKey]reviewRow
+	order []reviewKey
+}
+
+func (r *reviewFile) add(row reviewRow) {
+	if _, ok := r.rows[row.reviewKey]; !ok {
+		r.order = append(r.order, row.reviewKey)
+	}
+	r.rows[row.reviewKey] = row
+}
+
+func loadReview(path string) (reviewFile, error) {
+	review := reviewFile{rows: make(map[reviewKey]reviewRow)}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return review, nil
+	}
+	if err != nil {
+		return reviewFile{}, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return reviewFile{}, fmt.Errorf("read %s: %w", path, err)
+	}
+	if len(records) == 0 {
+		return review, nil
+	}
+	if !slices.Equal(records[0], reviewHeader) {
+		return reviewFile{}, fmt.Errorf("%s: unexpected header %v", path, records[0])
+	}
+	for i, rec := range records[1:] {
+		round, err := strconv.Atoi(rec[0])
+		if err != nil {
+			return reviewFile{}, fmt.Errorf("%s line %d: round %q", path, i+2, rec[0])
+		}
+		review.add(reviewRow{
+			reviewKey:               reviewKey{Round: round, Club: rec[1], Name: rec[2]},
+			ClubHint:                rec[3],
+			Position:                rec[4],
+			SuggestedName:           rec[5],
+			SuggestedPlayerSeasonID: rec[6],
+			Confidence:              rec[7],
+			PlayerSeasonID:          rec[8],
+		})
+	}
+	return review, nil
+}
+
+// save writes the review CSV atomically, so an interrupted run can't lose
+// the reviewer's decisions.
+func (r reviewFile) save(path string) error {
+	records := [][]string{reviewHeader}
+	for _, key := range r.order {
+		row := r.rows[key]
+		records = append(records, []string{
+			strconv.Itoa(row.Round), row.Club, row.Name, row.ClubHint, row.Position,
+			row.SuggestedName, row.SuggestedPlayerSeasonID, row.Confidence, row.PlayerSeasonID,
+		})
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(records); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
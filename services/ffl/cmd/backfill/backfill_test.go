@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+func TestFindArchiveRounds(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"2024_10.txt", "2024_2.txt", "2023_1.txt", "2024_2_teams.csv", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+	}
+
+	rounds, err := findArchiveRounds(dir, 2024)
+	require.NoError(t, err)
+	require.Len(t, rounds, 2)
+	assert.Equal(t, 2, rounds[0].Round, "sorted by round number, not name")
+	assert.Equal(t, 10, rounds[1].Round)
+	assert.Equal(t, filepath.Join(dir, "2024_2.txt"), rounds[0].Path)
+}
+
+func TestBackfillState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	state, err := loadState(path, 3)
+	require.NoError(t, err)
+	assert.Empty(t, state.Done, "a missing state file is a fresh start")
+
+	state.Done[1] = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, state.save(path))
+
+	reloaded, err := loadState(path, 3)
+	require.NoError(t, err)
+	assert.Contains(t, reloaded.Done, 1)
+
+	_, err = loadState(path, 4)
+	assert.Error(t, err, "state from another season is refused")
+}
+
+func TestReviewFileRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "review.csv")
+
+	review, err := loadReview(path)
+	require.NoError(t, err)
+	key := reviewKey{Round: 2, Club: "Ruiboys", Name: "Jezza"}
+	review.add(newReviewRow(key, application.ResolvedPlayer{
+		Parsed:         application.ParsedPlayerRow{Name: "Jezza", ClubHint: "Geel", Position: "goals"},
+		PlayerSeasonID: 41,
+		BestMatch: application.PlayerNameMatch{
+			Candidate:  application.PlayerCandidate{Name: "Jeremy Cameron"},
+			Confidence: 0.5,
+		},
+	}))
+	require.NoError(t, review.save(path))
+	assert.NoFileExists(t, path+".tmp", "written to a temp file, then renamed")
+
+	reloaded, err := loadReview(path)
+	require.NoError(t, err)
+	row, ok := reloaded.rows[key]
+	require.True(t, ok)
+	assert.Equal(t, "Jeremy Cameron", row.SuggestedName)
+	assert.Equal(t, "41", row.SuggestedPlayerSeasonID)
+	assert.Equal(t, "0.50", row.Confidence)
+	assert.Empty(t, row.PlayerSeasonID, "undecided until reviewed")
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -17,8 +18,10 @@ import (
 	pg "xffl/services/ffl/internal/infrastructure/postgres"
 	"xffl/services/ffl/internal/infrastructure/postgres/sqlcgen"
 	"xffl/services/ffl/internal/infrastructure/rpc"
+	"xffl/services/ffl/internal/infrastructure/teamsheet"
 	fflevents "xffl/services/ffl/internal/interface/events"
 	gql "xffl/services/ffl/internal/interface/graphql"
+	"xffl/shared/clock"
 	pgevents "xffl/shared/events/pg"
 )
 
@@ -65,6 +68,8 @@ func main() {
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
 	)
 
 	dispatcher := pgevents.New(pool, "xffl_events")
@@ -84,12 +89,14 @@ func main() {
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
 	eventHandlers := fflevents.NewHandlers(commands)
 	dispatcher.Subscribe(contractevents.AflPlayerMatchUpdated, eventHandlers.HandleAflPlayerMatchUpdated)
 	dispatcher.Subscribe(contractevents.AflMatchUpdated, eventHandlers.HandleAflMatchUpdated)
+	dispatcher.Subscribe(contractevents.AflPlayerMerged, eventHandlers.HandleAflPlayerMerged)
 	dispatcher.Subscribe(contractevents.FflClubMatchUpdated, eventHandlers.HandleFflClubMatchUpdated)
 	dispatcher.Subscribe(contractevents.FflClubMatchScoreFinalized, eventHandlers.HandleFflClubMatchScoreFinalized)
 	dispatcher.Subscribe(contractevents.FflMatchScoreFinalized, eventHandlers.HandleFflMatchScoreFinalized)
@@ -100,17 +107,46 @@ func main() {
 		}
 	}()
 
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
 	dataOps := application.NewDataOpsCommands(
 		db,
 		playerLookup,
-		forum.NewLevenshteinResolver(),
-		forum.NewParser(),
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
 		dispatcher,
 		commands,
 	)
 
-	resolver := &gql.Resolver{Queries: queries, Commands: commands, DataOps: dataOps}
+	clk := clockFromEnv(ctx)
+	drafts := application.NewDraftCommands(
+		db,
+		clk,
+		playerLookup,
+		pg.NewClubSeasonRepository(q),
+		pg.NewDraftRepository(q),
+	)
+	go runEvery(ctx, "DRAFT_CLOCK_INTERVAL", 5*time.Second, "draft clock processing", drafts.ProcessDraftClocks)
+
+	waivers := application.NewWaiverCommands(
+		db,
+		clk,
+		dispatcher,
+		playerLookup,
+		pg.NewRoundRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewWaiverRepository(q),
+	)
+	go runEvery(ctx, "WAIVER_INTERVAL", time.Minute, "waiver processing", waivers.ProcessDueWaivers)
+
+	resolver := &gql.Resolver{Queries: queries, Commands: commands, DataOps: dataOps, Drafts: drafts, Waivers: waivers}
 	srv := handler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
+	srv.SetErrorPresenter(gql.ErrorPresenter)
 	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
 		ctx = pg.WithQueryCounter(ctx)
 		ctx = gql.InjectLoaders(ctx, gql.NewLoaders(queries))
@@ -137,3 +173,44 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// clockFromEnv returns a FixedClock if CLOCK_OVERRIDE is set (for e2e tests),
+// otherwise a RealClock.
+func clockFromEnv(ctx context.Context) clock.Clock {
+	if override := os.Getenv("CLOCK_OVERRIDE"); override != "" {
+		t, err := time.Parse(time.RFC3339, override)
+		if err != nil {
+			slog.ErrorContext(ctx, "invalid CLOCK_OVERRIDE", slog.String("value", override), slog.Any("error", err))
+			os.Exit(1)
+		}
+		slog.InfoContext(ctx, "FFL clock overridden", slog.String("time", t.Format(time.RFC3339)))
+		return clock.FixedClock{T: t}
+	}
+	return clock.RealClock{}
+}
+
+// runEvery calls fn on a fixed interval until ctx is done. Used for the draft
+// pick clock (DRAFT_CLOCK_INTERVAL, default 5s) and scheduled waivers
+// (WAIVER_INTERVAL, default 1m); the env var overrides the default.
+func runEvery(ctx context.Context, envVar string, interval time.Duration, name string, fn func(context.Context) error) {
+	if v := os.Getenv(envVar); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			slog.ErrorContext(ctx, "invalid "+envVar, slog.String("value", v), slog.Any("error", err))
+			os.Exit(1)
+		}
+		interval = d
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				slog.ErrorContext(ctx, name+" failed", slog.Any("error", err))
+			}
+		}
+	}
+}
@@ -9,10 +9,15 @@ import (
 
 // WriteRepos provides repository access within a transaction.
 type WriteRepos struct {
+	Seasons       domain.SeasonRepository
 	Players       domain.PlayerRepository
 	PlayerSeasons domain.PlayerSeasonRepository
 	PlayerMatches domain.PlayerMatchRepository
 	ClubMatches   domain.ClubMatchRepository
+	Drafts        domain.DraftRepository
+	Waivers       domain.WaiverRepository
+	PlayerAliases DataopsPlayerAliasRepository
+	Submissions   DataopsSubmissionRepository
 }
 
 // TxManager abstracts transactional execution.
@@ -29,6 +34,7 @@ type Commands struct {
 	clubMatches   domain.ClubMatchRepository
 	clubSeasons   domain.ClubSeasonRepository
 	rounds        domain.RoundRepository
+	seasons       domain.SeasonRepository
 	playerMatches domain.PlayerMatchRepository
 	playerSeasons domain.PlayerSeasonRepository
 }
@@ -41,6 +47,7 @@ func NewCommands(
 	clubMatches domain.ClubMatchRepository,
 	clubSeasons domain.ClubSeasonRepository,
 	rounds domain.RoundRepository,
+	seasons domain.SeasonRepository,
 	playerMatches domain.PlayerMatchRepository,
 	playerSeasons domain.PlayerSeasonRepository,
 ) *Commands {
@@ -52,6 +59,7 @@ func NewCommands(
 		clubMatches:   clubMatches,
 		clubSeasons:   clubSeasons,
 		rounds:        rounds,
+		seasons:       seasons,
 		playerMatches: playerMatches,
 		playerSeasons: playerSeasons,
 	}
@@ -5,13 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"xffl/contracts/events"
 	"xffl/services/ffl/internal/domain"
 	sharedevents "xffl/shared/events"
 )
 
-const confidenceThreshold = 0.85
+// ConfidenceThreshold is the resolver confidence below which a match needs review.
+const ConfidenceThreshold = 0.85
 
 // ResolvedPlayer is a parsed player row that has been matched to an ffl.player_season record.
 type ResolvedPlayer struct {
@@ -24,13 +26,16 @@ type ResolvedPlayer struct {
 // ParseTeamSubmissionParams are the inputs to ParseTeamSubmission.
 type ParseTeamSubmissionParams struct {
 	ClubSeasonID int
-	TeamName     string // FFL team name as written in posts (e.g. "Ruiboys")
-	Post         string // raw pasted forum post text
+	ClubMatchID  int              // the submission is recorded against it
+	Format       SubmissionFormat // how Post is written; empty means a forum post
+	TeamName     string           // optional post format name (e.g. "Ruiboys") for clubs without a registered format
+	Post         string           // raw pasted forum post text, or the team sheet
 }
 
 // ParseTeamSubmissionResult is returned to the caller for review before confirming.
 type ParseTeamSubmissionResult struct {
 	ClubSeasonID    int
+	SubmissionID    int // the recorded submission; 0 if the post wasn't for a known club match
 	ResolvedPlayers []ResolvedPlayer
 	// NeedsReview contains indices into ResolvedPlayers where confidence < threshold
 	NeedsReview []int
@@ -39,6 +44,7 @@ type ParseTeamSubmissionResult struct {
 // ImportRoundTeamsParams are the confirmed inputs to ImportRoundTeams.
 type ImportRoundTeamsParams struct {
 	ClubMatchID     int
+	SubmissionID    int // the submission the team was parsed from; 0 for a team entered without one
 	ResolvedPlayers []ResolvedPlayer
 }
 
@@ -55,16 +61,26 @@ type DataOpsCommands struct {
 	playerLookup   PlayerLookup
 	playerResolver PlayerResolver
 	teamParser     TeamParser
+	sheetParsers   map[SubmissionFormat]TeamParser
+	postFormats    DataopsPostFormatRepository
+	aliases        DataopsPlayerAliasRepository
+	submissions    DataopsSubmissionRepository
+	clubs          domain.ClubRepository
 	dispatcher     sharedevents.Dispatcher
 	commands       *Commands
 }
 
-func NewDataOpsCommands(tx TxManager, lookup PlayerLookup, resolver PlayerResolver, parser TeamParser, dispatcher sharedevents.Dispatcher, commands *Commands) *DataOpsCommands {
+func NewDataOpsCommands(tx TxManager, lookup PlayerLookup, resolver PlayerResolver, parser TeamParser, sheetParsers map[SubmissionFormat]TeamParser, postFormats DataopsPostFormatRepository, aliases DataopsPlayerAliasRepository, submissions DataopsSubmissionRepository, clubs domain.ClubRepository, dispatcher sharedevents.Dispatcher, commands *Commands) *DataOpsCommands {
 	return &DataOpsCommands{
 		tx:             tx,
 		playerLookup:   lookup,
 		playerResolver: resolver,
 		teamParser:     parser,
+		sheetParsers:   sheetParsers,
+		postFormats:    postFormats,
+		aliases:        aliases,
+		submissions:    submissions,
+		clubs:          clubs,
 		dispatcher:     dispatcher,
 		commands:       commands,
 	}
@@ -97,14 +113,41 @@ func (c *DataOpsCommands) LookupCandidates(ctx context.Context, aflIDToPlayerSea
 	return candidates, nil
 }
 
-// ParseTeamSubmission parses a raw forum post and resolves each player against the squad.
-// No DB writes occur. The caller reviews the result and calls ImportRoundTeams to confirm.
+// ParseTeamSubmission parses a raw forum post, or a CSV or JSON team sheet, and resolves
+// each player against the squad. The post and what was read from it are recorded as a submission for audit, but the
+// team isn't saved: the caller reviews the result and calls ImportRoundTeams to confirm.
 func (c *DataOpsCommands) ParseTeamSubmission(ctx context.Context, params ParseTeamSubmissionParams, playerSeasons []domain.PlayerSeason, candidates []PlayerCandidate) (ParseTeamSubmissionResult, error) {
-	rows, err := c.teamParser.Parse(ctx, params.TeamName, params.Post)
+	rows, formatName, err := c.parseSubmission(ctx, params)
 	if err != nil {
-		return ParseTeamSubmissionResult{}, fmt.Errorf("parse forum post: %w", err)
+		return ParseTeamSubmissionResult{}, err
 	}
 
+	resolved, needsReview, err := c.resolveRows(ctx, rows, candidates)
+	if err != nil {
+		return ParseTeamSubmissionResult{}, err
+	}
+	result := ParseTeamSubmissionResult{
+		ClubSeasonID:    params.ClubSeasonID,
+		ResolvedPlayers: resolved,
+		NeedsReview:     needsReview,
+	}
+	if params.ClubMatchID != 0 {
+		result.SubmissionID, err = c.recordSubmission(ctx, SubmissionRecord{
+			ClubMatchID: params.ClubMatchID,
+			Format:      formatName,
+			Post:        params.Post,
+			Parsed:      resolved,
+		})
+		if err != nil {
+			return ParseTeamSubmissionResult{}, err
+		}
+	}
+	return result, nil
+}
+
+// resolveRows matches each parsed row against the candidate pool, returning
+// the resolved players and the indices of those needing review.
+func (c *DataOpsCommands) resolveRows(ctx context.Context, rows []ParsedPlayerRow, candidates []PlayerCandidate) ([]ResolvedPlayer, []int, error) {
 	// Build a lookup from AFLPlayerID → candidate (includes PlayerSeasonID from the caller).
 	candidateByAFLID := make(map[int]PlayerCandidate, len(candidates))
 	for _, cand := range candidates {
@@ -117,13 +160,18 @@ func (c *DataOpsCommands) ParseTeamSubmission(ctx context.Context, params ParseT
 	for _, row := range rows {
 		nameMatches, err := c.playerResolver.Resolve(ctx, row.Name, row.ClubHint, candidates)
 		if err != nil {
-			return ParseTeamSubmissionResult{}, fmt.Errorf("resolve %q: %w", row.Name, err)
+			return nil, nil, fmt.Errorf("resolve %q: %w", row.Name, err)
+		}
+
+		// A name the parser found in the alias dictionary names its player outright.
+		if cand, ok := candidateByAFLID[row.AFLPlayerID]; ok && row.AFLPlayerID != 0 {
+			nameMatches = append([]PlayerNameMatch{{Candidate: cand, Confidence: 1.0}}, nameMatches...)
 		}
 
 		rp := ResolvedPlayer{Parsed: row}
 		if len(nameMatches) > 0 {
 			rp.BestMatch = nameMatches[0]
-			rp.Confident = nameMatches[0].Confidence >= confidenceThreshold
+			rp.Confident = nameMatches[0].Confidence >= ConfidenceThreshold
 			rp.PlayerSeasonID = nameMatches[0].Candidate.PlayerID // PlayerID is the player_season_id in squad context
 		}
 		if !rp.Confident {
@@ -131,19 +179,89 @@ func (c *DataOpsCommands) ParseTeamSubmission(ctx context.Context, params ParseT
 		}
 		resolved = append(resolved, rp)
 	}
+	return resolved, needsReview, nil
+}
 
-	return ParseTeamSubmissionResult{
-		ClubSeasonID:    params.ClubSeasonID,
-		ResolvedPlayers: resolved,
-		NeedsReview:     needsReview,
-	}, nil
+// postFormatFor picks the format to read a club's post with: the club's
+// registered format if it has one, else the registered or built-in format
+// named teamName, else whichever format the post looks like.
+func (c *DataOpsCommands) postFormatFor(ctx context.Context, clubSeasonID int, teamName, post string) (PostFormat, error) {
+	format, found, err := c.postFormats.FindByClubSeasonID(ctx, clubSeasonID)
+	if err != nil {
+		return PostFormat{}, fmt.Errorf("load post format: %w", err)
+	}
+	if found {
+		return format, nil
+	}
+
+	registered, err := c.postFormats.FindAll(ctx)
+	if err != nil {
+		return PostFormat{}, fmt.Errorf("load post formats: %w", err)
+	}
+	formats := append(registered, c.teamParser.Formats()...)
+	if teamName != "" {
+		for _, f := range formats {
+			if strings.EqualFold(f.Name, teamName) {
+				return f, nil
+			}
+		}
+	}
+	if f, ok := DetectPostFormat(formats, post); ok {
+		return f, nil
+	}
+	return PostFormat{}, ErrUnknownPostFormat
+}
+
+// RegisterPostFormat stores a club's post format, replacing any earlier one.
+// Posts for the club are read with it from then on.
+func (c *DataOpsCommands) RegisterPostFormat(ctx context.Context, format PostFormat) (PostFormat, error) {
+	if err := format.Validate(); err != nil {
+		return PostFormat{}, err
+	}
+	return c.postFormats.Store(ctx, format)
+}
+
+// PostFormats returns every club's registered post format.
+func (c *DataOpsCommands) PostFormats(ctx context.Context) ([]PostFormat, error) {
+	return c.postFormats.FindAll(ctx)
+}
+
+// TestPostFormat parses a sample post with an unsaved format, so a format can
+// be checked before it is registered. No DB writes.
+func (c *DataOpsCommands) TestPostFormat(ctx context.Context, format PostFormat, post string) ([]ParsedPlayerRow, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	return c.teamParser.Parse(ctx, format, post)
 }
 
 // ImportRoundTeams converts resolved players to team entries and delegates to teamSubmitter.SetTeam,
 // which handles validation, diff-based persistence, scoring, and event publishing.
-func (c *DataOpsCommands) ImportRoundTeams(ctx context.Context, params ImportRoundTeamsParams) ([]domain.PlayerMatch, error) {
-	entries := make([]SetTeamEntry, 0, len(params.ResolvedPlayers))
-	for _, rp := range params.ResolvedPlayers {
+// Names of low-confidence matches the caller confirmed are learned as aliases, and the
+// confirmed team is recorded against the submission it was parsed from, in the same
+// transaction as the team.
+func (c *DataOpsCommands) ImportRoundTeams(ctx context.Context, params ImportRoundTeamsParams) (TeamSubmission, error) {
+	if err := c.checkSubmission(ctx, params); err != nil {
+		return TeamSubmission{}, err
+	}
+	ts, err := c.commands.setTeam(ctx, SetTeamParams{
+		ClubMatchID: params.ClubMatchID,
+		Entries:     teamEntries(params.ResolvedPlayers),
+	}, func(repos WriteRepos) error {
+		return confirmSubmission(ctx, repos.Submissions, params)
+	})
+	if err != nil {
+		return TeamSubmission{}, err
+	}
+	c.learnAliases(ctx, params.ResolvedPlayers)
+	return ts, nil
+}
+
+// teamEntries converts confirmed players to team entries, skipping any left
+// unmatched.
+func teamEntries(resolved []ResolvedPlayer) []SetTeamEntry {
+	entries := make([]SetTeamEntry, 0, len(resolved))
+	for _, rp := range resolved {
 		if rp.PlayerSeasonID == 0 {
 			continue
 		}
@@ -160,10 +278,7 @@ func (c *DataOpsCommands) ImportRoundTeams(ctx context.Context, params ImportRou
 		}
 		entries = append(entries, e)
 	}
-	return c.commands.SetTeam(ctx, SetTeamParams{
-		ClubMatchID: params.ClubMatchID,
-		Entries:     entries,
-	})
+	return entries
 }
 
 // MarkTeamFinal sets the club_match data_status to 'final' and publishes FFL.ClubMatchUpdated(final).
@@ -0,0 +1,352 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"xffl/services/ffl/internal/domain"
+	"xffl/shared/clock"
+)
+
+// CreateDraftParams are the inputs to CreateDraft. Exactly one of Order or
+// ReverseLadderSeasonID should be set: Order is an explicit first-round list of
+// club season IDs; ReverseLadderSeasonID derives it from that season's ladder.
+type CreateDraftParams struct {
+	SeasonID              int
+	Style                 domain.DraftStyle
+	Rounds                int
+	PickDuration          time.Duration
+	Order                 []int
+	ReverseLadderSeasonID *int
+}
+
+// DraftCommands runs the pre-season FFL draft. Pick clocks are measured with
+// the injected clock so tests and e2e runs can control time.
+type DraftCommands struct {
+	tx           TxManager
+	clock        clock.Clock
+	playerLookup PlayerLookup
+	clubSeasons  domain.ClubSeasonRepository
+	drafts       domain.DraftRepository
+}
+
+func NewDraftCommands(tx TxManager, clk clock.Clock, lookup PlayerLookup, clubSeasons domain.ClubSeasonRepository, drafts domain.DraftRepository) *DraftCommands {
+	return &DraftCommands{
+		tx:           tx,
+		clock:        clk,
+		playerLookup: lookup,
+		clubSeasons:  clubSeasons,
+		drafts:       drafts,
+	}
+}
+
+// CreateDraft lays out the full draft board for a season. The draft is created
+// pending; StartDraft opens it and starts the first pick's clock.
+func (c *DraftCommands) CreateDraft(ctx context.Context, p CreateDraftParams) (domain.Draft, error) {
+	if p.PickDuration <= 0 {
+		return domain.Draft{}, fmt.Errorf("draft: pick duration must be positive")
+	}
+	current, err := c.clubSeasons.FindBySeasonID(ctx, p.SeasonID)
+	if err != nil {
+		return domain.Draft{}, fmt.Errorf("find club seasons: %w", err)
+	}
+
+	order := p.Order
+	if p.ReverseLadderSeasonID != nil {
+		if len(order) > 0 {
+			return domain.Draft{}, fmt.Errorf("draft: specify either an explicit order or a reverse-ladder season, not both")
+		}
+		prior, err := c.clubSeasons.FindBySeasonID(ctx, *p.ReverseLadderSeasonID)
+		if err != nil {
+			return domain.Draft{}, fmt.Errorf("find prior season ladder: %w", err)
+		}
+		order = domain.ReverseLadderOrder(prior, current)
+	}
+
+	inSeason := make(map[int]bool, len(current))
+	for _, cs := range current {
+		inSeason[cs.ID] = true
+	}
+	for _, id := range order {
+		if !inSeason[id] {
+			return domain.Draft{}, fmt.Errorf("draft: club season %d is not in season %d", id, p.SeasonID)
+		}
+	}
+
+	picks, err := domain.BuildDraftPicks(p.Style, order, p.Rounds)
+	if err != nil {
+		return domain.Draft{}, err
+	}
+
+	var result domain.Draft
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		d, err := repos.Drafts.Create(ctx, domain.Draft{
+			SeasonID:     p.SeasonID,
+			Style:        p.Style,
+			Rounds:       p.Rounds,
+			PickDuration: p.PickDuration,
+			Status:       domain.DraftStatusPending,
+			Picks:        picks,
+		})
+		if err != nil {
+			return err
+		}
+		result = d
+		return nil
+	})
+	return result, err
+}
+
+// StartDraft opens a pending draft and starts the clock on the first pick.
+func (c *DraftCommands) StartDraft(ctx context.Context, draftID int) (domain.Draft, error) {
+	var result domain.Draft
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		d, err := repos.Drafts.FindByID(ctx, draftID)
+		if err != nil {
+			return err
+		}
+		if err := d.Start(c.clock.Now()); err != nil {
+			return err
+		}
+		if err := repos.Drafts.UpdateState(ctx, d); err != nil {
+			return err
+		}
+		result = d
+		return nil
+	})
+	return result, err
+}
+
+// SetDraftRankings replaces a club's ranked auto-pick list. The first ID is
+// the club's most preferred player.
+func (c *DraftCommands) SetDraftRankings(ctx context.Context, draftID, clubSeasonID int, aflPlayerSeasonIDs []int) error {
+	seen := make(map[int]bool, len(aflPlayerSeasonIDs))
+	for _, id := range aflPlayerSeasonIDs {
+		if seen[id] {
+			return fmt.Errorf("draft: AFL player season %d is ranked more than once", id)
+		}
+		seen[id] = true
+	}
+	return c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		if _, err := repos.Drafts.FindByID(ctx, draftID); err != nil {
+			return err
+		}
+		return repos.Drafts.ReplaceRankings(ctx, draftID, clubSeasonID, aflPlayerSeasonIDs)
+	})
+}
+
+// MakeDraftPick records clubSeasonID's selection of an AFL player and adds the
+// player to the club's squad, subject to the season's squad rules. Any picks
+// whose clock has already expired are auto-picked first, so a late pick is
+// rejected if the club is no longer on the clock.
+func (c *DraftCommands) MakeDraftPick(ctx context.Context, draftID, clubSeasonID, aflPlayerSeasonID int) (domain.DraftPick, error) {
+	var result domain.DraftPick
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		d, err := repos.Drafts.FindByID(ctx, draftID)
+		if err != nil {
+			return err
+		}
+		now := c.clock.Now()
+		if err := c.autoPickExpired(ctx, repos, &d, now); err != nil {
+			return err
+		}
+		if err := d.ValidatePick(clubSeasonID, aflPlayerSeasonID); err != nil {
+			return err
+		}
+		if err := c.checkNotInOtherSquad(ctx, repos, d, clubSeasonID, aflPlayerSeasonID); err != nil {
+			return err
+		}
+		pick, err := c.completePick(ctx, repos, &d, domain.DraftPickStatusPicked, &aflPlayerSeasonID, now)
+		if err != nil {
+			return err
+		}
+		result = pick
+		return nil
+	})
+	return result, err
+}
+
+// ProcessDraftClocks auto-picks every expired pick across all in-progress
+// drafts. It is safe to call repeatedly; drafts with a running clock are left
+// untouched.
+func (c *DraftCommands) ProcessDraftClocks(ctx context.Context) error {
+	drafts, err := c.drafts.FindInProgress(ctx)
+	if err != nil {
+		return fmt.Errorf("find in-progress drafts: %w", err)
+	}
+	now := c.clock.Now()
+	for _, d := range drafts {
+		if !d.Expired(now) {
+			continue
+		}
+		err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+			// Reload inside the transaction to start from the latest picks. A
+			// manual pick that commits first still makes UpdatePick fail with
+			// ErrDraftPickMade, rolling this tick back rather than filling the
+			// slot twice.
+			fresh, err := repos.Drafts.FindByID(ctx, d.ID)
+			if err != nil {
+				return err
+			}
+			return c.autoPickExpired(ctx, repos, &fresh, now)
+		})
+		if errors.Is(err, domain.ErrDraftPickMade) {
+			// A manual pick beat this tick; the next one starts from it.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("draft %d: %w", d.ID, err)
+		}
+	}
+	return nil
+}
+
+// autoPickExpired replays every pick whose deadline is at or before now. Each
+// auto-pick is timestamped at its own deadline, which starts the next clock.
+func (c *DraftCommands) autoPickExpired(ctx context.Context, repos WriteRepos, d *domain.Draft, now time.Time) error {
+	for d.Expired(now) {
+		deadline, _ := d.Deadline()
+		clubSeasonID := d.Picks[d.CurrentPick()].ClubSeasonID
+		aflPlayerSeasonID, err := c.chooseAutoPick(ctx, repos, *d, clubSeasonID)
+		if err != nil && !errors.Is(err, domain.ErrDraftNoRankedPlayers) && !errors.Is(err, domain.ErrSquadFull) {
+			return err
+		}
+		status := domain.DraftPickStatusAutoPicked
+		var pickID *int
+		if err == nil {
+			pickID = &aflPlayerSeasonID
+		} else {
+			slog.InfoContext(ctx, "draft pick forfeited",
+				slog.Int("draft_id", d.ID), slog.Int("club_season_id", clubSeasonID), slog.Any("reason", err))
+			status = domain.DraftPickStatusForfeited
+		}
+		if _, err := c.completePick(ctx, repos, d, status, pickID, deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chooseAutoPick returns the club's highest-ranked player still available. It
+// fails with domain.ErrSquadFull if the club's squad has no room for anyone.
+func (c *DraftCommands) chooseAutoPick(ctx context.Context, repos WriteRepos, d domain.Draft, clubSeasonID int) (int, error) {
+	rankings, err := repos.Drafts.FindRankings(ctx, d.ID, clubSeasonID)
+	if err != nil {
+		return 0, fmt.Errorf("find rankings: %w", err)
+	}
+	drafted := d.DraftedAFLPlayerSeasonIDs()
+	for _, r := range rankings {
+		if drafted[r.AFLPlayerSeasonID] {
+			continue
+		}
+		err := c.checkNotInOtherSquad(ctx, repos, d, clubSeasonID, r.AFLPlayerSeasonID)
+		if errors.Is(err, domain.ErrDraftPlayerInSquad) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		err = c.checkSquadRules(ctx, repos, d, clubSeasonID, r.AFLPlayerSeasonID)
+		if errors.Is(err, domain.ErrPlayerInAnotherSquad) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return r.AFLPlayerSeasonID, nil
+	}
+	return 0, domain.ErrDraftNoRankedPlayers
+}
+
+// checkNotInOtherSquad rejects an AFL player who is already active in the squad
+// of another club taking part in the draft.
+func (c *DraftCommands) checkNotInOtherSquad(ctx context.Context, repos WriteRepos, d domain.Draft, clubSeasonID, aflPlayerSeasonID int) error {
+	existing, err := repos.PlayerSeasons.FindByAFLPlayerSeasonID(ctx, aflPlayerSeasonID)
+	if err != nil {
+		return fmt.Errorf("find player seasons: %w", err)
+	}
+	inDraft := make(map[int]bool)
+	for _, p := range d.Picks {
+		inDraft[p.ClubSeasonID] = true
+	}
+	for _, ps := range existing {
+		if ps.ToRoundID == nil && ps.ClubSeasonID != clubSeasonID && inDraft[ps.ClubSeasonID] {
+			return domain.ErrDraftPlayerInSquad
+		}
+	}
+	return nil
+}
+
+// checkSquadRules applies the squad rules AddPlayerToSeason enforces: the
+// club's squad must have room under the season's maximum size, and the player
+// must not be in another club's squad in the season. Drafted players join
+// from the start of the season, so round order doesn't come into it.
+func (c *DraftCommands) checkSquadRules(ctx context.Context, repos WriteRepos, d domain.Draft, clubSeasonID, aflPlayerSeasonID int) error {
+	season, err := repos.Seasons.FindByID(ctx, d.SeasonID)
+	if err != nil {
+		return fmt.Errorf("find season: %w", err)
+	}
+	squad, err := repos.PlayerSeasons.FindByClubSeasonID(ctx, clubSeasonID)
+	if err != nil {
+		return fmt.Errorf("find squad: %w", err)
+	}
+	if err := domain.CheckSquadAdd(season, nil, squad, aflPlayerSeasonID, nil); err != nil {
+		return err
+	}
+	seasonClubs, err := c.clubSeasons.FindBySeasonID(ctx, d.SeasonID)
+	if err != nil {
+		return fmt.Errorf("find club seasons: %w", err)
+	}
+	inSeason := make(map[int]bool, len(seasonClubs))
+	for _, cs := range seasonClubs {
+		inSeason[cs.ID] = true
+	}
+	existing, err := repos.PlayerSeasons.FindByAFLPlayerSeasonID(ctx, aflPlayerSeasonID)
+	if err != nil {
+		return fmt.Errorf("find player seasons: %w", err)
+	}
+	return domain.CheckPlayerExclusive(nil, existing, inSeason, clubSeasonID, nil)
+}
+
+// completePick fills the current slot, creating the squad PlayerSeason for a
+// selected player, and persists the pick and draft state.
+func (c *DraftCommands) completePick(ctx context.Context, repos WriteRepos, d *domain.Draft, status domain.DraftPickStatus, aflPlayerSeasonID *int, at time.Time) (domain.DraftPick, error) {
+	var playerSeasonID *int
+	if aflPlayerSeasonID != nil {
+		clubSeasonID := d.Picks[d.CurrentPick()].ClubSeasonID
+		aflPlayerID, err := c.playerLookup.LookupPlayerSeason(ctx, *aflPlayerSeasonID)
+		if err != nil {
+			return domain.DraftPick{}, fmt.Errorf("lookup AFL player season: %w", err)
+		}
+		if err := c.checkSquadRules(ctx, repos, *d, clubSeasonID, *aflPlayerSeasonID); err != nil {
+			return domain.DraftPick{}, err
+		}
+		player, err := repos.Players.FindByAFLPlayerID(ctx, aflPlayerID)
+		if errors.Is(err, domain.ErrNotFound) {
+			player, err = repos.Players.Create(ctx, aflPlayerID)
+		}
+		if err != nil {
+			return domain.DraftPick{}, err
+		}
+		ps, err := repos.PlayerSeasons.Create(ctx, player.ID, clubSeasonID, nil, *aflPlayerSeasonID, nil)
+		if err != nil {
+			return domain.DraftPick{}, err
+		}
+		playerSeasonID = &ps.ID
+	}
+
+	pick, err := d.RecordPick(status, aflPlayerSeasonID, playerSeasonID, at)
+	if err != nil {
+		return domain.DraftPick{}, err
+	}
+	if err := repos.Drafts.UpdatePick(ctx, pick); err != nil {
+		return domain.DraftPick{}, err
+	}
+	if err := repos.Drafts.UpdateState(ctx, *d); err != nil {
+		return domain.DraftPick{}, err
+	}
+	return pick, nil
+}
@@ -0,0 +1,215 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"xffl/services/ffl/internal/domain"
+	"xffl/shared/namematch"
+)
+
+var roundNumberRE = regexp.MustCompile(`(\d+)\s*$`)
+
+// ExportTeam writes a club match's team as a forum post in the club's post
+// format, so a manager can post it rather than type it. Scores are left out.
+func (c *DataOpsCommands) ExportTeam(ctx context.Context, clubMatchID int) (string, error) {
+	cm, err := c.commands.clubMatches.FindByID(ctx, clubMatchID)
+	if err != nil {
+		return "", fmt.Errorf("find club match %d: %w", clubMatchID, err)
+	}
+	match, err := c.commands.matches.FindByID(ctx, cm.MatchID)
+	if err != nil {
+		return "", fmt.Errorf("find match %d: %w", cm.MatchID, err)
+	}
+	round, err := c.commands.rounds.FindByID(ctx, match.RoundID)
+	if err != nil {
+		return "", fmt.Errorf("find round %d: %w", match.RoundID, err)
+	}
+	club, err := c.clubForClubSeason(ctx, cm.ClubSeasonID)
+	if err != nil {
+		return "", err
+	}
+	return c.writeTeam(ctx, cm, club, roundNumber(round.Name), false)
+}
+
+// ExportRoundResults writes a round's results as one forum post: the round,
+// a line per match with both scores, then every submitted team in its
+// club's post format with scores, position subtotals and subs.
+func (c *DataOpsCommands) ExportRoundResults(ctx context.Context, roundID int) (string, error) {
+	round, err := c.commands.rounds.FindByID(ctx, roundID)
+	if err != nil {
+		return "", fmt.Errorf("find round %d: %w", roundID, err)
+	}
+	matches, err := c.commands.matches.FindByRoundID(ctx, roundID)
+	if err != nil {
+		return "", fmt.Errorf("load matches for round %d: %w", roundID, err)
+	}
+
+	summary := []string{round.Name}
+	var teams []string
+	for _, m := range matches {
+		var sides [2]string
+		for i, cmID := range []int{m.Home.ID, m.Away.ID} {
+			cm, err := c.commands.clubMatches.FindByID(ctx, cmID)
+			if err != nil {
+				return "", fmt.Errorf("find club match %d: %w", cmID, err)
+			}
+			club, err := c.clubForClubSeason(ctx, cm.ClubSeasonID)
+			if err != nil {
+				return "", err
+			}
+			team, err := c.writeTeam(ctx, cm, club, roundNumber(round.Name), true)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", club.Name, err)
+			}
+			if team != "" {
+				teams = append(teams, team)
+			}
+			sides[i] = fmt.Sprintf("%s %d", club.Name, cm.StoredScore)
+		}
+		summary = append(summary, sides[0]+" v "+sides[1])
+	}
+	return strings.Join(append([]string{strings.Join(summary, "\n")}, teams...), "\n\n"), nil
+}
+
+// writeTeam writes cm's team in the club's post format; an empty team writes
+// nothing.
+func (c *DataOpsCommands) writeTeam(ctx context.Context, cm domain.ClubMatch, club domain.Club, round int, scored bool) (string, error) {
+	pms, err := c.commands.playerMatches.FindByClubMatchID(ctx, cm.ID)
+	if err != nil {
+		return "", fmt.Errorf("load team for club match %d: %w", cm.ID, err)
+	}
+	if len(pms) == 0 {
+		return "", nil
+	}
+	cm.PlayerMatches = pms
+
+	format, err := c.writeFormat(ctx, cm.ClubSeasonID, club)
+	if err != nil {
+		return "", err
+	}
+	ids := make([]int, len(pms))
+	for i, pm := range pms {
+		ids[i] = pm.PlayerSeasonID
+	}
+	names, err := c.playerNames(ctx, ids)
+	if err != nil {
+		return "", err
+	}
+
+	team := PostTeam{Round: round, Scored: scored}
+	if scored {
+		team.Total = cm.StoredScore
+	}
+	for _, s := range cm.Slots() {
+		p := postPlayer(s.Starter, names)
+		p.Position = string(*s.Starter.Position)
+		if scored && s.Replaced {
+			p.Sub = surname(names[s.Scorer.PlayerSeasonID].Name)
+			p.SubScore = s.Scorer.Score
+			p.DNP = (s.Starter.AFLStatus != nil && *s.Starter.AFLStatus == domain.AFLStatusDNP) ||
+				(s.Starter.Status != nil && *s.Starter.Status == domain.PlayerMatchStatusSubbed)
+		}
+		team.Players = append(team.Players, p)
+	}
+	for _, pm := range pms {
+		if pm.BackupPositions == nil {
+			continue
+		}
+		p := postPlayer(pm, names)
+		p.Position = "bench"
+		p.BackupPositions = *pm.BackupPositions
+		if pm.InterchangePosition != nil {
+			p.InterchangePosition = *pm.InterchangePosition
+		}
+		team.Players = append(team.Players, p)
+	}
+
+	post, err := c.teamParser.WriteTeam(format, team)
+	if err != nil {
+		return "", fmt.Errorf("write team for club match %d: %w", cm.ID, err)
+	}
+	return post, nil
+}
+
+// writeFormat picks the format to write a club's posts in: its registered
+// format, else the built-in format named after the club.
+func (c *DataOpsCommands) writeFormat(ctx context.Context, clubSeasonID int, club domain.Club) (PostFormat, error) {
+	format, found, err := c.postFormats.FindByClubSeasonID(ctx, clubSeasonID)
+	if err != nil {
+		return PostFormat{}, fmt.Errorf("load post format: %w", err)
+	}
+	if found {
+		return format, nil
+	}
+	for _, f := range c.teamParser.Formats() {
+		if clubNameMatches(f.Name, club.Name) {
+			return f, nil
+		}
+	}
+	return PostFormat{}, fmt.Errorf("%w: %s has no post format", ErrCannotWritePost, club.Name)
+}
+
+// playerNames looks up the AFL name and club of each player season, keyed by
+// player season ID. Clubs are written as their usual codes.
+func (c *DataOpsCommands) playerNames(ctx context.Context, ids []int) (map[int]PlayerCandidate, error) {
+	players, err := c.commands.playerSeasons.FindPlayersForPlayerSeasonIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load players: %w", err)
+	}
+	aflIDToPlayerSeasonID := make(map[int]int, len(players))
+	for psID, p := range players {
+		aflIDToPlayerSeasonID[p.AFLPlayerID] = psID
+	}
+	candidates, err := c.LookupCandidates(ctx, aflIDToPlayerSeasonID)
+	if err != nil {
+		return nil, fmt.Errorf("lookup players: %w", err)
+	}
+	clubs := namematch.New(namematch.AFLClubs)
+	out := make(map[int]PlayerCandidate, len(candidates))
+	for _, cand := range candidates {
+		cand.Club = clubs.Code(cand.Club)
+		out[cand.PlayerID] = cand
+	}
+	return out, nil
+}
+
+func (c *DataOpsCommands) clubForClubSeason(ctx context.Context, clubSeasonID int) (domain.Club, error) {
+	cs, err := c.commands.clubSeasons.FindByID(ctx, clubSeasonID)
+	if err != nil {
+		return domain.Club{}, fmt.Errorf("find club season %d: %w", clubSeasonID, err)
+	}
+	club, err := c.clubs.FindByID(ctx, cs.ClubID)
+	if err != nil {
+		return domain.Club{}, fmt.Errorf("find club %d: %w", cs.ClubID, err)
+	}
+	return club, nil
+}
+
+func postPlayer(pm domain.PlayerMatch, names map[int]PlayerCandidate) PostPlayer {
+	n := names[pm.PlayerSeasonID]
+	return PostPlayer{Name: n.Name, Club: n.Club, Score: pm.Score}
+}
+
+// surname is the last word of a player's name, as posts refer to subs.
+func surname(name string) string {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// roundNumber reads the round number from a round's name ("Round 3" → 3),
+// or 0 if it has none.
+func roundNumber(name string) int {
+	m := roundNumberRE.FindStringSubmatch(name)
+	if m == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n
+}
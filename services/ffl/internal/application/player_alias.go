@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidPlayerAlias = errors.New("invalid player alias")
+
+// PlayerAlias maps a name Team Managers use on the forum — a nickname such as
+// "TDK", or a spelling the resolver can't match — to an AFL player. An alias
+// with no AFL player is a strip word: a nickname written inside a player's
+// name ("Jack Journeyman Smith") that the parser drops from it.
+type PlayerAlias struct {
+	ID          int
+	Alias       string // normalised; see NormaliseAlias
+	AFLPlayerID *int   // nil for a strip word
+	Learned     bool   // recorded from a confirmed low-confidence match rather than by an admin
+}
+
+// PlayerAliasLookup is the read side of the alias dictionary, consulted by the
+// forum parser and the name resolver.
+type PlayerAliasLookup interface {
+	FindAll(ctx context.Context) ([]PlayerAlias, error)
+	FindByAlias(ctx context.Context, alias string) (a PlayerAlias, found bool, err error)
+}
+
+// DataopsPlayerAliasRepository stores the alias dictionary, per the ACL pattern
+// (ADR-016). Aliases are unique; storing an existing alias repoints it, except
+// that a learned alias leaves one an admin entered as it is.
+type DataopsPlayerAliasRepository interface {
+	PlayerAliasLookup
+	Store(ctx context.Context, alias PlayerAlias) (PlayerAlias, error)
+	Update(ctx context.Context, alias PlayerAlias) (PlayerAlias, error)
+	Delete(ctx context.Context, id int) error
+	// ReassignAFLPlayer points the aliases of one AFL player at another.
+	ReassignAFLPlayer(ctx context.Context, fromAFLPlayerID, toAFLPlayerID int) error
+}
+
+// NormaliseAlias lowercases s and reduces punctuation and runs of whitespace
+// to single spaces, so "T. De-Koning" and "t de koning" are the same alias.
+func NormaliseAlias(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func (a PlayerAlias) validate() error {
+	if a.Alias == "" {
+		return fmt.Errorf("%w: alias is empty", ErrInvalidPlayerAlias)
+	}
+	if a.AFLPlayerID != nil && *a.AFLPlayerID <= 0 {
+		return fmt.Errorf("%w: invalid AFL player", ErrInvalidPlayerAlias)
+	}
+	return nil
+}
+
+// PlayerAliases returns the whole alias dictionary.
+func (c *DataOpsCommands) PlayerAliases(ctx context.Context) ([]PlayerAlias, error) {
+	return c.aliases.FindAll(ctx)
+}
+
+// CreatePlayerAlias adds an alias, repointing it if it already exists. A nil
+// aflPlayerID adds a strip word.
+func (c *DataOpsCommands) CreatePlayerAlias(ctx context.Context, alias string, aflPlayerID *int) (PlayerAlias, error) {
+	a := PlayerAlias{Alias: NormaliseAlias(alias), AFLPlayerID: aflPlayerID}
+	if err := a.validate(); err != nil {
+		return PlayerAlias{}, err
+	}
+	return c.aliases.Store(ctx, a)
+}
+
+// UpdatePlayerAlias changes an alias's text or player. An edited alias is no
+// longer considered learned.
+func (c *DataOpsCommands) UpdatePlayerAlias(ctx context.Context, id int, alias string, aflPlayerID *int) (PlayerAlias, error) {
+	a := PlayerAlias{ID: id, Alias: NormaliseAlias(alias), AFLPlayerID: aflPlayerID}
+	if err := a.validate(); err != nil {
+		return PlayerAlias{}, err
+	}
+	return c.aliases.Update(ctx, a)
+}
+
+func (c *DataOpsCommands) DeletePlayerAlias(ctx context.Context, id int) error {
+	return c.aliases.Delete(ctx, id)
+}
+
+// learnAliases records the parsed name of every low-confidence match the
+// manager confirmed, so the same name resolves next time. Failures are
+// logged, not returned: the team is already saved.
+func (c *DataOpsCommands) learnAliases(ctx context.Context, resolved []ResolvedPlayer) {
+	for _, rp := range resolved {
+		name := NormaliseAlias(rp.Parsed.Name)
+		if rp.Confident || rp.PlayerSeasonID == 0 || name == "" {
+			continue
+		}
+		err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+			ps, err := repos.PlayerSeasons.FindByID(ctx, rp.PlayerSeasonID)
+			if err != nil {
+				return fmt.Errorf("find player season: %w", err)
+			}
+			p, err := repos.Players.FindByID(ctx, ps.PlayerID)
+			if err != nil {
+				return fmt.Errorf("find player: %w", err)
+			}
+			_, err = repos.PlayerAliases.Store(ctx, PlayerAlias{Alias: name, AFLPlayerID: &p.AFLPlayerID, Learned: true})
+			return err
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "learn player alias failed",
+				slog.String("alias", name), slog.Int("player_season_id", rp.PlayerSeasonID), slog.Any("error", err))
+		}
+	}
+}
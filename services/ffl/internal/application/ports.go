@@ -49,10 +49,49 @@ type PlayerResolver interface {
 }
 
 // TeamParser parses a raw forum post into structured player rows.
-// The caller supplies the FFL team name and round number; the parser
-// identifies player names, positions, and optional scores.
+// The caller supplies the post format; the parser identifies player names,
+// positions, and optional scores.
 type TeamParser interface {
-	Parse(ctx context.Context, teamName, post string) ([]ParsedPlayerRow, error)
+	Parse(ctx context.Context, format PostFormat, post string) ([]ParsedPlayerRow, error)
+	// Formats returns the formats the parser ships with, used for clubs that
+	// have not registered their own.
+	Formats() []PostFormat
+	// SplitThread splits a pasted forum thread page into its posts, in the
+	// order they appear.
+	SplitThread(thread string) []ThreadPost
+	// WriteTeam writes a team as a post in format's layout, such that Parse
+	// with the same format reads the team back.
+	WriteTeam(format PostFormat, team PostTeam) (string, error)
+}
+
+// ThreadPost is one post split from a forum thread page.
+type ThreadPost struct {
+	Author string // forum username from the post header; empty if the post had none
+	Body   string
+}
+
+// PostTeam is a team to write as a forum post.
+type PostTeam struct {
+	Round   int          // round number, for formats that title posts with it; 0 if unknown
+	Players []PostPlayer // starters in team-sheet order, then the bench
+	Scored  bool         // write scores, subtotals and the total
+	Total   int
+}
+
+// PostPlayer is one player line of a PostTeam.
+type PostPlayer struct {
+	Name                string
+	Club                string // AFL club code, e.g. "Geel"
+	Position            string // starter position, or "bench"
+	BackupPositions     string // comma-separated, bench players only
+	InterchangePosition string
+	Score               int // the player's own score
+	// Sub and SubScore name the bench player whose score counted for this
+	// starter's slot, by surname; Sub is empty if the starter's own score
+	// counted. DNP is set when the starter didn't play.
+	Sub      string
+	SubScore int
+	DNP      bool
 }
 
 // ParsedPlayerRow is one player line extracted from a forum post.
@@ -64,4 +103,5 @@ type ParsedPlayerRow struct {
 	InterchangePosition string // bench players with interchange designation
 	Score               *int   // nil if not present in the post
 	Notes               string
+	AFLPlayerID         int // set when the name matched an alias; 0 otherwise
 }
@@ -0,0 +1,225 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"xffl/services/ffl/internal/domain"
+)
+
+var (
+	ErrInvalidPostFormat = errors.New("invalid post format")
+	ErrUnknownPostFormat = errors.New("could not identify the post format")
+	ErrCannotWritePost   = errors.New("team cannot be written in the post format")
+)
+
+// PostFormat describes how one FFL club lays out its team posts on the forum.
+// The forum parser interprets it at runtime, so a new club needs a new format,
+// not new code. All patterns are Go regular expressions matched against a
+// single trimmed line, except Detect, which is matched against the whole post.
+type PostFormat struct {
+	ClubID int
+	Name   string // how the club signs its posts, e.g. "Ruiboys"
+
+	// Detect matches posts written in this format. When several formats
+	// match a post, the one matching earliest in the post wins.
+	Detect string
+	// ScoreLines match team total and header lines, which are skipped.
+	ScoreLines []string
+	// Strip patterns are removed from every line before it is read.
+	Strip []string
+	// Sections are tried in order; a matching line starts that section.
+	Sections []PostSection
+	// Players are tried in order against each line inside a section; the
+	// first match becomes a player row.
+	Players []PostPlayerLine
+	// BenchCodes maps a bench code token to the position it backs up, e.g.
+	// "G" → "goals". "*" always means star.
+	BenchCodes map[string]string
+	// RawStatMultipliers turns positions posted as raw stat counts into
+	// points, e.g. "goals" → 5.
+	RawStatMultipliers map[string]int
+	// InterchangeMarker matches a line naming the bench star player as the
+	// interchange, for formats that don't mark it on the player's own line.
+	InterchangeMarker string
+	// Layout is how teams are written in this format when exported back to
+	// the forum. A format without one can be read but not written.
+	Layout PostLayout
+}
+
+// PostLayout is the writing side of a PostFormat: line templates that the
+// format's own patterns read back. In a template, {placeholder} is replaced
+// by its value and [optional text] is written only when every placeholder
+// inside it has a value. A line whose required placeholders are empty is
+// left out.
+//
+// Title and Footer take {round} (the round number) and {total}. Headers take
+// {subtotal}, the position's score. Player lines take {name}, {club} (the
+// AFL club code), {score} and, on bench lines, {code}. Subbed and
+// Interchanged also take {sub} and {subscore}: the surname and score of the
+// bench player who came on. Scores are only filled in for results.
+type PostLayout struct {
+	Title  string
+	Footer string
+	// Headers maps each position, and "bench", to its section header.
+	Headers map[string]string
+	// Player is a starter's line.
+	Player string
+	// Bench is a bench player's line.
+	Bench string
+	// BenchStar, if set, is the bench star's line instead of Bench.
+	BenchStar string
+	// Subbed is the line of a starter who didn't play and was covered from
+	// the bench; Interchanged, of a starter the interchange beat. When
+	// empty, the starter's plain line is written.
+	Subbed       string
+	Interchanged string
+	// InterchangeCode is the bench code of the star interchange; "* (INT)"
+	// if empty. A backup star is always "*".
+	InterchangeCode string
+	// InterchangeLine, if set, is written after the bench when the bench
+	// star is the interchange.
+	InterchangeLine string
+}
+
+// PostSection is a section header: Header matches the line, Position is the
+// position the lines below it fill, or "bench".
+type PostSection struct {
+	Header   string
+	Position string
+}
+
+// PostPlayerLine is one way a club writes a player line.
+//
+// Pattern uses named groups: name (required), club, code (bench code), score,
+// and interchange (a score the slot takes if it beats score). Any other
+// named group is only available to Notes, where {group} is replaced by the
+// group's text.
+type PostPlayerLine struct {
+	Section             string // only try this pattern in this section; empty for any
+	Pattern             string
+	BackupPositions     string // fixed backups for lines without a code, e.g. "star"
+	InterchangePosition string // fixed interchange position
+	Score               *int   // fixed slot score, e.g. 0 for a DNP line
+	Notes               string
+}
+
+// PlayerGroupName is the capture group every player line pattern must define.
+const PlayerGroupName = "name"
+
+// Validate checks every pattern compiles, positions are known, and the format
+// can produce player rows.
+func (f PostFormat) Validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPostFormat)
+	}
+	if _, err := compilePattern("detect", f.Detect); err != nil {
+		return err
+	}
+	for _, p := range append(slices.Clone(f.ScoreLines), f.Strip...) {
+		if _, err := compilePattern("line", p); err != nil {
+			return err
+		}
+	}
+	if f.InterchangeMarker != "" {
+		if _, err := compilePattern("interchange marker", f.InterchangeMarker); err != nil {
+			return err
+		}
+	}
+	if len(f.Sections) == 0 {
+		return fmt.Errorf("%w: at least one section is required", ErrInvalidPostFormat)
+	}
+	for _, s := range f.Sections {
+		if _, err := compilePattern("section header", s.Header); err != nil {
+			return err
+		}
+		if !isSectionPosition(s.Position) {
+			return fmt.Errorf("%w: unknown section position %q", ErrInvalidPostFormat, s.Position)
+		}
+	}
+	if len(f.Players) == 0 {
+		return fmt.Errorf("%w: at least one player line is required", ErrInvalidPostFormat)
+	}
+	for _, pl := range f.Players {
+		re, err := compilePattern("player line", pl.Pattern)
+		if err != nil {
+			return err
+		}
+		if re.SubexpIndex(PlayerGroupName) < 0 {
+			return fmt.Errorf("%w: player line %q has no (?P<name>…) group", ErrInvalidPostFormat, pl.Pattern)
+		}
+		if pl.Section != "" && !isSectionPosition(pl.Section) {
+			return fmt.Errorf("%w: unknown player line section %q", ErrInvalidPostFormat, pl.Section)
+		}
+	}
+	for code, pos := range f.BenchCodes {
+		if !isPosition(pos) {
+			return fmt.Errorf("%w: bench code %q maps to unknown position %q", ErrInvalidPostFormat, code, pos)
+		}
+	}
+	for pos, mult := range f.RawStatMultipliers {
+		if !isPosition(pos) || mult <= 0 {
+			return fmt.Errorf("%w: raw stat multiplier %s × %d", ErrInvalidPostFormat, pos, mult)
+		}
+	}
+	for pos := range f.Layout.Headers {
+		if !isSectionPosition(pos) {
+			return fmt.Errorf("%w: layout header for unknown position %q", ErrInvalidPostFormat, pos)
+		}
+	}
+	return nil
+}
+
+// DetectPostFormat returns the format whose Detect pattern matches earliest in
+// post. Ties go to the format listed first. Formats with invalid patterns are
+// skipped.
+func DetectPostFormat(formats []PostFormat, post string) (PostFormat, bool) {
+	best, bestAt := -1, -1
+	for i, f := range formats {
+		re, err := regexp.Compile(f.Detect)
+		if err != nil {
+			continue
+		}
+		loc := re.FindStringIndex(post)
+		if loc == nil {
+			continue
+		}
+		if best < 0 || loc[0] < bestAt {
+			best, bestAt = i, loc[0]
+		}
+	}
+	if best < 0 {
+		return PostFormat{}, false
+	}
+	return formats[best], true
+}
+
+// DataopsPostFormatRepository stores each club's registered post format, keyed
+// by FFL club ID, per the ACL pattern (ADR-016).
+type DataopsPostFormatRepository interface {
+	FindAll(ctx context.Context) ([]PostFormat, error)
+	FindByClubSeasonID(ctx context.Context, clubSeasonID int) (format PostFormat, found bool, err error)
+	Store(ctx context.Context, format PostFormat) (PostFormat, error)
+}
+
+func compilePattern(kind, pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: %s pattern is empty", ErrInvalidPostFormat, kind)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s pattern: %v", ErrInvalidPostFormat, kind, err)
+	}
+	return re, nil
+}
+
+func isPosition(s string) bool {
+	return slices.Contains(domain.Positions, domain.Position(s))
+}
+
+func isSectionPosition(s string) bool {
+	return s == "bench" || isPosition(s)
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"xffl/services/ffl/internal/domain"
 )
@@ -17,6 +19,8 @@ type Queries struct {
 	players       domain.PlayerRepository
 	playerMatches domain.PlayerMatchRepository
 	playerSeasons domain.PlayerSeasonRepository
+	drafts        domain.DraftRepository
+	waivers       domain.WaiverRepository
 }
 
 func NewQueries(
@@ -29,6 +33,8 @@ func NewQueries(
 	players domain.PlayerRepository,
 	playerMatches domain.PlayerMatchRepository,
 	playerSeasons domain.PlayerSeasonRepository,
+	drafts domain.DraftRepository,
+	waivers domain.WaiverRepository,
 ) *Queries {
 	return &Queries{
 		clubs:         clubs,
@@ -40,6 +46,8 @@ func NewQueries(
 		players:       players,
 		playerMatches: playerMatches,
 		playerSeasons: playerSeasons,
+		drafts:        drafts,
+		waivers:       waivers,
 	}
 }
 
@@ -135,6 +143,22 @@ func (q *Queries) GetPlayersForPlayerSeasonIDs(ctx context.Context, ids []int) (
 	return q.playerSeasons.FindPlayersForPlayerSeasonIDs(ctx, ids)
 }
 
+func (q *Queries) GetClubsForClubSeasonIDs(ctx context.Context, ids []int) (map[int]domain.Club, error) {
+	return q.clubSeasons.FindClubsForClubSeasonIDs(ctx, ids)
+}
+
+func (q *Queries) GetPlayerSeasonsByIDs(ctx context.Context, ids []int) (map[int]domain.PlayerSeason, error) {
+	pss, err := q.playerSeasons.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]domain.PlayerSeason, len(pss))
+	for _, ps := range pss {
+		out[ps.ID] = ps
+	}
+	return out, nil
+}
+
 // GetClubForClubSeason resolves the club for a club_season record.
 func (q *Queries) GetClubForClubSeason(ctx context.Context, clubSeasonID int) (domain.Club, error) {
 	cs, err := q.clubSeasons.FindByID(ctx, clubSeasonID)
@@ -152,3 +176,60 @@ func (q *Queries) GetPlayerForPlayerSeason(ctx context.Context, playerSeasonID i
 	}
 	return q.players.FindByID(ctx, ps.PlayerID)
 }
+
+func (q *Queries) GetDraft(ctx context.Context, id int) (domain.Draft, error) {
+	return q.drafts.FindByID(ctx, id)
+}
+
+func (q *Queries) GetDraftBySeason(ctx context.Context, seasonID int) (domain.Draft, error) {
+	return q.drafts.FindBySeasonID(ctx, seasonID)
+}
+
+func (q *Queries) GetDraftRankings(ctx context.Context, draftID, clubSeasonID int) ([]domain.DraftRanking, error) {
+	return q.drafts.FindRankings(ctx, draftID, clubSeasonID)
+}
+
+func (q *Queries) GetWaiverClaims(ctx context.Context, roundID int) ([]domain.WaiverClaim, error) {
+	return q.waivers.FindClaimsByRoundID(ctx, roundID)
+}
+
+func (q *Queries) GetWaiverSchedule(ctx context.Context, roundID int) (domain.WaiverSchedule, error) {
+	return q.waivers.FindScheduleByRoundID(ctx, roundID)
+}
+
+// RoundTeamStatus is one club's team sheet for a round: whether it has been
+// submitted and what it is missing. Lockout is the match start, after which the
+// team can no longer change; zero if the match has no start time.
+type RoundTeamStatus struct {
+	ClubMatch    domain.ClubMatch
+	Lockout      time.Time
+	Completeness domain.TeamCompleteness
+}
+
+// GetRoundTeamStatus reports every club match in a round, home then away for
+// each match. A club match with DataStatus no_data has not been submitted.
+func (q *Queries) GetRoundTeamStatus(ctx context.Context, roundID int) ([]RoundTeamStatus, error) {
+	matches, err := q.matches.FindByRoundID(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RoundTeamStatus, 0, 2*len(matches))
+	for _, m := range matches {
+		for _, id := range []int{m.Home.ID, m.Away.ID} {
+			cm, err := q.clubMatches.FindByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("find club match %d: %w", id, err)
+			}
+			cm.PlayerMatches, err = q.playerMatches.FindByClubMatchID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("find player matches for club match %d: %w", id, err)
+			}
+			out = append(out, RoundTeamStatus{
+				ClubMatch:    cm,
+				Lockout:      m.StartTime,
+				Completeness: cm.Completeness(),
+			})
+		}
+	}
+	return out, nil
+}
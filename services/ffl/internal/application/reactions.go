@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -22,6 +23,7 @@ type PlayerMatchUpdate struct {
 	Marks             int
 	Tackles           int
 	Hitouts           int
+	Extra             map[string]float64
 }
 
 // ProcessPlayerMatchUpdated finds all FFL player matches for the given AFL player in the
@@ -54,6 +56,7 @@ func (c *Commands) ProcessPlayerMatchUpdated(ctx context.Context, update PlayerM
 		Marks:     update.Marks,
 		Tackles:   update.Tackles,
 		Hitouts:   update.Hitouts,
+		Extra:     update.Extra,
 	}
 
 	for _, ps := range fflPlayerSeasons {
@@ -140,6 +143,60 @@ func (c *Commands) ProcessAFLMatchUpdated(ctx context.Context, payload events.Af
 	return nil
 }
 
+// ProcessAFLPlayerMerged reacts to AFL.PlayerMerged: in one transaction, points FFL players,
+// forum aliases, player seasons, draft rankings and picks, and pending waiver claims linked to
+// the duplicate AFL player and its merged player seasons at the survivor's.
+func (c *Commands) ProcessAFLPlayerMerged(ctx context.Context, payload events.AflPlayerMergedPayload) error {
+	return c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		// Both AFL players can have an FFL player if each was picked up; the
+		// duplicate's player seasons then move to the survivor's FFL player.
+		survivor, survivorErr := repos.Players.FindByAFLPlayerID(ctx, payload.SurvivorPlayerID)
+		if survivorErr != nil && !errors.Is(survivorErr, domain.ErrNotFound) {
+			return fmt.Errorf("find player for AFL player %d: %w", payload.SurvivorPlayerID, survivorErr)
+		}
+		duplicate, duplicateErr := repos.Players.FindByAFLPlayerID(ctx, payload.DuplicatePlayerID)
+		if duplicateErr != nil && !errors.Is(duplicateErr, domain.ErrNotFound) {
+			return fmt.Errorf("find player for AFL player %d: %w", payload.DuplicatePlayerID, duplicateErr)
+		}
+		if survivorErr == nil && duplicateErr == nil {
+			if err := repos.PlayerSeasons.ReassignPlayer(ctx, duplicate.ID, survivor.ID); err != nil {
+				return fmt.Errorf("move player seasons of player %d: %w", duplicate.ID, err)
+			}
+			if err := repos.Players.Delete(ctx, duplicate.ID); err != nil {
+				return fmt.Errorf("delete merged player %d: %w", duplicate.ID, err)
+			}
+			slog.InfoContext(ctx, "FFL players merged",
+				slog.Int("player_id", survivor.ID),
+				slog.Int("duplicate_player_id", duplicate.ID),
+			)
+		}
+		n, err := repos.Players.ReassignAFLPlayer(ctx, payload.DuplicatePlayerID, payload.SurvivorPlayerID)
+		if err != nil {
+			return fmt.Errorf("reassign players of AFL player %d: %w", payload.DuplicatePlayerID, err)
+		}
+		if err := repos.PlayerAliases.ReassignAFLPlayer(ctx, payload.DuplicatePlayerID, payload.SurvivorPlayerID); err != nil {
+			return fmt.Errorf("reassign aliases of AFL player %d: %w", payload.DuplicatePlayerID, err)
+		}
+		for duplicate, survivor := range payload.PlayerSeasonIDs {
+			if err := repos.PlayerSeasons.ReassignAFLPlayerSeason(ctx, duplicate, survivor); err != nil {
+				return fmt.Errorf("reassign player seasons of AFL player_season %d: %w", duplicate, err)
+			}
+			if err := repos.Drafts.ReassignAFLPlayerSeason(ctx, duplicate, survivor); err != nil {
+				return fmt.Errorf("reassign draft rankings and picks of AFL player_season %d: %w", duplicate, err)
+			}
+			if err := repos.Waivers.ReassignPendingAFLPlayerSeason(ctx, duplicate, survivor); err != nil {
+				return fmt.Errorf("reassign waiver claims of AFL player_season %d: %w", duplicate, err)
+			}
+		}
+		slog.InfoContext(ctx, "AFL player merged",
+			slog.Int("survivor_player_id", payload.SurvivorPlayerID),
+			slog.Int("duplicate_player_id", payload.DuplicatePlayerID),
+			slog.Int("players", n),
+		)
+		return nil
+	})
+}
+
 // applyAFLStatusMap updates drv_afl_status for player_matches in a club_match whose
 // afl_player_season_id is in the map. Players not in the map (other AFL matches) are unaffected.
 func (c *Commands) applyAFLStatusMap(ctx context.Context, clubMatchID int, statusMap map[int]string) error {
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"xffl/services/ffl/internal/domain"
+)
+
+// AFLStatsChange is an AFL player's stat line as a correction would leave it.
+type AFLStatsChange struct {
+	AFLPlayerSeasonID int
+	Stats             domain.AFLStats
+}
+
+// PlayerScoreImpact is how a correction would change a player's score.
+// PlayerMatch holds the current score.
+type PlayerScoreImpact struct {
+	PlayerMatch domain.PlayerMatch
+	NewScore    int
+}
+
+// ClubMatchScoreImpact is how a correction would change a club match's
+// score. ClubMatch is loaded with its player matches.
+type ClubMatchScoreImpact struct {
+	ClubMatch domain.ClubMatch
+	OldScore  int
+	NewScore  int
+	Players   []PlayerScoreImpact // only players whose score changes
+}
+
+// ScoreImpact works out how AFL stat corrections for a round would ripple
+// into FFL scores, without writing anything: each FFL player match named for
+// a corrected player is re-scored for its position, and its club match
+// re-totalled with the new scores, subs and interchanges included. Club
+// matches are listed in the order their players first change; a round FFL
+// doesn't play has no impact.
+func (q *Queries) ScoreImpact(ctx context.Context, aflRoundID int, changes []AFLStatsChange) ([]ClubMatchScoreImpact, error) {
+	round, err := q.rounds.FindByAFLRoundID(ctx, aflRoundID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find FFL round for AFL round %d: %w", aflRoundID, err)
+	}
+
+	newScores := make(map[int]int) // player match ID → score
+	var clubMatchIDs []int
+	for _, change := range changes {
+		playerSeasons, err := q.playerSeasons.FindByAFLPlayerSeasonID(ctx, change.AFLPlayerSeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("find FFL player seasons for AFL player_season %d: %w", change.AFLPlayerSeasonID, err)
+		}
+		for _, ps := range playerSeasons {
+			pm, err := q.playerMatches.FindByPlayerSeasonAndRound(ctx, ps.ID, round.ID)
+			if errors.Is(err, domain.ErrNotFound) {
+				// Not named in a team this round.
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("find player match for player season %d: %w", ps.ID, err)
+			}
+			score := pm.CalculateScore(change.Stats)
+			if score == pm.Score {
+				continue
+			}
+			newScores[pm.ID] = score
+			if !slices.Contains(clubMatchIDs, pm.ClubMatchID) {
+				clubMatchIDs = append(clubMatchIDs, pm.ClubMatchID)
+			}
+		}
+	}
+
+	impacts := make([]ClubMatchScoreImpact, 0, len(clubMatchIDs))
+	for _, id := range clubMatchIDs {
+		cm, err := q.clubMatches.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("load club_match %d: %w", id, err)
+		}
+		cm.PlayerMatches, err = q.playerMatches.FindByClubMatchID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("load player matches for club_match %d: %w", id, err)
+		}
+
+		impact := ClubMatchScoreImpact{ClubMatch: cm, OldScore: cm.Score()}
+		after := cm
+		after.PlayerMatches = slices.Clone(cm.PlayerMatches)
+		for i, pm := range after.PlayerMatches {
+			if score, ok := newScores[pm.ID]; ok {
+				impact.Players = append(impact.Players, PlayerScoreImpact{PlayerMatch: pm, NewScore: score})
+				after.PlayerMatches[i].Score = score
+			}
+		}
+		impact.NewScore = after.Score()
+		impacts = append(impacts, impact)
+	}
+	return impacts, nil
+}
@@ -11,13 +11,45 @@ import (
 // ID is the only cross-service handle the caller needs to provide; the FFL
 // service resolves it to the underlying afl.player.id via Twirp and find-or-
 // creates the ffl.player row.
+//
+// The squad must have room under the season's maximum size, and the AFL player
+// must not be active in another club's squad in the same season from
+// fromRoundID onward.
 func (c *Commands) AddPlayerToSeason(ctx context.Context, clubSeasonID, aflPlayerSeasonID int, fromRoundID, costCents *int) (domain.PlayerSeason, error) {
+	season, order, err := c.squadRules(ctx, clubSeasonID)
+	if err != nil {
+		return domain.PlayerSeason{}, err
+	}
+	seasonClubs, err := c.clubSeasons.FindBySeasonID(ctx, season.ID)
+	if err != nil {
+		return domain.PlayerSeason{}, fmt.Errorf("find club seasons: %w", err)
+	}
+	inSeason := make(map[int]bool, len(seasonClubs))
+	for _, cs := range seasonClubs {
+		inSeason[cs.ID] = true
+	}
+
 	aflPlayerID, err := c.playerLookup.LookupPlayerSeason(ctx, aflPlayerSeasonID)
 	if err != nil {
 		return domain.PlayerSeason{}, fmt.Errorf("lookup AFL player season: %w", err)
 	}
 	var result domain.PlayerSeason
 	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		squad, err := repos.PlayerSeasons.FindByClubSeasonID(ctx, clubSeasonID)
+		if err != nil {
+			return fmt.Errorf("find squad: %w", err)
+		}
+		if err := domain.CheckSquadAdd(season, order, squad, aflPlayerSeasonID, fromRoundID); err != nil {
+			return err
+		}
+		existing, err := repos.PlayerSeasons.FindByAFLPlayerSeasonID(ctx, aflPlayerSeasonID)
+		if err != nil {
+			return fmt.Errorf("find player seasons: %w", err)
+		}
+		if err := domain.CheckPlayerExclusive(order, existing, inSeason, clubSeasonID, fromRoundID); err != nil {
+			return err
+		}
+
 		player, err := repos.Players.FindByAFLPlayerID(ctx, aflPlayerID)
 		if err != nil {
 			player, err = repos.Players.Create(ctx, aflPlayerID)
@@ -50,8 +82,61 @@ func (c *Commands) UpdatePlayerSeasonDetails(ctx context.Context, id int, notes
 }
 
 // RemovePlayerFromSeason records the last round a player was in the squad, preserving history.
+// The squad must stay at or above the season's minimum size after that round.
 func (c *Commands) RemovePlayerFromSeason(ctx context.Context, playerSeasonID int, toRoundID int) error {
+	ps, err := c.playerSeasons.FindByID(ctx, playerSeasonID)
+	if err != nil {
+		return err
+	}
+	season, order, err := c.squadRules(ctx, ps.ClubSeasonID)
+	if err != nil {
+		return err
+	}
 	return c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		squad, err := repos.PlayerSeasons.FindByClubSeasonID(ctx, ps.ClubSeasonID)
+		if err != nil {
+			return fmt.Errorf("find squad: %w", err)
+		}
+		if err := domain.CheckSquadRemove(season, order, squad, playerSeasonID, toRoundID); err != nil {
+			return err
+		}
 		return repos.PlayerSeasons.SetEndRound(ctx, playerSeasonID, toRoundID)
 	})
 }
+
+// SetSeasonSquadLimits sets the minimum and maximum squad size for every club
+// in a season. Either bound may be nil for no limit. Existing squads are not
+// checked; the limits apply to subsequent changes.
+func (c *Commands) SetSeasonSquadLimits(ctx context.Context, seasonID int, minSize, maxSize *int) (domain.Season, error) {
+	if err := domain.ValidateSquadLimits(minSize, maxSize); err != nil {
+		return domain.Season{}, err
+	}
+	var result domain.Season
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		s, err := repos.Seasons.UpdateSquadLimits(ctx, seasonID, minSize, maxSize)
+		if err != nil {
+			return err
+		}
+		result = s
+		return nil
+	})
+	return result, err
+}
+
+// squadRules loads the season a club season belongs to and the order of its
+// rounds, which squad size and exclusivity checks are evaluated against.
+func (c *Commands) squadRules(ctx context.Context, clubSeasonID int) (domain.Season, domain.RoundOrder, error) {
+	cs, err := c.clubSeasons.FindByID(ctx, clubSeasonID)
+	if err != nil {
+		return domain.Season{}, nil, fmt.Errorf("find club season: %w", err)
+	}
+	season, err := c.seasons.FindByID(ctx, cs.SeasonID)
+	if err != nil {
+		return domain.Season{}, nil, fmt.Errorf("find season: %w", err)
+	}
+	rounds, err := c.rounds.FindBySeasonID(ctx, cs.SeasonID)
+	if err != nil {
+		return domain.Season{}, nil, fmt.Errorf("find rounds: %w", err)
+	}
+	return season, domain.NewRoundOrder(rounds), nil
+}
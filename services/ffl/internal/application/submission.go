@@ -0,0 +1,324 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"xffl/services/ffl/internal/domain"
+)
+
+// SubmissionRecord is a team post as it was submitted for a club match, kept
+// so a dispute can be settled against what the manager actually posted: the
+// raw post, what the parser read from it, and the team confirmed after review.
+type SubmissionRecord struct {
+	ID          int
+	ClubMatchID int
+	Author      string // forum username, for posts read from a thread
+	Format      string // name of the post format the post was read with
+	Post        string // empty for a team confirmed without a parsed post
+	Parsed      []ResolvedPlayer
+	Confirmed   []ResolvedPlayer // nil until confirmed
+	SubmittedAt time.Time
+	ConfirmedAt *time.Time
+}
+
+// Team is the submission's team: as confirmed, or as parsed if it never was.
+func (s SubmissionRecord) Team() []ResolvedPlayer {
+	if s.Confirmed != nil {
+		return s.Confirmed
+	}
+	return s.Parsed
+}
+
+// DataopsSubmissionRepository stores team submissions, per the ACL pattern
+// (ADR-016).
+type DataopsSubmissionRepository interface {
+	Create(ctx context.Context, s SubmissionRecord) (SubmissionRecord, error)
+	// Confirm records the team confirmed from a submission, replacing any
+	// earlier confirmation.
+	Confirm(ctx context.Context, id int, confirmed []ResolvedPlayer) error
+	FindByID(ctx context.Context, id int) (SubmissionRecord, error)
+	// FindByClubMatchID returns a club match's submissions, oldest first.
+	FindByClubMatchID(ctx context.Context, clubMatchID int) ([]SubmissionRecord, error)
+}
+
+// SubmissionChangeKind is what changed about a player between two versions
+// of a team.
+type SubmissionChangeKind string
+
+const (
+	SubmissionChangeAdded    SubmissionChangeKind = "added"
+	SubmissionChangeRemoved  SubmissionChangeKind = "removed"
+	SubmissionChangePlayer   SubmissionChangeKind = "player" // the same parsed name, resolved to another player
+	SubmissionChangePosition SubmissionChangeKind = "position"
+	SubmissionChangeScore    SubmissionChangeKind = "score"
+)
+
+// SubmissionChange is one difference between two versions of a team. From
+// and To are the old and new position, score or player; From is empty for
+// an added player, To for a removed one.
+type SubmissionChange struct {
+	Kind   SubmissionChangeKind
+	Player string // the player's name, or the parsed name of a row that didn't resolve
+	From   string
+	To     string
+}
+
+// SubmissionHistoryEntry is a submission with what changed in it.
+type SubmissionHistoryEntry struct {
+	Submission SubmissionRecord
+	// Corrections are what the reviewer changed between the parsed and the
+	// confirmed team.
+	Corrections []SubmissionChange
+	// Changes are how the team differs from the previous submission's, and
+	// PostDiff how the post does, line by line: "- " for a line removed and
+	// "+ " for a line added. Both are empty for the first submission.
+	Changes  []SubmissionChange
+	PostDiff []string
+}
+
+// SubmissionHistory returns every team submitted for a club match, oldest
+// first, with the reviewer's corrections to each and the changes from one
+// submission to the next.
+func (c *DataOpsCommands) SubmissionHistory(ctx context.Context, clubMatchID int) ([]SubmissionHistoryEntry, error) {
+	subs, err := c.submissions.FindByClubMatchID(ctx, clubMatchID)
+	if err != nil {
+		return nil, fmt.Errorf("load submissions for club match %d: %w", clubMatchID, err)
+	}
+
+	var ids []int
+	for _, s := range subs {
+		for _, team := range [][]ResolvedPlayer{s.Parsed, s.Confirmed} {
+			for _, rp := range team {
+				if rp.PlayerSeasonID != 0 {
+					ids = append(ids, rp.PlayerSeasonID)
+				}
+			}
+		}
+	}
+	names, err := c.playerNames(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	// Confirmed players carry only their player season; name them.
+	for _, s := range subs {
+		for i, rp := range s.Confirmed {
+			if n, ok := names[rp.PlayerSeasonID]; ok && rp.BestMatch.Candidate.Name == "" {
+				s.Confirmed[i].BestMatch.Candidate = n
+			}
+		}
+	}
+
+	entries := make([]SubmissionHistoryEntry, len(subs))
+	for i, s := range subs {
+		entries[i].Submission = s
+		if s.Confirmed != nil && s.Post != "" {
+			entries[i].Corrections = diffTeams(s.Parsed, s.Confirmed)
+		}
+		if i > 0 {
+			entries[i].Changes = diffTeams(subs[i-1].Team(), s.Team())
+			entries[i].PostDiff = diffLines(subs[i-1].Post, s.Post)
+		}
+	}
+	return entries, nil
+}
+
+// recordSubmission records a parsed post for audit, returning its ID. A post
+// parsed again before it is confirmed isn't recorded twice.
+func (c *DataOpsCommands) recordSubmission(ctx context.Context, s SubmissionRecord) (int, error) {
+	prev, err := c.submissions.FindByClubMatchID(ctx, s.ClubMatchID)
+	if err != nil {
+		return 0, fmt.Errorf("load submissions for club match %d: %w", s.ClubMatchID, err)
+	}
+	if n := len(prev); n > 0 && prev[n-1].Post == s.Post && prev[n-1].Confirmed == nil {
+		return prev[n-1].ID, nil
+	}
+	rec, err := c.submissions.Create(ctx, s)
+	if err != nil {
+		return 0, fmt.Errorf("record submission for club match %d: %w", s.ClubMatchID, err)
+	}
+	return rec.ID, nil
+}
+
+// checkSubmission makes sure a team being confirmed names a submission of its
+// own club match, if it names one at all.
+func (c *DataOpsCommands) checkSubmission(ctx context.Context, params ImportRoundTeamsParams) error {
+	if params.SubmissionID == 0 {
+		return nil
+	}
+	s, err := c.submissions.FindByID(ctx, params.SubmissionID)
+	if err != nil {
+		return fmt.Errorf("find submission %d: %w", params.SubmissionID, err)
+	}
+	if s.ClubMatchID != params.ClubMatchID {
+		return fmt.Errorf("submission %d for club match %d: %w", params.SubmissionID, params.ClubMatchID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+// confirmSubmission records the confirmed team against its submission, or as
+// a submission of its own when it was entered without a parsed post. It runs
+// in the transaction saving the team, so the team isn't saved without it.
+func confirmSubmission(ctx context.Context, submissions DataopsSubmissionRepository, params ImportRoundTeamsParams) error {
+	id := params.SubmissionID
+	confirmed := params.ResolvedPlayers
+	if confirmed == nil {
+		confirmed = []ResolvedPlayer{}
+	}
+	if id == 0 {
+		rec, err := submissions.Create(ctx, SubmissionRecord{ClubMatchID: params.ClubMatchID})
+		if err != nil {
+			return fmt.Errorf("record submission for club match %d: %w", params.ClubMatchID, err)
+		}
+		id = rec.ID
+	}
+	if err := submissions.Confirm(ctx, id, confirmed); err != nil {
+		return fmt.Errorf("confirm submission %d: %w", id, err)
+	}
+	return nil
+}
+
+// diffTeams lists how team b differs from team a: players removed, then
+// players whose position or score changed, then players added. Rows are
+// paired by player season and parsed name, then by player season alone, then
+// by parsed name alone; a parsed name that resolved to another player is one
+// change rather than a removal and an add.
+func diffTeams(a, b []ResolvedPlayer) []SubmissionChange {
+	samePlayer := func(x, y ResolvedPlayer) bool {
+		return x.PlayerSeasonID != 0 && x.PlayerSeasonID == y.PlayerSeasonID
+	}
+	sameName := func(x, y ResolvedPlayer) bool {
+		return x.Parsed.Name != "" && NormaliseAlias(x.Parsed.Name) == NormaliseAlias(y.Parsed.Name)
+	}
+
+	pairOf := make([]int, len(a)) // index into b, or -1
+	for i := range pairOf {
+		pairOf[i] = -1
+	}
+	paired := make([]bool, len(b))
+	pair := func(same func(x, y ResolvedPlayer) bool) {
+		for i := range a {
+			for j := range b {
+				if pairOf[i] < 0 && !paired[j] && same(a[i], b[j]) {
+					pairOf[i], paired[j] = j, true
+				}
+			}
+		}
+	}
+	pair(func(x, y ResolvedPlayer) bool { return samePlayer(x, y) && sameName(x, y) })
+	pair(samePlayer)
+	pair(sameName)
+
+	var removed, changed, added []SubmissionChange
+	for i, old := range a {
+		if pairOf[i] < 0 {
+			removed = append(removed, SubmissionChange{Kind: SubmissionChangeRemoved, Player: playerName(old), From: slot(old.Parsed)})
+			continue
+		}
+		cur := b[pairOf[i]]
+		if old.PlayerSeasonID != cur.PlayerSeasonID {
+			changed = append(changed, SubmissionChange{Kind: SubmissionChangePlayer, Player: cur.Parsed.Name,
+				From: old.BestMatch.Candidate.Name, To: cur.BestMatch.Candidate.Name})
+		}
+		if from, to := slot(old.Parsed), slot(cur.Parsed); from != to {
+			changed = append(changed, SubmissionChange{Kind: SubmissionChangePosition, Player: playerName(cur), From: from, To: to})
+		}
+		if from, to := score(old.Parsed.Score), score(cur.Parsed.Score); from != to {
+			changed = append(changed, SubmissionChange{Kind: SubmissionChangeScore, Player: playerName(cur), From: from, To: to})
+		}
+	}
+	for j, rp := range b {
+		if !paired[j] {
+			added = append(added, SubmissionChange{Kind: SubmissionChangeAdded, Player: playerName(rp), To: slot(rp.Parsed)})
+		}
+	}
+	return append(append(removed, changed...), added...)
+}
+
+// playerName names a row: by the player it resolved to if the match was
+// confident, else as parsed.
+func playerName(rp ResolvedPlayer) string {
+	resolved := rp.BestMatch.Candidate.Name
+	if resolved != "" && (rp.Confident || rp.Parsed.Name == "") {
+		return resolved
+	}
+	return rp.Parsed.Name
+}
+
+// slot describes a row's place in the team: its position, or for the bench
+// the positions it backs up and any interchange ("bench (goals,marks)").
+func slot(p ParsedPlayerRow) string {
+	if p.Position != "bench" {
+		return p.Position
+	}
+	s := "bench (" + p.BackupPositions + ")"
+	if p.InterchangePosition != "" {
+		s += " interchange " + p.InterchangePosition
+	}
+	return s
+}
+
+func score(s *int) string {
+	if s == nil {
+		return ""
+	}
+	return strconv.Itoa(*s)
+}
+
+// diffLines lists the lines removed from a ("- ") and added in b ("+ "), in
+// order, keeping the lines the two share in their longest common run.
+func diffLines(a, b string) []string {
+	la, lb := splitLines(a), splitLines(b)
+	// common[i][j] is the length of the longest common subsequence of la[i:] and lb[j:].
+	common := make([][]int, len(la)+1)
+	for i := range common {
+		common[i] = make([]int, len(lb)+1)
+	}
+	for i := len(la) - 1; i >= 0; i-- {
+		for j := len(lb) - 1; j >= 0; j-- {
+			if la[i] == lb[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(la) && j < len(lb) {
+		switch {
+		case la[i] == lb[j]:
+			i, j = i+1, j+1
+		case common[i+1][j] >= common[i][j+1]:
+			diff = append(diff, "- "+la[i])
+			i++
+		default:
+			diff = append(diff, "+ "+lb[j])
+			j++
+		}
+	}
+	for ; i < len(la); i++ {
+		diff = append(diff, "- "+la[i])
+	}
+	for ; j < len(lb); j++ {
+		diff = append(diff, "+ "+lb[j])
+	}
+	return diff
+}
+
+// splitLines splits a post into lines with trailing whitespace trimmed, so
+// line endings pasted from different browsers don't show as changes.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t\r")
+	}
+	return lines
+}
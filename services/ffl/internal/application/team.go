@@ -25,108 +25,176 @@ type SetTeamEntry struct {
 	Score               *int // optional seed score for new players (AFL events are authoritative once set)
 }
 
+// TeamSubmission is a saved team along with what it is still missing.
+type TeamSubmission struct {
+	PlayerMatches []domain.PlayerMatch
+	Completeness  domain.TeamCompleteness
+}
+
+func newTeamSubmission(pms []domain.PlayerMatch) TeamSubmission {
+	return TeamSubmission{PlayerMatches: pms, Completeness: domain.CheckTeamCompleteness(pms)}
+}
+
 // SetTeam persists a complete team for a club match using diff-based persistence to
 // preserve afl_player_match_id links for returning players. It validates team composition
 // via the domain, computes a provisional score, updates data_status, and publishes
-// FFL.TeamSubmitted.
-func (c *Commands) SetTeam(ctx context.Context, params SetTeamParams) ([]domain.PlayerMatch, error) {
+// FFL.TeamSubmitted. Partial teams are accepted; the returned completeness report
+// says what is missing.
+func (c *Commands) SetTeam(ctx context.Context, params SetTeamParams) (TeamSubmission, error) {
+	return c.setTeam(ctx, params, nil)
+}
+
+// setTeam is SetTeam, also calling record, when given, in the transaction
+// that saves the team so the team isn't saved if it fails.
+func (c *Commands) setTeam(ctx context.Context, params SetTeamParams, record func(repos WriteRepos) error) (TeamSubmission, error) {
 	var result []domain.PlayerMatch
 	var matchID int
 
 	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
-		// load the ClubMatch
-		cm, err := repos.ClubMatches.FindByID(ctx, params.ClubMatchID)
-		if err != nil {
-			return fmt.Errorf("find club match: %w", err)
+		var err error
+		matchID, result, err = saveTeam(ctx, repos, params)
+		if err != nil || record == nil {
+			return err
 		}
-		matchID = cm.MatchID
+		return record(repos)
+	})
+	if err != nil {
+		return TeamSubmission{}, err
+	}
+	return c.afterSetTeam(ctx, params.ClubMatchID, matchID, result), nil
+}
 
-		// load the PlayerMatches - key by PlayerSeasonID to ease lookup when updating with
-		// the incoming changes in the next step
-		existing, err := repos.PlayerMatches.FindByClubMatchID(ctx, params.ClubMatchID)
-		if err != nil {
-			return fmt.Errorf("find existing player matches: %w", err)
-		}
-		existingByPS := make(map[int]domain.PlayerMatch, len(existing))
-		for _, pm := range existing {
-			existingByPS[pm.PlayerSeasonID] = pm
-		}
+// SetTeams persists several teams, as SetTeam does, in a single transaction:
+// if any team fails validation, none is saved.
+func (c *Commands) SetTeams(ctx context.Context, teams []SetTeamParams) ([]TeamSubmission, error) {
+	return c.setTeams(ctx, teams, nil)
+}
 
-		// build the new list of PlayerMatches, copy over existing data - ID, AFL Link etc -  for
-		// any PMs that already exist
-		newPlayers := make([]domain.PlayerMatch, 0, len(params.Entries))
-		inNewTeam := make(map[int]bool)
-		for _, e := range params.Entries {
-			if e.PlayerSeasonID == 0 {
-				continue
+// setTeams is SetTeams, also calling record, when given, in the transaction
+// that saves the teams.
+func (c *Commands) setTeams(ctx context.Context, teams []SetTeamParams, record func(repos WriteRepos) error) ([]TeamSubmission, error) {
+	results := make([][]domain.PlayerMatch, len(teams))
+	matchIDs := make([]int, len(teams))
+
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		for i, params := range teams {
+			var err error
+			matchIDs[i], results[i], err = saveTeam(ctx, repos, params)
+			if err != nil {
+				return fmt.Errorf("club match %d: %w", params.ClubMatchID, err)
 			}
-			inNewTeam[e.PlayerSeasonID] = true
-			newPlayers = append(newPlayers, entryToPlayerMatch(e, params.ClubMatchID, existingByPS))
 		}
-
-		// validate and submit the team
-		if _, err := cm.SubmitTeam(newPlayers); err != nil {
-			return err
+		if record == nil {
+			return nil
 		}
+		return record(repos)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// do diff-based persistence: delete any PlayerMatches no longer needed, and upsert the rest
-		for _, pm := range existing {
-			if !inNewTeam[pm.PlayerSeasonID] {
-				if err := repos.PlayerMatches.DeleteByID(ctx, pm.ID); err != nil {
-					return fmt.Errorf("delete removed player_match %d: %w", pm.ID, err)
-				}
-			}
+	subs := make([]TeamSubmission, len(teams))
+	for i, params := range teams {
+		subs[i] = c.afterSetTeam(ctx, params.ClubMatchID, matchIDs[i], results[i])
+	}
+	return subs, nil
+}
+
+// saveTeam validates and writes one team within a transaction, returning the
+// club match's match ID and the saved player matches.
+func saveTeam(ctx context.Context, repos WriteRepos, params SetTeamParams) (int, []domain.PlayerMatch, error) {
+	// load the ClubMatch
+	cm, err := repos.ClubMatches.FindByID(ctx, params.ClubMatchID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("find club match: %w", err)
+	}
+
+	// load the PlayerMatches - key by PlayerSeasonID to ease lookup when updating with
+	// the incoming changes in the next step
+	existing, err := repos.PlayerMatches.FindByClubMatchID(ctx, params.ClubMatchID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("find existing player matches: %w", err)
+	}
+	existingByPS := make(map[int]domain.PlayerMatch, len(existing))
+	for _, pm := range existing {
+		existingByPS[pm.PlayerSeasonID] = pm
+	}
+
+	// build the new list of PlayerMatches, copy over existing data - ID, AFL Link etc -  for
+	// any PMs that already exist
+	newPlayers := make([]domain.PlayerMatch, 0, len(params.Entries))
+	inNewTeam := make(map[int]bool)
+	for _, e := range params.Entries {
+		if e.PlayerSeasonID == 0 {
+			continue
 		}
+		inNewTeam[e.PlayerSeasonID] = true
+		newPlayers = append(newPlayers, entryToPlayerMatch(e, params.ClubMatchID, existingByPS))
+	}
 
-		result = make([]domain.PlayerMatch, 0, len(cm.PlayerMatches))
-		for _, pm := range cm.PlayerMatches {
-			upserted, err := repos.PlayerMatches.Upsert(ctx, upsertParamsFromPlayerMatch(pm))
-			if err != nil {
-				return fmt.Errorf("upsert player_match for player_season %d: %w", pm.PlayerSeasonID, err)
+	// validate and submit the team
+	if _, err := cm.SubmitTeam(newPlayers); err != nil {
+		return 0, nil, err
+	}
+
+	// do diff-based persistence: delete any PlayerMatches no longer needed, and upsert the rest
+	for _, pm := range existing {
+		if !inNewTeam[pm.PlayerSeasonID] {
+			if err := repos.PlayerMatches.DeleteByID(ctx, pm.ID); err != nil {
+				return 0, nil, fmt.Errorf("delete removed player_match %d: %w", pm.ID, err)
 			}
-			result = append(result, upserted)
 		}
+	}
 
-		// compute the provisional score and set status
-		cm.PlayerMatches = result
-		if err := repos.ClubMatches.UpdateScore(ctx, cm.ID, cm.Score()); err != nil {
-			return fmt.Errorf("update club match score: %w", err)
-		}
-		if err := repos.ClubMatches.UpdateDataStatus(ctx, cm.ID, cm.DataStatus); err != nil {
-			return fmt.Errorf("update club match data status: %w", err)
+	result := make([]domain.PlayerMatch, 0, len(cm.PlayerMatches))
+	for _, pm := range cm.PlayerMatches {
+		upserted, err := repos.PlayerMatches.Upsert(ctx, upsertParamsFromPlayerMatch(pm))
+		if err != nil {
+			return 0, nil, fmt.Errorf("upsert player_match for player_season %d: %w", pm.PlayerSeasonID, err)
 		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		result = append(result, upserted)
 	}
 
+	// compute the provisional score and set status
+	cm.PlayerMatches = result
+	if err := repos.ClubMatches.UpdateScore(ctx, cm.ID, cm.Score()); err != nil {
+		return 0, nil, fmt.Errorf("update club match score: %w", err)
+	}
+	if err := repos.ClubMatches.UpdateDataStatus(ctx, cm.ID, cm.DataStatus); err != nil {
+		return 0, nil, fmt.Errorf("update club match data status: %w", err)
+	}
+	return cm.MatchID, result, nil
+}
+
+// afterSetTeam recalculates a saved team's score and publishes
+// FFL.ClubMatchUpdated. Failures are logged, not returned: the team is saved.
+func (c *Commands) afterSetTeam(ctx context.Context, clubMatchID, matchID int, result []domain.PlayerMatch) TeamSubmission {
 	// Recalculate scores now that the team is persisted and AFL stats may already be available.
-	if err := c.RecalculateScore(ctx, params.ClubMatchID); err != nil {
-		slog.WarnContext(ctx, "recalculate club match score failed after SetTeam", slog.Int("club_match_id", params.ClubMatchID), slog.Any("error", err))
+	if err := c.RecalculateScore(ctx, clubMatchID); err != nil {
+		slog.WarnContext(ctx, "recalculate club match score failed after SetTeam", slog.Int("club_match_id", clubMatchID), slog.Any("error", err))
 	}
 
 	// Reload player_matches after score recalculation so the snapshot is current.
-	latest, err := c.playerMatches.FindByClubMatchID(ctx, params.ClubMatchID)
+	latest, err := c.playerMatches.FindByClubMatchID(ctx, clubMatchID)
 	if err != nil {
-		slog.WarnContext(ctx, "reload player_matches failed after SetTeam", slog.Int("club_match_id", params.ClubMatchID), slog.Any("error", err))
+		slog.WarnContext(ctx, "reload player_matches failed after SetTeam", slog.Int("club_match_id", clubMatchID), slog.Any("error", err))
 		latest = result
 	}
 
 	match, err := c.matches.FindByID(ctx, matchID)
 	if err != nil {
 		slog.WarnContext(ctx, "failed to load match for FflClubMatchUpdated event", slog.Int("match_id", matchID), slog.Any("error", err))
-		return result, nil
+		return newTeamSubmission(result)
 	}
 
-	cm, err := c.clubMatches.FindByID(ctx, params.ClubMatchID)
+	cm, err := c.clubMatches.FindByID(ctx, clubMatchID)
 	if err != nil {
-		slog.WarnContext(ctx, "failed to load club_match for FflClubMatchUpdated event", slog.Int("club_match_id", params.ClubMatchID), slog.Any("error", err))
-		return result, nil
+		slog.WarnContext(ctx, "failed to load club_match for FflClubMatchUpdated event", slog.Int("club_match_id", clubMatchID), slog.Any("error", err))
+		return newTeamSubmission(result)
 	}
 
 	b, err := json.Marshal(events.FflClubMatchUpdatedPayload{
-		ClubMatchID:   params.ClubMatchID,
+		ClubMatchID:   clubMatchID,
 		MatchID:       matchID,
 		RoundID:       match.RoundID,
 		DataStatus:    string(cm.DataStatus),
@@ -134,10 +202,10 @@ func (c *Commands) SetTeam(ctx context.Context, params SetTeamParams) ([]domain.
 	})
 	if err == nil {
 		if err := c.dispatcher.Publish(ctx, events.FflClubMatchUpdated, b); err != nil {
-			slog.WarnContext(ctx, "publish FflClubMatchUpdated failed", slog.Int("club_match_id", params.ClubMatchID), slog.Any("error", err))
+			slog.WarnContext(ctx, "publish FflClubMatchUpdated failed", slog.Int("club_match_id", clubMatchID), slog.Any("error", err))
 		}
 	}
-	return result, nil
+	return newTeamSubmission(result)
 }
 
 // DeclareSubs records substitution and interchange decisions for a club match.
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidTeamSheet = errors.New("invalid team sheet")
+
+// SubmissionFormat is how a team submission is written: a forum post, read
+// with the club's post format, or a team sheet exported from a spreadsheet.
+type SubmissionFormat string
+
+const (
+	SubmissionFormatForum SubmissionFormat = "forum"
+	SubmissionFormatCSV   SubmissionFormat = "csv"
+	SubmissionFormatJSON  SubmissionFormat = "json"
+)
+
+// parseSubmission reads the player rows from a submission in its format,
+// returning them with the name of the format they were read with. A forum
+// post is read with the club's post format; a team sheet with the sheet
+// parser for its format, which ignores the post format it's given.
+func (c *DataOpsCommands) parseSubmission(ctx context.Context, params ParseTeamSubmissionParams) ([]ParsedPlayerRow, string, error) {
+	if params.Format == "" || params.Format == SubmissionFormatForum {
+		format, err := c.postFormatFor(ctx, params.ClubSeasonID, params.TeamName, params.Post)
+		if err != nil {
+			return nil, "", err
+		}
+		rows, err := c.teamParser.Parse(ctx, format, params.Post)
+		if err != nil {
+			return nil, "", fmt.Errorf("parse forum post: %w", err)
+		}
+		return rows, format.Name, nil
+	}
+
+	parser, ok := c.sheetParsers[params.Format]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: no parser for %s team sheets", ErrInvalidTeamSheet, params.Format)
+	}
+	name := string(params.Format)
+	rows, err := parser.Parse(ctx, PostFormat{Name: name}, params.Post)
+	if err != nil {
+		return nil, "", fmt.Errorf("parse %s team sheet: %w", name, err)
+	}
+	return rows, name, nil
+}
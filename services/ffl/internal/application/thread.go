@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"xffl/services/ffl/internal/domain"
+)
+
+// ParseThreadParams are the inputs to ParseThread.
+type ParseThreadParams struct {
+	RoundID int
+	Thread  string // a whole forum thread page, as pasted
+	DryRun  bool   // don't record the posts as submissions
+}
+
+// ThreadTeam is one team post found in a thread, resolved against the squad
+// of the club it was matched to.
+type ThreadTeam struct {
+	Author      string
+	Format      string // name of the post format the post was read with
+	Post        string
+	ClubMatchID int // 0 when no club playing the round could be matched
+	Result      ParseTeamSubmissionResult
+}
+
+// ParseThreadResult is a round's thread, split into teams for review before
+// confirming with ImportThreadTeams.
+type ParseThreadResult struct {
+	RoundID int
+	Teams   []ThreadTeam
+	// MissingClubMatchIDs are the round's club matches no team post was found for.
+	MissingClubMatchIDs []int
+}
+
+// roundClub is a club playing in a round.
+type roundClub struct {
+	clubMatchID  int
+	clubSeasonID int
+	club         domain.Club
+}
+
+// ParseThread splits a pasted forum thread page into posts, reads each post
+// that holds a team, and maps it to the club match of the club that posted
+// it. Posts without player rows (chat, score-only replies) are dropped. When
+// a club posts more than once, its latest post wins. Every post matched to a
+// club match is recorded as a submission for audit, unless DryRun is set; no
+// team is saved.
+func (c *DataOpsCommands) ParseThread(ctx context.Context, params ParseThreadParams) (ParseThreadResult, error) {
+	clubs, err := c.roundClubs(ctx, params.RoundID)
+	if err != nil {
+		return ParseThreadResult{}, err
+	}
+	registered, err := c.postFormats.FindAll(ctx)
+	if err != nil {
+		return ParseThreadResult{}, fmt.Errorf("load post formats: %w", err)
+	}
+	formats := append(registered, c.teamParser.Formats()...)
+
+	result := ParseThreadResult{RoundID: params.RoundID}
+	teamByClubMatch := make(map[int]int) // club match ID → index into result.Teams
+	candidates := make(map[int][]PlayerCandidate)
+	for _, post := range c.teamParser.SplitThread(params.Thread) {
+		format, ok := DetectPostFormat(formats, post.Body)
+		if !ok {
+			continue
+		}
+		rc, matched := threadClub(clubs, format, post.Author)
+		if matched {
+			// A club's registered format reads its posts, whatever was detected.
+			for _, f := range registered {
+				if f.ClubID == rc.club.ID {
+					format = f
+				}
+			}
+		}
+
+		rows, err := c.teamParser.Parse(ctx, format, post.Body)
+		if err != nil {
+			return ParseThreadResult{}, fmt.Errorf("parse post by %q: %w", post.Author, err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		if matched {
+			if _, loaded := candidates[rc.clubSeasonID]; !loaded {
+				pool, err := c.squadCandidates(ctx, rc.clubSeasonID)
+				if err != nil {
+					return ParseThreadResult{}, err
+				}
+				candidates[rc.clubSeasonID] = pool
+			}
+		}
+		resolved, needsReview, err := c.resolveRows(ctx, rows, candidates[rc.clubSeasonID])
+		if err != nil {
+			return ParseThreadResult{}, err
+		}
+
+		var submissionID int
+		if matched && !params.DryRun {
+			submissionID, err = c.recordSubmission(ctx, SubmissionRecord{
+				ClubMatchID: rc.clubMatchID,
+				Author:      post.Author,
+				Format:      format.Name,
+				Post:        post.Body,
+				Parsed:      resolved,
+			})
+			if err != nil {
+				return ParseThreadResult{}, err
+			}
+		}
+
+		team := ThreadTeam{
+			Author:      post.Author,
+			Format:      format.Name,
+			Post:        post.Body,
+			ClubMatchID: rc.clubMatchID,
+			Result: ParseTeamSubmissionResult{
+				ClubSeasonID:    rc.clubSeasonID,
+				SubmissionID:    submissionID,
+				ResolvedPlayers: resolved,
+				NeedsReview:     needsReview,
+			},
+		}
+		if i, seen := teamByClubMatch[rc.clubMatchID]; matched && seen {
+			result.Teams[i] = team
+			continue
+		}
+		if matched {
+			teamByClubMatch[rc.clubMatchID] = len(result.Teams)
+		}
+		result.Teams = append(result.Teams, team)
+	}
+
+	for _, rc := range clubs {
+		if _, ok := teamByClubMatch[rc.clubMatchID]; !ok {
+			result.MissingClubMatchIDs = append(result.MissingClubMatchIDs, rc.clubMatchID)
+		}
+	}
+	return result, nil
+}
+
+// ImportThreadTeams saves every confirmed team from a thread in one
+// transaction: if any team is invalid, none is saved. Names of low-confidence
+// matches the caller confirmed are learned as aliases, and each confirmed
+// team is recorded against the submission it was parsed from in the same
+// transaction.
+func (c *DataOpsCommands) ImportThreadTeams(ctx context.Context, teams []ImportRoundTeamsParams) ([]TeamSubmission, error) {
+	params := make([]SetTeamParams, len(teams))
+	for i, t := range teams {
+		if err := c.checkSubmission(ctx, t); err != nil {
+			return nil, err
+		}
+		params[i] = SetTeamParams{ClubMatchID: t.ClubMatchID, Entries: teamEntries(t.ResolvedPlayers)}
+	}
+	subs, err := c.commands.setTeams(ctx, params, func(repos WriteRepos) error {
+		for _, t := range teams {
+			if err := confirmSubmission(ctx, repos.Submissions, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range teams {
+		c.learnAliases(ctx, t.ResolvedPlayers)
+	}
+	return subs, nil
+}
+
+// roundClubs loads the clubs playing in a round with their club matches.
+func (c *DataOpsCommands) roundClubs(ctx context.Context, roundID int) ([]roundClub, error) {
+	matches, err := c.commands.matches.FindByRoundID(ctx, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("load matches for round %d: %w", roundID, err)
+	}
+	var out []roundClub
+	var clubIDs []int
+	for _, m := range matches {
+		for _, cmID := range []int{m.Home.ID, m.Away.ID} {
+			cm, err := c.commands.clubMatches.FindByID(ctx, cmID)
+			if err != nil {
+				return nil, fmt.Errorf("load club match %d: %w", cmID, err)
+			}
+			cs, err := c.commands.clubSeasons.FindByID(ctx, cm.ClubSeasonID)
+			if err != nil {
+				return nil, fmt.Errorf("load club season %d: %w", cm.ClubSeasonID, err)
+			}
+			out = append(out, roundClub{clubMatchID: cm.ID, clubSeasonID: cs.ID, club: domain.Club{ID: cs.ClubID}})
+			clubIDs = append(clubIDs, cs.ClubID)
+		}
+	}
+	clubs, err := c.clubs.FindByIDs(ctx, clubIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load clubs: %w", err)
+	}
+	for i := range out {
+		out[i].club = clubs[out[i].club.ID]
+	}
+	return out, nil
+}
+
+// squadCandidates builds the candidate pool for a club season's squad.
+func (c *DataOpsCommands) squadCandidates(ctx context.Context, clubSeasonID int) ([]PlayerCandidate, error) {
+	pss, err := c.commands.playerSeasons.FindByClubSeasonID(ctx, clubSeasonID)
+	if err != nil {
+		return nil, fmt.Errorf("load squad for club season %d: %w", clubSeasonID, err)
+	}
+	ids := make([]int, len(pss))
+	for i, ps := range pss {
+		ids[i] = ps.ID
+	}
+	players, err := c.commands.playerSeasons.FindPlayersForPlayerSeasonIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load players for club season %d: %w", clubSeasonID, err)
+	}
+	aflIDToPlayerSeasonID := make(map[int]int, len(players))
+	for psID, p := range players {
+		aflIDToPlayerSeasonID[p.AFLPlayerID] = psID
+	}
+	candidates, err := c.LookupCandidates(ctx, aflIDToPlayerSeasonID)
+	if err != nil {
+		return nil, fmt.Errorf("lookup candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// threadClub finds the club in the round a post belongs to: the club whose
+// registered format it was read with, else the club the post's author or
+// its format is named after.
+func threadClub(clubs []roundClub, format PostFormat, author string) (roundClub, bool) {
+	if format.ClubID != 0 {
+		for _, rc := range clubs {
+			if rc.club.ID == format.ClubID {
+				return rc, true
+			}
+		}
+	}
+	for _, name := range []string{author, format.Name} {
+		for _, rc := range clubs {
+			if clubNameMatches(name, rc.club.Name) {
+				return rc, true
+			}
+		}
+	}
+	return roundClub{}, false
+}
+
+// clubNameMatches reports whether name, as written on the forum, names club:
+// the same name ignoring spaces, one a prefix of the other ("ruiboy" for
+// "Ruiboys"), or the club's initials ("THC" for "The Howling Cows").
+func clubNameMatches(name, club string) bool {
+	words := strings.Fields(NormaliseAlias(club))
+	n := strings.ReplaceAll(NormaliseAlias(name), " ", "")
+	cl := strings.Join(words, "")
+	if n == "" || cl == "" {
+		return false
+	}
+	if n == cl || (len(n) >= 3 && strings.HasPrefix(cl, n)) || (len(cl) >= 3 && strings.HasPrefix(n, cl)) {
+		return true
+	}
+	var initials strings.Builder
+	for _, w := range words {
+		initials.WriteRune([]rune(w)[0])
+	}
+	return n == initials.String()
+}
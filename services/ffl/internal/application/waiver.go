@@ -0,0 +1,346 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"xffl/contracts/events"
+	"xffl/services/ffl/internal/domain"
+	"xffl/shared/clock"
+	sharedevents "xffl/shared/events"
+)
+
+// SubmitWaiverClaimParams are the inputs to SubmitWaiverClaim.
+type SubmitWaiverClaimParams struct {
+	RoundID            int
+	ClubSeasonID       int
+	AFLPlayerSeasonID  int
+	DropPlayerSeasonID int
+	Priority           int
+}
+
+// WaiverCommands runs the in-season waiver wire. Clubs lodge claims against a
+// round; when the round's waivers run, claims are decided in reverse-ladder
+// order and successful clubs swap the dropped player for the claimed one from
+// the next round.
+type WaiverCommands struct {
+	tx            TxManager
+	clock         clock.Clock
+	dispatcher    sharedevents.Dispatcher
+	playerLookup  PlayerLookup
+	rounds        domain.RoundRepository
+	clubSeasons   domain.ClubSeasonRepository
+	playerSeasons domain.PlayerSeasonRepository
+	waivers       domain.WaiverRepository
+}
+
+func NewWaiverCommands(
+	tx TxManager,
+	clk clock.Clock,
+	dispatcher sharedevents.Dispatcher,
+	lookup PlayerLookup,
+	rounds domain.RoundRepository,
+	clubSeasons domain.ClubSeasonRepository,
+	playerSeasons domain.PlayerSeasonRepository,
+	waivers domain.WaiverRepository,
+) *WaiverCommands {
+	return &WaiverCommands{
+		tx:            tx,
+		clock:         clk,
+		dispatcher:    dispatcher,
+		playerLookup:  lookup,
+		rounds:        rounds,
+		clubSeasons:   clubSeasons,
+		playerSeasons: playerSeasons,
+		waivers:       waivers,
+	}
+}
+
+// ScheduleWaivers sets when a round's waivers are processed. Rescheduling is
+// allowed until they have run. A season's last round can't have waivers, as
+// there is no following round for claimed players to join from.
+func (c *WaiverCommands) ScheduleWaivers(ctx context.Context, roundID int, processAt time.Time) (domain.WaiverSchedule, error) {
+	round, err := c.rounds.FindByID(ctx, roundID)
+	if err != nil {
+		return domain.WaiverSchedule{}, err
+	}
+	if _, err := c.nextRound(ctx, round); err != nil {
+		return domain.WaiverSchedule{}, err
+	}
+	if err := c.checkNotProcessed(ctx, c.waivers, roundID); err != nil {
+		return domain.WaiverSchedule{}, err
+	}
+	var result domain.WaiverSchedule
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		s, err := repos.Waivers.UpsertSchedule(ctx, domain.WaiverSchedule{RoundID: roundID, ProcessAt: processAt})
+		if err != nil {
+			return err
+		}
+		result = s
+		return nil
+	})
+	return result, err
+}
+
+// SubmitWaiverClaim lodges a club's claim for an unowned AFL player, paired
+// with the squad player the club will drop if the claim succeeds.
+func (c *WaiverCommands) SubmitWaiverClaim(ctx context.Context, p SubmitWaiverClaimParams) (domain.WaiverClaim, error) {
+	if p.Priority < 1 {
+		return domain.WaiverClaim{}, fmt.Errorf("waiver: priority must be at least 1")
+	}
+	round, err := c.rounds.FindByID(ctx, p.RoundID)
+	if err != nil {
+		return domain.WaiverClaim{}, err
+	}
+	if err := c.checkNotProcessed(ctx, c.waivers, p.RoundID); err != nil {
+		return domain.WaiverClaim{}, err
+	}
+
+	drop, err := c.playerSeasons.FindByID(ctx, p.DropPlayerSeasonID)
+	if err != nil {
+		return domain.WaiverClaim{}, fmt.Errorf("find drop player season: %w", err)
+	}
+	if drop.ClubSeasonID != p.ClubSeasonID || drop.ToRoundID != nil {
+		return domain.WaiverClaim{}, fmt.Errorf("waiver: player season %d is not in club season %d's squad", p.DropPlayerSeasonID, p.ClubSeasonID)
+	}
+
+	owned, _, err := c.seasonSquads(ctx, c.playerSeasons, round.SeasonID)
+	if err != nil {
+		return domain.WaiverClaim{}, err
+	}
+	if owned[p.AFLPlayerSeasonID] {
+		return domain.WaiverClaim{}, fmt.Errorf("waiver: %s", domain.WaiverFailPlayerOwned)
+	}
+
+	var result domain.WaiverClaim
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		claim, err := repos.Waivers.CreateClaim(ctx, domain.WaiverClaim{
+			RoundID:            p.RoundID,
+			ClubSeasonID:       p.ClubSeasonID,
+			AFLPlayerSeasonID:  p.AFLPlayerSeasonID,
+			DropPlayerSeasonID: p.DropPlayerSeasonID,
+			Priority:           p.Priority,
+			Status:             domain.WaiverClaimStatusPending,
+		})
+		if err != nil {
+			return err
+		}
+		result = claim
+		return nil
+	})
+	return result, err
+}
+
+// CancelWaiverClaim withdraws a pending claim.
+func (c *WaiverCommands) CancelWaiverClaim(ctx context.Context, claimID int) (domain.WaiverClaim, error) {
+	var result domain.WaiverClaim
+	err := c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		claim, err := repos.Waivers.FindClaimByID(ctx, claimID)
+		if err != nil {
+			return err
+		}
+		if claim.Status != domain.WaiverClaimStatusPending {
+			return domain.ErrWaiverClaimClosed
+		}
+		claim.Status = domain.WaiverClaimStatusCancelled
+		if err := repos.Waivers.UpdateClaim(ctx, claim); err != nil {
+			return err
+		}
+		result = claim
+		return nil
+	})
+	return result, err
+}
+
+// ProcessDueWaivers processes every round whose scheduled waiver time has
+// passed. It is safe to call repeatedly; processed rounds are skipped. A round
+// that fails is logged and retried on the next call without holding up the
+// others.
+func (c *WaiverCommands) ProcessDueWaivers(ctx context.Context) error {
+	due, err := c.waivers.FindDueSchedules(ctx, c.clock.Now())
+	if err != nil {
+		return fmt.Errorf("find due waiver schedules: %w", err)
+	}
+	for _, s := range due {
+		if _, err := c.ProcessWaivers(ctx, s.RoundID); err != nil {
+			slog.WarnContext(ctx, "process waivers failed", slog.Int("round_id", s.RoundID), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+// ProcessWaivers decides every pending claim for a round, regardless of its
+// schedule. Successful claims end the dropped player's squad membership at
+// this round and add the claimed player from the next round. One
+// FFL.WaiverClaimProcessed event is published per decided claim.
+func (c *WaiverCommands) ProcessWaivers(ctx context.Context, roundID int) ([]domain.WaiverClaim, error) {
+	round, err := c.rounds.FindByID(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	next, err := c.nextRound(ctx, round)
+	if err != nil {
+		return nil, err
+	}
+	clubSeasons, err := c.clubSeasons.FindBySeasonID(ctx, round.SeasonID)
+	if err != nil {
+		return nil, fmt.Errorf("find club seasons: %w", err)
+	}
+	order := domain.ReverseLadderOrder(clubSeasons, clubSeasons)
+
+	now := c.clock.Now()
+	var results []domain.WaiverClaim
+	err = c.tx.WithTx(ctx, func(repos WriteRepos) error {
+		if err := c.checkNotProcessed(ctx, repos.Waivers, roundID); err != nil {
+			return err
+		}
+		owned, squad, err := c.seasonSquads(ctx, repos.PlayerSeasons, round.SeasonID)
+		if err != nil {
+			return err
+		}
+		claims, err := repos.Waivers.FindClaimsByRoundID(ctx, roundID)
+		if err != nil {
+			return fmt.Errorf("find waiver claims: %w", err)
+		}
+
+		results = domain.ResolveWaivers(order, claims, owned, squad)
+		for i := range results {
+			claim := &results[i]
+			if claim.Status == domain.WaiverClaimStatusSucceeded {
+				ps, err := c.swapPlayer(ctx, repos, *claim, round.ID, next.ID)
+				if err != nil {
+					return fmt.Errorf("claim %d: %w", claim.ID, err)
+				}
+				claim.PlayerSeasonID = &ps.ID
+			}
+			claim.ProcessedAt = &now
+			if err := repos.Waivers.UpdateClaim(ctx, *claim); err != nil {
+				return err
+			}
+		}
+
+		// A manual run of an unscheduled round still records that it ran.
+		if _, err := repos.Waivers.FindScheduleByRoundID(ctx, roundID); errors.Is(err, domain.ErrNotFound) {
+			if _, err := repos.Waivers.UpsertSchedule(ctx, domain.WaiverSchedule{RoundID: roundID, ProcessAt: now}); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+		return repos.Waivers.MarkScheduleProcessed(ctx, roundID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, claim := range results {
+		c.publishClaimProcessed(ctx, claim, next.ID)
+	}
+	return results, nil
+}
+
+// swapPlayer adds the claimed AFL player to the club's squad from fromRoundID
+// and ends the dropped player's membership at toRoundID.
+func (c *WaiverCommands) swapPlayer(ctx context.Context, repos WriteRepos, claim domain.WaiverClaim, toRoundID, fromRoundID int) (domain.PlayerSeason, error) {
+	aflPlayerID, err := c.playerLookup.LookupPlayerSeason(ctx, claim.AFLPlayerSeasonID)
+	if err != nil {
+		return domain.PlayerSeason{}, fmt.Errorf("lookup AFL player season: %w", err)
+	}
+	player, err := repos.Players.FindByAFLPlayerID(ctx, aflPlayerID)
+	if errors.Is(err, domain.ErrNotFound) {
+		player, err = repos.Players.Create(ctx, aflPlayerID)
+	}
+	if err != nil {
+		return domain.PlayerSeason{}, err
+	}
+	ps, err := repos.PlayerSeasons.Create(ctx, player.ID, claim.ClubSeasonID, &fromRoundID, claim.AFLPlayerSeasonID, nil)
+	if err != nil {
+		return domain.PlayerSeason{}, err
+	}
+	if err := repos.PlayerSeasons.SetEndRound(ctx, claim.DropPlayerSeasonID, toRoundID); err != nil {
+		return domain.PlayerSeason{}, err
+	}
+	return ps, nil
+}
+
+// nextRound returns the round after r in its season.
+func (c *WaiverCommands) nextRound(ctx context.Context, r domain.Round) (domain.Round, error) {
+	rounds, err := c.rounds.FindBySeasonID(ctx, r.SeasonID)
+	if err != nil {
+		return domain.Round{}, fmt.Errorf("find rounds: %w", err)
+	}
+	for i, candidate := range rounds {
+		if candidate.ID == r.ID && i+1 < len(rounds) {
+			return rounds[i+1], nil
+		}
+	}
+	return domain.Round{}, fmt.Errorf("waiver: round %d: %w", r.ID, domain.ErrNoFollowingRound)
+}
+
+// seasonSquads returns the AFL player seasons owned by any club in the season,
+// and a map of each active squad player season ID to its club season.
+func (c *WaiverCommands) seasonSquads(ctx context.Context, playerSeasons domain.PlayerSeasonRepository, seasonID int) (map[int]bool, map[int]int, error) {
+	clubSeasons, err := c.clubSeasons.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find club seasons: %w", err)
+	}
+	owned := make(map[int]bool)
+	squad := make(map[int]int)
+	for _, cs := range clubSeasons {
+		players, err := playerSeasons.FindByClubSeasonID(ctx, cs.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("find squad for club season %d: %w", cs.ID, err)
+		}
+		for _, ps := range players {
+			if ps.ToRoundID != nil {
+				continue
+			}
+			owned[ps.AFLPlayerSeasonID] = true
+			squad[ps.ID] = cs.ID
+		}
+	}
+	return owned, squad, nil
+}
+
+func (c *WaiverCommands) checkNotProcessed(ctx context.Context, waivers domain.WaiverRepository, roundID int) error {
+	s, err := waivers.FindScheduleByRoundID(ctx, roundID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("find waiver schedule: %w", err)
+	}
+	if s.ProcessedAt != nil {
+		return domain.ErrWaiversProcessed
+	}
+	return nil
+}
+
+func (c *WaiverCommands) publishClaimProcessed(ctx context.Context, claim domain.WaiverClaim, fromRoundID int) {
+	payload := events.FflWaiverClaimProcessedPayload{
+		ClaimID:            claim.ID,
+		RoundID:            claim.RoundID,
+		ClubSeasonID:       claim.ClubSeasonID,
+		AFLPlayerSeasonID:  claim.AFLPlayerSeasonID,
+		DropPlayerSeasonID: claim.DropPlayerSeasonID,
+		Status:             string(claim.Status),
+		PlayerSeasonID:     claim.PlayerSeasonID,
+	}
+	if claim.FailureReason != nil {
+		payload.FailureReason = *claim.FailureReason
+	}
+	if claim.Status == domain.WaiverClaimStatusSucceeded {
+		payload.FromRoundID = &fromRoundID
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	if err := c.dispatcher.Publish(ctx, events.FflWaiverClaimProcessed, b); err != nil {
+		slog.WarnContext(ctx, "publish FflWaiverClaimProcessed failed", slog.Int("waiver_claim_id", claim.ID), slog.Any("error", err))
+	}
+}
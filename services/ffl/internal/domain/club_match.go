@@ -143,6 +143,66 @@ func validateTeam(entries []PlayerMatch) error {
 	return nil
 }
 
+// PositionGap is a position with starter slots left unfilled.
+type PositionGap struct {
+	Position Position
+	Empty    int
+}
+
+// TeamCompleteness reports what a team is missing compared with a full sheet:
+// every starter slot filled, every position backed up on the bench, and an
+// interchange set. It is advisory only — validateTeam still accepts partial
+// teams and they are scored as submitted.
+type TeamCompleteness struct {
+	EmptySlots         []PositionGap // in Positions order
+	UncoveredPositions []Position    // positions no bench player backs up, in Positions order
+	NoInterchange      bool
+}
+
+// Complete reports whether nothing is missing.
+func (c TeamCompleteness) Complete() bool {
+	return len(c.EmptySlots) == 0 && len(c.UncoveredPositions) == 0 && !c.NoInterchange
+}
+
+// Completeness reports what the club match's current team is missing.
+func (cm ClubMatch) Completeness() TeamCompleteness {
+	return CheckTeamCompleteness(cm.PlayerMatches)
+}
+
+// CheckTeamCompleteness compares a team against a full sheet. Entries are
+// assumed to have passed validateTeam.
+func CheckTeamCompleteness(entries []PlayerMatch) TeamCompleteness {
+	starters := make(map[Position]int)
+	covered := make(map[Position]bool)
+	var c TeamCompleteness
+	c.NoInterchange = true
+
+	for _, e := range entries {
+		if e.isBench() {
+			for _, pos := range parsePositions(*e.BackupPositions) {
+				covered[pos] = true
+			}
+			if e.InterchangePosition != nil {
+				c.NoInterchange = false
+			}
+			continue
+		}
+		if e.Position != nil {
+			starters[*e.Position]++
+		}
+	}
+
+	for _, pos := range Positions {
+		if empty := PositionSlots[pos] - starters[pos]; empty > 0 {
+			c.EmptySlots = append(c.EmptySlots, PositionGap{Position: pos, Empty: empty})
+		}
+		if !covered[pos] {
+			c.UncoveredPositions = append(c.UncoveredPositions, pos)
+		}
+	}
+	return c
+}
+
 // containsPosition checks whether a comma-separated positions string contains pos.
 func containsPosition(positions string, pos Position) bool {
 	for _, p := range strings.Split(positions, ",") {
@@ -233,10 +293,53 @@ func (cm ClubMatch) DeclareSubs(subbedOutIDs []int, interchangeApplied bool) ([]
 //     subbed starters are covered via BackupPositions; interchanged starters are swapped with
 //     the interchange bench player. Bench players stay named in both modes.
 func (cm ClubMatch) Score() int {
+	total := 0
+	for _, s := range cm.Slots() {
+		total += s.Scorer.Score
+	}
+	return total
+}
+
+// Slot is a starter's place in the team and the player whose score counts for
+// it: the starter, or the bench player who came on for them.
+type Slot struct {
+	Starter  PlayerMatch
+	Scorer   PlayerMatch
+	Replaced bool // a bench player's score counts for the slot
+}
+
+// Slots returns every starter slot in team-sheet order, filled the way Score
+// counts it.
+func (cm ClubMatch) Slots() []Slot {
+	starters := make(map[Position][]*PlayerMatch)
+	var bench []*PlayerMatch
+	for i := range cm.PlayerMatches {
+		pm := &cm.PlayerMatches[i]
+		if pm.isBench() {
+			bench = append(bench, pm)
+		} else if pm.Position != nil {
+			starters[*pm.Position] = append(starters[*pm.Position], pm)
+		}
+	}
+
+	filled := make(map[Position][]*PlayerMatch, len(starters))
+	for pos, slots := range starters {
+		filled[pos] = append([]*PlayerMatch(nil), slots...)
+	}
 	if cm.isTMMode() {
-		return cm.scoreTM()
+		fillTM(filled, bench)
+	} else {
+		fillAuto(filled, bench)
+	}
+
+	var out []Slot
+	for _, pos := range Positions {
+		for si, starter := range starters[pos] {
+			scorer := filled[pos][si]
+			out = append(out, Slot{Starter: *starter, Scorer: *scorer, Replaced: scorer != starter})
+		}
 	}
-	return cm.scoreAuto()
+	return out
 }
 
 // isTMMode returns true if any starter has an explicit TM decision recorded.
@@ -252,20 +355,8 @@ func (cm ClubMatch) isTMMode() bool {
 	return false
 }
 
-// scoreAuto substitutes all DNP starters and applies interchange where beneficial.
-func (cm ClubMatch) scoreAuto() int {
-	starters := make(map[Position][]*PlayerMatch)
-	var bench []*PlayerMatch
-
-	for i := range cm.PlayerMatches {
-		pm := &cm.PlayerMatches[i]
-		if pm.isBench() {
-			bench = append(bench, pm)
-		} else if pm.Position != nil {
-			starters[*pm.Position] = append(starters[*pm.Position], pm)
-		}
-	}
-
+// fillAuto substitutes all DNP starters and applies interchange where beneficial.
+func fillAuto(starters map[Position][]*PlayerMatch, bench []*PlayerMatch) {
 	used := make(map[int]bool)
 
 	// Substitution: replace each DNP starter with the first eligible bench player.
@@ -310,31 +401,11 @@ func (cm ClubMatch) scoreAuto() int {
 			used[i] = true
 		}
 	}
-
-	total := 0
-	for _, slots := range starters {
-		for _, pm := range slots {
-			total += pm.Score
-		}
-	}
-	return total
 }
 
-// scoreTM applies explicit TM decisions: interchanged starters swap with the interchange
+// fillTM applies explicit TM decisions: interchanged starters swap with the interchange
 // bench player; subbed starters are covered via BackupPositions.
-func (cm ClubMatch) scoreTM() int {
-	starters := make(map[Position][]*PlayerMatch)
-	var bench []*PlayerMatch
-
-	for i := range cm.PlayerMatches {
-		pm := &cm.PlayerMatches[i]
-		if pm.isBench() {
-			bench = append(bench, pm)
-		} else if pm.Position != nil {
-			starters[*pm.Position] = append(starters[*pm.Position], pm)
-		}
-	}
-
+func fillTM(starters map[Position][]*PlayerMatch, bench []*PlayerMatch) {
 	used := make(map[int]bool)
 
 	// Interchange: swap the starter marked interchanged with the interchange bench player.
@@ -370,14 +441,6 @@ func (cm ClubMatch) scoreTM() int {
 			}
 		}
 	}
-
-	total := 0
-	for _, slots := range starters {
-		for _, pm := range slots {
-			total += pm.Score
-		}
-	}
-	return total
 }
 
 type ClubMatchRepository interface {
@@ -302,6 +302,29 @@ func TestClubMatch_Score_AutoModeUnchangedWhenAllNamed(t *testing.T) {
 	assert.Equal(t, 12, cm.Score()) // auto mode applies sub
 }
 
+func TestClubMatch_Slots(t *testing.T) {
+	cm := ClubMatch{PlayerMatches: []PlayerMatch{
+		{ID: 1, Position: pos(PositionKicks), Score: 8},
+		{ID: 2, Position: pos(PositionGoals), AFLStatus: aflSts(AFLStatusDNP)},
+		{ID: 3, Position: pos(PositionGoals), Score: 10},
+		{ID: 4, Score: 12, BackupPositions: strPtr("goals,marks")},
+		{ID: 5, Score: 15, BackupPositions: strPtr("kicks,handballs"), InterchangePosition: strPtr("kicks")},
+	}}
+
+	slots := cm.Slots()
+	require.Len(t, slots, 3)
+	// Team-sheet order: goals before kicks.
+	assert.Equal(t, 2, slots[0].Starter.ID)
+	assert.Equal(t, 4, slots[0].Scorer.ID, "bench subs for the DNP goals starter")
+	assert.True(t, slots[0].Replaced)
+	assert.Equal(t, 3, slots[1].Scorer.ID)
+	assert.False(t, slots[1].Replaced)
+	assert.Equal(t, 1, slots[2].Starter.ID)
+	assert.Equal(t, 5, slots[2].Scorer.ID, "interchange beats the kicks starter")
+	assert.True(t, slots[2].Replaced)
+	assert.Equal(t, 37, cm.Score())
+}
+
 func strPtr(s string) *string { return &s }
 func bpPtr(s string) *string  { return &s }
 func icPtr(s string) *string  { return &s }
@@ -615,3 +638,67 @@ func TestValidateTeam_InvalidCases(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckTeamCompleteness(t *testing.T) {
+	fullBench := func(interchange *string) []PlayerMatch {
+		star, goals, handballs, tackles := PositionStar, PositionGoals, PositionHandballs, PositionTackles
+		return []PlayerMatch{
+			{Position: &star, BackupPositions: bpPtr("star")},
+			{Position: &goals, BackupPositions: bpPtr("goals,kicks"), InterchangePosition: interchange},
+			{Position: &handballs, BackupPositions: bpPtr("handballs,marks")},
+			{Position: &tackles, BackupPositions: bpPtr("tackles,hitouts")},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		entries []PlayerMatch
+		want    TeamCompleteness
+	}{
+		{
+			name:    "full team with bench and interchange is complete",
+			entries: append(validFullTeam(), fullBench(strPtr("goals"))...),
+			want:    TeamCompleteness{},
+		},
+		{
+			name:    "empty team misses everything",
+			entries: nil,
+			want: TeamCompleteness{
+				EmptySlots: []PositionGap{
+					{PositionGoals, 3}, {PositionKicks, 4}, {PositionHandballs, 4},
+					{PositionMarks, 2}, {PositionTackles, 2}, {PositionHitouts, 2}, {PositionStar, 1},
+				},
+				UncoveredPositions: Positions,
+				NoInterchange:      true,
+			},
+		},
+		{
+			name: "bench players do not fill starter slots",
+			entries: func() []PlayerMatch {
+				var entries []PlayerMatch
+				for _, e := range validFullTeam() {
+					if *e.Position != PositionStar {
+						entries = append(entries, e)
+					}
+				}
+				return append(entries, fullBench(strPtr("goals"))...)
+			}(),
+			want: TeamCompleteness{EmptySlots: []PositionGap{{PositionStar, 1}}},
+		},
+		{
+			name:    "full starters with partial bench and no interchange",
+			entries: append(validFullTeam(), fullBench(nil)[1:]...),
+			want: TeamCompleteness{
+				UncoveredPositions: []Position{PositionStar},
+				NoInterchange:      true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckTeamCompleteness(tt.entries)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Complete(), got.Complete())
+		})
+	}
+}
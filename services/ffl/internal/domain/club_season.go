@@ -29,5 +29,6 @@ type ClubSeasonRepository interface {
 	FindBySeasonID(ctx context.Context, seasonID int) ([]ClubSeason, error)
 	FindByID(ctx context.Context, id int) (ClubSeason, error)
 	FindByClubAndSeason(ctx context.Context, clubID int, seasonID int) (ClubSeason, error)
+	FindClubsForClubSeasonIDs(ctx context.Context, ids []int) (map[int]Club, error)
 	Update(ctx context.Context, cs ClubSeason) error
 }
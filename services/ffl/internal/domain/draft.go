@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrDraftNotInProgress   = errors.New("draft is not in progress")
+	ErrDraftNotYourPick     = errors.New("draft: it is not this club's pick")
+	ErrDraftPlayerDrafted   = errors.New("draft: player has already been drafted")
+	ErrDraftPlayerInSquad   = errors.New("draft: player is already in another club's squad")
+	ErrDraftAlreadyStarted  = errors.New("draft has already started")
+	ErrDraftNoRankedPlayers = errors.New("draft: no available players in ranked list")
+	ErrDraftPickMade        = errors.New("draft: pick has already been made")
+)
+
+// DraftStyle controls how pick order repeats across draft rounds.
+type DraftStyle string
+
+const (
+	// DraftStyleLinear repeats the same order every round.
+	DraftStyleLinear DraftStyle = "linear"
+	// DraftStyleSnake reverses the order on every second round.
+	DraftStyleSnake DraftStyle = "snake"
+)
+
+type DraftStatus string
+
+const (
+	DraftStatusPending    DraftStatus = "pending"
+	DraftStatusInProgress DraftStatus = "in_progress"
+	DraftStatusComplete   DraftStatus = "complete"
+)
+
+type DraftPickStatus string
+
+const (
+	DraftPickStatusPending    DraftPickStatus = "pending"
+	DraftPickStatusPicked     DraftPickStatus = "picked"
+	DraftPickStatusAutoPicked DraftPickStatus = "auto_picked"
+	// DraftPickStatusForfeited marks a pick whose clock expired with nothing
+	// available on the club's ranked list.
+	DraftPickStatusForfeited DraftPickStatus = "forfeited"
+)
+
+// Draft is the pre-season FFL draft for a season. The full board of picks is
+// generated when the draft is created; making a pick fills in the next slot.
+type Draft struct {
+	ID             int
+	SeasonID       int
+	Style          DraftStyle
+	Rounds         int
+	PickDuration   time.Duration
+	Status         DraftStatus
+	ClockStartedAt *time.Time
+	Picks          []DraftPick
+}
+
+type DraftPick struct {
+	ID                int
+	DraftID           int
+	PickNumber        int
+	Round             int
+	ClubSeasonID      int
+	AFLPlayerSeasonID *int
+	PlayerSeasonID    *int
+	Status            DraftPickStatus
+	PickedAt          *time.Time
+}
+
+// IsMade reports whether the pick slot has been used (picked, auto-picked or forfeited).
+func (p DraftPick) IsMade() bool {
+	return p.Status != "" && p.Status != DraftPickStatusPending
+}
+
+// DraftRanking is one entry in a club's ranked auto-pick list. Lower Rank is preferred.
+type DraftRanking struct {
+	DraftID           int
+	ClubSeasonID      int
+	AFLPlayerSeasonID int
+	Rank              int
+}
+
+// BuildDraftPicks lays out the full pick board for the given first-round order.
+func BuildDraftPicks(style DraftStyle, order []int, rounds int) ([]DraftPick, error) {
+	if len(order) == 0 {
+		return nil, fmt.Errorf("draft: order must contain at least one club")
+	}
+	if rounds < 1 {
+		return nil, fmt.Errorf("draft: rounds must be at least 1, got %d", rounds)
+	}
+	if style != DraftStyleLinear && style != DraftStyleSnake {
+		return nil, fmt.Errorf("draft: unknown style %q", style)
+	}
+	seen := make(map[int]bool, len(order))
+	for _, id := range order {
+		if seen[id] {
+			return nil, fmt.Errorf("draft: club season %d appears more than once in the order", id)
+		}
+		seen[id] = true
+	}
+
+	picks := make([]DraftPick, 0, len(order)*rounds)
+	for r := 1; r <= rounds; r++ {
+		roundOrder := order
+		if style == DraftStyleSnake && r%2 == 0 {
+			roundOrder = slices.Clone(order)
+			slices.Reverse(roundOrder)
+		}
+		for _, csID := range roundOrder {
+			picks = append(picks, DraftPick{
+				PickNumber:   len(picks) + 1,
+				Round:        r,
+				ClubSeasonID: csID,
+				Status:       DraftPickStatusPending,
+			})
+		}
+	}
+	return picks, nil
+}
+
+// ReverseLadderOrder returns the current season's club season IDs ordered from
+// the bottom of the prior season's ladder to the top. Clubs are matched across
+// seasons by ClubID; clubs with no prior-season record pick first.
+func ReverseLadderOrder(prior, current []ClubSeason) []int {
+	ranked := slices.Clone(prior)
+	slices.SortStableFunc(ranked, func(a, b ClubSeason) int {
+		if a.PremiershipPoints != b.PremiershipPoints {
+			return a.PremiershipPoints - b.PremiershipPoints
+		}
+		switch {
+		case a.Percentage() < b.Percentage():
+			return -1
+		case a.Percentage() > b.Percentage():
+			return 1
+		}
+		return 0
+	})
+
+	byClub := make(map[int]int, len(current))
+	for _, cs := range current {
+		byClub[cs.ClubID] = cs.ID
+	}
+	placed := make(map[int]bool, len(current))
+	var order []int
+	for _, cs := range ranked {
+		if id, ok := byClub[cs.ClubID]; ok && !placed[id] {
+			order = append(order, id)
+			placed[id] = true
+		}
+	}
+	var newcomers []int
+	for _, cs := range current {
+		if !placed[cs.ID] {
+			newcomers = append(newcomers, cs.ID)
+		}
+	}
+	return append(newcomers, order...)
+}
+
+// CurrentPick returns the index of the next pick to be made, or -1 when the board is full.
+func (d Draft) CurrentPick() int {
+	for i, p := range d.Picks {
+		if !p.IsMade() {
+			return i
+		}
+	}
+	return -1
+}
+
+// Deadline returns when the current pick's clock expires. ok is false when
+// there is no running clock.
+func (d Draft) Deadline() (deadline time.Time, ok bool) {
+	if d.Status != DraftStatusInProgress || d.ClockStartedAt == nil || d.CurrentPick() < 0 {
+		return time.Time{}, false
+	}
+	return d.ClockStartedAt.Add(d.PickDuration), true
+}
+
+// Expired reports whether the current pick's clock has run out at now.
+func (d Draft) Expired(now time.Time) bool {
+	deadline, ok := d.Deadline()
+	return ok && !now.Before(deadline)
+}
+
+// Start opens the draft and starts the clock for the first pick.
+func (d *Draft) Start(now time.Time) error {
+	if d.Status != DraftStatusPending {
+		return ErrDraftAlreadyStarted
+	}
+	d.Status = DraftStatusInProgress
+	d.ClockStartedAt = &now
+	return nil
+}
+
+// DraftedAFLPlayerSeasonIDs returns the set of AFL player seasons already taken in this draft.
+func (d Draft) DraftedAFLPlayerSeasonIDs() map[int]bool {
+	out := make(map[int]bool)
+	for _, p := range d.Picks {
+		if p.AFLPlayerSeasonID != nil {
+			out[*p.AFLPlayerSeasonID] = true
+		}
+	}
+	return out
+}
+
+// ValidatePick checks that clubSeasonID is on the clock and that the AFL
+// player has not already been taken in this draft. Squad membership outside the
+// draft is checked by the caller, which has access to the squad repository.
+func (d Draft) ValidatePick(clubSeasonID, aflPlayerSeasonID int) error {
+	if d.Status != DraftStatusInProgress {
+		return ErrDraftNotInProgress
+	}
+	idx := d.CurrentPick()
+	if idx < 0 {
+		return ErrDraftNotInProgress
+	}
+	if d.Picks[idx].ClubSeasonID != clubSeasonID {
+		return ErrDraftNotYourPick
+	}
+	if d.DraftedAFLPlayerSeasonIDs()[aflPlayerSeasonID] {
+		return ErrDraftPlayerDrafted
+	}
+	return nil
+}
+
+// RecordPick fills the current pick slot and advances the clock. The next
+// pick's clock starts at at, so a chain of expired picks is replayed at each
+// deadline rather than all at once. Returns the updated pick.
+func (d *Draft) RecordPick(status DraftPickStatus, aflPlayerSeasonID, playerSeasonID *int, at time.Time) (DraftPick, error) {
+	if d.Status != DraftStatusInProgress {
+		return DraftPick{}, ErrDraftNotInProgress
+	}
+	idx := d.CurrentPick()
+	if idx < 0 {
+		return DraftPick{}, ErrDraftNotInProgress
+	}
+	p := &d.Picks[idx]
+	p.Status = status
+	p.AFLPlayerSeasonID = aflPlayerSeasonID
+	p.PlayerSeasonID = playerSeasonID
+	p.PickedAt = &at
+
+	if d.CurrentPick() < 0 {
+		d.Status = DraftStatusComplete
+		d.ClockStartedAt = nil
+	} else {
+		d.ClockStartedAt = &at
+	}
+	return *p, nil
+}
+
+type DraftRepository interface {
+	Create(ctx context.Context, d Draft) (Draft, error)
+	FindByID(ctx context.Context, id int) (Draft, error)
+	FindBySeasonID(ctx context.Context, seasonID int) (Draft, error)
+	FindInProgress(ctx context.Context) ([]Draft, error)
+	UpdateState(ctx context.Context, d Draft) error
+	// UpdatePick fills a pending pick, failing with ErrDraftPickMade if the
+	// pick has been filled since the draft was loaded.
+	UpdatePick(ctx context.Context, p DraftPick) error
+	FindRankings(ctx context.Context, draftID, clubSeasonID int) ([]DraftRanking, error)
+	ReplaceRankings(ctx context.Context, draftID, clubSeasonID int, aflPlayerSeasonIDs []int) error
+	// ReassignAFLPlayerSeason points the rankings and picks of one AFL player
+	// season at another, dropping a ranking the club already has for it.
+	ReassignAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pickClubs(picks []DraftPick) []int {
+	out := make([]int, len(picks))
+	for i, p := range picks {
+		out[i] = p.ClubSeasonID
+	}
+	return out
+}
+
+func TestBuildDraftPicks(t *testing.T) {
+	tests := []struct {
+		name    string
+		style   DraftStyle
+		order   []int
+		rounds  int
+		want    []int
+		wantErr bool
+	}{
+		{name: "linear", style: DraftStyleLinear, order: []int{1, 2, 3}, rounds: 2, want: []int{1, 2, 3, 1, 2, 3}},
+		{name: "snake", style: DraftStyleSnake, order: []int{1, 2, 3}, rounds: 3, want: []int{1, 2, 3, 3, 2, 1, 1, 2, 3}},
+		{name: "empty order", style: DraftStyleLinear, order: nil, rounds: 1, wantErr: true},
+		{name: "zero rounds", style: DraftStyleSnake, order: []int{1}, rounds: 0, wantErr: true},
+		{name: "unknown style", style: "random", order: []int{1}, rounds: 1, wantErr: true},
+		{name: "duplicate club", style: DraftStyleLinear, order: []int{1, 1}, rounds: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			picks, err := BuildDraftPicks(tt.style, tt.order, tt.rounds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pickClubs(picks))
+			for i, p := range picks {
+				assert.Equal(t, i+1, p.PickNumber)
+				assert.Equal(t, i/len(tt.order)+1, p.Round)
+				assert.Equal(t, DraftPickStatusPending, p.Status)
+			}
+		})
+	}
+}
+
+func TestReverseLadderOrder(t *testing.T) {
+	prior := []ClubSeason{
+		{ID: 11, ClubID: 1, PremiershipPoints: 40, For: 1000, Against: 900},
+		{ID: 12, ClubID: 2, PremiershipPoints: 20, For: 900, Against: 1000},
+		{ID: 13, ClubID: 3, PremiershipPoints: 40, For: 1100, Against: 900},
+		{ID: 14, ClubID: 4, PremiershipPoints: 8, For: 800, Against: 1200},
+	}
+	current := []ClubSeason{
+		{ID: 21, ClubID: 1},
+		{ID: 22, ClubID: 2},
+		{ID: 23, ClubID: 3},
+		{ID: 25, ClubID: 5}, // new club this season
+	}
+	// Club 4 left the league; club 5 is new and picks first.
+	assert.Equal(t, []int{25, 22, 21, 23}, ReverseLadderOrder(prior, current))
+}
+
+func newTestDraft(t *testing.T, start time.Time) Draft {
+	t.Helper()
+	picks, err := BuildDraftPicks(DraftStyleSnake, []int{1, 2}, 2)
+	require.NoError(t, err)
+	d := Draft{ID: 1, Style: DraftStyleSnake, Rounds: 2, PickDuration: time.Minute, Status: DraftStatusPending, Picks: picks}
+	require.NoError(t, d.Start(start))
+	return d
+}
+
+func TestDraft_ValidatePick(t *testing.T) {
+	start := time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)
+
+	t.Run("not started", func(t *testing.T) {
+		d := Draft{Status: DraftStatusPending, Picks: []DraftPick{{ClubSeasonID: 1}}}
+		assert.ErrorIs(t, d.ValidatePick(1, 100), ErrDraftNotInProgress)
+	})
+
+	t.Run("wrong club", func(t *testing.T) {
+		d := newTestDraft(t, start)
+		assert.ErrorIs(t, d.ValidatePick(2, 100), ErrDraftNotYourPick)
+	})
+
+	t.Run("already drafted", func(t *testing.T) {
+		d := newTestDraft(t, start)
+		afl := 100
+		_, err := d.RecordPick(DraftPickStatusPicked, &afl, nil, start)
+		require.NoError(t, err)
+		assert.ErrorIs(t, d.ValidatePick(2, 100), ErrDraftPlayerDrafted)
+		assert.NoError(t, d.ValidatePick(2, 101))
+	})
+}
+
+func TestDraft_RecordPickAdvancesClock(t *testing.T) {
+	start := time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)
+	d := newTestDraft(t, start)
+
+	deadline, ok := d.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, start.Add(time.Minute), deadline)
+	assert.False(t, d.Expired(start.Add(59*time.Second)))
+	assert.True(t, d.Expired(start.Add(time.Minute)))
+
+	at := start.Add(30 * time.Second)
+	afl := 100
+	p, err := d.RecordPick(DraftPickStatusPicked, &afl, nil, at)
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.PickNumber)
+	assert.Equal(t, 1, d.CurrentPick())
+	deadline, _ = d.Deadline()
+	assert.Equal(t, at.Add(time.Minute), deadline)
+
+	for i := 0; i < 3; i++ {
+		_, err := d.RecordPick(DraftPickStatusForfeited, nil, nil, at)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, DraftStatusComplete, d.Status)
+	assert.Equal(t, -1, d.CurrentPick())
+	assert.False(t, d.Expired(at.Add(time.Hour)))
+
+	_, err = d.RecordPick(DraftPickStatusPicked, &afl, nil, at)
+	assert.ErrorIs(t, err, ErrDraftNotInProgress)
+}
+
+func TestDraft_StartTwice(t *testing.T) {
+	d := newTestDraft(t, time.Now())
+	assert.ErrorIs(t, d.Start(time.Now()), ErrDraftAlreadyStarted)
+}
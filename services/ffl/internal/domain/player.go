@@ -13,4 +13,7 @@ type PlayerRepository interface {
 	FindByAFLPlayerID(ctx context.Context, aflPlayerID int) (Player, error)
 	Create(ctx context.Context, aflPlayerID int) (Player, error)
 	Delete(ctx context.Context, id int) error
+	// ReassignAFLPlayer points the players linked to one AFL player at
+	// another, returning how many were.
+	ReassignAFLPlayer(ctx context.Context, fromAFLPlayerID, toAFLPlayerID int) (int, error)
 }
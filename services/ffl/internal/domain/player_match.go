@@ -18,6 +18,17 @@ const (
 	PositionStar      Position = "star"
 )
 
+// Positions lists every position in team-sheet order.
+var Positions = []Position{
+	PositionGoals,
+	PositionKicks,
+	PositionHandballs,
+	PositionMarks,
+	PositionTackles,
+	PositionHitouts,
+	PositionStar,
+}
+
 // PositionSlots defines the maximum number of starter slots per position.
 var PositionSlots = map[Position]int{
 	PositionGoals:     3,
@@ -50,15 +61,22 @@ const (
 
 // AFLStatus is the AFL participation status for this player in this match.
 // Computed from AFL data and propagated via events; dnp is inferred after match finalisation.
+// Before the match it carries the player's AFL availability, if any has been reported.
 type AFLStatus string
 
 const (
-	AFLStatusPlaying AFLStatus = "playing" // AFL match in progress, player has stats
-	AFLStatusPlayed  AFLStatus = "played"  // AFL match final, player participated
-	AFLStatusDNP     AFLStatus = "dnp"     // did not play in AFL match
+	AFLStatusNamed     AFLStatus = "named"     // selected in the AFL club's team
+	AFLStatusEmergency AFLStatus = "emergency" // listed as an AFL emergency
+	AFLStatusInjured   AFLStatus = "injured"   // out injured
+	AFLStatusSuspended AFLStatus = "suspended" // out suspended
+	AFLStatusPlaying   AFLStatus = "playing"   // AFL match in progress, player has stats
+	AFLStatusPlayed    AFLStatus = "played"    // AFL match final, player participated
+	AFLStatusDNP       AFLStatus = "dnp"       // did not play in AFL match
 )
 
 // AFLStats holds the AFL performance statistics used to calculate fantasy scores.
+// Extra holds the AFL service's stats beyond these (contested possessions, time on
+// ground, …) by name, for scoring formulas that use them; the current one doesn't.
 type AFLStats struct {
 	Goals     int
 	Kicks     int
@@ -66,6 +84,7 @@ type AFLStats struct {
 	Marks     int
 	Tackles   int
 	Hitouts   int
+	Extra     map[string]float64
 }
 
 type PlayerMatch struct {
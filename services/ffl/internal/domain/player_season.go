@@ -23,4 +23,9 @@ type PlayerSeasonRepository interface {
 	SetEndRound(ctx context.Context, id int, toRoundID int) error
 	Delete(ctx context.Context, id int) error
 	UpdateDetails(ctx context.Context, id int, notes *string) (PlayerSeason, error)
+	// ReassignAFLPlayerSeason points the player seasons linked to one AFL
+	// player season at another.
+	ReassignAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error
+	// ReassignPlayer moves the player seasons of one player to another.
+	ReassignPlayer(ctx context.Context, fromPlayerID, toPlayerID int) error
 }
@@ -7,9 +7,13 @@ type Season struct {
 	Name        string
 	LeagueID    int
 	AFLSeasonID int
+	// Squad size bounds for every club in the season; nil means no limit.
+	MinSquadSize *int
+	MaxSquadSize *int
 }
 
 type SeasonRepository interface {
 	FindAll(ctx context.Context) ([]Season, error)
 	FindByID(ctx context.Context, id int) (Season, error)
+	UpdateSquadLimits(ctx context.Context, id int, minSize, maxSize *int) (Season, error)
 }
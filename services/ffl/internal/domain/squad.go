@@ -0,0 +1,136 @@
+package domain
+
+import "errors"
+
+var (
+	ErrSquadFull            = errors.New("squad is at its maximum size")
+	ErrSquadBelowMinimum    = errors.New("squad would fall below its minimum size")
+	ErrPlayerInAnotherSquad = errors.New("AFL player is already in another club's squad this season")
+	ErrInvalidSquadLimits   = errors.New("invalid squad limits: sizes must be non-negative and minimum must not exceed maximum")
+)
+
+// ValidateSquadLimits checks a season's squad size bounds. Either may be nil.
+func ValidateSquadLimits(minSize, maxSize *int) error {
+	if (minSize != nil && *minSize < 0) || (maxSize != nil && *maxSize < 0) {
+		return ErrInvalidSquadLimits
+	}
+	if minSize != nil && maxSize != nil && *minSize > *maxSize {
+		return ErrInvalidSquadLimits
+	}
+	return nil
+}
+
+// RoundOrder maps each round ID in a season to its position, so tenure
+// boundaries (from/to round IDs) can be compared.
+type RoundOrder map[int]int
+
+// NewRoundOrder indexes rounds in the order given (season order).
+func NewRoundOrder(rounds []Round) RoundOrder {
+	order := make(RoundOrder, len(rounds))
+	for i, r := range rounds {
+		order[r.ID] = i
+	}
+	return order
+}
+
+// ActiveFrom reports whether ps is still in its squad at or after the given
+// round (nil = start of season), i.e. whether its tenure overlaps an
+// open-ended tenure starting there.
+func (ps PlayerSeason) ActiveFrom(order RoundOrder, roundID *int) bool {
+	if ps.ToRoundID == nil || roundID == nil {
+		return true
+	}
+	return order[*ps.ToRoundID] >= order[*roundID]
+}
+
+// ActiveAfter reports whether ps is still in its squad after the given round.
+func (ps PlayerSeason) ActiveAfter(order RoundOrder, roundID int) bool {
+	return ps.ToRoundID == nil || order[*ps.ToRoundID] > order[roundID]
+}
+
+// CheckSquadAdd enforces the season's maximum squad size when a player joins
+// from fromRoundID. squad is the club's full PlayerSeason history. The squad
+// is measured at its largest from fromRoundID on, so tenures that never
+// overlap count once. Re-adding a player already active in the squad does not
+// grow it.
+func CheckSquadAdd(season Season, order RoundOrder, squad []PlayerSeason, aflPlayerSeasonID int, fromRoundID *int) error {
+	if season.MaxSquadSize == nil {
+		return nil
+	}
+	start := 0
+	if fromRoundID != nil {
+		start = order[*fromRoundID]
+	}
+	// The squad only grows when a tenure starts, so its largest size is at
+	// fromRoundID or at a later tenure's first round.
+	positions := []int{start}
+	var active []PlayerSeason
+	for _, ps := range squad {
+		if !ps.ActiveFrom(order, fromRoundID) {
+			continue
+		}
+		if ps.AFLPlayerSeasonID == aflPlayerSeasonID {
+			return nil
+		}
+		active = append(active, ps)
+		if ps.FromRoundID != nil && order[*ps.FromRoundID] > start {
+			positions = append(positions, order[*ps.FromRoundID])
+		}
+	}
+	size := 0
+	for _, pos := range positions {
+		n := 0
+		for _, ps := range active {
+			if ps.activeAt(order, pos) {
+				n++
+			}
+		}
+		size = max(size, n)
+	}
+	if size >= *season.MaxSquadSize {
+		return ErrSquadFull
+	}
+	return nil
+}
+
+// activeAt reports whether ps is in its squad for the round at position pos.
+func (ps PlayerSeason) activeAt(order RoundOrder, pos int) bool {
+	if ps.FromRoundID != nil && order[*ps.FromRoundID] > pos {
+		return false
+	}
+	return ps.ToRoundID == nil || order[*ps.ToRoundID] >= pos
+}
+
+// CheckSquadRemove enforces the season's minimum squad size when
+// playerSeasonID leaves after toRoundID.
+func CheckSquadRemove(season Season, order RoundOrder, squad []PlayerSeason, playerSeasonID, toRoundID int) error {
+	if season.MinSquadSize == nil {
+		return nil
+	}
+	remaining := 0
+	for _, ps := range squad {
+		if ps.ID != playerSeasonID && ps.ActiveAfter(order, toRoundID) {
+			remaining++
+		}
+	}
+	if remaining < *season.MinSquadSize {
+		return ErrSquadBelowMinimum
+	}
+	return nil
+}
+
+// CheckPlayerExclusive rejects adding an AFL player to clubSeasonID from
+// fromRoundID while they are active in another club of the same season.
+// existing holds every PlayerSeason for the AFL player season; seasonClubs is
+// the set of club season IDs in the FFL season.
+func CheckPlayerExclusive(order RoundOrder, existing []PlayerSeason, seasonClubs map[int]bool, clubSeasonID int, fromRoundID *int) error {
+	for _, ps := range existing {
+		if ps.ClubSeasonID == clubSeasonID || !seasonClubs[ps.ClubSeasonID] {
+			continue
+		}
+		if ps.ActiveFrom(order, fromRoundID) {
+			return ErrPlayerInAnotherSquad
+		}
+	}
+	return nil
+}
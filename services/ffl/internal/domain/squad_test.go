@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestValidateSquadLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max *int
+		wantErr  bool
+	}{
+		{name: "no limits"},
+		{name: "min only", min: intPtr(18)},
+		{name: "equal", min: intPtr(22), max: intPtr(22)},
+		{name: "min above max", min: intPtr(23), max: intPtr(22), wantErr: true},
+		{name: "negative", max: intPtr(-1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSquadLimits(tt.min, tt.max)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSquadLimits)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Rounds 101, 102, 103 in season order.
+var testRoundOrder = NewRoundOrder([]Round{{ID: 101}, {ID: 102}, {ID: 103}, {ID: 104}, {ID: 105}})
+
+func TestCheckSquadAdd(t *testing.T) {
+	season := Season{MaxSquadSize: intPtr(2)}
+	squad := []PlayerSeason{
+		{ID: 1, AFLPlayerSeasonID: 11},
+		{ID: 2, AFLPlayerSeasonID: 12, ToRoundID: intPtr(101)},
+		{ID: 3, AFLPlayerSeasonID: 13, FromRoundID: intPtr(102)},
+	}
+
+	// Two future tenures that never overlap: rounds 102 and 104-105.
+	successive := []PlayerSeason{
+		{ID: 4, AFLPlayerSeasonID: 14, FromRoundID: intPtr(102), ToRoundID: intPtr(102)},
+		{ID: 5, AFLPlayerSeasonID: 15, FromRoundID: intPtr(104), ToRoundID: intPtr(105)},
+	}
+	// The same two tenures sharing round 104.
+	overlapping := []PlayerSeason{
+		{ID: 4, AFLPlayerSeasonID: 14, FromRoundID: intPtr(102), ToRoundID: intPtr(104)},
+		{ID: 5, AFLPlayerSeasonID: 15, FromRoundID: intPtr(104), ToRoundID: intPtr(105)},
+	}
+
+	tests := []struct {
+		name    string
+		season  Season
+		squad   []PlayerSeason
+		afl     int
+		from    *int
+		wantErr error
+	}{
+		{name: "no maximum", season: Season{}, afl: 20},
+		{name: "full from start of season", season: season, afl: 20, wantErr: ErrSquadFull},
+		{name: "full from round 1", season: season, afl: 20, from: intPtr(101), wantErr: ErrSquadFull},
+		{name: "delisted player frees a spot later", season: Season{MaxSquadSize: intPtr(3)}, afl: 20, from: intPtr(102)},
+		{name: "re-adding an active player", season: season, afl: 11},
+		{name: "successive future tenures count once", season: season, squad: successive, afl: 20, from: intPtr(101)},
+		{name: "overlapping future tenures both count", season: season, squad: overlapping, afl: 20, from: intPtr(101), wantErr: ErrSquadFull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := squad
+			if tt.squad != nil {
+				s = tt.squad
+			}
+			err := CheckSquadAdd(tt.season, testRoundOrder, s, tt.afl, tt.from)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckSquadRemove(t *testing.T) {
+	squad := []PlayerSeason{
+		{ID: 1},
+		{ID: 2},
+		{ID: 3, ToRoundID: intPtr(102)},
+	}
+	// After round 101 players 1, 2 and 3 remain; after round 102 only 1 and 2.
+	assert.NoError(t, CheckSquadRemove(Season{}, testRoundOrder, squad, 1, 101))
+	assert.NoError(t, CheckSquadRemove(Season{MinSquadSize: intPtr(2)}, testRoundOrder, squad, 1, 101))
+	assert.ErrorIs(t, CheckSquadRemove(Season{MinSquadSize: intPtr(2)}, testRoundOrder, squad, 1, 102), ErrSquadBelowMinimum)
+}
+
+func TestCheckPlayerExclusive(t *testing.T) {
+	seasonClubs := map[int]bool{1: true, 2: true}
+	tests := []struct {
+		name     string
+		existing []PlayerSeason
+		from     *int
+		wantErr  bool
+	}{
+		{name: "unowned"},
+		{name: "same club", existing: []PlayerSeason{{ClubSeasonID: 1}}},
+		{name: "other league's club", existing: []PlayerSeason{{ClubSeasonID: 9}}},
+		{name: "active in other club", existing: []PlayerSeason{{ClubSeasonID: 2}}, from: intPtr(103), wantErr: true},
+		{name: "other club tenure overlaps", existing: []PlayerSeason{{ClubSeasonID: 2, ToRoundID: intPtr(102)}}, from: intPtr(102), wantErr: true},
+		{name: "other club tenure ended", existing: []PlayerSeason{{ClubSeasonID: 2, ToRoundID: intPtr(101)}}, from: intPtr(102)},
+		{name: "from season start overlaps any tenure", existing: []PlayerSeason{{ClubSeasonID: 2, ToRoundID: intPtr(101)}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPlayerExclusive(testRoundOrder, tt.existing, seasonClubs, 1, tt.from)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrPlayerInAnotherSquad)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
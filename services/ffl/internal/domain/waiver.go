@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+)
+
+var (
+	ErrWaiversProcessed  = errors.New("waivers for this round have already been processed")
+	ErrWaiverClaimClosed = errors.New("waiver claim is no longer pending")
+	ErrNoFollowingRound  = errors.New("round has no following round to add waiver players from")
+)
+
+type WaiverClaimStatus string
+
+const (
+	WaiverClaimStatusPending   WaiverClaimStatus = "pending"
+	WaiverClaimStatusSucceeded WaiverClaimStatus = "succeeded"
+	WaiverClaimStatusFailed    WaiverClaimStatus = "failed"
+	WaiverClaimStatusCancelled WaiverClaimStatus = "cancelled"
+)
+
+// Reasons recorded on failed waiver claims.
+const (
+	WaiverFailPlayerOwned = "player already in a squad"
+	WaiverFailDropMissing = "drop player no longer in squad"
+	WaiverFailNotInOrder  = "club not in waiver order"
+)
+
+// WaiverClaim is a club's request to add an unowned AFL player in exchange for
+// dropping one of its own squad players. Priority orders a club's own claims;
+// 1 is the club's first preference.
+type WaiverClaim struct {
+	ID                 int
+	RoundID            int
+	ClubSeasonID       int
+	AFLPlayerSeasonID  int
+	DropPlayerSeasonID int
+	Priority           int
+	Status             WaiverClaimStatus
+	FailureReason      *string
+	PlayerSeasonID     *int // squad row created for a successful claim
+	ProcessedAt        *time.Time
+}
+
+// WaiverSchedule is when a round's waivers run. ProcessedAt is set once they have.
+type WaiverSchedule struct {
+	RoundID     int
+	ProcessAt   time.Time
+	ProcessedAt *time.Time
+}
+
+// IsDue reports whether the waivers should run at now.
+func (s WaiverSchedule) IsDue(now time.Time) bool {
+	return s.ProcessedAt == nil && !now.Before(s.ProcessAt)
+}
+
+// ResolveWaivers decides the outcome of each pending claim. order lists club
+// season IDs in waiver priority (first = highest). Clubs take turns: the
+// highest-priority club is awarded its most preferred valid claim and then
+// moves to the back of the order. A claim fails when its player is already
+// owned or its drop player has left the club's squad.
+//
+// owned is the set of AFL player season IDs currently in any squad; squad maps
+// each active player season ID to its club season. Neither input is modified.
+// Returns every claim with its outcome, in the order decisions were made.
+func ResolveWaivers(order []int, claims []WaiverClaim, owned map[int]bool, squad map[int]int) []WaiverClaim {
+	taken := make(map[int]bool, len(owned))
+	for id, ok := range owned {
+		taken[id] = ok
+	}
+	dropOwner := make(map[int]int, len(squad))
+	for psID, csID := range squad {
+		dropOwner[psID] = csID
+	}
+
+	byClub := make(map[int][]WaiverClaim)
+	for _, c := range claims {
+		if c.Status == WaiverClaimStatusPending {
+			byClub[c.ClubSeasonID] = append(byClub[c.ClubSeasonID], c)
+		}
+	}
+	for cs := range byClub {
+		slices.SortStableFunc(byClub[cs], func(a, b WaiverClaim) int { return a.Priority - b.Priority })
+	}
+
+	fail := func(c WaiverClaim, reason string) WaiverClaim {
+		c.Status = WaiverClaimStatusFailed
+		c.FailureReason = &reason
+		return c
+	}
+
+	queue := slices.Clone(order)
+	var out []WaiverClaim
+	for {
+		awarded := false
+		for i, cs := range queue {
+			for len(byClub[cs]) > 0 {
+				c := byClub[cs][0]
+				byClub[cs] = byClub[cs][1:]
+				if taken[c.AFLPlayerSeasonID] {
+					out = append(out, fail(c, WaiverFailPlayerOwned))
+					continue
+				}
+				if owner, ok := dropOwner[c.DropPlayerSeasonID]; !ok || owner != cs {
+					out = append(out, fail(c, WaiverFailDropMissing))
+					continue
+				}
+				c.Status = WaiverClaimStatusSucceeded
+				taken[c.AFLPlayerSeasonID] = true
+				delete(dropOwner, c.DropPlayerSeasonID)
+				out = append(out, c)
+				queue = append(slices.Delete(queue, i, i+1), cs)
+				awarded = true
+				break
+			}
+			if awarded {
+				break
+			}
+		}
+		if !awarded {
+			break
+		}
+	}
+
+	// Claims from clubs missing from the order cannot be ranked; fail them
+	// rather than leave them pending forever.
+	for _, cs := range slices.Sorted(maps.Keys(byClub)) {
+		for _, c := range byClub[cs] {
+			out = append(out, fail(c, WaiverFailNotInOrder))
+		}
+	}
+	return out
+}
+
+type WaiverRepository interface {
+	CreateClaim(ctx context.Context, c WaiverClaim) (WaiverClaim, error)
+	FindClaimByID(ctx context.Context, id int) (WaiverClaim, error)
+	FindClaimsByRoundID(ctx context.Context, roundID int) ([]WaiverClaim, error)
+	UpdateClaim(ctx context.Context, c WaiverClaim) error
+	UpsertSchedule(ctx context.Context, s WaiverSchedule) (WaiverSchedule, error)
+	FindScheduleByRoundID(ctx context.Context, roundID int) (WaiverSchedule, error)
+	FindDueSchedules(ctx context.Context, asOf time.Time) ([]WaiverSchedule, error)
+	MarkScheduleProcessed(ctx context.Context, roundID int, at time.Time) error
+	// ReassignPendingAFLPlayerSeason points the pending claims for one AFL
+	// player season at another.
+	ReassignPendingAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type waiverOutcome struct {
+	ID     int
+	Status WaiverClaimStatus
+	Reason string
+}
+
+func outcomes(claims []WaiverClaim) []waiverOutcome {
+	out := make([]waiverOutcome, len(claims))
+	for i, c := range claims {
+		out[i] = waiverOutcome{ID: c.ID, Status: c.Status}
+		if c.FailureReason != nil {
+			out[i].Reason = *c.FailureReason
+		}
+	}
+	return out
+}
+
+func claim(id, club, afl, drop, priority int) WaiverClaim {
+	return WaiverClaim{ID: id, ClubSeasonID: club, AFLPlayerSeasonID: afl, DropPlayerSeasonID: drop, Priority: priority, Status: WaiverClaimStatusPending}
+}
+
+func TestResolveWaivers(t *testing.T) {
+	// Club 1 owns player seasons 11 and 12; club 2 owns 21 and 22.
+	squad := map[int]int{11: 1, 12: 1, 21: 2, 22: 2}
+	owned := map[int]bool{900: true}
+
+	tests := []struct {
+		name   string
+		order  []int
+		claims []WaiverClaim
+		want   []waiverOutcome
+	}{
+		{
+			name:   "higher priority club wins contested player",
+			order:  []int{2, 1},
+			claims: []WaiverClaim{claim(1, 1, 500, 11, 1), claim(2, 2, 500, 21, 1)},
+			want: []waiverOutcome{
+				{ID: 2, Status: WaiverClaimStatusSucceeded},
+				{ID: 1, Status: WaiverClaimStatusFailed, Reason: WaiverFailPlayerOwned},
+			},
+		},
+		{
+			name:  "successful club moves to back of order",
+			order: []int{1, 2},
+			claims: []WaiverClaim{
+				claim(1, 1, 500, 11, 1),
+				claim(2, 1, 501, 12, 2),
+				claim(3, 2, 501, 21, 1),
+			},
+			want: []waiverOutcome{
+				{ID: 1, Status: WaiverClaimStatusSucceeded},
+				{ID: 3, Status: WaiverClaimStatusSucceeded},
+				{ID: 2, Status: WaiverClaimStatusFailed, Reason: WaiverFailPlayerOwned},
+			},
+		},
+		{
+			name:  "drop player can only be used once",
+			order: []int{1},
+			claims: []WaiverClaim{
+				claim(1, 1, 500, 11, 1),
+				claim(2, 1, 501, 11, 2),
+			},
+			want: []waiverOutcome{
+				{ID: 1, Status: WaiverClaimStatusSucceeded},
+				{ID: 2, Status: WaiverClaimStatusFailed, Reason: WaiverFailDropMissing},
+			},
+		},
+		{
+			name:   "drop must be in the claiming club's squad",
+			order:  []int{1},
+			claims: []WaiverClaim{claim(1, 1, 500, 21, 1)},
+			want:   []waiverOutcome{{ID: 1, Status: WaiverClaimStatusFailed, Reason: WaiverFailDropMissing}},
+		},
+		{
+			name:   "already owned player fails and falls through to next preference",
+			order:  []int{1},
+			claims: []WaiverClaim{claim(1, 1, 900, 11, 1), claim(2, 1, 500, 11, 2)},
+			want: []waiverOutcome{
+				{ID: 1, Status: WaiverClaimStatusFailed, Reason: WaiverFailPlayerOwned},
+				{ID: 2, Status: WaiverClaimStatusSucceeded},
+			},
+		},
+		{
+			name:  "cancelled claims are ignored and unordered clubs fail",
+			order: []int{1},
+			claims: []WaiverClaim{
+				{ID: 1, ClubSeasonID: 1, AFLPlayerSeasonID: 500, DropPlayerSeasonID: 11, Priority: 1, Status: WaiverClaimStatusCancelled},
+				claim(2, 3, 501, 31, 1),
+			},
+			want: []waiverOutcome{{ID: 2, Status: WaiverClaimStatusFailed, Reason: WaiverFailNotInOrder}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, outcomes(ResolveWaivers(tt.order, tt.claims, owned, squad)))
+		})
+	}
+
+	// Inputs are not modified.
+	assert.Len(t, owned, 1)
+	assert.Len(t, squad, 4)
+}
+
+func TestWaiverSchedule_IsDue(t *testing.T) {
+	at := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := WaiverSchedule{RoundID: 1, ProcessAt: at}
+	assert.False(t, s.IsDue(at.Add(-time.Second)))
+	assert.True(t, s.IsDue(at))
+	s.ProcessedAt = &at
+	assert.False(t, s.IsDue(at.Add(time.Hour)))
+}
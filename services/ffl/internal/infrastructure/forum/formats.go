@@ -0,0 +1,189 @@
+package forum
+
+import "xffl/services/ffl/internal/application"
+
+// Built-in post formats for the league's four clubs. They are plain data, in
+// the same shape a club registers through registerFFLPostFormat, and are
+// only used for clubs without a registered format.
+
+// standardSections are the section headers every club uses; some clubs write
+// abbreviations (HB, RUCK) or running subtotals after the header.
+var standardSections = []application.PostSection{
+	{Header: `(?i)^GOALS?\b[\s\d=]*$`, Position: "goals"},
+	{Header: `(?i)^KICKS?\b[\s\d=]*$`, Position: "kicks"},
+	{Header: `(?i)^(?:HANDBALLS?|HBS?)\b[\s\d=]*$`, Position: "handballs"},
+	{Header: `(?i)^MARKS?\b[\s\d=]*$`, Position: "marks"},
+	{Header: `(?i)^TACKLES?\b[\s\d=]*$`, Position: "tackles"},
+	{Header: `(?i)^(?:HITOUTS?|RUCKS?|HO)\b[\s\d=]*$`, Position: "hitouts"},
+	{Header: `(?i)^STAR\b[\s\d=]*$`, Position: "star"},
+	{Header: `(?i)^(?:BENCH|INTERCHANGE)\b[\s\d=]*$`, Position: "bench"},
+}
+
+// standardHeaders are the section headers written when exporting a team,
+// with the position's subtotal in results.
+var standardHeaders = map[string]string{
+	"goals":     "GOALS[ {subtotal}]",
+	"kicks":     "KICKS[ {subtotal}]",
+	"handballs": "HANDBALLS[ {subtotal}]",
+	"marks":     "MARKS[ {subtotal}]",
+	"tackles":   "TACKLES[ {subtotal}]",
+	"hitouts":   "HITOUTS[ {subtotal}]",
+	"star":      "STAR[ {subtotal}]",
+	"bench":     "BENCH",
+}
+
+// standardBenchCodes maps bench code letters to positions.
+var standardBenchCodes = map[string]string{
+	"G": "goals", "K": "kicks",
+	"H": "handballs", "HB": "handballs",
+	"M": "marks", "T": "tackles",
+	"R": "hitouts", "HO": "hitouts",
+	"S": "star",
+}
+
+func builtinFormats() []application.PostFormat {
+	zero := 0
+	return []application.PostFormat{
+		{
+			// Jeremy Cameron – Geel            15
+			Name:       "Ruiboys",
+			Detect:     `–`,
+			ScoreLines: []string{`^R\d+\s+\d+$`},
+			Sections:   standardSections,
+			Players: []application.PostPlayerLine{
+				{
+					Section: "bench",
+					Pattern: `^(?P<name>.+?)\s*[–\-]\s*(?P<club>[A-Z][a-zA-Z]+)\s*(?P<code>\*\s*\(?\s*(?i:INT)\s*\)?|\*|[A-Za-z]+/[A-Za-z]+)(?:\s*(?P<score>\d+))?`,
+				},
+				{
+					Pattern: `^(?P<name>.+?)\s*[–\-]\s*(?P<club>[A-Z][a-zA-Z]+)\s+(?P<score>\d+)\s+(?i:sub)\s+(?P<interchange>\d+)`,
+					Notes:   "starter score {score}; interchange/sub used, slot score = {interchange}",
+				},
+				{Pattern: `^(?P<name>.+?)\s*[–\-]\s*(?P<club>[A-Z][a-zA-Z]+)(?:.*?(?P<score>\d+))?\D*$`},
+			},
+			BenchCodes: standardBenchCodes,
+			Layout: application.PostLayout{
+				Title:        "R{round}[ {total}]",
+				Headers:      standardHeaders,
+				Player:       "{name} – {club}[ {score}]",
+				Bench:        "{name} – {club} {code}[ {score}]",
+				Subbed:       "{name} – {club} {score} sub {subscore}",
+				Interchanged: "{name} – {club} {score} sub {subscore}",
+			},
+		},
+		{
+			// Z Merrett (Ess)  10
+			Name:       "Slashers",
+			Detect:     `(?im)^\s*TOTAL\s*:\s*\d+`,
+			ScoreLines: []string{`(?i)^TOTAL\s*:\s*\d+`},
+			Sections:   standardSections,
+			Players: []application.PostPlayerLine{
+				{
+					Section: "bench",
+					Pattern: `^(?P<code>[A-Za-z]+/[A-Za-z]+)\s*[-=]\s*(?P<name>[A-Z][A-Za-z\s'\-]+?)\s*(?:\((?P<club>[A-Z][a-zA-Z]+)\))?(?:\s*(?P<score>\d+))?\s*$`,
+				},
+				{
+					// ***A Brayshaw (Fre)***  70
+					Section:             "bench",
+					Pattern:             `^\*{2,3}\s*(?P<name>[A-Z][A-Za-z\s'\-]+?)\s*(?:\((?P<club>[A-Z][a-zA-Z]+)\))?\s*\*{2,3}\s*(?P<score>\d+)?`,
+					BackupPositions:     "star",
+					InterchangePosition: "star",
+				},
+				{
+					Pattern: `(?i)^(?P<name>[a-z][a-z\s'\-]+?)\s*(?:\((?P<club>[a-z]+)\))?\s+dnp\s*[-–]\s*interchange\s+(?P<sub>\w+)\s+(?P<subscore>\d+)`,
+					Score:   &zero,
+					Notes:   "DNP; {sub} subbed in for {subscore} pts",
+				},
+				{
+					// M Holmes (Geel)  35 - interchanged with Brayshaw 70
+					Pattern: `^(?P<name>[A-Z][A-Za-z\s'\-]+?)\s*\((?P<club>[A-Z][a-zA-Z]+)\)\s+(?P<score>\d+).*?[-–]\s*(?i:interchanged?)\s+(?:(?i:with)\s+)?(?P<with>\w+)\s+(?P<interchange>\d+)`,
+					Notes:   "interchange occurred: {with} {interchange} > starter {score}; slot score = {interchange}",
+				},
+				{Pattern: `^(?P<name>[A-Z][A-Za-z\s'\-]+?)\s*\((?P<club>[A-Z][a-zA-Z]+)\)(?:\s+(?P<score>\d+))?`},
+				{Pattern: `^(?P<name>[A-Z][A-Za-z\s'\-]+)`},
+			},
+			BenchCodes: standardBenchCodes,
+			Layout: application.PostLayout{
+				Footer:       "TOTAL: {total}",
+				Headers:      standardHeaders,
+				Player:       "{name} ({club})[ {score}]",
+				Bench:        "{code} - {name} ({club})[ {score}]",
+				BenchStar:    "***{name} ({club})***[ {score}]",
+				Subbed:       "{name} ({club}) dnp - interchange {sub} {subscore}",
+				Interchanged: "{name} ({club}) {score} - interchanged with {sub} {subscore}",
+			},
+		},
+		{
+			// Ben King (GC) 2 — goals, marks and tackles are posted as raw stats.
+			Name:       "Cheetahs",
+			Detect:     `(?i)\bCHEETAHS\b`,
+			ScoreLines: []string{`(?i)^CHEETAHS\s+\d+`},
+			Sections:   standardSections,
+			Players: []application.PostPlayerLine{
+				{
+					// Harry Sheezel (NM) * *
+					Section: "bench",
+					Pattern: `^(?P<name>.+?)\s*\((?P<club>[A-Z][a-zA-Z]+)\)\s*(?P<code>[^\s,]+),?(?:\s+[^\d\s]*(?P<score>\d+)\b)?`,
+				},
+				{Pattern: `^(?P<name>.+?)\s*\((?P<club>[A-Z][a-zA-Z]+)\)\D*(?P<score>\d+)?`},
+			},
+			BenchCodes:         standardBenchCodes,
+			RawStatMultipliers: map[string]int{"goals": 5, "marks": 2, "tackles": 4},
+			InterchangeMarker:  `(?i)^Interchange\s*=\s*\*`,
+			Layout: application.PostLayout{
+				Title:           "CHEETAHS[ {total}]",
+				Headers:         standardHeaders,
+				Player:          "{name} ({club})[ {score}]",
+				Bench:           "{name} ({club}) {code}[ {score}]",
+				InterchangeCode: "*",
+				InterchangeLine: "Interchange = *",
+			},
+		},
+		{
+			// Touk Miller GCS- 11
+			Name:       "THC",
+			Detect:     `(?i)\bTHC\b`,
+			ScoreLines: []string{`(?i)^THC[-–\s]+\d+`},
+			Strip:      []string{`(?i)\s*\(AV\)`},
+			Sections: append([]application.PostSection{
+				{Header: `(?i)^I/C[–\-]\s*\w+`, Position: "bench"},
+			}, standardSections...),
+			Players: []application.PostPlayerLine{
+				{
+					// Star- Toby Greene GWS- 60   or   *= Toby Greene GWS- 60
+					Section:             "bench",
+					Pattern:             `(?i)^(?:Star[-–]|\*\s*=)\s*(?P<name>.+?)(?:\s+(?P<club>[A-Za-z]{2,4}))?\s*(?:[-=]?\s*(?P<score>\d+))?\s*$`,
+					BackupPositions:     "star",
+					InterchangePosition: "star",
+				},
+				{
+					// K/HB- Nick Blakey SYD
+					Section: "bench",
+					Pattern: `(?i)^(?P<code>[A-Z]+/[A-Z]+)\s*[-=]\s*(?P<name>.+?)(?:\s+(?P<club>[A-Za-z]{2,4}))?\s*(?:[-=]?\s*(?P<score>\d+))?\s*$`,
+				},
+				{
+					Pattern: `(?i)^(?P<name>.+?)\s+(?P<club>[A-Z]+)\s*[-–]?\s*DNP[-=]\s*(?P<sub>\d+)`,
+					Score:   &zero,
+					Notes:   "DNP; sub contributed {sub} pts",
+				},
+				{
+					Pattern: `(?i)^(?P<name>.+?)\s+(?P<club>[A-Z]+)\s+x(?P<mult>\d+)\s*=\s*(?P<score>\d+)`,
+					Notes:   "explicit multiplier x{mult} shown",
+				},
+				{Pattern: `^(?P<name>.+?)\s+(?P<club>[A-Za-z]{2,4})\s*[-=]\s*(?P<score>\d+)`},
+				{Pattern: `^(?P<name>.+?)\s+(?P<club>[A-Za-z]{2,4})\s+(?P<score>\d+)\s*$`},
+				{Pattern: `^(?P<name>.+?)\s+(?P<club>[A-Za-z]{2,4})\s*$`},
+				{Pattern: `^(?P<name>.+)$`},
+			},
+			BenchCodes: standardBenchCodes,
+			Layout: application.PostLayout{
+				Title:     "THC[- {total}]",
+				Headers:   standardHeaders,
+				Player:    "{name} {club}[- {score}]",
+				Bench:     "{code}- {name} {club}[- {score}]",
+				BenchStar: "Star- {name} {club}[- {score}]",
+				Subbed:    "{name} {club} DNP- {subscore}",
+			},
+		},
+	}
+}
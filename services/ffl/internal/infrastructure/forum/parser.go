@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -11,161 +12,121 @@ import (
 )
 
 // Parser implements application.TeamParser for Tapatalk FFL forum posts.
-// Supports all four team formats: Ruiboys, Slashers, Cheetahs, THC.
-type Parser struct{}
-
-func NewParser() *Parser { return &Parser{} }
-
-// position aliases: raw token → canonical position name
-var positionAliases = map[string]string{
-	"GOALS": "goals", "GOAL": "goals",
-	"KICKS": "kicks", "KICK": "kicks",
-	"HANDBALLS": "handballs", "HANDBALL": "handballs", "HB": "handballs", "HBS": "handballs",
-	"MARKS": "marks", "MARK": "marks",
-	"TACKLES": "tackles", "TACKLE": "tackles",
-	"HITOUTS": "hitouts", "HITOUT": "hitouts",
-	"RUCK": "hitouts", "RUCKS": "hitouts", "HO": "hitouts",
-	"STAR": "star",
-	"BENCH": "bench", "INTERCHANGE": "bench",
+// It reads any club's layout from an application.PostFormat; the formats the
+// league's clubs have always used ship as built-ins (see formats.go). Player
+// names are looked up in the alias dictionary so nicknames name their player.
+type Parser struct {
+	aliases application.PlayerAliasLookup
 }
 
-// bench letter codes → position
-var benchLetter = map[string]string{
-	"G": "goals", "K": "kicks",
-	"H": "handballs", "HB": "handballs",
-	"M": "marks", "T": "tackles",
-	"R": "hitouts", "HO": "hitouts",
-	"S": "star",
-}
-
-// nicknames: lowercase key → canonical name
-var nicknames = map[string][2]string{
-	"tdk":         {"Tom De Koning", "SK"},
-	"t de koning": {"Tom De Koning", "SK"},
-	"the bont":    {"M Bontempelli", "WB"},
-}
-
-var stripWords = []string{"Journeyman", "Mountain Goat"}
+func NewParser(aliases application.PlayerAliasLookup) *Parser { return &Parser{aliases: aliases} }
 
 var (
-	artifactRE   = regexp.MustCompile(`(?i)^(Quote|Edit|Share|Like|Dislike|Pin\s+Topic|TATLTWDNMTS|Bloody\s+Legend|hugs?\s*$|reacted\s+to|\w+\s+reacted\s+to|\w+\s+likes?\s+this\s+post|likes?\s+this\s+post|\d{1,2}:\d{2}\s*(AM|PM))`)
-	memberNumRE  = regexp.MustCompile(`^\d[\d,]{3,}$`)
-	subtotalRE   = regexp.MustCompile(`^\s*\d+\s*$`)
-	sectionRE    = regexp.MustCompile(`(?i)^\s*(?:I/C[–-]\s*)?(GOALS?|KICKS?|HANDBALLS?|HANDBALL|HB|HBS|MARKS?|TACKLES?|HITOUTS?|RUCK[S]?|HO|STAR|BENCH|INTERCHANGE)\b[\s\d=]*$`)
-	icSectionRE  = regexp.MustCompile(`(?i)^\s*I/C[–\-]\s*\w+`)
-	thcScoreRE   = regexp.MustCompile(`(?i)^THC[-–\s]+(\d+)`)
-	cheetahRE    = regexp.MustCompile(`(?i)^CHEETAHS\s+(\d+)`)
-	totalRE      = regexp.MustCompile(`(?i)^TOTAL\s*:\s*(\d+)`)
-	ruiHeaderRE  = regexp.MustCompile(`^R\d+\s+(\d+)$`)
-	bareScoreRE  = regexp.MustCompile(`^(\d{3,})\s*$`)
-	icStarMRE    = regexp.MustCompile(`^\*\s*=\s*(.+)`)
-	icStarLabelRE = regexp.MustCompile(`(?i)^Star[-–]\s*(.+)`)
-	benchCodeRE  = regexp.MustCompile(`(?i)^([A-Z]+/[A-Z]+)\s*[-=]\s*(.+)`)
+	artifactRE  = regexp.MustCompile(`(?i)^(Quote|Edit|Share|Like|Dislike|Pin\s+Topic|TATLTWDNMTS|Bloody\s+Legend|hugs?\s*$|reacted\s+to|\w+\s+reacted\s+to|\w+\s+likes?\s+this\s+post|likes?\s+this\s+post|\d{1,2}:\d{2}\s*(AM|PM))`)
+	memberNumRE = regexp.MustCompile(`^\d[\d,]{3,}$`)
+	subtotalRE  = regexp.MustCompile(`^\s*\d+\s*$`)
+	notesRE     = regexp.MustCompile(`\{(\w+)\}`)
 )
 
-func (p *Parser) Parse(_ context.Context, teamName, post string) ([]application.ParsedPlayerRow, error) {
-	lines := splitLines(post)
-	team := teamName
-	if team == "" {
-		team = detectTeam(lines)
+func (p *Parser) Formats() []application.PostFormat { return builtinFormats() }
+
+func (p *Parser) Parse(ctx context.Context, format application.PostFormat, post string) ([]application.ParsedPlayerRow, error) {
+	cf, err := compileFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := p.aliases.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load player aliases: %w", err)
 	}
-	if team == "" {
-		return nil, fmt.Errorf("could not identify team from post")
+	// Longest first, so "mountain goat" is stripped before a shorter strip
+	// word inside it.
+	slices.SortFunc(aliases, func(a, b application.PlayerAlias) int { return len(b.Alias) - len(a.Alias) })
+
+	rows := cf.parse(splitLines(post))
+	for i := range rows {
+		applyAlias(&rows[i], aliases)
 	}
-	return parseBlock(team, lines), nil
+	return rows, nil
 }
 
-// --- team detection ---
+// --- compiled format ---
+
+type compiledSection struct {
+	header   *regexp.Regexp
+	position string
+}
+
+type compiledPlayerLine struct {
+	application.PostPlayerLine
+	re *regexp.Regexp
+}
+
+type compiledFormat struct {
+	format            application.PostFormat
+	scoreLines        []*regexp.Regexp
+	strip             []*regexp.Regexp
+	sections          []compiledSection
+	players           []compiledPlayerLine
+	interchangeMarker *regexp.Regexp
+}
 
-func detectTeam(lines []string) string {
-	text := strings.Join(lines[:min(5, len(lines))], "\n")
-	if strings.Contains(strings.ToUpper(text), "THC") {
-		return "THC"
+func compileFormat(f application.PostFormat) (*compiledFormat, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
 	}
-	if strings.Contains(strings.ToUpper(text), "CHEETAHS") {
-		return "Cheetahs"
+	// Validate has compiled every pattern, so MustCompile cannot panic.
+	cf := &compiledFormat{format: f}
+	for _, p := range f.ScoreLines {
+		cf.scoreLines = append(cf.scoreLines, regexp.MustCompile(p))
 	}
-	for _, l := range lines {
-		if totalRE.MatchString(l) {
-			return "Slashers"
-		}
+	for _, p := range f.Strip {
+		cf.strip = append(cf.strip, regexp.MustCompile(p))
 	}
-	for _, l := range lines {
-		if strings.Contains(l, "–") {
-			return "Ruiboys"
-		}
+	for _, s := range f.Sections {
+		cf.sections = append(cf.sections, compiledSection{header: regexp.MustCompile(s.Header), position: s.Position})
+	}
+	for _, pl := range f.Players {
+		cf.players = append(cf.players, compiledPlayerLine{PostPlayerLine: pl, re: regexp.MustCompile(pl.Pattern)})
+	}
+	if f.InterchangeMarker != "" {
+		cf.interchangeMarker = regexp.MustCompile(f.InterchangeMarker)
 	}
-	return ""
+	return cf, nil
 }
 
 // --- block parser ---
 
-func parseBlock(team string, lines []string) []application.ParsedPlayerRow {
+func (cf *compiledFormat) parse(lines []string) []application.ParsedPlayerRow {
 	var rows []application.ParsedPlayerRow
 	currentPos := ""
-	inIC := false
-
-	isRuiboys := team == "Ruiboys"
-	isSlashers := team == "Slashers"
-	isCheetahs := team == "Cheetahs"
-	isTHC := team == "THC"
 
 	for _, rawLine := range lines {
 		line := strings.TrimSpace(rawLine)
-		if line == "" {
-			continue
-		}
-
-		// skip score header lines — we only want player scores
-		if isRuiboys && (ruiHeaderRE.MatchString(line) || (bareScoreRE.MatchString(line) && currentPos == "")) {
-			continue
-		}
-		if (isTHC || isSlashers) && bareScoreRE.MatchString(line) && currentPos == "" {
-			continue
-		}
-		if isCheetahs && cheetahRE.MatchString(line) {
-			continue
+		for _, re := range cf.strip {
+			line = strings.TrimSpace(re.ReplaceAllString(line, ""))
 		}
-		if isTHC && thcScoreRE.MatchString(line) {
-			continue
-		}
-		if isSlashers && totalRE.MatchString(line) {
+		if line == "" || cf.isScoreLine(line) || isArtifact(line) {
 			continue
 		}
 
-		if isArtifact(line) {
-			continue
-		}
-
-		// position subtotals — numeric-only lines after position is set
+		// position subtotals — numeric-only lines
 		if subtotalRE.MatchString(line) {
 			continue
 		}
 
-		// THC I/C section header
-		if isTHC && icSectionRE.MatchString(line) {
-			inIC = true
-			currentPos = "bench"
-			continue
-		}
-
-		// "Interchange = * *" — for Cheetahs, marks the bench star player as interchange
-		if regexp.MustCompile(`(?i)^Interchange\s*=\s*\*`).MatchString(line) {
-			if isCheetahs {
-				for i := len(rows) - 1; i >= 0; i-- {
-					if rows[i].BackupPositions == "star" {
-						rows[i].InterchangePosition = "star"
-						break
-					}
+		if cf.interchangeMarker != nil && cf.interchangeMarker.MatchString(line) {
+			for i := len(rows) - 1; i >= 0; i-- {
+				if rows[i].BackupPositions == "star" {
+					rows[i].InterchangePosition = "star"
+					break
 				}
 			}
 			continue
 		}
 
-		// position section header
-		if m := sectionRE.FindStringSubmatch(line); m != nil {
-			currentPos = normalisePosition(m[1])
-			inIC = false
+		if pos, ok := cf.section(line); ok {
+			currentPos = pos
 			continue
 		}
 
@@ -173,282 +134,105 @@ func parseBlock(team string, lines []string) []application.ParsedPlayerRow {
 			continue
 		}
 
-		var row *application.ParsedPlayerRow
-		switch {
-		case isRuiboys:
-			row = parseRuiboys(line, currentPos)
-		case isSlashers:
-			row = parseSlashers(line, currentPos)
-		case isCheetahs:
-			row = parseCheetahs(line, currentPos)
-		case isTHC:
-			row = parseTHC(line, currentPos, inIC)
-		}
-		if row != nil {
-			rows = append(rows, *row)
+		if row, ok := cf.playerRow(line, currentPos); ok {
+			rows = append(rows, row)
 		}
 	}
 	return rows
 }
 
-// --- Ruiboys ---
-
-var ruiPlayerRE = regexp.MustCompile(`^(.+?)\s*[–\-]\s*([A-Z][a-zA-Z]+)\s*(.*)`)
-
-func parseRuiboys(line, position string) *application.ParsedPlayerRow {
-	m := ruiPlayerRE.FindStringSubmatch(line)
-	if m == nil {
-		return nil
-	}
-	name, clubHint, rest := strings.TrimSpace(m[1]), strings.TrimSpace(m[2]), strings.TrimSpace(m[3])
-	name, clubHint = resolveNickname(name, clubHint)
-
-	row := &application.ParsedPlayerRow{Name: name, ClubHint: clubHint, Position: position}
-
-	if position == "bench" {
-		bm := regexp.MustCompile(`(?i)^(\*\s*\(?\s*INT\s*\)?|\*|[A-Z]+/[A-Z]+)\s*(.*)`).FindStringSubmatch(rest)
-		if bm != nil {
-			bp, ic := decodeBenchCode(strings.TrimSpace(bm[1]))
-			row.BackupPositions = bp
-			row.InterchangePosition = ic
-			tail := strings.TrimSpace(bm[2])
-			if tail != "" && !regexp.MustCompile(`\d+[\\|]\d+`).MatchString(tail) {
-				row.Score = extractScore(tail)
-			}
+func (cf *compiledFormat) isScoreLine(line string) bool {
+	for _, re := range cf.scoreLines {
+		if re.MatchString(line) {
+			return true
 		}
-		return row
 	}
+	return false
+}
 
-	subM := regexp.MustCompile(`(?i)(\d+)\s+sub\s+(\d+)`).FindStringSubmatch(rest)
-	if subM != nil {
-		s, _ := strconv.Atoi(subM[2])
-		row.Score = &s
-		row.Notes = fmt.Sprintf("starter score %s; interchange/sub used, slot score = %d", subM[1], s)
-	} else {
-		row.Score = lastNumber(rest)
+func (cf *compiledFormat) section(line string) (string, bool) {
+	for _, s := range cf.sections {
+		if s.header.MatchString(line) {
+			return s.position, true
+		}
 	}
-	return row
+	return "", false
 }
 
-// --- Slashers ---
-
-var slashersNameClubRE = regexp.MustCompile(`^([A-Z][A-Za-z\s'\-]+?)\s*\(([A-Z][a-zA-Z]+)\)`)
-
-func parseSlashers(line, position string) *application.ParsedPlayerRow {
-	// bench code prefix: "K/G - Name"
-	if position == "bench" {
-		if bm := benchCodeRE.FindStringSubmatch(line); bm != nil {
-			bp, ic := decodeBenchCode(bm[1])
-			n, club := slashersNameClub(bm[2])
-			n, club = resolveNickname(n, club)
-			return &application.ParsedPlayerRow{
-				Name: n, ClubHint: club, Position: position,
-				BackupPositions: bp, InterchangePosition: ic,
-				Score: extractScore(strings.SplitN(bm[2], "(", 2)[len(strings.SplitN(bm[2], "(", 2))-1]),
-			}
+// playerRow reads line with the first player line pattern that matches it.
+func (cf *compiledFormat) playerRow(line, position string) (application.ParsedPlayerRow, bool) {
+	for _, pl := range cf.players {
+		if pl.Section != "" && pl.Section != position {
+			continue
 		}
-		// interchange player: ***Name (Club)***
-		if m := regexp.MustCompile(`^\*{2,3}\s*(.+?)\s*\*{2,3}\s*(.*)`).FindStringSubmatch(line); m != nil {
-			n, club := slashersNameClub(m[1])
-			n, club = resolveNickname(n, club)
-			s := extractScore(m[2])
-			return &application.ParsedPlayerRow{
-				Name: n, ClubHint: club, Position: position,
-				BackupPositions: "star", InterchangePosition: "star", Score: s,
-			}
+		m := pl.re.FindStringSubmatch(line)
+		if m == nil {
+			continue
 		}
-	}
-
-	// DNP line
-	if m := regexp.MustCompile(`(?i)^(.+?)\s+dnp\s*[-–]\s*interchange\s+(\w+)\s+(\d+)`).FindStringSubmatch(line); m != nil {
-		n, club := slashersNameClub(m[1])
-		zero := 0
-		return &application.ParsedPlayerRow{
-			Name: n, ClubHint: club, Position: position,
-			Score: &zero, Notes: fmt.Sprintf("DNP; %s subbed in for %s pts", m[2], m[3]),
+		groups := make(map[string]string)
+		for i, name := range pl.re.SubexpNames() {
+			if name != "" && m[i] != "" {
+				groups[name] = strings.TrimSpace(m[i])
+			}
 		}
-	}
-
-	n, club := slashersNameClub(line)
-	if n == "" {
-		return nil
-	}
-	n, club = resolveNickname(n, club)
-	sm := regexp.MustCompile(`\)\s+(\d+)`).FindStringSubmatch(line)
-	var score *int
-	if sm != nil {
-		v, _ := strconv.Atoi(sm[1])
-		score = &v
-	}
-
-	// interchange annotation on STAR line
-	notes := ""
-	if icm := regexp.MustCompile(`(?i)[-–]\s*interchange[d]?\s+(?:with\s+)?(\w+)\s+(\d+)`).FindStringSubmatch(line); icm != nil {
-		icScore, _ := strconv.Atoi(icm[2])
-		if score != nil && icScore > *score {
-			notes = fmt.Sprintf("interchange occurred: %s %d > starter %d; slot score = %d", icm[1], icScore, *score, icScore)
-			score = &icScore
+		name := strings.Join(strings.Fields(groups["name"]), " ")
+		if name == "" {
+			continue
 		}
+		return cf.buildRow(pl, groups, name, groups["club"], position), true
 	}
-	return &application.ParsedPlayerRow{Name: n, ClubHint: club, Position: position, Score: score, Notes: notes}
+	return application.ParsedPlayerRow{}, false
 }
 
-func slashersNameClub(s string) (string, string) {
-	s = strings.TrimSpace(s)
-	if m := slashersNameClubRE.FindStringSubmatch(s); m != nil {
-		return strings.TrimSpace(m[1]), strings.TrimSpace(m[2])
+func (cf *compiledFormat) buildRow(pl compiledPlayerLine, groups map[string]string, name, club, position string) application.ParsedPlayerRow {
+	row := application.ParsedPlayerRow{
+		Name:                name,
+		ClubHint:            club,
+		Position:            position,
+		BackupPositions:     pl.BackupPositions,
+		InterchangePosition: pl.InterchangePosition,
 	}
-	if m := regexp.MustCompile(`^([A-Z][A-Za-z\s'\-]+)`).FindStringSubmatch(s); m != nil {
-		return strings.TrimSpace(m[1]), ""
+	if code, ok := groups["code"]; ok {
+		row.BackupPositions, row.InterchangePosition = decodeBenchCode(code, cf.format.BenchCodes)
 	}
-	return "", ""
-}
-
-// --- Cheetahs ---
-
-var cheetahsPlayerRE = regexp.MustCompile(`^(.+?)\s*\(([A-Z][a-zA-Z]+)\)\s*(.*)`)
 
-// Cheetahs show raw stats for these positions; multiply to get FFL pts.
-var cheetahsRawMultipliers = map[string]int{"goals": 5, "marks": 2, "tackles": 4}
-
-func parseCheetahs(line, position string) *application.ParsedPlayerRow {
-	m := cheetahsPlayerRE.FindStringSubmatch(line)
-	if m == nil {
-		return nil
-	}
-	name := stripInlineNicknames(strings.TrimSpace(m[1]))
-	club := strings.TrimSpace(m[2])
-	rest := strings.TrimSpace(m[3])
-	name, club = resolveNickname(name, club)
-
-	row := &application.ParsedPlayerRow{Name: name, ClubHint: club, Position: position}
-
-	if position == "bench" {
-		tokens := strings.Fields(rest)
-		if len(tokens) > 0 {
-			code := strings.TrimRight(tokens[0], ",")
-			bp, ic := decodeBenchCode(code)
-			row.BackupPositions = bp
-			row.InterchangePosition = ic
-			tail := strings.Join(tokens[1:], " ")
-			if tail != "" && !regexp.MustCompile(`\d+[,]\d+`).MatchString(tail) {
-				row.Score = extractScore(tail)
-			}
+	notes := pl.Notes
+	switch {
+	case pl.Score != nil:
+		s := *pl.Score
+		row.Score = &s
+	case groups["score"] != "":
+		row.Score = atoiPtr(groups["score"])
+		if mult, ok := cf.format.RawStatMultipliers[position]; ok {
+			pts := *row.Score * mult
+			notes = fmt.Sprintf("raw %s stat: %d × %d = %d", position, *row.Score, mult, pts)
+			row.Score = &pts
 		}
-		return row
 	}
 
-	rawScore := extractScore(rest)
-	if rawScore != nil {
-		if mult, ok := cheetahsRawMultipliers[position]; ok {
-			ffl := *rawScore * mult
-			row.Score = &ffl
-			row.Notes = fmt.Sprintf("raw %s stat: %d × %d = %d", position, *rawScore, mult, ffl)
+	// An interchange score replaces the slot score only when it is higher;
+	// otherwise nothing changed and the line's notes don't apply.
+	if ic, ok := groups["interchange"]; ok {
+		icScore := atoiPtr(ic)
+		if row.Score == nil || *icScore > *row.Score {
+			row.Score = icScore
 		} else {
-			row.Score = rawScore
-		}
-	}
-	return row
-}
-
-// --- THC ---
-
-func parseTHC(line, position string, inIC bool) *application.ParsedPlayerRow {
-	// I/C section: "Star- Name CLUB= score"
-	if inIC {
-		if m := icStarLabelRE.FindStringSubmatch(line); m != nil {
-			n, club, score, notes := thcNameScore(strings.TrimSpace(m[1]))
-			return &application.ParsedPlayerRow{
-				Name: n, ClubHint: club, Position: "bench",
-				BackupPositions: "star", InterchangePosition: "star", Score: score, Notes: notes,
-			}
-		}
-		// "*= Name CLUB= score"
-		if m := icStarMRE.FindStringSubmatch(line); m != nil {
-			n, club, score, notes := thcNameScore(strings.TrimSpace(m[1]))
-			return &application.ParsedPlayerRow{
-				Name: n, ClubHint: club, Position: "bench",
-				BackupPositions: "star", InterchangePosition: "star", Score: score, Notes: notes,
-			}
+			notes = ""
 		}
-		// "K/HB- Name CLUB"
-		if m := benchCodeRE.FindStringSubmatch(line); m != nil {
-			bp, ic := decodeBenchCode(m[1])
-			n, club, score, notes := thcNameScore(strings.TrimSpace(m[2]))
-			return &application.ParsedPlayerRow{
-				Name: n, ClubHint: club, Position: "bench",
-				BackupPositions: bp, InterchangePosition: ic, Score: score, Notes: notes,
-			}
-		}
-	}
-
-	n, club, score, notes := thcNameScore(line)
-	if n == "" {
-		return nil
 	}
-	return &application.ParsedPlayerRow{Name: n, ClubHint: club, Position: position, Score: score, Notes: notes}
-}
 
-var (
-	thcDNPStatRE  = regexp.MustCompile(`(?i)^(.+?)\s+([A-Z]+)\s+DNP[-=]\s*(\d+)[A-Za-z]+`)
-	thcDNPRE      = regexp.MustCompile(`(?i)^(.+?)\s+([A-Z]+)\s*[-–]?\s*DNP[-=]\s*(\d+)`)
-	thcMultRE     = regexp.MustCompile(`(?i)^(.+?)\s+([A-Z]+)\s+x(\d+)\s*=\s*(\d+)`)
-	thcScoreLineRE = regexp.MustCompile(`^(.+?)\s+([A-Za-z]{2,4})\s*[-=]\s*(\d+)`)
-	thcSpaceRE    = regexp.MustCompile(`^(.+?)\s+([A-Za-z]{2,4})\s+(\d+)\s*$`)
-	thcNoScoreRE  = regexp.MustCompile(`^(.+?)\s+([A-Za-z]{2,4})\s*$`)
-)
-
-func thcNameScore(s string) (name, club string, score *int, notes string) {
-	s = strings.TrimSpace(s)
-	s = regexp.MustCompile(`(?i)\s*\(AV\)`).ReplaceAllString(s, "")
-
-	if m := thcDNPStatRE.FindStringSubmatch(s); m != nil {
-		zero := 0
-		return stripInlineNicknames(m[1]), m[2], &zero, fmt.Sprintf("DNP; sub contributed %s pts", m[3])
-	}
-	if m := thcDNPRE.FindStringSubmatch(s); m != nil {
-		zero := 0
-		return stripInlineNicknames(m[1]), m[2], &zero, fmt.Sprintf("DNP; sub contributed %s pts", m[3])
-	}
-	if m := thcMultRE.FindStringSubmatch(s); m != nil {
-		v, _ := strconv.Atoi(m[4])
-		return stripInlineNicknames(m[1]), m[2], &v, fmt.Sprintf("explicit multiplier x%s shown", m[3])
-	}
-	if m := thcScoreLineRE.FindStringSubmatch(s); m != nil {
-		n := stripInlineNicknames(m[1])
-		c := strings.ToUpper(m[2])
-		v, _ := strconv.Atoi(m[3])
-		n, c = resolveNickname(n, c)
-		return n, c, &v, ""
-	}
-	if m := thcSpaceRE.FindStringSubmatch(s); m != nil {
-		n := stripInlineNicknames(m[1])
-		c := strings.ToUpper(m[2])
-		v, _ := strconv.Atoi(m[3])
-		n, c = resolveNickname(n, c)
-		return n, c, &v, ""
-	}
-	if m := thcNoScoreRE.FindStringSubmatch(s); m != nil {
-		n := stripInlineNicknames(m[1])
-		c := strings.ToUpper(m[2])
-		n, c = resolveNickname(n, c)
-		return n, c, nil, ""
-	}
-	n, c := resolveNickname(stripInlineNicknames(s), "")
-	return n, c, nil, ""
+	row.Notes = notesRE.ReplaceAllStringFunc(notes, func(ph string) string {
+		return groups[ph[1:len(ph)-1]]
+	})
+	return row
 }
 
 // --- helpers ---
 
-func normalisePosition(raw string) string {
-	key := strings.ToUpper(strings.TrimSpace(raw))
-	if p, ok := positionAliases[key]; ok {
-		return p
-	}
-	return strings.ToLower(raw)
-}
-
-func decodeBenchCode(code string) (backupPositions, interchangePosition string) {
+// decodeBenchCode turns a bench code such as "K/M" into backup positions.
+// "*" backs up star; "* (INT)" is the star interchange. Unknown tokens are
+// kept lowercased so the review step shows them.
+func decodeBenchCode(code string, codes map[string]string) (backupPositions, interchangePosition string) {
 	code = strings.TrimSpace(code)
 	upper := strings.ToUpper(code)
 	if upper == "*" {
@@ -461,7 +245,7 @@ func decodeBenchCode(code string) (backupPositions, interchangePosition string)
 	var positions []string
 	for _, p := range parts {
 		p = strings.TrimSpace(p)
-		if pos, ok := benchLetter[p]; ok {
+		if pos, ok := codes[p]; ok {
 			positions = append(positions, pos)
 		} else {
 			positions = append(positions, strings.ToLower(p))
@@ -470,25 +254,40 @@ func decodeBenchCode(code string) (backupPositions, interchangePosition string)
 	return strings.Join(positions, ","), ""
 }
 
-func resolveNickname(name, club string) (string, string) {
-	lower := strings.ToLower(name)
-	for nick, canonical := range nicknames {
-		if strings.Contains(lower, nick) {
-			c := canonical[1]
-			if c == "" {
-				c = club
+// applyAlias looks the row's name up in the alias dictionary. Strip words
+// inside a longer name are inline nicknames ("Jack Journeyman Smith") and are
+// dropped from it; a name that is then an alias names the alias's player. An
+// alias that is only part of a name is otherwise left alone, as the name may
+// be another player's.
+func applyAlias(row *application.ParsedPlayerRow, aliases []application.PlayerAlias) {
+	fields := strings.Fields(row.Name)
+	norm := make([]string, len(fields))
+	for i, f := range fields {
+		norm[i] = application.NormaliseAlias(f)
+	}
+	for _, a := range aliases {
+		if a.AFLPlayerID != nil {
+			continue
+		}
+		n := len(strings.Fields(a.Alias))
+		for i := 0; i+n <= len(norm) && n < len(norm); {
+			if strings.Join(norm[i:i+n], " ") != a.Alias {
+				i++
+				continue
 			}
-			return canonical[0], c
+			fields = append(fields[:i:i], fields[i+n:]...)
+			norm = append(norm[:i:i], norm[i+n:]...)
 		}
 	}
-	return stripInlineNicknames(name), club
-}
+	row.Name = strings.Join(fields, " ")
 
-func stripInlineNicknames(name string) string {
-	for _, word := range stripWords {
-		name = strings.ReplaceAll(name, word, "")
+	whole := strings.Join(norm, " ")
+	for _, a := range aliases {
+		if a.AFLPlayerID != nil && a.Alias == whole {
+			row.AFLPlayerID = *a.AFLPlayerID
+			return
+		}
 	}
-	return strings.Join(strings.Fields(name), " ")
 }
 
 func isArtifact(line string) bool {
@@ -508,21 +307,8 @@ func isArtifact(line string) bool {
 	return onlyEmoji && strings.TrimSpace(line) != ""
 }
 
-func extractScore(s string) *int {
-	m := regexp.MustCompile(`\b(\d+)\b`).FindStringSubmatch(s)
-	if m == nil {
-		return nil
-	}
-	v, _ := strconv.Atoi(m[1])
-	return &v
-}
-
-func lastNumber(s string) *int {
-	nums := regexp.MustCompile(`\d+`).FindAllString(s, -1)
-	if len(nums) == 0 {
-		return nil
-	}
-	v, _ := strconv.Atoi(nums[len(nums)-1])
+func atoiPtr(s string) *int {
+	v, _ := strconv.Atoi(s)
 	return &v
 }
 
@@ -531,10 +317,3 @@ func splitLines(text string) []string {
 	text = strings.ReplaceAll(text, "\r", "\n")
 	return strings.Split(text, "\n")
 }
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
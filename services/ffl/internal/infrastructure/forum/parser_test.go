@@ -18,6 +18,33 @@ func readTestdata(t *testing.T, name string) string {
 	return string(b)
 }
 
+// parseBuiltin parses post with whichever built-in format it is detected as.
+func parseBuiltin(t *testing.T, post string) []application.ParsedPlayerRow {
+	t.Helper()
+	p := NewParser(stubAliases{})
+	format, ok := application.DetectPostFormat(p.Formats(), post)
+	require.True(t, ok, "no built-in format detected")
+	rows, err := p.Parse(context.Background(), format, post)
+	require.NoError(t, err)
+	return rows
+}
+
+// stubAliases is an in-memory alias dictionary.
+type stubAliases []application.PlayerAlias
+
+func (s stubAliases) FindAll(context.Context) ([]application.PlayerAlias, error) {
+	return append([]application.PlayerAlias(nil), s...), nil
+}
+
+func (s stubAliases) FindByAlias(_ context.Context, alias string) (application.PlayerAlias, bool, error) {
+	for _, a := range s {
+		if a.Alias == alias {
+			return a, true, nil
+		}
+	}
+	return application.PlayerAlias{}, false, nil
+}
+
 func findRow(rows []application.ParsedPlayerRow, name string) *application.ParsedPlayerRow {
 	for i := range rows {
 		if rows[i].Name == name {
@@ -29,8 +56,7 @@ func findRow(rows []application.ParsedPlayerRow, name string) *application.Parse
 
 func TestParseRuiboys(t *testing.T) {
 	post := readTestdata(t, "ruiboys.txt")
-	rows, err := NewParser().Parse(context.Background(), "", post)
-	require.NoError(t, err)
+	rows := parseBuiltin(t, post)
 	assert.Len(t, rows, 22)
 
 	r := findRow(rows, "Jeremy Cameron")
@@ -60,8 +86,7 @@ func TestParseRuiboys(t *testing.T) {
 
 func TestParseSlashers(t *testing.T) {
 	post := readTestdata(t, "slashers.txt")
-	rows, err := NewParser().Parse(context.Background(), "", post)
-	require.NoError(t, err)
+	rows := parseBuiltin(t, post)
 	assert.Len(t, rows, 22)
 
 	r := findRow(rows, "Z Merrett")
@@ -94,8 +119,7 @@ func TestParseSlashers(t *testing.T) {
 
 func TestParseCheetahs(t *testing.T) {
 	post := readTestdata(t, "cheetahs.txt")
-	rows, err := NewParser().Parse(context.Background(), "", post)
-	require.NoError(t, err)
+	rows := parseBuiltin(t, post)
 	assert.Len(t, rows, 22)
 
 	r := findRow(rows, "Ben King")
@@ -126,8 +150,7 @@ func TestParseCheetahs(t *testing.T) {
 
 func TestParseTHC(t *testing.T) {
 	post := readTestdata(t, "thc.txt")
-	rows, err := NewParser().Parse(context.Background(), "", post)
-	require.NoError(t, err)
+	rows := parseBuiltin(t, post)
 	assert.Len(t, rows, 22)
 
 	r := findRow(rows, "Touk Miller")
@@ -154,3 +177,106 @@ func TestParseTHC(t *testing.T) {
 	require.NotNil(t, r, "Nick Blakey not found")
 	assert.Equal(t, "kicks,handballs", r.BackupPositions)
 }
+
+func TestDetectBuiltinFormats(t *testing.T) {
+	formats := NewParser(stubAliases{}).Formats()
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"ruiboys.txt", "Ruiboys"},
+		{"slashers.txt", "Slashers"},
+		{"cheetahs.txt", "Cheetahs"},
+		{"thc.txt", "THC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			f, ok := application.DetectPostFormat(formats, readTestdata(t, tt.file))
+			require.True(t, ok)
+			assert.Equal(t, tt.want, f.Name)
+		})
+	}
+}
+
+func TestBuiltinFormatsAreValid(t *testing.T) {
+	for _, f := range NewParser(stubAliases{}).Formats() {
+		assert.NoError(t, f.Validate(), f.Name)
+	}
+}
+
+func TestParseRegisteredFormat(t *testing.T) {
+	// A club that writes "position: Name [Club] score" and codes its bench "B1 …".
+	format := application.PostFormat{
+		Name:   "Magpies",
+		Detect: `(?i)^MAGPIES`,
+		Sections: []application.PostSection{
+			{Header: `(?i)^F$`, Position: "goals"},
+			{Header: `(?i)^R$`, Position: "hitouts"},
+			{Header: `(?i)^SUBS$`, Position: "bench"},
+		},
+		Players: []application.PostPlayerLine{
+			{Section: "bench", Pattern: `^(?P<name>[^\[]+)\[(?P<club>\w+)\]\s+(?P<code>\S+)`},
+			{Pattern: `^(?P<name>[^\[]+)\[(?P<club>\w+)\](?:\s+(?P<score>\d+))?`},
+		},
+		BenchCodes:         map[string]string{"FWD": "goals", "RK": "hitouts"},
+		RawStatMultipliers: map[string]int{"goals": 5},
+	}
+	post := "MAGPIES 101\nF\nJeremy Cameron [Geel] 3\nR\nMax Gawn [Melb] 40\nSUBS\nTom Powell [NM] FWD/RK\n"
+
+	rows, err := NewParser(stubAliases{}).Parse(context.Background(), format, post)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, application.ParsedPlayerRow{
+		Name: "Jeremy Cameron", ClubHint: "Geel", Position: "goals",
+		Score: intPtr(15), Notes: "raw goals stat: 3 × 5 = 15",
+	}, rows[0])
+	assert.Equal(t, "hitouts", rows[1].Position)
+	assert.Equal(t, 40, *rows[1].Score)
+	assert.Equal(t, "bench", rows[2].Position)
+	assert.Equal(t, "goals,hitouts", rows[2].BackupPositions)
+}
+
+func TestParseRejectsInvalidFormat(t *testing.T) {
+	format := application.PostFormat{
+		Name:     "Broken",
+		Detect:   `x`,
+		Sections: []application.PostSection{{Header: `^GOALS$`, Position: "goals"}},
+		Players:  []application.PostPlayerLine{{Pattern: `^(?P<player>.+)$`}},
+	}
+	_, err := NewParser(stubAliases{}).Parse(context.Background(), format, "GOALS\nSomeone")
+	assert.ErrorIs(t, err, application.ErrInvalidPostFormat)
+}
+
+func TestParseAppliesAliases(t *testing.T) {
+	aliases := stubAliases{
+		{Alias: "tdk", AFLPlayerID: intPtr(11)},
+		{Alias: "the bont", AFLPlayerID: intPtr(12)},
+		{Alias: "journeyman"},
+		{Alias: "mountain goat"},
+	}
+	format := application.PostFormat{
+		Name:     "Plain",
+		Detect:   `x`,
+		Sections: []application.PostSection{{Header: `^GOALS$`, Position: "goals"}},
+		Players:  []application.PostPlayerLine{{Pattern: `^(?P<name>.+?)(?:\s+(?P<score>\d+))?$`}},
+	}
+	post := "GOALS\nTDK 20\nThe Bont\nJack Journeyman Smith 5\nJeremy Cameron 15\nTDK Jones\nMountain Goat TDK"
+
+	rows, err := NewParser(aliases).Parse(context.Background(), format, post)
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+
+	assert.Equal(t, "TDK", rows[0].Name, "a name that is an alias is kept as written")
+	assert.Equal(t, 11, rows[0].AFLPlayerID)
+	assert.Equal(t, 12, rows[1].AFLPlayerID)
+	assert.Equal(t, "Jack Smith", rows[2].Name, "strip word dropped from the name")
+	assert.Zero(t, rows[2].AFLPlayerID, "a strip word names no player")
+	assert.Zero(t, rows[3].AFLPlayerID)
+	assert.Equal(t, "TDK Jones", rows[4].Name)
+	assert.Zero(t, rows[4].AFLPlayerID, "an alias that is only part of the name is not applied")
+	assert.Equal(t, "TDK", rows[5].Name, "a name that is an alias once stripped")
+	assert.Equal(t, 11, rows[5].AFLPlayerID)
+}
+
+func intPtr(v int) *int { return &v }
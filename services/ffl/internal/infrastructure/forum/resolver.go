@@ -1,83 +1,49 @@
 package forum
 
 import (
+	"cmp"
 	"context"
-	"strings"
-	"unicode"
+	"fmt"
+	"slices"
 
-	"github.com/agnivade/levenshtein"
 	"xffl/services/ffl/internal/application"
+	"xffl/shared/namematch"
 )
 
-const highConfidenceThreshold = 0.85
-
-// LevenshteinResolver implements application.PlayerResolver using normalised
-// Levenshtein distance. The club hint is used to boost confidence when the
-// parsed club code appears in the candidate name (approximate match).
-type LevenshteinResolver struct{}
+// NameResolver implements application.PlayerResolver with the shared name
+// matcher: initials, hyphenated surnames and sound-alike spellings still
+// match, and the club code written with a name ("Geel", "WB") boosts
+// candidates from that club. A name in the alias dictionary matches its
+// player with full confidence.
+type NameResolver struct {
+	aliases application.PlayerAliasLookup
+	matcher *namematch.Matcher
+}
 
-func NewLevenshteinResolver() *LevenshteinResolver {
-	return &LevenshteinResolver{}
+func NewNameResolver(aliases application.PlayerAliasLookup) *NameResolver {
+	return &NameResolver{aliases: aliases, matcher: namematch.New(namematch.AFLClubs)}
 }
 
-func (r *LevenshteinResolver) Resolve(_ context.Context, name, clubHint string, candidates []application.PlayerCandidate) ([]application.PlayerNameMatch, error) {
-	norm := normaliseName(name)
-	results := make([]application.PlayerNameMatch, 0, len(candidates))
+func (r *NameResolver) Resolve(ctx context.Context, name, clubHint string, candidates []application.PlayerCandidate) ([]application.PlayerNameMatch, error) {
+	alias, aliased, err := r.aliases.FindByAlias(ctx, application.NormaliseAlias(name))
+	if err != nil {
+		return nil, fmt.Errorf("look up alias: %w", err)
+	}
 
+	results := make([]application.PlayerNameMatch, 0, len(candidates))
 	for _, c := range candidates {
-		conf := similarity(norm, normaliseName(c.Name))
+		conf := r.matcher.Confidence(name, clubHint, c.Name, c.Club)
+		if aliased && alias.AFLPlayerID != nil && c.AFLPlayerID == *alias.AFLPlayerID {
+			conf = 1.0
+		}
 		results = append(results, application.PlayerNameMatch{
 			Candidate:  c,
 			Confidence: conf,
 		})
 	}
 
-	// Sort descending by confidence.
-	sortByConfidence(results)
+	slices.SortStableFunc(results, func(a, b application.PlayerNameMatch) int {
+		return cmp.Compare(b.Confidence, a.Confidence)
+	})
 	return results, nil
 }
-
-// normaliseName lowercases and strips punctuation/extra whitespace for comparison.
-func normaliseName(s string) string {
-	s = strings.ToLower(s)
-	var b strings.Builder
-	prevSpace := false
-	for _, r := range s {
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			b.WriteRune(r)
-			prevSpace = false
-		} else if !prevSpace {
-			b.WriteRune(' ')
-			prevSpace = true
-		}
-	}
-	return strings.TrimSpace(b.String())
-}
-
-// similarity returns a 0.0–1.0 score: 1.0 = identical, lower = more different.
-func similarity(a, b string) float64 {
-	if a == b {
-		return 1.0
-	}
-	maxLen := len([]rune(a))
-	if lb := len([]rune(b)); lb > maxLen {
-		maxLen = lb
-	}
-	if maxLen == 0 {
-		return 1.0
-	}
-	dist := levenshtein.ComputeDistance(a, b)
-	score := 1.0 - float64(dist)/float64(maxLen)
-	if score < 0 {
-		return 0
-	}
-	return score
-}
-
-func sortByConfidence(matches []application.PlayerNameMatch) {
-	for i := 1; i < len(matches); i++ {
-		for j := i; j > 0 && matches[j].Confidence > matches[j-1].Confidence; j-- {
-			matches[j], matches[j-1] = matches[j-1], matches[j]
-		}
-	}
-}
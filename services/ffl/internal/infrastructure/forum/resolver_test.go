@@ -0,0 +1,67 @@
+package forum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+func TestResolveRanksByName(t *testing.T) {
+	candidates := []application.PlayerCandidate{
+		{AFLPlayerID: 1, Name: "Tom De Koning"},
+		{AFLPlayerID: 2, Name: "Jeremy Cameron"},
+	}
+	matches, err := NewNameResolver(stubAliases{}).Resolve(context.Background(), "Jeremy Camron", "", candidates)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, 2, matches[0].Candidate.AFLPlayerID)
+	assert.Less(t, matches[0].Confidence, 1.0)
+}
+
+func TestResolveUsesAlias(t *testing.T) {
+	candidates := []application.PlayerCandidate{
+		{AFLPlayerID: 1, Name: "Tom De Koning"},
+		{AFLPlayerID: 2, Name: "Jeremy Cameron"},
+	}
+	r := NewNameResolver(stubAliases{{Alias: "tdk", AFLPlayerID: intPtr(1)}})
+
+	matches, err := r.Resolve(context.Background(), "TDK", "", candidates)
+	require.NoError(t, err)
+	require.NotEmpty(t, matches)
+	assert.Equal(t, 1, matches[0].Candidate.AFLPlayerID)
+	assert.Equal(t, 1.0, matches[0].Confidence)
+}
+
+func TestResolveMatchesInitial(t *testing.T) {
+	candidates := []application.PlayerCandidate{
+		{AFLPlayerID: 1, Name: "Marcus Windhager"},
+		{AFLPlayerID: 2, Name: "Marcus Bontempelli"},
+	}
+	matches, err := NewNameResolver(stubAliases{}).Resolve(context.Background(), "M Bontempelli", "", candidates)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, 2, matches[0].Candidate.AFLPlayerID)
+	assert.GreaterOrEqual(t, matches[0].Confidence, 0.9)
+}
+
+func TestResolveBoostsClubHint(t *testing.T) {
+	candidates := []application.PlayerCandidate{
+		{AFLPlayerID: 1, Name: "Charlie Cameron", Club: "Brisbane Lions"},
+		{AFLPlayerID: 2, Name: "Jeremy Cameron", Club: "Geelong Cats"},
+	}
+	r := NewNameResolver(stubAliases{})
+
+	matches, err := r.Resolve(context.Background(), "Cameron", "Geel", candidates)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, 2, matches[0].Candidate.AFLPlayerID)
+	assert.Greater(t, matches[0].Confidence, matches[1].Confidence)
+
+	matches, err = r.Resolve(context.Background(), "Cameron", "BL", candidates)
+	require.NoError(t, err)
+	assert.Equal(t, 1, matches[0].Candidate.AFLPlayerID)
+}
@@ -0,0 +1,86 @@
+package forum
+
+import (
+	"slices"
+	"strings"
+
+	"xffl/services/ffl/internal/application"
+)
+
+// SplitThread splits a Tapatalk thread page into posts. Each post ends with
+// its footer — "Quote", then "Edit" and "Share" a few lines on — and the
+// next starts with a header: the author's username, member number, rank
+// and timestamp. The header is dropped from the body; the first post on a
+// page may have none.
+func (p *Parser) SplitThread(thread string) []application.ThreadPost {
+	lines := splitLines(thread)
+	var posts []application.ThreadPost
+	var current []string
+	flush := func() {
+		if post, ok := threadPost(current); ok {
+			posts = append(posts, post)
+		}
+		current = nil
+	}
+	for i := 0; i < len(lines); i++ {
+		if isPostFooter(lines[i:]) {
+			flush()
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "Share" {
+				i++
+			}
+			continue
+		}
+		current = append(current, lines[i])
+	}
+	flush()
+	return posts
+}
+
+// isPostFooter reports whether lines start with a post footer.
+func isPostFooter(lines []string) bool {
+	if strings.TrimSpace(lines[0]) != "Quote" {
+		return false
+	}
+	var ahead []string
+	for _, l := range lines[1:min(len(lines), 8)] {
+		ahead = append(ahead, strings.TrimSpace(l))
+	}
+	return slices.Contains(ahead, "Edit") && slices.Contains(ahead, "Share")
+}
+
+// threadPost strips the post header from lines. The header is recognised by
+// its member number, which must come within the first few non-blank lines;
+// the line before it is the author.
+func threadPost(lines []string) (application.ThreadPost, bool) {
+	var nonBlank []int
+	for i, l := range lines {
+		if strings.TrimSpace(l) != "" {
+			nonBlank = append(nonBlank, i)
+		}
+		if len(nonBlank) == 3 {
+			break
+		}
+	}
+
+	var post application.ThreadPost
+	start := 0
+	for k, i := range nonBlank {
+		if k == 0 || !memberNumRE.MatchString(strings.TrimSpace(lines[i])) {
+			continue
+		}
+		post.Author = strings.TrimSpace(lines[nonBlank[k-1]])
+		start = i + 1
+		break
+	}
+	// Rank, timestamp and reaction lines follow the header.
+	for start < len(lines) {
+		l := strings.TrimSpace(lines[start])
+		if l != "" && !artifactRE.MatchString(l) {
+			break
+		}
+		start++
+	}
+
+	post.Body = strings.TrimSpace(strings.Join(lines[start:], "\n"))
+	return post, post.Body != ""
+}
@@ -0,0 +1,46 @@
+package forum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+func TestSplitThread(t *testing.T) {
+	p := NewParser(stubAliases{})
+	posts := p.SplitThread(readTestdata(t, "thread.txt"))
+	require.Len(t, posts, 4)
+
+	authors := make([]string, len(posts))
+	for i, post := range posts {
+		authors[i] = post.Author
+	}
+	assert.Equal(t, []string{"", "Slashers", "ruiboy", "thc"}, authors, "the first post on the page has no header")
+
+	assert.Contains(t, posts[0].Body, "CHEETAHS 342")
+	assert.NotContains(t, posts[0].Body, "Quote", "footer dropped")
+	assert.True(t, strings.HasPrefix(posts[1].Body, "Slashers - Round 4"), "header dropped")
+	assert.NotContains(t, posts[2].Body, "Bloody Legend")
+
+	formats := make([]string, len(posts))
+	for i, post := range posts {
+		f, ok := application.DetectPostFormat(p.Formats(), post.Body)
+		require.True(t, ok, "post %d", i)
+		formats[i] = f.Name
+	}
+	assert.Equal(t, []string{"Cheetahs", "Slashers", "Ruiboys", "THC"}, formats)
+
+	assert.Len(t, parseBuiltin(t, posts[2].Body), 22)
+	assert.Empty(t, parseBuiltin(t, posts[3].Body), "a chat post has no player rows")
+}
+
+func TestSplitThreadWithoutFooters(t *testing.T) {
+	posts := NewParser(stubAliases{}).SplitThread("Ruiboys R1 300\nGOALS\nJeremy Cameron – Geel 15\n")
+	require.Len(t, posts, 1)
+	assert.Empty(t, posts[0].Author)
+	assert.Equal(t, "Ruiboys R1 300\nGOALS\nJeremy Cameron – Geel 15", posts[0].Body)
+}
@@ -0,0 +1,198 @@
+package forum
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"xffl/services/ffl/internal/application"
+)
+
+var (
+	placeholderRE = regexp.MustCompile(`\{(\w+)\}`)
+	optionalRE    = regexp.MustCompile(`\[([^\[\]]*)\]`)
+)
+
+// WriteTeam writes team in the format's layout: the title, then each
+// position's section with its players, the bench, and the footer. Starters
+// in a position the format posts as raw stats are written as the raw count.
+func (p *Parser) WriteTeam(format application.PostFormat, team application.PostTeam) (string, error) {
+	l := format.Layout
+	if l.Player == "" || l.Bench == "" {
+		return "", fmt.Errorf("%w: %s has no layout", application.ErrCannotWritePost, format.Name)
+	}
+	totals := map[string]string{"round": "", "total": ""}
+	if team.Round > 0 {
+		totals["round"] = strconv.Itoa(team.Round)
+	}
+	if team.Scored {
+		totals["total"] = strconv.Itoa(team.Total)
+	}
+
+	var lines []string
+	write := func(tmpl string, values map[string]string) {
+		if line, ok := fillTemplate(tmpl, values); ok {
+			lines = append(lines, line)
+		}
+	}
+	write(l.Title, totals)
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+
+	subtotals := make(map[string]int)
+	for _, pl := range team.Players {
+		if pl.Sub != "" {
+			subtotals[pl.Position] += pl.SubScore
+		} else {
+			subtotals[pl.Position] += pl.Score
+		}
+	}
+
+	section := ""
+	interchange := false
+	for _, pl := range team.Players {
+		if pl.Position != section {
+			header, ok := l.Headers[pl.Position]
+			if !ok {
+				return "", fmt.Errorf("%w: %s has no %s header", application.ErrCannotWritePost, format.Name, pl.Position)
+			}
+			if section != "" {
+				lines = append(lines, "")
+			}
+			values := map[string]string{"subtotal": ""}
+			if team.Scored && pl.Position != "bench" {
+				values["subtotal"] = strconv.Itoa(subtotals[pl.Position])
+			}
+			write(header, values)
+			section = pl.Position
+		}
+
+		values := map[string]string{"name": pl.Name, "club": pl.Club, "score": "", "code": "", "sub": pl.Sub, "subscore": ""}
+		if team.Scored {
+			score, err := postedScore(format, pl)
+			if err != nil {
+				return "", err
+			}
+			values["score"] = score
+			values["subscore"] = strconv.Itoa(pl.SubScore)
+		}
+
+		tmpl := l.Player
+		switch {
+		case pl.Position == "bench":
+			tmpl = l.Bench
+			star := pl.BackupPositions == "star"
+			if star && l.BenchStar != "" {
+				tmpl = l.BenchStar
+			}
+			code, err := benchCode(format, pl)
+			if err != nil {
+				return "", err
+			}
+			values["code"] = code
+			interchange = interchange || (star && pl.InterchangePosition == "star")
+		case team.Scored && pl.Sub != "" && pl.DNP && l.Subbed != "":
+			tmpl = l.Subbed
+		case team.Scored && pl.Sub != "" && !pl.DNP && l.Interchanged != "":
+			tmpl = l.Interchanged
+		}
+		write(tmpl, values)
+	}
+	if interchange && l.InterchangeLine != "" {
+		lines = append(lines, l.InterchangeLine)
+	}
+	if footer, ok := fillTemplate(l.Footer, totals); ok {
+		lines = append(lines, "", footer)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// postedScore is a player's score as the format posts it: a raw stat count
+// for starters in raw stat positions, otherwise points.
+func postedScore(format application.PostFormat, pl application.PostPlayer) (string, error) {
+	mult, raw := format.RawStatMultipliers[pl.Position]
+	if !raw {
+		return strconv.Itoa(pl.Score), nil
+	}
+	if pl.Score%mult != 0 {
+		return "", fmt.Errorf("%w: %s's %s score of %d is not a whole number of stats × %d",
+			application.ErrCannotWritePost, pl.Name, pl.Position, pl.Score, mult)
+	}
+	return strconv.Itoa(pl.Score / mult), nil
+}
+
+// benchCode is the code for a bench player's backup positions: "*" for the
+// backup star, the format's interchange code for the star interchange, and
+// otherwise the format's shortest bench code for each position, joined by
+// "/" ("K/M").
+func benchCode(format application.PostFormat, pl application.PostPlayer) (string, error) {
+	if pl.BackupPositions == "star" {
+		if pl.InterchangePosition != "star" {
+			return "*", nil
+		}
+		if format.Layout.InterchangeCode != "" {
+			return format.Layout.InterchangeCode, nil
+		}
+		return "* (INT)", nil
+	}
+	var codes []string
+	for _, pos := range strings.Split(pl.BackupPositions, ",") {
+		code, ok := positionCode(format.BenchCodes, strings.TrimSpace(pos))
+		if !ok {
+			return "", fmt.Errorf("%w: %s has no bench code for %s", application.ErrCannotWritePost, format.Name, pos)
+		}
+		codes = append(codes, code)
+	}
+	return strings.Join(codes, "/"), nil
+}
+
+// positionCode returns the shortest code for pos, the first alphabetically
+// among equals, so the same team is always written the same way.
+func positionCode(codes map[string]string, pos string) (string, bool) {
+	var found []string
+	for _, code := range slices.Sorted(maps.Keys(codes)) {
+		if codes[code] == pos {
+			found = append(found, code)
+		}
+	}
+	if len(found) == 0 {
+		return "", false
+	}
+	return slices.MinFunc(found, func(a, b string) int { return cmp.Compare(len(a), len(b)) }), true
+}
+
+// fillTemplate fills a layout template: optional [segments] whose
+// placeholders all have values are kept, the rest dropped, then every
+// {placeholder} is replaced. It reports false for an empty template or one
+// whose required placeholders aren't all filled.
+func fillTemplate(tmpl string, values map[string]string) (string, bool) {
+	if tmpl == "" {
+		return "", false
+	}
+	filled := func(s string) bool {
+		for _, m := range placeholderRE.FindAllStringSubmatch(s, -1) {
+			if values[m[1]] == "" {
+				return false
+			}
+		}
+		return true
+	}
+	line := optionalRE.ReplaceAllStringFunc(tmpl, func(seg string) string {
+		if inner := seg[1 : len(seg)-1]; filled(inner) {
+			return inner
+		}
+		return ""
+	})
+	if !filled(line) {
+		return "", false
+	}
+	line = placeholderRE.ReplaceAllStringFunc(line, func(ph string) string {
+		return values[ph[1:len(ph)-1]]
+	})
+	return strings.TrimRight(line, " "), true
+}
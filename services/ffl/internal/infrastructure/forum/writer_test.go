@@ -0,0 +1,188 @@
+package forum
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+// exportTeam is a team with a starter in every position, two bench players
+// backing up pairs of positions, and the bench star as interchange. Scores
+// of raw stat positions are whole stats, as scores from AFL stats are.
+func exportTeam(scored bool) application.PostTeam {
+	return application.PostTeam{
+		Round:  4,
+		Scored: scored,
+		Total:  154,
+		Players: []application.PostPlayer{
+			{Name: "Jeremy Cameron", Club: "Geel", Position: "goals", Score: 15},
+			{Name: "Charlie Curnow", Club: "Carl", Position: "goals", Score: 10},
+			{Name: "Nick Daicos", Club: "Coll", Position: "kicks", Score: 24},
+			{Name: "Zach Merrett", Club: "Ess", Position: "handballs", Score: 18},
+			{Name: "Harry Sheezel", Club: "NM", Position: "handballs", Score: 0},
+			{Name: "Tom De Koning", Club: "Carl", Position: "marks", Score: 14},
+			{Name: "Tim English", Club: "WB", Position: "tackles", Score: 12},
+			{Name: "Max Gawn", Club: "Melb", Position: "hitouts", Score: 31},
+			{Name: "Marcus Bontempelli", Club: "WB", Position: "star", Score: 30},
+			{Name: "Nick Blakey", Club: "Syd", Position: "bench", BackupPositions: "kicks,handballs", Score: 21},
+			{Name: "Ben King", Club: "GC", Position: "bench", BackupPositions: "goals,marks", Score: 5},
+			{Name: "Toby Greene", Club: "GWS", Position: "bench", BackupPositions: "star", InterchangePosition: "star", Score: 60},
+		},
+	}
+}
+
+// teamRows is what parsing a written team should give back.
+func teamRows(team application.PostTeam) []application.ParsedPlayerRow {
+	rows := make([]application.ParsedPlayerRow, len(team.Players))
+	for i, p := range team.Players {
+		rows[i] = application.ParsedPlayerRow{
+			Name:                p.Name,
+			ClubHint:            p.Club,
+			Position:            p.Position,
+			BackupPositions:     p.BackupPositions,
+			InterchangePosition: p.InterchangePosition,
+		}
+		if team.Scored {
+			score := p.Score
+			rows[i].Score = &score
+		}
+	}
+	return rows
+}
+
+// withoutNotes drops the notes the parser adds, such as raw stat workings.
+func withoutNotes(rows []application.ParsedPlayerRow) []application.ParsedPlayerRow {
+	for i := range rows {
+		rows[i].Notes = ""
+	}
+	return rows
+}
+
+func TestWriteTeamRoundTrip(t *testing.T) {
+	p := NewParser(stubAliases{})
+	for _, format := range p.Formats() {
+		for _, scored := range []bool{false, true} {
+			name := format.Name + "/team"
+			if scored {
+				name = format.Name + "/results"
+			}
+			t.Run(name, func(t *testing.T) {
+				team := exportTeam(scored)
+				post, err := p.WriteTeam(format, team)
+				require.NoError(t, err)
+
+				rows, err := p.Parse(context.Background(), format, post)
+				require.NoError(t, err)
+				assert.Equal(t, teamRows(team), withoutNotes(rows), "post:\n%s", post)
+			})
+		}
+	}
+}
+
+func TestWriteTeamIsDetected(t *testing.T) {
+	p := NewParser(stubAliases{})
+	for _, format := range p.Formats() {
+		post, err := p.WriteTeam(format, exportTeam(true))
+		require.NoError(t, err)
+		detected, ok := application.DetectPostFormat(p.Formats(), post)
+		require.True(t, ok, "%s: no format detected", format.Name)
+		assert.Equal(t, format.Name, detected.Name)
+	}
+}
+
+func TestWriteTeamResults(t *testing.T) {
+	p := NewParser(stubAliases{})
+	format := builtinFormat(t, "Ruiboys")
+
+	post, err := p.WriteTeam(format, exportTeam(true))
+	require.NoError(t, err)
+	lines := strings.Split(post, "\n")
+	assert.Equal(t, "R4 154", lines[0])
+	assert.Contains(t, lines, "GOALS 25")
+	assert.Contains(t, lines, "HANDBALLS 18")
+	assert.Contains(t, lines, "Toby Greene – GWS * (INT) 60")
+	assert.Contains(t, lines, "Nick Blakey – Syd K/H 21")
+}
+
+func TestWriteTeamSubs(t *testing.T) {
+	p := NewParser(stubAliases{})
+	team := exportTeam(true)
+	// Sheezel didn't play and Blakey came on; Greene's 60 beat Bontempelli.
+	team.Players[4].Sub, team.Players[4].SubScore, team.Players[4].DNP = "Blakey", 21, true
+	team.Players[8].Sub, team.Players[8].SubScore = "Greene", 60
+
+	tests := []struct {
+		format string
+		dnp    string
+		ic     string
+	}{
+		{"Ruiboys", "Harry Sheezel – NM 0 sub 21", "Marcus Bontempelli – WB 30 sub 60"},
+		{"Slashers", "Harry Sheezel (NM) dnp - interchange Blakey 21", "Marcus Bontempelli (WB) 30 - interchanged with Greene 60"},
+		{"THC", "Harry Sheezel NM DNP- 21", "Marcus Bontempelli WB- 30"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			format := builtinFormat(t, tt.format)
+			post, err := p.WriteTeam(format, team)
+			require.NoError(t, err)
+			lines := strings.Split(post, "\n")
+			assert.Contains(t, lines, tt.dnp)
+			assert.Contains(t, lines, tt.ic)
+			assert.Contains(t, lines, "HANDBALLS 39", "subtotal counts the sub")
+
+			rows, err := p.Parse(context.Background(), format, post)
+			require.NoError(t, err)
+			assert.Len(t, rows, len(team.Players))
+		})
+	}
+}
+
+func TestWriteTeamErrors(t *testing.T) {
+	p := NewParser(stubAliases{})
+
+	noLayout := builtinFormat(t, "Ruiboys")
+	noLayout.Layout = application.PostLayout{}
+	_, err := p.WriteTeam(noLayout, exportTeam(false))
+	assert.ErrorIs(t, err, application.ErrCannotWritePost)
+
+	// Cheetahs post goals as a goal count, so 7 points can't be written.
+	team := exportTeam(true)
+	team.Players[0].Score = 7
+	_, err = p.WriteTeam(builtinFormat(t, "Cheetahs"), team)
+	assert.ErrorIs(t, err, application.ErrCannotWritePost)
+}
+
+func TestFillTemplate(t *testing.T) {
+	values := map[string]string{"name": "Max Gawn", "club": "Melb", "score": ""}
+	tests := []struct {
+		tmpl string
+		want string
+		ok   bool
+	}{
+		{"{name} ({club})", "Max Gawn (Melb)", true},
+		{"{name} ({club})[ {score}]", "Max Gawn (Melb)", true},
+		{"TOTAL: {score}", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := fillTemplate(tt.tmpl, values)
+		assert.Equal(t, tt.ok, ok, tt.tmpl)
+		assert.Equal(t, tt.want, got, tt.tmpl)
+	}
+}
+
+func builtinFormat(t *testing.T, name string) application.PostFormat {
+	t.Helper()
+	for _, f := range builtinFormats() {
+		if f.Name == name {
+			return f
+		}
+	}
+	t.Fatalf("no built-in format %q", name)
+	return application.PostFormat{}
+}
@@ -36,10 +36,15 @@ func (db *DB) WithTx(ctx context.Context, fn func(repos application.WriteRepos)
 
 	txQ := sqlcgen.New(tx)
 	repos := application.WriteRepos{
+		Seasons:       NewSeasonRepository(txQ),
 		Players:       NewPlayerRepository(txQ),
 		PlayerSeasons: NewPlayerSeasonRepository(txQ),
 		PlayerMatches: NewPlayerMatchRepository(txQ),
 		ClubMatches:   NewClubMatchRepository(txQ),
+		Drafts:        NewDraftRepository(txQ),
+		Waivers:       NewWaiverRepository(txQ),
+		PlayerAliases: NewDataopsPlayerAliasRepository(txQ),
+		Submissions:   NewDataopsSubmissionRepository(txQ),
 	}
 
 	if err := fn(repos); err != nil {
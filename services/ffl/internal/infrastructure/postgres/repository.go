@@ -2,9 +2,14 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"xffl/services/ffl/internal/application"
 	"xffl/services/ffl/internal/domain"
 	"xffl/services/ffl/internal/infrastructure/postgres/sqlcgen"
 )
@@ -17,6 +22,14 @@ func derefOr(p *int32) int {
 	return int(*p)
 }
 
+// nilIfEmpty converts an optional string to a nullable column value.
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 // intToInt32Ptr converts *int to *int32 for sqlc params.
 func intToInt32Ptr(p *int) *int32 {
 	if p == nil {
@@ -85,7 +98,7 @@ func (r *SeasonRepository) FindAll(ctx context.Context) ([]domain.Season, error)
 	}
 	out := make([]domain.Season, len(rows))
 	for i, row := range rows {
-		out[i] = domain.Season{ID: int(row.ID), Name: row.Name, LeagueID: int(row.LeagueID), AFLSeasonID: int(row.AflSeasonID)}
+		out[i] = toSeason(row.ID, row.Name, row.LeagueID, row.AflSeasonID, row.MinSquadSize, row.MaxSquadSize)
 	}
 	return out, nil
 }
@@ -95,7 +108,33 @@ func (r *SeasonRepository) FindByID(ctx context.Context, id int) (domain.Season,
 	if err != nil {
 		return domain.Season{}, err
 	}
-	return domain.Season{ID: int(row.ID), Name: row.Name, LeagueID: int(row.LeagueID), AFLSeasonID: int(row.AflSeasonID)}, nil
+	return toSeason(row.ID, row.Name, row.LeagueID, row.AflSeasonID, row.MinSquadSize, row.MaxSquadSize), nil
+}
+
+func (r *SeasonRepository) UpdateSquadLimits(ctx context.Context, id int, minSize, maxSize *int) (domain.Season, error) {
+	row, err := r.q.UpdateSeasonSquadLimits(ctx, sqlcgen.UpdateSeasonSquadLimitsParams{
+		ID:           int32(id),
+		MinSquadSize: intPtrToInt32Ptr(minSize),
+		MaxSquadSize: intPtrToInt32Ptr(maxSize),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Season{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Season{}, err
+	}
+	return toSeason(row.ID, row.Name, row.LeagueID, row.AflSeasonID, row.MinSquadSize, row.MaxSquadSize), nil
+}
+
+func toSeason(id int32, name string, leagueID, aflSeasonID int32, minSquadSize, maxSquadSize *int32) domain.Season {
+	return domain.Season{
+		ID:           int(id),
+		Name:         name,
+		LeagueID:     int(leagueID),
+		AFLSeasonID:  int(aflSeasonID),
+		MinSquadSize: int32PtrToIntPtr(minSquadSize),
+		MaxSquadSize: int32PtrToIntPtr(maxSquadSize),
+	}
 }
 
 // --- Round ---
@@ -332,6 +371,22 @@ func (r *ClubSeasonRepository) FindByClubAndSeason(ctx context.Context, clubID i
 	return toClubSeason(row.ID, row.ClubID, row.SeasonID, row.DrvPlayed, row.DrvWon, row.DrvLost, row.DrvDrawn, row.DrvFor, row.DrvAgainst, row.DrvExtraPoints, row.DrvPremiershipPoints), nil
 }
 
+func (r *ClubSeasonRepository) FindClubsForClubSeasonIDs(ctx context.Context, ids []int) (map[int]domain.Club, error) {
+	int32IDs := make([]int32, len(ids))
+	for i, id := range ids {
+		int32IDs[i] = int32(id)
+	}
+	rows, err := r.q.FindClubsByClubSeasonIDs(ctx, int32IDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]domain.Club, len(rows))
+	for _, row := range rows {
+		out[int(row.ClubSeasonID)] = domain.Club{ID: int(row.ClubID), Name: row.Name}
+	}
+	return out, nil
+}
+
 func (r *ClubSeasonRepository) Update(ctx context.Context, cs domain.ClubSeason) error {
 	p := int32(cs.Played)
 	w := int32(cs.Won)
@@ -464,6 +519,9 @@ func (r *PlayerRepository) FindByID(ctx context.Context, id int) (domain.Player,
 
 func (r *PlayerRepository) FindByAFLPlayerID(ctx context.Context, aflPlayerID int) (domain.Player, error) {
 	row, err := r.q.FindPlayerByAFLPlayerID(ctx, int32(aflPlayerID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Player{}, domain.ErrNotFound
+	}
 	if err != nil {
 		return domain.Player{}, err
 	}
@@ -482,6 +540,14 @@ func (r *PlayerRepository) Delete(ctx context.Context, id int) error {
 	return r.q.DeletePlayer(ctx, int32(id))
 }
 
+func (r *PlayerRepository) ReassignAFLPlayer(ctx context.Context, fromAFLPlayerID, toAFLPlayerID int) (int, error) {
+	n, err := r.q.ReassignPlayersAFLPlayer(ctx, sqlcgen.ReassignPlayersAFLPlayerParams{
+		ToAflPlayerID:   int32(toAFLPlayerID),
+		FromAflPlayerID: int32(fromAFLPlayerID),
+	})
+	return int(n), err
+}
+
 // --- PlayerMatch ---
 
 type PlayerMatchRepository struct{ q *sqlcgen.Queries }
@@ -564,6 +630,9 @@ func (r *PlayerMatchRepository) FindByPlayerSeasonAndRound(ctx context.Context,
 		PlayerSeasonID: int32(playerSeasonID),
 		RoundID:        int32(roundID),
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.PlayerMatch{}, domain.ErrNotFound
+	}
 	if err != nil {
 		return domain.PlayerMatch{}, err
 	}
@@ -762,3 +831,775 @@ func (r *PlayerSeasonRepository) UpdateDetails(ctx context.Context, id int, note
 	}
 	return toPlayerSeason(row.ID, row.PlayerID, row.ClubSeasonID, row.AflPlayerSeasonID, row.FromRoundID, row.ToRoundID, row.Notes, row.CostCents), nil
 }
+
+func (r *PlayerSeasonRepository) ReassignAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error {
+	return r.q.ReassignPlayerSeasonsAFLPlayerSeason(ctx, sqlcgen.ReassignPlayerSeasonsAFLPlayerSeasonParams{
+		ToAflPlayerSeasonID:   int32(toAFLPlayerSeasonID),
+		FromAflPlayerSeasonID: int32(fromAFLPlayerSeasonID),
+	})
+}
+
+func (r *PlayerSeasonRepository) ReassignPlayer(ctx context.Context, fromPlayerID, toPlayerID int) error {
+	return r.q.ReassignPlayerSeasonsPlayer(ctx, sqlcgen.ReassignPlayerSeasonsPlayerParams{
+		ToPlayerID:   int32(toPlayerID),
+		FromPlayerID: int32(fromPlayerID),
+	})
+}
+
+// --- Draft ---
+
+type DraftRepository struct{ q *sqlcgen.Queries }
+
+func NewDraftRepository(q *sqlcgen.Queries) *DraftRepository {
+	return &DraftRepository{q: q}
+}
+
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func timestamptzPtr(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
+
+func toDraft(id, seasonID int32, style string, rounds, pickSeconds int32, status string, clockStartedAt pgtype.Timestamptz) domain.Draft {
+	return domain.Draft{
+		ID:             int(id),
+		SeasonID:       int(seasonID),
+		Style:          domain.DraftStyle(style),
+		Rounds:         int(rounds),
+		PickDuration:   time.Duration(pickSeconds) * time.Second,
+		Status:         domain.DraftStatus(status),
+		ClockStartedAt: timestamptzPtr(clockStartedAt),
+	}
+}
+
+func (r *DraftRepository) withPicks(ctx context.Context, d domain.Draft) (domain.Draft, error) {
+	rows, err := r.q.FindDraftPicksByDraftID(ctx, int32(d.ID))
+	if err != nil {
+		return domain.Draft{}, err
+	}
+	d.Picks = make([]domain.DraftPick, len(rows))
+	for i, row := range rows {
+		d.Picks[i] = domain.DraftPick{
+			ID:                int(row.ID),
+			DraftID:           int(row.DraftID),
+			PickNumber:        int(row.PickNumber),
+			Round:             int(row.Round),
+			ClubSeasonID:      int(row.ClubSeasonID),
+			AFLPlayerSeasonID: int32PtrToIntPtr(row.AflPlayerSeasonID),
+			PlayerSeasonID:    int32PtrToIntPtr(row.PlayerSeasonID),
+			Status:            domain.DraftPickStatus(row.Status),
+			PickedAt:          timestamptzPtr(row.PickedAt),
+		}
+	}
+	return d, nil
+}
+
+func (r *DraftRepository) Create(ctx context.Context, d domain.Draft) (domain.Draft, error) {
+	row, err := r.q.CreateDraft(ctx, sqlcgen.CreateDraftParams{
+		SeasonID:    int32(d.SeasonID),
+		Style:       string(d.Style),
+		Rounds:      int32(d.Rounds),
+		PickSeconds: int32(d.PickDuration / time.Second),
+		Status:      string(d.Status),
+	})
+	if err != nil {
+		return domain.Draft{}, err
+	}
+	out := toDraft(row.ID, row.SeasonID, row.Style, row.Rounds, row.PickSeconds, row.Status, row.ClockStartedAt)
+	out.Picks = make([]domain.DraftPick, len(d.Picks))
+	for i, p := range d.Picks {
+		id, err := r.q.CreateDraftPick(ctx, sqlcgen.CreateDraftPickParams{
+			DraftID:      row.ID,
+			PickNumber:   int32(p.PickNumber),
+			Round:        int32(p.Round),
+			ClubSeasonID: int32(p.ClubSeasonID),
+			Status:       string(p.Status),
+		})
+		if err != nil {
+			return domain.Draft{}, err
+		}
+		p.ID = int(id)
+		p.DraftID = out.ID
+		out.Picks[i] = p
+	}
+	return out, nil
+}
+
+func (r *DraftRepository) FindByID(ctx context.Context, id int) (domain.Draft, error) {
+	row, err := r.q.FindDraftByID(ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Draft{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Draft{}, err
+	}
+	return r.withPicks(ctx, toDraft(row.ID, row.SeasonID, row.Style, row.Rounds, row.PickSeconds, row.Status, row.ClockStartedAt))
+}
+
+func (r *DraftRepository) FindBySeasonID(ctx context.Context, seasonID int) (domain.Draft, error) {
+	row, err := r.q.FindDraftBySeasonID(ctx, int32(seasonID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Draft{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Draft{}, err
+	}
+	return r.withPicks(ctx, toDraft(row.ID, row.SeasonID, row.Style, row.Rounds, row.PickSeconds, row.Status, row.ClockStartedAt))
+}
+
+func (r *DraftRepository) FindInProgress(ctx context.Context) ([]domain.Draft, error) {
+	rows, err := r.q.FindDraftsByStatus(ctx, string(domain.DraftStatusInProgress))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Draft, len(rows))
+	for i, row := range rows {
+		d, err := r.withPicks(ctx, toDraft(row.ID, row.SeasonID, row.Style, row.Rounds, row.PickSeconds, row.Status, row.ClockStartedAt))
+		if err != nil {
+			return nil, err
+		}
+		out[i] = d
+	}
+	return out, nil
+}
+
+func (r *DraftRepository) UpdateState(ctx context.Context, d domain.Draft) error {
+	return r.q.UpdateDraftState(ctx, sqlcgen.UpdateDraftStateParams{
+		ID:             int32(d.ID),
+		Status:         string(d.Status),
+		ClockStartedAt: toTimestamptz(d.ClockStartedAt),
+	})
+}
+
+// UpdatePick fills a pending pick. It fails with domain.ErrDraftPickMade if
+// the pick was filled since the draft was loaded, e.g. by a concurrent clock
+// tick, so the caller's transaction rolls back instead of filling it twice.
+func (r *DraftRepository) UpdatePick(ctx context.Context, p domain.DraftPick) error {
+	n, err := r.q.UpdateDraftPick(ctx, sqlcgen.UpdateDraftPickParams{
+		ID:                int32(p.ID),
+		AflPlayerSeasonID: intPtrToInt32Ptr(p.AFLPlayerSeasonID),
+		PlayerSeasonID:    intPtrToInt32Ptr(p.PlayerSeasonID),
+		Status:            string(p.Status),
+		PickedAt:          toTimestamptz(p.PickedAt),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrDraftPickMade
+	}
+	return nil
+}
+
+func (r *DraftRepository) FindRankings(ctx context.Context, draftID, clubSeasonID int) ([]domain.DraftRanking, error) {
+	rows, err := r.q.FindDraftRankings(ctx, sqlcgen.FindDraftRankingsParams{
+		DraftID:      int32(draftID),
+		ClubSeasonID: int32(clubSeasonID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.DraftRanking, len(rows))
+	for i, row := range rows {
+		out[i] = domain.DraftRanking{
+			DraftID:           int(row.DraftID),
+			ClubSeasonID:      int(row.ClubSeasonID),
+			AFLPlayerSeasonID: int(row.AflPlayerSeasonID),
+			Rank:              int(row.Rank),
+		}
+	}
+	return out, nil
+}
+
+func (r *DraftRepository) ReplaceRankings(ctx context.Context, draftID, clubSeasonID int, aflPlayerSeasonIDs []int) error {
+	if err := r.q.DeleteDraftRankings(ctx, sqlcgen.DeleteDraftRankingsParams{
+		DraftID:      int32(draftID),
+		ClubSeasonID: int32(clubSeasonID),
+	}); err != nil {
+		return err
+	}
+	for i, id := range aflPlayerSeasonIDs {
+		if err := r.q.CreateDraftRanking(ctx, sqlcgen.CreateDraftRankingParams{
+			DraftID:           int32(draftID),
+			ClubSeasonID:      int32(clubSeasonID),
+			AflPlayerSeasonID: int32(id),
+			Rank:              int32(i + 1),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *DraftRepository) ReassignAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error {
+	// A club that ranked both keeps its ranking of the survivor.
+	if err := r.q.DeleteDraftRankingsRankedAs(ctx, sqlcgen.DeleteDraftRankingsRankedAsParams{
+		FromAflPlayerSeasonID: int32(fromAFLPlayerSeasonID),
+		ToAflPlayerSeasonID:   int32(toAFLPlayerSeasonID),
+	}); err != nil {
+		return err
+	}
+	if err := r.q.ReassignDraftRankingsAFLPlayerSeason(ctx, sqlcgen.ReassignDraftRankingsAFLPlayerSeasonParams{
+		ToAflPlayerSeasonID:   int32(toAFLPlayerSeasonID),
+		FromAflPlayerSeasonID: int32(fromAFLPlayerSeasonID),
+	}); err != nil {
+		return err
+	}
+	return r.q.ReassignDraftPicksAFLPlayerSeason(ctx, sqlcgen.ReassignDraftPicksAFLPlayerSeasonParams{
+		ToAflPlayerSeasonID:   int32(toAFLPlayerSeasonID),
+		FromAflPlayerSeasonID: int32(fromAFLPlayerSeasonID),
+	})
+}
+
+// --- Waiver ---
+
+type WaiverRepository struct{ q *sqlcgen.Queries }
+
+func NewWaiverRepository(q *sqlcgen.Queries) *WaiverRepository {
+	return &WaiverRepository{q: q}
+}
+
+// toWaiverClaim converts any of the waiver claim row types, which share a column list.
+func toWaiverClaim(row sqlcgen.FindWaiverClaimByIDRow) domain.WaiverClaim {
+	return domain.WaiverClaim{
+		ID:                 int(row.ID),
+		RoundID:            int(row.RoundID),
+		ClubSeasonID:       int(row.ClubSeasonID),
+		AFLPlayerSeasonID:  int(row.AflPlayerSeasonID),
+		DropPlayerSeasonID: int(row.DropPlayerSeasonID),
+		Priority:           int(row.Priority),
+		Status:             domain.WaiverClaimStatus(row.Status),
+		FailureReason:      row.FailureReason,
+		PlayerSeasonID:     int32PtrToIntPtr(row.PlayerSeasonID),
+		ProcessedAt:        timestamptzPtr(row.ProcessedAt),
+	}
+}
+
+// toWaiverSchedule converts any of the waiver schedule row types, which share a column list.
+func toWaiverSchedule(row sqlcgen.FindWaiverScheduleByRoundIDRow) domain.WaiverSchedule {
+	return domain.WaiverSchedule{
+		RoundID:     int(row.RoundID),
+		ProcessAt:   row.ProcessAt.Time,
+		ProcessedAt: timestamptzPtr(row.ProcessedAt),
+	}
+}
+
+func (r *WaiverRepository) CreateClaim(ctx context.Context, c domain.WaiverClaim) (domain.WaiverClaim, error) {
+	row, err := r.q.CreateWaiverClaim(ctx, sqlcgen.CreateWaiverClaimParams{
+		RoundID:            int32(c.RoundID),
+		ClubSeasonID:       int32(c.ClubSeasonID),
+		AflPlayerSeasonID:  int32(c.AFLPlayerSeasonID),
+		DropPlayerSeasonID: int32(c.DropPlayerSeasonID),
+		Priority:           int32(c.Priority),
+		Status:             string(c.Status),
+	})
+	if err != nil {
+		return domain.WaiverClaim{}, err
+	}
+	return toWaiverClaim(sqlcgen.FindWaiverClaimByIDRow(row)), nil
+}
+
+func (r *WaiverRepository) FindClaimByID(ctx context.Context, id int) (domain.WaiverClaim, error) {
+	row, err := r.q.FindWaiverClaimByID(ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.WaiverClaim{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.WaiverClaim{}, err
+	}
+	return toWaiverClaim(row), nil
+}
+
+func (r *WaiverRepository) FindClaimsByRoundID(ctx context.Context, roundID int) ([]domain.WaiverClaim, error) {
+	rows, err := r.q.FindWaiverClaimsByRoundID(ctx, int32(roundID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.WaiverClaim, len(rows))
+	for i, row := range rows {
+		out[i] = toWaiverClaim(sqlcgen.FindWaiverClaimByIDRow(row))
+	}
+	return out, nil
+}
+
+func (r *WaiverRepository) UpdateClaim(ctx context.Context, c domain.WaiverClaim) error {
+	return r.q.UpdateWaiverClaim(ctx, sqlcgen.UpdateWaiverClaimParams{
+		ID:             int32(c.ID),
+		Status:         string(c.Status),
+		FailureReason:  c.FailureReason,
+		PlayerSeasonID: intPtrToInt32Ptr(c.PlayerSeasonID),
+		ProcessedAt:    toTimestamptz(c.ProcessedAt),
+	})
+}
+
+func (r *WaiverRepository) UpsertSchedule(ctx context.Context, s domain.WaiverSchedule) (domain.WaiverSchedule, error) {
+	row, err := r.q.UpsertWaiverSchedule(ctx, sqlcgen.UpsertWaiverScheduleParams{
+		RoundID:   int32(s.RoundID),
+		ProcessAt: toTimestamptz(&s.ProcessAt),
+	})
+	if err != nil {
+		return domain.WaiverSchedule{}, err
+	}
+	return toWaiverSchedule(sqlcgen.FindWaiverScheduleByRoundIDRow(row)), nil
+}
+
+func (r *WaiverRepository) FindScheduleByRoundID(ctx context.Context, roundID int) (domain.WaiverSchedule, error) {
+	row, err := r.q.FindWaiverScheduleByRoundID(ctx, int32(roundID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.WaiverSchedule{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.WaiverSchedule{}, err
+	}
+	return toWaiverSchedule(row), nil
+}
+
+func (r *WaiverRepository) FindDueSchedules(ctx context.Context, asOf time.Time) ([]domain.WaiverSchedule, error) {
+	rows, err := r.q.FindDueWaiverSchedules(ctx, toTimestamptz(&asOf))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.WaiverSchedule, len(rows))
+	for i, row := range rows {
+		out[i] = toWaiverSchedule(sqlcgen.FindWaiverScheduleByRoundIDRow(row))
+	}
+	return out, nil
+}
+
+func (r *WaiverRepository) MarkScheduleProcessed(ctx context.Context, roundID int, at time.Time) error {
+	return r.q.MarkWaiverScheduleProcessed(ctx, sqlcgen.MarkWaiverScheduleProcessedParams{
+		RoundID:     int32(roundID),
+		ProcessedAt: toTimestamptz(&at),
+	})
+}
+
+func (r *WaiverRepository) ReassignPendingAFLPlayerSeason(ctx context.Context, fromAFLPlayerSeasonID, toAFLPlayerSeasonID int) error {
+	return r.q.ReassignPendingWaiverClaimsAFLPlayerSeason(ctx, sqlcgen.ReassignPendingWaiverClaimsAFLPlayerSeasonParams{
+		ToAflPlayerSeasonID:   int32(toAFLPlayerSeasonID),
+		FromAflPlayerSeasonID: int32(fromAFLPlayerSeasonID),
+	})
+}
+
+// --- DataopsPostFormatRepository ---
+
+type DataopsPostFormatRepository struct{ q *sqlcgen.Queries }
+
+func NewDataopsPostFormatRepository(q *sqlcgen.Queries) *DataopsPostFormatRepository {
+	return &DataopsPostFormatRepository{q: q}
+}
+
+// postFormatSpec is the JSON stored in dataops_post_format.spec: everything in
+// an application.PostFormat except its key and name, which have columns.
+type postFormatSpec struct {
+	Detect             string               `json:"detect"`
+	ScoreLines         []string             `json:"scoreLines,omitempty"`
+	Strip              []string             `json:"strip,omitempty"`
+	Sections           []postSectionSpec    `json:"sections"`
+	Players            []postPlayerLineSpec `json:"players"`
+	BenchCodes         map[string]string    `json:"benchCodes,omitempty"`
+	RawStatMultipliers map[string]int       `json:"rawStatMultipliers,omitempty"`
+	InterchangeMarker  string               `json:"interchangeMarker,omitempty"`
+	Layout             *postLayoutSpec      `json:"layout,omitempty"`
+}
+
+type postLayoutSpec struct {
+	Title           string            `json:"title,omitempty"`
+	Footer          string            `json:"footer,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Player          string            `json:"player"`
+	Bench           string            `json:"bench"`
+	BenchStar       string            `json:"benchStar,omitempty"`
+	Subbed          string            `json:"subbed,omitempty"`
+	Interchanged    string            `json:"interchanged,omitempty"`
+	InterchangeCode string            `json:"interchangeCode,omitempty"`
+	InterchangeLine string            `json:"interchangeLine,omitempty"`
+}
+
+type postSectionSpec struct {
+	Header   string `json:"header"`
+	Position string `json:"position"`
+}
+
+type postPlayerLineSpec struct {
+	Section             string `json:"section,omitempty"`
+	Pattern             string `json:"pattern"`
+	BackupPositions     string `json:"backupPositions,omitempty"`
+	InterchangePosition string `json:"interchangePosition,omitempty"`
+	Score               *int   `json:"score,omitempty"`
+	Notes               string `json:"notes,omitempty"`
+}
+
+func toPostFormatSpec(f application.PostFormat) postFormatSpec {
+	s := postFormatSpec{
+		Detect:             f.Detect,
+		ScoreLines:         f.ScoreLines,
+		Strip:              f.Strip,
+		Sections:           make([]postSectionSpec, len(f.Sections)),
+		Players:            make([]postPlayerLineSpec, len(f.Players)),
+		BenchCodes:         f.BenchCodes,
+		RawStatMultipliers: f.RawStatMultipliers,
+		InterchangeMarker:  f.InterchangeMarker,
+	}
+	for i, sec := range f.Sections {
+		s.Sections[i] = postSectionSpec(sec)
+	}
+	for i, pl := range f.Players {
+		s.Players[i] = postPlayerLineSpec(pl)
+	}
+	if f.Layout.Player != "" || f.Layout.Bench != "" {
+		layout := postLayoutSpec(f.Layout)
+		s.Layout = &layout
+	}
+	return s
+}
+
+func toPostFormat(clubID int32, name string, spec []byte) (application.PostFormat, error) {
+	var s postFormatSpec
+	if err := json.Unmarshal(spec, &s); err != nil {
+		return application.PostFormat{}, fmt.Errorf("decode post format for club %d: %w", clubID, err)
+	}
+	f := application.PostFormat{
+		ClubID:             int(clubID),
+		Name:               name,
+		Detect:             s.Detect,
+		ScoreLines:         s.ScoreLines,
+		Strip:              s.Strip,
+		Sections:           make([]application.PostSection, len(s.Sections)),
+		Players:            make([]application.PostPlayerLine, len(s.Players)),
+		BenchCodes:         s.BenchCodes,
+		RawStatMultipliers: s.RawStatMultipliers,
+		InterchangeMarker:  s.InterchangeMarker,
+	}
+	for i, sec := range s.Sections {
+		f.Sections[i] = application.PostSection(sec)
+	}
+	for i, pl := range s.Players {
+		f.Players[i] = application.PostPlayerLine(pl)
+	}
+	if s.Layout != nil {
+		f.Layout = application.PostLayout(*s.Layout)
+	}
+	return f, nil
+}
+
+func (r *DataopsPostFormatRepository) FindAll(ctx context.Context) ([]application.PostFormat, error) {
+	rows, err := r.q.FindDataopsPostFormats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.PostFormat, 0, len(rows))
+	for _, row := range rows {
+		f, err := toPostFormat(row.ClubID, row.Name, row.Spec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func (r *DataopsPostFormatRepository) FindByClubSeasonID(ctx context.Context, clubSeasonID int) (application.PostFormat, bool, error) {
+	row, err := r.q.FindDataopsPostFormatByClubSeasonID(ctx, int32(clubSeasonID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return application.PostFormat{}, false, nil
+	}
+	if err != nil {
+		return application.PostFormat{}, false, err
+	}
+	f, err := toPostFormat(row.ClubID, row.Name, row.Spec)
+	return f, err == nil, err
+}
+
+func (r *DataopsPostFormatRepository) Store(ctx context.Context, format application.PostFormat) (application.PostFormat, error) {
+	spec, err := json.Marshal(toPostFormatSpec(format))
+	if err != nil {
+		return application.PostFormat{}, fmt.Errorf("encode post format: %w", err)
+	}
+	row, err := r.q.UpsertDataopsPostFormat(ctx, sqlcgen.UpsertDataopsPostFormatParams{
+		ClubID: int32(format.ClubID),
+		Name:   format.Name,
+		Spec:   spec,
+	})
+	if err != nil {
+		return application.PostFormat{}, err
+	}
+	return toPostFormat(row.ClubID, row.Name, row.Spec)
+}
+
+// --- DataopsPlayerAliasRepository ---
+
+type DataopsPlayerAliasRepository struct{ q *sqlcgen.Queries }
+
+func NewDataopsPlayerAliasRepository(q *sqlcgen.Queries) *DataopsPlayerAliasRepository {
+	return &DataopsPlayerAliasRepository{q: q}
+}
+
+// toPlayerAlias converts any of the player alias row types, which share a column list.
+func toPlayerAlias(row sqlcgen.FindDataopsPlayerAliasByAliasRow) application.PlayerAlias {
+	return application.PlayerAlias{
+		ID:          int(row.ID),
+		Alias:       row.Alias,
+		AFLPlayerID: int32PtrToIntPtr(row.AflPlayerID),
+		Learned:     row.Learned,
+	}
+}
+
+func (r *DataopsPlayerAliasRepository) FindAll(ctx context.Context) ([]application.PlayerAlias, error) {
+	rows, err := r.q.FindDataopsPlayerAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.PlayerAlias, len(rows))
+	for i, row := range rows {
+		out[i] = toPlayerAlias(sqlcgen.FindDataopsPlayerAliasByAliasRow(row))
+	}
+	return out, nil
+}
+
+func (r *DataopsPlayerAliasRepository) FindByAlias(ctx context.Context, alias string) (application.PlayerAlias, bool, error) {
+	row, err := r.q.FindDataopsPlayerAliasByAlias(ctx, alias)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return application.PlayerAlias{}, false, nil
+	}
+	if err != nil {
+		return application.PlayerAlias{}, false, err
+	}
+	return toPlayerAlias(row), true, nil
+}
+
+func (r *DataopsPlayerAliasRepository) Store(ctx context.Context, alias application.PlayerAlias) (application.PlayerAlias, error) {
+	row, err := r.q.UpsertDataopsPlayerAlias(ctx, sqlcgen.UpsertDataopsPlayerAliasParams{
+		Alias:       alias.Alias,
+		AflPlayerID: intPtrToInt32Ptr(alias.AFLPlayerID),
+		Learned:     alias.Learned,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// A learned alias doesn't replace an admin's; keep theirs.
+		existing, err := r.q.FindDataopsPlayerAliasByAlias(ctx, alias.Alias)
+		if err != nil {
+			return application.PlayerAlias{}, err
+		}
+		return toPlayerAlias(existing), nil
+	}
+	if err != nil {
+		return application.PlayerAlias{}, err
+	}
+	return toPlayerAlias(sqlcgen.FindDataopsPlayerAliasByAliasRow(row)), nil
+}
+
+func (r *DataopsPlayerAliasRepository) Update(ctx context.Context, alias application.PlayerAlias) (application.PlayerAlias, error) {
+	row, err := r.q.UpdateDataopsPlayerAlias(ctx, sqlcgen.UpdateDataopsPlayerAliasParams{
+		ID:          int32(alias.ID),
+		Alias:       alias.Alias,
+		AflPlayerID: intPtrToInt32Ptr(alias.AFLPlayerID),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return application.PlayerAlias{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return application.PlayerAlias{}, err
+	}
+	return toPlayerAlias(sqlcgen.FindDataopsPlayerAliasByAliasRow(row)), nil
+}
+
+func (r *DataopsPlayerAliasRepository) Delete(ctx context.Context, id int) error {
+	n, err := r.q.DeleteDataopsPlayerAlias(ctx, int32(id))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *DataopsPlayerAliasRepository) ReassignAFLPlayer(ctx context.Context, fromAFLPlayerID, toAFLPlayerID int) error {
+	return r.q.ReassignDataopsPlayerAliases(ctx, sqlcgen.ReassignDataopsPlayerAliasesParams{
+		ToAflPlayerID:   intPtrToInt32Ptr(&toAFLPlayerID),
+		FromAflPlayerID: intPtrToInt32Ptr(&fromAFLPlayerID),
+	})
+}
+
+// --- DataopsSubmissionRepository ---
+
+type DataopsSubmissionRepository struct{ q *sqlcgen.Queries }
+
+func NewDataopsSubmissionRepository(q *sqlcgen.Queries) *DataopsSubmissionRepository {
+	return &DataopsSubmissionRepository{q: q}
+}
+
+// resolvedPlayerSpec is the JSON stored for each player in
+// dataops_team_submission.parsed and .confirmed.
+type resolvedPlayerSpec struct {
+	Name                string  `json:"name"`
+	ClubHint            string  `json:"clubHint,omitempty"`
+	Position            string  `json:"position"`
+	BackupPositions     string  `json:"backupPositions,omitempty"`
+	InterchangePosition string  `json:"interchangePosition,omitempty"`
+	Score               *int    `json:"score,omitempty"`
+	Notes               string  `json:"notes,omitempty"`
+	AFLPlayerID         int     `json:"aflPlayerId,omitempty"`
+	PlayerSeasonID      int     `json:"playerSeasonId,omitempty"`
+	MatchedName         string  `json:"matchedName,omitempty"`
+	MatchedClub         string  `json:"matchedClub,omitempty"`
+	Confidence          float64 `json:"confidence"`
+	Confident           bool    `json:"confident"`
+}
+
+func toResolvedPlayerSpecs(players []application.ResolvedPlayer) ([]byte, error) {
+	if players == nil {
+		return []byte("null"), nil
+	}
+	specs := make([]resolvedPlayerSpec, len(players))
+	for i, rp := range players {
+		specs[i] = resolvedPlayerSpec{
+			Name:                rp.Parsed.Name,
+			ClubHint:            rp.Parsed.ClubHint,
+			Position:            rp.Parsed.Position,
+			BackupPositions:     rp.Parsed.BackupPositions,
+			InterchangePosition: rp.Parsed.InterchangePosition,
+			Score:               rp.Parsed.Score,
+			Notes:               rp.Parsed.Notes,
+			AFLPlayerID:         rp.Parsed.AFLPlayerID,
+			PlayerSeasonID:      rp.PlayerSeasonID,
+			MatchedName:         rp.BestMatch.Candidate.Name,
+			MatchedClub:         rp.BestMatch.Candidate.Club,
+			Confidence:          rp.BestMatch.Confidence,
+			Confident:           rp.Confident,
+		}
+	}
+	return json.Marshal(specs)
+}
+
+func toResolvedPlayers(data []byte) ([]application.ResolvedPlayer, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var specs []resolvedPlayerSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, err
+	}
+	if specs == nil {
+		return nil, nil
+	}
+	players := make([]application.ResolvedPlayer, len(specs))
+	for i, s := range specs {
+		players[i] = application.ResolvedPlayer{
+			Parsed: application.ParsedPlayerRow{
+				Name:                s.Name,
+				ClubHint:            s.ClubHint,
+				Position:            s.Position,
+				BackupPositions:     s.BackupPositions,
+				InterchangePosition: s.InterchangePosition,
+				Score:               s.Score,
+				Notes:               s.Notes,
+				AFLPlayerID:         s.AFLPlayerID,
+			},
+			PlayerSeasonID: s.PlayerSeasonID,
+			BestMatch: application.PlayerNameMatch{
+				Candidate: application.PlayerCandidate{
+					PlayerID:    s.PlayerSeasonID,
+					AFLPlayerID: s.AFLPlayerID,
+					Name:        s.MatchedName,
+					Club:        s.MatchedClub,
+				},
+				Confidence: s.Confidence,
+			},
+			Confident: s.Confident,
+		}
+	}
+	return players, nil
+}
+
+// toSubmissionRecord converts any of the team submission row types, which
+// share a column list.
+func toSubmissionRecord(row sqlcgen.FindDataopsTeamSubmissionByIDRow) (application.SubmissionRecord, error) {
+	s := application.SubmissionRecord{
+		ID:          int(row.ID),
+		ClubMatchID: int(row.ClubMatchID),
+		Post:        row.Post,
+		SubmittedAt: row.CreatedAt.Time,
+		ConfirmedAt: timestamptzPtr(row.ConfirmedAt),
+	}
+	if row.Author != nil {
+		s.Author = *row.Author
+	}
+	if row.Format != nil {
+		s.Format = *row.Format
+	}
+	var err error
+	if s.Parsed, err = toResolvedPlayers(row.Parsed); err != nil {
+		return application.SubmissionRecord{}, fmt.Errorf("decode parsed team of submission %d: %w", row.ID, err)
+	}
+	if s.Confirmed, err = toResolvedPlayers(row.Confirmed); err != nil {
+		return application.SubmissionRecord{}, fmt.Errorf("decode confirmed team of submission %d: %w", row.ID, err)
+	}
+	return s, nil
+}
+
+func (r *DataopsSubmissionRepository) Create(ctx context.Context, s application.SubmissionRecord) (application.SubmissionRecord, error) {
+	parsed, err := toResolvedPlayerSpecs(s.Parsed)
+	if err != nil {
+		return application.SubmissionRecord{}, err
+	}
+	row, err := r.q.CreateDataopsTeamSubmission(ctx, sqlcgen.CreateDataopsTeamSubmissionParams{
+		ClubMatchID: int32(s.ClubMatchID),
+		Author:      nilIfEmpty(s.Author),
+		Format:      nilIfEmpty(s.Format),
+		Post:        s.Post,
+		Parsed:      parsed,
+	})
+	if err != nil {
+		return application.SubmissionRecord{}, err
+	}
+	return toSubmissionRecord(sqlcgen.FindDataopsTeamSubmissionByIDRow(row))
+}
+
+func (r *DataopsSubmissionRepository) Confirm(ctx context.Context, id int, confirmed []application.ResolvedPlayer) error {
+	data, err := toResolvedPlayerSpecs(confirmed)
+	if err != nil {
+		return err
+	}
+	n, err := r.q.ConfirmDataopsTeamSubmission(ctx, sqlcgen.ConfirmDataopsTeamSubmissionParams{ID: int32(id), Confirmed: data})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *DataopsSubmissionRepository) FindByID(ctx context.Context, id int) (application.SubmissionRecord, error) {
+	row, err := r.q.FindDataopsTeamSubmissionByID(ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return application.SubmissionRecord{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return application.SubmissionRecord{}, err
+	}
+	return toSubmissionRecord(row)
+}
+
+func (r *DataopsSubmissionRepository) FindByClubMatchID(ctx context.Context, clubMatchID int) ([]application.SubmissionRecord, error) {
+	rows, err := r.q.FindDataopsTeamSubmissionsByClubMatchID(ctx, int32(clubMatchID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]application.SubmissionRecord, len(rows))
+	for i, row := range rows {
+		if out[i], err = toSubmissionRecord(sqlcgen.FindDataopsTeamSubmissionByIDRow(row)); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
@@ -20,6 +20,12 @@ SELECT id, club_id, season_id,
 FROM ffl.club_season
 WHERE club_id = $1 AND season_id = $2 AND deleted_at IS NULL;
 
+-- name: FindClubsByClubSeasonIDs :many
+SELECT cs.id AS club_season_id, c.id AS club_id, c.name
+FROM ffl.club_season cs
+JOIN ffl.club c ON c.id = cs.club_id
+WHERE cs.id = ANY(@club_season_ids::int[]) AND cs.deleted_at IS NULL AND c.deleted_at IS NULL;
+
 -- name: UpdateFflClubSeason :exec
 UPDATE ffl.club_season
 SET drv_played             = $2,
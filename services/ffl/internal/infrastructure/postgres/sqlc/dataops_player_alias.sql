@@ -0,0 +1,31 @@
+-- name: DeleteDataopsPlayerAlias :execrows
+DELETE FROM ffl.dataops_player_alias WHERE id = $1;
+
+-- name: FindDataopsPlayerAliasByAlias :one
+SELECT id, alias, afl_player_id, learned
+FROM ffl.dataops_player_alias
+WHERE alias = $1;
+
+-- name: FindDataopsPlayerAliases :many
+SELECT id, alias, afl_player_id, learned
+FROM ffl.dataops_player_alias
+ORDER BY alias;
+
+-- name: UpdateDataopsPlayerAlias :one
+UPDATE ffl.dataops_player_alias
+SET alias = $2, afl_player_id = $3, learned = FALSE, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING id, alias, afl_player_id, learned;
+
+-- name: UpsertDataopsPlayerAlias :one
+INSERT INTO ffl.dataops_player_alias (alias, afl_player_id, learned)
+VALUES ($1, $2, $3)
+ON CONFLICT (alias) DO UPDATE
+SET afl_player_id = EXCLUDED.afl_player_id, learned = EXCLUDED.learned, updated_at = CURRENT_TIMESTAMP
+WHERE dataops_player_alias.learned OR NOT EXCLUDED.learned
+RETURNING id, alias, afl_player_id, learned;
+
+-- name: ReassignDataopsPlayerAliases :exec
+UPDATE ffl.dataops_player_alias
+SET afl_player_id = @to_afl_player_id, updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_id = @from_afl_player_id;
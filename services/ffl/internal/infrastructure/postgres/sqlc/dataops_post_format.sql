@@ -0,0 +1,17 @@
+-- name: FindDataopsPostFormatByClubSeasonID :one
+SELECT f.club_id, f.name, f.spec
+FROM ffl.dataops_post_format f
+JOIN ffl.club_season cs ON cs.club_id = f.club_id
+WHERE cs.id = $1 AND cs.deleted_at IS NULL;
+
+-- name: FindDataopsPostFormats :many
+SELECT club_id, name, spec
+FROM ffl.dataops_post_format
+ORDER BY club_id;
+
+-- name: UpsertDataopsPostFormat :one
+INSERT INTO ffl.dataops_post_format (club_id, name, spec)
+VALUES ($1, $2, $3)
+ON CONFLICT (club_id) DO UPDATE
+SET name = EXCLUDED.name, spec = EXCLUDED.spec, updated_at = CURRENT_TIMESTAMP
+RETURNING club_id, name, spec;
@@ -0,0 +1,20 @@
+-- name: ConfirmDataopsTeamSubmission :execrows
+UPDATE ffl.dataops_team_submission
+SET confirmed = $2, confirmed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateDataopsTeamSubmission :one
+INSERT INTO ffl.dataops_team_submission (club_match_id, author, format, post, parsed)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, club_match_id, author, format, post, parsed, confirmed, confirmed_at;
+
+-- name: FindDataopsTeamSubmissionByID :one
+SELECT id, created_at, club_match_id, author, format, post, parsed, confirmed, confirmed_at
+FROM ffl.dataops_team_submission
+WHERE id = $1;
+
+-- name: FindDataopsTeamSubmissionsByClubMatchID :many
+SELECT id, created_at, club_match_id, author, format, post, parsed, confirmed, confirmed_at
+FROM ffl.dataops_team_submission
+WHERE club_match_id = $1
+ORDER BY created_at, id;
@@ -0,0 +1,82 @@
+-- name: CreateDraft :one
+INSERT INTO ffl.draft (season_id, style, rounds, pick_seconds, status)
+VALUES (@season_id, @style, @rounds, @pick_seconds, @status)
+RETURNING id, season_id, style, rounds, pick_seconds, status, clock_started_at;
+
+-- name: CreateDraftPick :one
+INSERT INTO ffl.draft_pick (draft_id, pick_number, round, club_season_id, status)
+VALUES (@draft_id, @pick_number, @round, @club_season_id, @status)
+RETURNING id;
+
+-- name: FindDraftByID :one
+SELECT id, season_id, style, rounds, pick_seconds, status, clock_started_at
+FROM ffl.draft
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: FindDraftBySeasonID :one
+SELECT id, season_id, style, rounds, pick_seconds, status, clock_started_at
+FROM ffl.draft
+WHERE season_id = $1 AND deleted_at IS NULL;
+
+-- name: FindDraftsByStatus :many
+SELECT id, season_id, style, rounds, pick_seconds, status, clock_started_at
+FROM ffl.draft
+WHERE status = $1 AND deleted_at IS NULL
+ORDER BY id;
+
+-- name: FindDraftPicksByDraftID :many
+SELECT id, draft_id, pick_number, round, club_season_id, afl_player_season_id, player_season_id, status, picked_at
+FROM ffl.draft_pick
+WHERE draft_id = $1 AND deleted_at IS NULL
+ORDER BY pick_number;
+
+-- name: UpdateDraftState :exec
+UPDATE ffl.draft
+SET status = @status,
+    clock_started_at = @clock_started_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND deleted_at IS NULL;
+
+-- name: UpdateDraftPick :execrows
+UPDATE ffl.draft_pick
+SET afl_player_season_id = @afl_player_season_id,
+    player_season_id = @player_season_id,
+    status = @status,
+    picked_at = @picked_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND status = 'pending' AND deleted_at IS NULL;
+
+-- name: FindDraftRankings :many
+SELECT draft_id, club_season_id, afl_player_season_id, rank
+FROM ffl.draft_ranking
+WHERE draft_id = @draft_id AND club_season_id = @club_season_id AND deleted_at IS NULL
+ORDER BY rank;
+
+-- name: DeleteDraftRankings :exec
+DELETE FROM ffl.draft_ranking
+WHERE draft_id = @draft_id AND club_season_id = @club_season_id;
+
+-- name: CreateDraftRanking :exec
+INSERT INTO ffl.draft_ranking (draft_id, club_season_id, afl_player_season_id, rank)
+VALUES (@draft_id, @club_season_id, @afl_player_season_id, @rank);
+
+-- name: DeleteDraftRankingsRankedAs :exec
+DELETE FROM ffl.draft_ranking
+WHERE afl_player_season_id = @from_afl_player_season_id
+  AND (draft_id, club_season_id) IN (
+    SELECT draft_id, club_season_id
+    FROM ffl.draft_ranking
+    WHERE afl_player_season_id = @to_afl_player_season_id
+  );
+
+-- name: ReassignDraftRankingsAFLPlayerSeason :exec
+UPDATE ffl.draft_ranking
+SET afl_player_season_id = @to_afl_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_season_id = @from_afl_player_season_id AND deleted_at IS NULL;
+
+-- name: ReassignDraftPicksAFLPlayerSeason :exec
+UPDATE ffl.draft_pick
+SET afl_player_season_id = @to_afl_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_season_id = @from_afl_player_season_id AND deleted_at IS NULL;
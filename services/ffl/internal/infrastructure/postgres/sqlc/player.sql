@@ -24,3 +24,9 @@ UPDATE ffl.player
 SET deleted_at = CURRENT_TIMESTAMP,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ReassignPlayersAFLPlayer :execrows
+UPDATE ffl.player
+SET afl_player_id = @to_afl_player_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_id = @from_afl_player_id AND deleted_at IS NULL;
@@ -54,3 +54,15 @@ UPDATE ffl.player_season
 SET deleted_at = CURRENT_TIMESTAMP,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ReassignPlayerSeasonsAFLPlayerSeason :exec
+UPDATE ffl.player_season
+SET afl_player_season_id = @to_afl_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_season_id = @from_afl_player_season_id AND deleted_at IS NULL;
+
+-- name: ReassignPlayerSeasonsPlayer :exec
+UPDATE ffl.player_season
+SET player_id = @to_player_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE player_id = @from_player_id AND deleted_at IS NULL;
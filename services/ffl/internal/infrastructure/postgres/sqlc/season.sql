@@ -1,10 +1,18 @@
 -- name: FindAllSeasons :many
-SELECT id, name, league_id, afl_season_id
+SELECT id, name, league_id, afl_season_id, min_squad_size, max_squad_size
 FROM ffl.season
 WHERE deleted_at IS NULL
 ORDER BY name;
 
 -- name: FindSeasonByID :one
-SELECT id, name, league_id, afl_season_id
+SELECT id, name, league_id, afl_season_id, min_squad_size, max_squad_size
 FROM ffl.season
 WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: UpdateSeasonSquadLimits :one
+UPDATE ffl.season
+SET min_squad_size = @min_squad_size,
+    max_squad_size = @max_squad_size,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND deleted_at IS NULL
+RETURNING id, name, league_id, afl_season_id, min_squad_size, max_squad_size;
@@ -0,0 +1,57 @@
+-- name: CreateWaiverClaim :one
+INSERT INTO ffl.waiver_claim (round_id, club_season_id, afl_player_season_id, drop_player_season_id, priority, status)
+VALUES (@round_id, @club_season_id, @afl_player_season_id, @drop_player_season_id, @priority, @status)
+RETURNING id, round_id, club_season_id, afl_player_season_id, drop_player_season_id, priority, status, failure_reason, player_season_id, processed_at;
+
+-- name: FindWaiverClaimByID :one
+SELECT id, round_id, club_season_id, afl_player_season_id, drop_player_season_id, priority, status, failure_reason, player_season_id, processed_at
+FROM ffl.waiver_claim
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: FindWaiverClaimsByRoundID :many
+SELECT id, round_id, club_season_id, afl_player_season_id, drop_player_season_id, priority, status, failure_reason, player_season_id, processed_at
+FROM ffl.waiver_claim
+WHERE round_id = $1 AND deleted_at IS NULL
+ORDER BY club_season_id, priority, id;
+
+-- name: UpdateWaiverClaim :exec
+UPDATE ffl.waiver_claim
+SET status = @status,
+    failure_reason = @failure_reason,
+    player_season_id = @player_season_id,
+    processed_at = @processed_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND deleted_at IS NULL;
+
+-- name: ReassignPendingWaiverClaimsAFLPlayerSeason :exec
+UPDATE ffl.waiver_claim
+SET afl_player_season_id = @to_afl_player_season_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_season_id = @from_afl_player_season_id
+  AND status = 'pending' AND deleted_at IS NULL;
+
+-- name: UpsertWaiverSchedule :one
+INSERT INTO ffl.waiver_schedule (round_id, process_at)
+VALUES (@round_id, @process_at)
+ON CONFLICT (round_id) DO UPDATE
+SET process_at = EXCLUDED.process_at,
+    deleted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING round_id, process_at, processed_at;
+
+-- name: FindWaiverScheduleByRoundID :one
+SELECT round_id, process_at, processed_at
+FROM ffl.waiver_schedule
+WHERE round_id = $1 AND deleted_at IS NULL;
+
+-- name: FindDueWaiverSchedules :many
+SELECT round_id, process_at, processed_at
+FROM ffl.waiver_schedule
+WHERE processed_at IS NULL AND process_at <= $1 AND deleted_at IS NULL
+ORDER BY process_at, round_id;
+
+-- name: MarkWaiverScheduleProcessed :exec
+UPDATE ffl.waiver_schedule
+SET processed_at = @processed_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE round_id = @round_id AND deleted_at IS NULL;
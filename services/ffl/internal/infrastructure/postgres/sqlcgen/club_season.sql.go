@@ -151,6 +151,39 @@ func (q *Queries) FindClubSeasonsBySeasonID(ctx context.Context, seasonID int32)
 	return items, nil
 }
 
+const findClubsByClubSeasonIDs = `-- name: FindClubsByClubSeasonIDs :many
+SELECT cs.id AS club_season_id, c.id AS club_id, c.name
+FROM ffl.club_season cs
+JOIN ffl.club c ON c.id = cs.club_id
+WHERE cs.id = ANY($1::int[]) AND cs.deleted_at IS NULL AND c.deleted_at IS NULL
+`
+
+type FindClubsByClubSeasonIDsRow struct {
+	ClubSeasonID int32
+	ClubID       int32
+	Name         string
+}
+
+func (q *Queries) FindClubsByClubSeasonIDs(ctx context.Context, clubSeasonIds []int32) ([]FindClubsByClubSeasonIDsRow, error) {
+	rows, err := q.db.Query(ctx, findClubsByClubSeasonIDs, clubSeasonIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindClubsByClubSeasonIDsRow{}
+	for rows.Next() {
+		var i FindClubsByClubSeasonIDsRow
+		if err := rows.Scan(&i.ClubSeasonID, &i.ClubID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateFflClubSeason = `-- name: UpdateFflClubSeason :exec
 UPDATE ffl.club_season
 SET drv_played             = $2,
@@ -46,6 +46,74 @@ type FflClubSeason struct {
 	DrvPremiershipPoints *int32
 }
 
+type FflDataopsPlayerAlias struct {
+	ID          int32
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	Alias       string
+	AflPlayerID *int32
+	Learned     bool
+}
+
+type FflDataopsPostFormat struct {
+	ClubID    int32
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	Name      string
+	Spec      []byte
+}
+
+type FflDataopsTeamSubmission struct {
+	ID          int32
+	CreatedAt   pgtype.Timestamptz
+	ClubMatchID int32
+	Author      *string
+	Format      *string
+	Post        string
+	Parsed      []byte
+	Confirmed   []byte
+	ConfirmedAt pgtype.Timestamptz
+}
+
+type FflDraft struct {
+	ID             int32
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	DeletedAt      pgtype.Timestamptz
+	SeasonID       int32
+	Style          string
+	Rounds         int32
+	PickSeconds    int32
+	Status         string
+	ClockStartedAt pgtype.Timestamptz
+}
+
+type FflDraftPick struct {
+	ID                int32
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+	DraftID           int32
+	PickNumber        int32
+	Round             int32
+	ClubSeasonID      int32
+	AflPlayerSeasonID *int32
+	PlayerSeasonID    *int32
+	Status            string
+	PickedAt          pgtype.Timestamptz
+}
+
+type FflDraftRanking struct {
+	ID                int32
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+	DraftID           int32
+	ClubSeasonID      int32
+	AflPlayerSeasonID int32
+	Rank              int32
+}
+
 type FflLeague struct {
 	ID        int32
 	CreatedAt pgtype.Timestamptz
@@ -115,11 +183,39 @@ type FflRound struct {
 }
 
 type FflSeason struct {
+	ID           int32
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+	LeagueID     int32
+	Name         string
+	AflSeasonID  int32
+	MinSquadSize *int32
+	MaxSquadSize *int32
+}
+
+type FflWaiverClaim struct {
+	ID                 int32
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	DeletedAt          pgtype.Timestamptz
+	RoundID            int32
+	ClubSeasonID       int32
+	AflPlayerSeasonID  int32
+	DropPlayerSeasonID int32
+	Priority           int32
+	Status             string
+	FailureReason      *string
+	PlayerSeasonID     *int32
+	ProcessedAt        pgtype.Timestamptz
+}
+
+type FflWaiverSchedule struct {
 	ID          int32
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 	DeletedAt   pgtype.Timestamptz
-	LeagueID    int32
-	Name        string
-	AflSeasonID int32
+	RoundID     int32
+	ProcessAt   pgtype.Timestamptz
+	ProcessedAt pgtype.Timestamptz
 }
@@ -106,3 +106,23 @@ func (q *Queries) FindPlayerByID(ctx context.Context, id int32) (FindPlayerByIDR
 	err := row.Scan(&i.ID, &i.AflPlayerID)
 	return i, err
 }
+
+const reassignPlayersAFLPlayer = `-- name: ReassignPlayersAFLPlayer :execrows
+UPDATE ffl.player
+SET afl_player_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_id = $2 AND deleted_at IS NULL
+`
+
+type ReassignPlayersAFLPlayerParams struct {
+	ToAflPlayerID   int32
+	FromAflPlayerID int32
+}
+
+func (q *Queries) ReassignPlayersAFLPlayer(ctx context.Context, arg ReassignPlayersAFLPlayerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reassignPlayersAFLPlayer, arg.ToAflPlayerID, arg.FromAflPlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -279,6 +279,40 @@ func (q *Queries) FindPlayersByPlayerSeasonIDs(ctx context.Context, playerSeason
 	return items, nil
 }
 
+const reassignPlayerSeasonsAFLPlayerSeason = `-- name: ReassignPlayerSeasonsAFLPlayerSeason :exec
+UPDATE ffl.player_season
+SET afl_player_season_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE afl_player_season_id = $2 AND deleted_at IS NULL
+`
+
+type ReassignPlayerSeasonsAFLPlayerSeasonParams struct {
+	ToAflPlayerSeasonID   int32
+	FromAflPlayerSeasonID int32
+}
+
+func (q *Queries) ReassignPlayerSeasonsAFLPlayerSeason(ctx context.Context, arg ReassignPlayerSeasonsAFLPlayerSeasonParams) error {
+	_, err := q.db.Exec(ctx, reassignPlayerSeasonsAFLPlayerSeason, arg.ToAflPlayerSeasonID, arg.FromAflPlayerSeasonID)
+	return err
+}
+
+const reassignPlayerSeasonsPlayer = `-- name: ReassignPlayerSeasonsPlayer :exec
+UPDATE ffl.player_season
+SET player_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE player_id = $2 AND deleted_at IS NULL
+`
+
+type ReassignPlayerSeasonsPlayerParams struct {
+	ToPlayerID   int32
+	FromPlayerID int32
+}
+
+func (q *Queries) ReassignPlayerSeasonsPlayer(ctx context.Context, arg ReassignPlayerSeasonsPlayerParams) error {
+	_, err := q.db.Exec(ctx, reassignPlayerSeasonsPlayer, arg.ToPlayerID, arg.FromPlayerID)
+	return err
+}
+
 const setPlayerSeasonEndRound = `-- name: SetPlayerSeasonEndRound :exec
 UPDATE ffl.player_season
 SET to_round_id = $1,
@@ -6,13 +6,24 @@ package sqlcgen
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AllAFLStatusesFinal(ctx context.Context, clubMatchID int32) (bool, error)
 	CountFinalClubMatchesByMatchID(ctx context.Context, matchID int32) (int64, error)
+	ConfirmDataopsTeamSubmission(ctx context.Context, arg ConfirmDataopsTeamSubmissionParams) (int64, error)
+	CreateDataopsTeamSubmission(ctx context.Context, arg CreateDataopsTeamSubmissionParams) (CreateDataopsTeamSubmissionRow, error)
+	CreateDraft(ctx context.Context, arg CreateDraftParams) (CreateDraftRow, error)
+	CreateDraftPick(ctx context.Context, arg CreateDraftPickParams) (int32, error)
+	CreateDraftRanking(ctx context.Context, arg CreateDraftRankingParams) error
 	CreatePlayer(ctx context.Context, aflPlayerID int32) (CreatePlayerRow, error)
 	CreatePlayerSeason(ctx context.Context, arg CreatePlayerSeasonParams) (CreatePlayerSeasonRow, error)
+	CreateWaiverClaim(ctx context.Context, arg CreateWaiverClaimParams) (CreateWaiverClaimRow, error)
+	DeleteDataopsPlayerAlias(ctx context.Context, id int32) (int64, error)
+	DeleteDraftRankings(ctx context.Context, arg DeleteDraftRankingsParams) error
+	DeleteDraftRankingsRankedAs(ctx context.Context, arg DeleteDraftRankingsRankedAsParams) error
 	DeletePlayer(ctx context.Context, id int32) error
 	DeletePlayerMatchByID(ctx context.Context, id int32) error
 	DeletePlayerMatchesByClubMatchID(ctx context.Context, clubMatchID int32) error
@@ -26,7 +37,20 @@ type Querier interface {
 	FindClubSeasonByClubAndSeason(ctx context.Context, arg FindClubSeasonByClubAndSeasonParams) (FindClubSeasonByClubAndSeasonRow, error)
 	FindClubSeasonByID(ctx context.Context, id int32) (FindClubSeasonByIDRow, error)
 	FindClubSeasonsBySeasonID(ctx context.Context, seasonID int32) ([]FindClubSeasonsBySeasonIDRow, error)
+	FindClubsByClubSeasonIDs(ctx context.Context, clubSeasonIds []int32) ([]FindClubsByClubSeasonIDsRow, error)
 	FindClubsByIDs(ctx context.Context, ids []int32) ([]FindClubsByIDsRow, error)
+	FindDataopsPlayerAliasByAlias(ctx context.Context, alias string) (FindDataopsPlayerAliasByAliasRow, error)
+	FindDataopsPlayerAliases(ctx context.Context) ([]FindDataopsPlayerAliasesRow, error)
+	FindDataopsPostFormatByClubSeasonID(ctx context.Context, id int32) (FindDataopsPostFormatByClubSeasonIDRow, error)
+	FindDataopsPostFormats(ctx context.Context) ([]FindDataopsPostFormatsRow, error)
+	FindDataopsTeamSubmissionByID(ctx context.Context, id int32) (FindDataopsTeamSubmissionByIDRow, error)
+	FindDataopsTeamSubmissionsByClubMatchID(ctx context.Context, clubMatchID int32) ([]FindDataopsTeamSubmissionsByClubMatchIDRow, error)
+	FindDraftByID(ctx context.Context, id int32) (FindDraftByIDRow, error)
+	FindDraftBySeasonID(ctx context.Context, seasonID int32) (FindDraftBySeasonIDRow, error)
+	FindDraftPicksByDraftID(ctx context.Context, draftID int32) ([]FindDraftPicksByDraftIDRow, error)
+	FindDraftRankings(ctx context.Context, arg FindDraftRankingsParams) ([]FindDraftRankingsRow, error)
+	FindDraftsByStatus(ctx context.Context, status string) ([]FindDraftsByStatusRow, error)
+	FindDueWaiverSchedules(ctx context.Context, processAt pgtype.Timestamptz) ([]FindDueWaiverSchedulesRow, error)
 	FindFinalFflMatchesBySeasonID(ctx context.Context, seasonID int32) ([]FindFinalFflMatchesBySeasonIDRow, error)
 	FindMatchByID(ctx context.Context, id int32) (FindMatchByIDRow, error)
 	FindMatchesByIDs(ctx context.Context, ids []int32) ([]FindMatchesByIDsRow, error)
@@ -45,16 +69,35 @@ type Querier interface {
 	FindRoundByID(ctx context.Context, id int32) (FindRoundByIDRow, error)
 	FindRoundsBySeasonID(ctx context.Context, seasonID int32) ([]FindRoundsBySeasonIDRow, error)
 	FindSeasonByID(ctx context.Context, id int32) (FindSeasonByIDRow, error)
+	FindWaiverClaimByID(ctx context.Context, id int32) (FindWaiverClaimByIDRow, error)
+	FindWaiverClaimsByRoundID(ctx context.Context, roundID int32) ([]FindWaiverClaimsByRoundIDRow, error)
+	FindWaiverScheduleByRoundID(ctx context.Context, roundID int32) (FindWaiverScheduleByRoundIDRow, error)
+	MarkWaiverScheduleProcessed(ctx context.Context, arg MarkWaiverScheduleProcessedParams) error
+	ReassignDataopsPlayerAliases(ctx context.Context, arg ReassignDataopsPlayerAliasesParams) error
+	ReassignDraftPicksAFLPlayerSeason(ctx context.Context, arg ReassignDraftPicksAFLPlayerSeasonParams) error
+	ReassignDraftRankingsAFLPlayerSeason(ctx context.Context, arg ReassignDraftRankingsAFLPlayerSeasonParams) error
+	ReassignPendingWaiverClaimsAFLPlayerSeason(ctx context.Context, arg ReassignPendingWaiverClaimsAFLPlayerSeasonParams) error
+	ReassignPlayerSeasonsAFLPlayerSeason(ctx context.Context, arg ReassignPlayerSeasonsAFLPlayerSeasonParams) error
+	ReassignPlayerSeasonsPlayer(ctx context.Context, arg ReassignPlayerSeasonsPlayerParams) error
+	ReassignPlayersAFLPlayer(ctx context.Context, arg ReassignPlayersAFLPlayerParams) (int64, error)
 	SetPlayerSeasonEndRound(ctx context.Context, arg SetPlayerSeasonEndRoundParams) error
 	UpdateAFLPlayerMatchID(ctx context.Context, arg UpdateAFLPlayerMatchIDParams) error
 	UpdateClubMatchDataStatus(ctx context.Context, arg UpdateClubMatchDataStatusParams) error
 	UpdateClubMatchScore(ctx context.Context, arg UpdateClubMatchScoreParams) error
+	UpdateDataopsPlayerAlias(ctx context.Context, arg UpdateDataopsPlayerAliasParams) (UpdateDataopsPlayerAliasRow, error)
+	UpdateDraftPick(ctx context.Context, arg UpdateDraftPickParams) (int64, error)
+	UpdateDraftState(ctx context.Context, arg UpdateDraftStateParams) error
 	UpdateDrvAFLStatus(ctx context.Context, arg UpdateDrvAFLStatusParams) error
 	UpdateFflClubSeason(ctx context.Context, arg UpdateFflClubSeasonParams) error
 	UpdateFflMatchResult(ctx context.Context, arg UpdateFflMatchResultParams) error
 	UpdatePlayerMatchStatus(ctx context.Context, arg UpdatePlayerMatchStatusParams) error
 	UpdatePlayerSeason(ctx context.Context, arg UpdatePlayerSeasonParams) (UpdatePlayerSeasonRow, error)
+	UpdateSeasonSquadLimits(ctx context.Context, arg UpdateSeasonSquadLimitsParams) (UpdateSeasonSquadLimitsRow, error)
+	UpdateWaiverClaim(ctx context.Context, arg UpdateWaiverClaimParams) error
+	UpsertDataopsPlayerAlias(ctx context.Context, arg UpsertDataopsPlayerAliasParams) (UpsertDataopsPlayerAliasRow, error)
+	UpsertDataopsPostFormat(ctx context.Context, arg UpsertDataopsPostFormatParams) (UpsertDataopsPostFormatRow, error)
 	UpsertPlayerMatch(ctx context.Context, arg UpsertPlayerMatchParams) (UpsertPlayerMatchRow, error)
+	UpsertWaiverSchedule(ctx context.Context, arg UpsertWaiverScheduleParams) (UpsertWaiverScheduleRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -10,17 +10,19 @@ import (
 )
 
 const findAllSeasons = `-- name: FindAllSeasons :many
-SELECT id, name, league_id, afl_season_id
+SELECT id, name, league_id, afl_season_id, min_squad_size, max_squad_size
 FROM ffl.season
 WHERE deleted_at IS NULL
 ORDER BY name
 `
 
 type FindAllSeasonsRow struct {
-	ID          int32
-	Name        string
-	LeagueID    int32
-	AflSeasonID int32
+	ID           int32
+	Name         string
+	LeagueID     int32
+	AflSeasonID  int32
+	MinSquadSize *int32
+	MaxSquadSize *int32
 }
 
 func (q *Queries) FindAllSeasons(ctx context.Context) ([]FindAllSeasonsRow, error) {
@@ -37,6 +39,8 @@ func (q *Queries) FindAllSeasons(ctx context.Context) ([]FindAllSeasonsRow, erro
 			&i.Name,
 			&i.LeagueID,
 			&i.AflSeasonID,
+			&i.MinSquadSize,
+			&i.MaxSquadSize,
 		); err != nil {
 			return nil, err
 		}
@@ -49,16 +53,18 @@ func (q *Queries) FindAllSeasons(ctx context.Context) ([]FindAllSeasonsRow, erro
 }
 
 const findSeasonByID = `-- name: FindSeasonByID :one
-SELECT id, name, league_id, afl_season_id
+SELECT id, name, league_id, afl_season_id, min_squad_size, max_squad_size
 FROM ffl.season
 WHERE id = $1 AND deleted_at IS NULL
 `
 
 type FindSeasonByIDRow struct {
-	ID          int32
-	Name        string
-	LeagueID    int32
-	AflSeasonID int32
+	ID           int32
+	Name         string
+	LeagueID     int32
+	AflSeasonID  int32
+	MinSquadSize *int32
+	MaxSquadSize *int32
 }
 
 func (q *Queries) FindSeasonByID(ctx context.Context, id int32) (FindSeasonByIDRow, error) {
@@ -69,6 +75,46 @@ func (q *Queries) FindSeasonByID(ctx context.Context, id int32) (FindSeasonByIDR
 		&i.Name,
 		&i.LeagueID,
 		&i.AflSeasonID,
+		&i.MinSquadSize,
+		&i.MaxSquadSize,
+	)
+	return i, err
+}
+
+const updateSeasonSquadLimits = `-- name: UpdateSeasonSquadLimits :one
+UPDATE ffl.season
+SET min_squad_size = $1,
+    max_squad_size = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $3 AND deleted_at IS NULL
+RETURNING id, name, league_id, afl_season_id, min_squad_size, max_squad_size
+`
+
+type UpdateSeasonSquadLimitsParams struct {
+	MinSquadSize *int32
+	MaxSquadSize *int32
+	ID           int32
+}
+
+type UpdateSeasonSquadLimitsRow struct {
+	ID           int32
+	Name         string
+	LeagueID     int32
+	AflSeasonID  int32
+	MinSquadSize *int32
+	MaxSquadSize *int32
+}
+
+func (q *Queries) UpdateSeasonSquadLimits(ctx context.Context, arg UpdateSeasonSquadLimitsParams) (UpdateSeasonSquadLimitsRow, error) {
+	row := q.db.QueryRow(ctx, updateSeasonSquadLimits, arg.MinSquadSize, arg.MaxSquadSize, arg.ID)
+	var i UpdateSeasonSquadLimitsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LeagueID,
+		&i.AflSeasonID,
+		&i.MinSquadSize,
+		&i.MaxSquadSize,
 	)
 	return i, err
 }
@@ -0,0 +1,154 @@
+package teamsheet
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"xffl/services/ffl/internal/application"
+)
+
+// csvColumns are the column names a CSV sheet's header may use, lowercased,
+// in the order columns are read when the sheet has no header.
+var csvColumns = []struct {
+	names []string
+	set   func(r *sheetRow, v string)
+}{
+	{[]string{"name", "player"}, func(r *sheetRow, v string) { r.Name = v }},
+	{[]string{"club", "afl club"}, func(r *sheetRow, v string) { r.Club = v }},
+	{[]string{"position", "pos"}, func(r *sheetRow, v string) { r.Position = v }},
+	{[]string{"backups", "backup", "backup positions"}, func(r *sheetRow, v string) { r.Backups = v }},
+	{[]string{"interchange", "int"}, func(r *sheetRow, v string) { r.Interchange = v }},
+	{[]string{"score", "points"}, func(r *sheetRow, v string) { r.Score = v }},
+}
+
+// CSVParser implements application.TeamParser for CSV team sheets. Cells
+// copied straight out of a spreadsheet are tab-separated, so a sheet whose
+// first line has tabs and no commas is read as TSV.
+//
+// The first row is a header if it names a name and a position column; its
+// columns may come in any order, and columns it doesn't know, such as notes,
+// are ignored. A sheet without one is read in the order name, club,
+// position, backups, interchange, score.
+type CSVParser struct{}
+
+func NewCSVParser() *CSVParser { return &CSVParser{} }
+
+// Formats returns nil: a sheet's layout is fixed, not registered per club.
+func (p *CSVParser) Formats() []application.PostFormat { return nil }
+
+func (p *CSVParser) SplitThread(thread string) []application.ThreadPost { return wholeSheet(thread) }
+
+// Parse reads a CSV team sheet. The format is ignored.
+func (p *CSVParser) Parse(_ context.Context, _ application.PostFormat, post string) ([]application.ParsedPlayerRow, error) {
+	r := csv.NewReader(strings.NewReader(post))
+	first, _, _ := strings.Cut(post, "\n")
+	if strings.Contains(first, "\t") && !strings.Contains(first, ",") {
+		r.Comma = '\t'
+	}
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	var (
+		columns []func(*sheetRow, string)
+		rows    []application.ParsedPlayerRow
+	)
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", application.ErrInvalidTeamSheet, err)
+		}
+		if blank(record) {
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		if columns == nil {
+			var header bool
+			columns, header = csvHeader(record)
+			if header {
+				continue
+			}
+		}
+
+		var sr sheetRow
+		for i, v := range record {
+			if i < len(columns) && columns[i] != nil {
+				columns[i](&sr, v)
+			}
+		}
+		row, err := playerRow(line, sr)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no players", application.ErrInvalidTeamSheet)
+	}
+	return rows, nil
+}
+
+// WriteTeam writes team as a CSV sheet with a header, and a score column if
+// the team is scored. The format is ignored.
+func (p *CSVParser) WriteTeam(_ application.PostFormat, team application.PostTeam) (string, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	header := []string{"name", "club", "position", "backups", "interchange"}
+	if team.Scored {
+		header = append(header, "score")
+	}
+	records := [][]string{header}
+	for _, r := range sheetRows(team) {
+		record := []string{r.Name, r.Club, r.Position, r.Backups, r.Interchange}
+		if team.Scored {
+			record = append(record, r.Score)
+		}
+		records = append(records, record)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return "", fmt.Errorf("write team sheet: %w", err)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// csvHeader returns the column setters for a sheet whose first record is
+// record: the header's columns if it is one, else the default order.
+func csvHeader(record []string) ([]func(*sheetRow, string), bool) {
+	columns := make([]func(*sheetRow, string), len(record))
+	var name, position bool
+	for i, cell := range record {
+		cell = strings.ToLower(strings.TrimSpace(cell))
+		for j, c := range csvColumns {
+			for _, n := range c.names {
+				if cell == n {
+					columns[i] = c.set
+					name = name || j == 0
+					position = position || j == 2
+				}
+			}
+		}
+	}
+	if name && position {
+		return columns, true
+	}
+	columns = make([]func(*sheetRow, string), len(csvColumns))
+	for i, c := range csvColumns {
+		columns[i] = c.set
+	}
+	return columns, false
+}
+
+func blank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
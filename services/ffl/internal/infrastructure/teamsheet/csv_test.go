@@ -0,0 +1,49 @@
+package teamsheet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+func TestCSVParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet string
+	}{
+		{"header", "Player,Club,Pos,Backups,Interchange\nMax Gawn,Melb,Hitouts,,\nNick Blakey,Syd,bench,Kicks/Handballs,\n"},
+		{"header in another order with notes", "notes,position,name,backup positions\n,hitouts,Max Gawn,\nquad,bench,Nick Blakey,\"kicks, handballs\"\n"},
+		{"no header", "Max Gawn,Melb,hitouts\n\nNick Blakey,Syd,bench,kicks;handballs\n"},
+		{"tab-separated", "name\tclub\tposition\tbackups\nMax Gawn\tMelb\thitouts\t\nNick Blakey\tSyd\tbench\tkicks/handballs\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := NewCSVParser().Parse(context.Background(), application.PostFormat{}, tt.sheet)
+			require.NoError(t, err)
+			require.Len(t, rows, 2)
+			assert.Equal(t, "Max Gawn", rows[0].Name)
+			assert.Equal(t, "hitouts", rows[0].Position)
+			assert.Equal(t, "Nick Blakey", rows[1].Name)
+			assert.Equal(t, "bench", rows[1].Position)
+			assert.Equal(t, "kicks,handballs", rows[1].BackupPositions)
+		})
+	}
+}
+
+func TestCSVParseErrors(t *testing.T) {
+	p := NewCSVParser()
+
+	_, err := p.Parse(context.Background(), application.PostFormat{}, "name,position\nMax Gawn,hitouts\nNick Blakey,ruck\n")
+	assert.ErrorIs(t, err, application.ErrInvalidTeamSheet)
+	assert.ErrorContains(t, err, "row 3", "rows are numbered by line")
+
+	_, err = p.Parse(context.Background(), application.PostFormat{}, "name,position\n")
+	assert.ErrorIs(t, err, application.ErrInvalidTeamSheet)
+
+	_, err = p.Parse(context.Background(), application.PostFormat{}, "name,position\n\"Max Gawn,hitouts\n")
+	assert.ErrorIs(t, err, application.ErrInvalidTeamSheet)
+}
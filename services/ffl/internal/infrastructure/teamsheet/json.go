@@ -0,0 +1,123 @@
+package teamsheet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"xffl/services/ffl/internal/application"
+)
+
+// JSONParser implements application.TeamParser for JSON team sheets: an
+// array of players, or an object with the array under "players". Backups may
+// be a string ("kicks,marks") or an array, and score a number or a string.
+//
+//	{"players": [
+//	  {"name": "Max Gawn", "club": "Melb", "position": "hitouts"},
+//	  {"name": "Nick Blakey", "club": "Syd", "position": "bench", "backups": ["kicks", "handballs"]}
+//	]}
+type JSONParser struct{}
+
+func NewJSONParser() *JSONParser { return &JSONParser{} }
+
+// Formats returns nil: a sheet's layout is fixed, not registered per club.
+func (p *JSONParser) Formats() []application.PostFormat { return nil }
+
+func (p *JSONParser) SplitThread(thread string) []application.ThreadPost { return wholeSheet(thread) }
+
+type jsonSheet struct {
+	Players []jsonPlayer `json:"players"`
+}
+
+type jsonPlayer struct {
+	Name        string          `json:"name"`
+	Club        string          `json:"club,omitempty"`
+	Position    string          `json:"position"`
+	Backups     jsonBackups     `json:"backups,omitempty"`
+	Interchange string          `json:"interchange,omitempty"`
+	Score       json.RawMessage `json:"score,omitempty"`
+}
+
+// jsonBackups reads backup positions written as a string or an array.
+type jsonBackups string
+
+func (b *jsonBackups) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*b = jsonBackups(strings.Join(list, ","))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("backups must be a string or an array of positions")
+	}
+	*b = jsonBackups(s)
+	return nil
+}
+
+// Parse reads a JSON team sheet. The format is ignored.
+func (p *JSONParser) Parse(_ context.Context, _ application.PostFormat, post string) ([]application.ParsedPlayerRow, error) {
+	data := bytes.TrimSpace([]byte(post))
+	var sheet jsonSheet
+	var err error
+	if bytes.HasPrefix(data, []byte("[")) {
+		err = json.Unmarshal(data, &sheet.Players)
+	} else {
+		err = json.Unmarshal(data, &sheet)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", application.ErrInvalidTeamSheet, err)
+	}
+	if len(sheet.Players) == 0 {
+		return nil, fmt.Errorf("%w: no players", application.ErrInvalidTeamSheet)
+	}
+
+	rows := make([]application.ParsedPlayerRow, len(sheet.Players))
+	for i, jp := range sheet.Players {
+		score := string(jp.Score)
+		if unquoted, err := strconv.Unquote(score); err == nil {
+			score = unquoted
+		} else if score == "null" {
+			score = ""
+		}
+		rows[i], err = playerRow(i+1, sheetRow{
+			Name:        jp.Name,
+			Club:        jp.Club,
+			Position:    jp.Position,
+			Backups:     string(jp.Backups),
+			Interchange: jp.Interchange,
+			Score:       score,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// WriteTeam writes team as a JSON sheet, with scores if the team is scored.
+// The format is ignored.
+func (p *JSONParser) WriteTeam(_ application.PostFormat, team application.PostTeam) (string, error) {
+	var sheet jsonSheet
+	for _, r := range sheetRows(team) {
+		jp := jsonPlayer{
+			Name:        r.Name,
+			Club:        r.Club,
+			Position:    r.Position,
+			Backups:     jsonBackups(r.Backups),
+			Interchange: r.Interchange,
+		}
+		if r.Score != "" {
+			jp.Score = json.RawMessage(r.Score)
+		}
+		sheet.Players = append(sheet.Players, jp)
+	}
+	data, err := json.MarshalIndent(sheet, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("write team sheet: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,53 @@
+package teamsheet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+func TestJSONParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet string
+	}{
+		{"object", `{"players": [
+			{"name": "Max Gawn", "club": "Melb", "position": "hitouts", "score": 31},
+			{"name": "Nick Blakey", "club": "Syd", "position": "bench", "backups": ["kicks", "handballs"], "interchange": "kicks"}
+		]}`},
+		{"array with string backups and score", `[
+			{"name": "Max Gawn", "club": "Melb", "position": "Hitouts", "score": "31"},
+			{"name": "Nick Blakey", "club": "Syd", "position": "bench", "backups": "kicks/handballs", "interchange": "kicks", "score": null}
+		]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := NewJSONParser().Parse(context.Background(), application.PostFormat{}, tt.sheet)
+			require.NoError(t, err)
+			require.Len(t, rows, 2)
+			assert.Equal(t, "hitouts", rows[0].Position)
+			require.NotNil(t, rows[0].Score)
+			assert.Equal(t, 31, *rows[0].Score)
+			assert.Equal(t, "kicks,handballs", rows[1].BackupPositions)
+			assert.Equal(t, "kicks", rows[1].InterchangePosition)
+			assert.Nil(t, rows[1].Score)
+		})
+	}
+}
+
+func TestJSONParseErrors(t *testing.T) {
+	p := NewJSONParser()
+	for _, sheet := range []string{
+		`{"players": []}`,
+		`[{"name": "Max Gawn", "position": "hitouts"},`,
+		`[{"name": "Max Gawn", "position": "bench", "backups": 3}]`,
+		`[{"name": "Max Gawn", "position": "hitouts", "score": "lots"}]`,
+	} {
+		_, err := p.Parse(context.Background(), application.PostFormat{}, sheet)
+		assert.ErrorIs(t, err, application.ErrInvalidTeamSheet, sheet)
+	}
+}
@@ -0,0 +1,124 @@
+// Package teamsheet reads teams from team sheets — CSV and JSON exported
+// from the spreadsheets some managers keep their teams in — into the same
+// player rows the forum parser reads from posts, so they are resolved,
+// reviewed and confirmed the same way.
+//
+// A sheet has a row per player: name, club, position, backups, interchange
+// and, for results, score. Bench players have position "bench" and the
+// positions they back up, separated by commas, slashes or semicolons.
+package teamsheet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"xffl/services/ffl/internal/application"
+	"xffl/services/ffl/internal/domain"
+)
+
+var backupSepRE = regexp.MustCompile(`\s*[,/;]\s*`)
+
+// Parsers returns a parser for each team sheet format.
+func Parsers() map[application.SubmissionFormat]application.TeamParser {
+	return map[application.SubmissionFormat]application.TeamParser{
+		application.SubmissionFormatCSV:  NewCSVParser(),
+		application.SubmissionFormatJSON: NewJSONParser(),
+	}
+}
+
+// sheetRow is one player's row as written in a sheet.
+type sheetRow struct {
+	Name        string
+	Club        string
+	Position    string
+	Backups     string
+	Interchange string
+	Score       string
+}
+
+// playerRow checks and normalises a sheet row: positions are lowercased and
+// backups joined by commas ("Kicks / Marks" → "kicks,marks"). n is the row's
+// number in the sheet, for errors.
+func playerRow(n int, r sheetRow) (application.ParsedPlayerRow, error) {
+	fail := func(format string, args ...any) (application.ParsedPlayerRow, error) {
+		return application.ParsedPlayerRow{}, fmt.Errorf("%w: row %d: %s", application.ErrInvalidTeamSheet, n, fmt.Sprintf(format, args...))
+	}
+
+	row := application.ParsedPlayerRow{
+		Name:     strings.TrimSpace(r.Name),
+		ClubHint: strings.TrimSpace(r.Club),
+		Position: strings.ToLower(strings.TrimSpace(r.Position)),
+	}
+	if row.Name == "" {
+		return fail("no player name")
+	}
+	backups := strings.TrimSpace(r.Backups)
+	interchange := strings.ToLower(strings.TrimSpace(r.Interchange))
+
+	if row.Position != "bench" {
+		if !isPosition(row.Position) {
+			return fail("%s has unknown position %q", row.Name, r.Position)
+		}
+		if backups != "" || interchange != "" {
+			return fail("%s starts at %s but has backups; only bench players back up", row.Name, row.Position)
+		}
+	} else {
+		if backups == "" {
+			return fail("%s is on the bench with no backup positions", row.Name)
+		}
+		positions := backupSepRE.Split(strings.ToLower(backups), -1)
+		for _, pos := range positions {
+			if !isPosition(pos) {
+				return fail("%s backs up unknown position %q", row.Name, pos)
+			}
+		}
+		row.BackupPositions = strings.Join(positions, ",")
+		if interchange != "" && !isPosition(interchange) {
+			return fail("%s has unknown interchange position %q", row.Name, r.Interchange)
+		}
+		row.InterchangePosition = interchange
+	}
+
+	if s := strings.TrimSpace(r.Score); s != "" {
+		score, err := strconv.Atoi(s)
+		if err != nil {
+			return fail("%s has score %q, not a whole number", row.Name, s)
+		}
+		row.Score = &score
+	}
+	return row, nil
+}
+
+// sheetRows is the inverse of playerRow, for writing a team as a sheet.
+func sheetRows(team application.PostTeam) []sheetRow {
+	rows := make([]sheetRow, len(team.Players))
+	for i, p := range team.Players {
+		rows[i] = sheetRow{
+			Name:        p.Name,
+			Club:        p.Club,
+			Position:    p.Position,
+			Backups:     p.BackupPositions,
+			Interchange: p.InterchangePosition,
+		}
+		if team.Scored {
+			rows[i].Score = strconv.Itoa(p.Score)
+		}
+	}
+	return rows
+}
+
+func isPosition(s string) bool {
+	_, ok := domain.PositionSlots[domain.Position(s)]
+	return ok
+}
+
+// wholeSheet is how a sheet parser splits a thread: sheets aren't posted in
+// threads, so the whole of it is one sheet.
+func wholeSheet(thread string) []application.ThreadPost {
+	if strings.TrimSpace(thread) == "" {
+		return nil
+	}
+	return []application.ThreadPost{{Body: thread}}
+}
@@ -0,0 +1,95 @@
+package teamsheet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+)
+
+// sheetTeam is a team with a starter in every position and bench players
+// backing up pairs of positions and the star, one as interchange.
+func sheetTeam(scored bool) application.PostTeam {
+	return application.PostTeam{
+		Scored: scored,
+		Players: []application.PostPlayer{
+			{Name: "Jeremy Cameron", Club: "Geel", Position: "goals", Score: 15},
+			{Name: "Nick Daicos", Club: "Coll", Position: "kicks", Score: 24},
+			{Name: "Zach Merrett", Club: "Ess", Position: "handballs", Score: 18},
+			{Name: "Tom De Koning", Club: "Carl", Position: "marks", Score: 14},
+			{Name: "Tim English", Club: "WB", Position: "tackles", Score: 12},
+			{Name: "Max Gawn", Club: "Melb", Position: "hitouts", Score: 31},
+			{Name: "Marcus Bontempelli", Club: "WB", Position: "star", Score: 30},
+			{Name: "Nick Blakey", Club: "Syd", Position: "bench", BackupPositions: "kicks,handballs", Score: 21},
+			{Name: "Toby Greene", Club: "GWS", Position: "bench", BackupPositions: "star", InterchangePosition: "star", Score: 60},
+		},
+	}
+}
+
+func TestWriteTeamRoundTrip(t *testing.T) {
+	for format, p := range Parsers() {
+		for _, scored := range []bool{false, true} {
+			name := string(format) + "/team"
+			if scored {
+				name = string(format) + "/results"
+			}
+			t.Run(name, func(t *testing.T) {
+				team := sheetTeam(scored)
+				sheet, err := p.WriteTeam(application.PostFormat{}, team)
+				require.NoError(t, err)
+
+				rows, err := p.Parse(context.Background(), application.PostFormat{}, sheet)
+				require.NoError(t, err)
+				require.Len(t, rows, len(team.Players), "sheet:\n%s", sheet)
+				for i, pl := range team.Players {
+					assert.Equal(t, pl.Name, rows[i].Name)
+					assert.Equal(t, pl.Club, rows[i].ClubHint)
+					assert.Equal(t, pl.Position, rows[i].Position)
+					assert.Equal(t, pl.BackupPositions, rows[i].BackupPositions)
+					assert.Equal(t, pl.InterchangePosition, rows[i].InterchangePosition)
+					if scored {
+						require.NotNil(t, rows[i].Score)
+						assert.Equal(t, pl.Score, *rows[i].Score)
+					} else {
+						assert.Nil(t, rows[i].Score)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestPlayerRow(t *testing.T) {
+	row, err := playerRow(2, sheetRow{Name: " Nick Blakey ", Club: "Syd", Position: "Bench", Backups: "Kicks / Handballs", Interchange: "KICKS"})
+	require.NoError(t, err)
+	assert.Equal(t, application.ParsedPlayerRow{
+		Name:                "Nick Blakey",
+		ClubHint:            "Syd",
+		Position:            "bench",
+		BackupPositions:     "kicks,handballs",
+		InterchangePosition: "kicks",
+	}, row)
+
+	tests := []struct {
+		name string
+		row  sheetRow
+	}{
+		{"no name", sheetRow{Position: "goals"}},
+		{"unknown position", sheetRow{Name: "Max Gawn", Position: "ruck"}},
+		{"starter with backups", sheetRow{Name: "Max Gawn", Position: "hitouts", Backups: "marks"}},
+		{"bench without backups", sheetRow{Name: "Max Gawn", Position: "bench"}},
+		{"unknown backup", sheetRow{Name: "Max Gawn", Position: "bench", Backups: "marks,ruck"}},
+		{"unknown interchange", sheetRow{Name: "Max Gawn", Position: "bench", Backups: "marks", Interchange: "ruck"}},
+		{"score not a number", sheetRow{Name: "Max Gawn", Position: "hitouts", Score: "31.5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := playerRow(7, tt.row)
+			assert.ErrorIs(t, err, application.ErrInvalidTeamSheet)
+			assert.ErrorContains(t, err, "row 7")
+		})
+	}
+}
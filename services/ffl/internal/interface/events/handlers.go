@@ -35,6 +35,7 @@ func (h *Handlers) HandleAflPlayerMatchUpdated(ctx context.Context, payload []by
 		Marks:             p.Marks,
 		Tackles:           p.Tackles,
 		Hitouts:           p.Hitouts,
+		Extra:             p.Extra,
 	})
 }
 
@@ -46,6 +47,14 @@ func (h *Handlers) HandleAflMatchUpdated(ctx context.Context, payload []byte) er
 	return h.commands.ProcessAFLMatchUpdated(ctx, p)
 }
 
+func (h *Handlers) HandleAflPlayerMerged(ctx context.Context, payload []byte) error {
+	var p contractevents.AflPlayerMergedPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("unmarshal AflPlayerMerged: %w", err)
+	}
+	return h.commands.ProcessAFLPlayerMerged(ctx, p)
+}
+
 func (h *Handlers) HandleFflClubMatchUpdated(ctx context.Context, payload []byte) error {
 	var p contractevents.FflClubMatchUpdatedPayload
 	if err := json.Unmarshal(payload, &p); err != nil {
@@ -1,8 +1,12 @@
 package graphql
 
 import (
+	"maps"
+	"slices"
 	"strconv"
+	"time"
 
+	"xffl/services/ffl/internal/application"
 	"xffl/services/ffl/internal/domain"
 )
 
@@ -14,6 +18,27 @@ func fromID(id string) (int, error) {
 	return strconv.Atoi(id)
 }
 
+// optionalID converts an optional ID argument, returning 0 when it is omitted.
+func optionalID(id *string) (int, error) {
+	if id == nil {
+		return 0, nil
+	}
+	return fromID(*id)
+}
+
+// optionalIDPtr converts an optional ID argument, returning nil when it is
+// omitted.
+func optionalIDPtr(id *string) (*int, error) {
+	if id == nil {
+		return nil, nil
+	}
+	v, err := fromID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
 func toStringPtr(s string) *string {
 	if s == "" {
 		return nil
@@ -49,7 +74,7 @@ func convertPlayers(players []domain.Player) []*FFLPlayer {
 }
 
 func convertSeason(s domain.Season) *FFLSeason {
-	return &FFLSeason{ID: toID(s.ID), Name: s.Name}
+	return &FFLSeason{ID: toID(s.ID), Name: s.Name, MinSquadSize: s.MinSquadSize, MaxSquadSize: s.MaxSquadSize}
 }
 
 func convertSeasons(seasons []domain.Season) []*FFLSeason {
@@ -172,3 +197,398 @@ func convertPlayerSeason(ps domain.PlayerSeason, player domain.Player) *FFLPlaye
 	}
 	return result
 }
+
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+func convertDraftPick(p domain.DraftPick) *FFLDraftPick {
+	result := &FFLDraftPick{
+		ID:           toID(p.ID),
+		PickNumber:   p.PickNumber,
+		Round:        p.Round,
+		ClubSeasonID: toID(p.ClubSeasonID),
+		Status:       FFLDraftPickStatus(p.Status),
+		PickedAt:     formatTimePtr(p.PickedAt),
+	}
+	if p.AFLPlayerSeasonID != nil {
+		id := toID(*p.AFLPlayerSeasonID)
+		result.AflPlayerSeasonID = &id
+		result.AflPlayerSeason = &AFLPlayerSeason{ID: id}
+	}
+	if p.PlayerSeasonID != nil {
+		id := toID(*p.PlayerSeasonID)
+		result.PlayerSeasonID = &id
+	}
+	return result
+}
+
+func convertDraft(d domain.Draft) *FFLDraft {
+	result := &FFLDraft{
+		ID:          toID(d.ID),
+		SeasonID:    toID(d.SeasonID),
+		Style:       FFLDraftStyle(d.Style),
+		Rounds:      d.Rounds,
+		PickSeconds: int(d.PickDuration / time.Second),
+		Status:      FFLDraftStatus(d.Status),
+		Picks:       make([]*FFLDraftPick, len(d.Picks)),
+	}
+	for i, p := range d.Picks {
+		result.Picks[i] = convertDraftPick(p)
+	}
+	if deadline, ok := d.Deadline(); ok {
+		n := d.Picks[d.CurrentPick()].PickNumber
+		result.CurrentPickNumber = &n
+		result.PickDeadline = formatTimePtr(&deadline)
+	}
+	return result
+}
+
+func convertWaiverClaim(c domain.WaiverClaim) *FFLWaiverClaim {
+	aflPlayerSeasonID := toID(c.AFLPlayerSeasonID)
+	result := &FFLWaiverClaim{
+		ID:                 toID(c.ID),
+		RoundID:            toID(c.RoundID),
+		ClubSeasonID:       toID(c.ClubSeasonID),
+		AflPlayerSeasonID:  aflPlayerSeasonID,
+		AflPlayerSeason:    &AFLPlayerSeason{ID: aflPlayerSeasonID},
+		DropPlayerSeasonID: toID(c.DropPlayerSeasonID),
+		Priority:           c.Priority,
+		Status:             FFLWaiverClaimStatus(c.Status),
+		FailureReason:      c.FailureReason,
+		ProcessedAt:        formatTimePtr(c.ProcessedAt),
+	}
+	if c.PlayerSeasonID != nil {
+		id := toID(*c.PlayerSeasonID)
+		result.PlayerSeasonID = &id
+	}
+	return result
+}
+
+func convertWaiverClaims(claims []domain.WaiverClaim) []*FFLWaiverClaim {
+	out := make([]*FFLWaiverClaim, len(claims))
+	for i, c := range claims {
+		out[i] = convertWaiverClaim(c)
+	}
+	return out
+}
+
+func convertWaiverSchedule(s domain.WaiverSchedule) *FFLWaiverSchedule {
+	return &FFLWaiverSchedule{
+		RoundID:     toID(s.RoundID),
+		ProcessAt:   *formatTimePtr(&s.ProcessAt),
+		ProcessedAt: formatTimePtr(s.ProcessedAt),
+	}
+}
+
+func convertTeamCompleteness(c domain.TeamCompleteness) *FFLTeamCompleteness {
+	gaps := make([]*FFLPositionGap, len(c.EmptySlots))
+	for i, g := range c.EmptySlots {
+		gaps[i] = &FFLPositionGap{Position: string(g.Position), Empty: g.Empty}
+	}
+	uncovered := make([]string, len(c.UncoveredPositions))
+	for i, p := range c.UncoveredPositions {
+		uncovered[i] = string(p)
+	}
+	return &FFLTeamCompleteness{
+		Complete:           c.Complete(),
+		EmptySlots:         gaps,
+		UncoveredPositions: uncovered,
+		NoInterchange:      c.NoInterchange,
+	}
+}
+
+func convertRoundTeamStatus(s application.RoundTeamStatus, club domain.Club) *FFLRoundTeamStatus {
+	var lockout *string
+	if !s.Lockout.IsZero() {
+		lockout = formatTimePtr(&s.Lockout)
+	}
+	return &FFLRoundTeamStatus{
+		ClubMatch:    convertClubMatch(s.ClubMatch, club),
+		Submitted:    s.ClubMatch.DataStatus != domain.ClubMatchDataNoData,
+		Lockout:      lockout,
+		Completeness: convertTeamCompleteness(s.Completeness),
+	}
+}
+
+func convertPostFormat(f application.PostFormat) *FFLPostFormat {
+	out := &FFLPostFormat{
+		ClubID:             toID(f.ClubID),
+		Name:               f.Name,
+		Detect:             f.Detect,
+		ScoreLines:         nonNil(f.ScoreLines),
+		Strip:              nonNil(f.Strip),
+		Sections:           make([]*FFLPostSection, len(f.Sections)),
+		Players:            make([]*FFLPostPlayerLine, len(f.Players)),
+		BenchCodes:         make([]*FFLBenchCode, 0, len(f.BenchCodes)),
+		RawStatMultipliers: make([]*FFLRawStatMultiplier, 0, len(f.RawStatMultipliers)),
+		InterchangeMarker:  optionalString(f.InterchangeMarker),
+	}
+	for i, s := range f.Sections {
+		out.Sections[i] = &FFLPostSection{Header: s.Header, Position: s.Position}
+	}
+	for i, pl := range f.Players {
+		out.Players[i] = &FFLPostPlayerLine{
+			Section:             optionalString(pl.Section),
+			Pattern:             pl.Pattern,
+			BackupPositions:     optionalString(pl.BackupPositions),
+			InterchangePosition: optionalString(pl.InterchangePosition),
+			Score:               pl.Score,
+			Notes:               optionalString(pl.Notes),
+		}
+	}
+	for _, code := range slices.Sorted(maps.Keys(f.BenchCodes)) {
+		out.BenchCodes = append(out.BenchCodes, &FFLBenchCode{Code: code, Position: f.BenchCodes[code]})
+	}
+	for _, pos := range slices.Sorted(maps.Keys(f.RawStatMultipliers)) {
+		out.RawStatMultipliers = append(out.RawStatMultipliers, &FFLRawStatMultiplier{Position: pos, Multiplier: f.RawStatMultipliers[pos]})
+	}
+	if l := f.Layout; l.Player != "" {
+		out.Layout = &FFLPostLayout{
+			Title:           optionalString(l.Title),
+			Footer:          optionalString(l.Footer),
+			Headers:         make([]*FFLPostSection, 0, len(l.Headers)),
+			Player:          l.Player,
+			Bench:           l.Bench,
+			BenchStar:       optionalString(l.BenchStar),
+			Subbed:          optionalString(l.Subbed),
+			Interchanged:    optionalString(l.Interchanged),
+			InterchangeCode: optionalString(l.InterchangeCode),
+			InterchangeLine: optionalString(l.InterchangeLine),
+		}
+		for _, pos := range slices.Sorted(maps.Keys(l.Headers)) {
+			out.Layout.Headers = append(out.Layout.Headers, &FFLPostSection{Header: l.Headers[pos], Position: pos})
+		}
+	}
+	return out
+}
+
+func postFormatFromInput(in FFLPostFormatInput) (application.PostFormat, error) {
+	clubID, err := fromID(in.ClubID)
+	if err != nil {
+		return application.PostFormat{}, err
+	}
+	f := application.PostFormat{
+		ClubID:     clubID,
+		Name:       in.Name,
+		Detect:     in.Detect,
+		ScoreLines: in.ScoreLines,
+		Strip:      in.Strip,
+		Sections:   make([]application.PostSection, len(in.Sections)),
+		Players:    make([]application.PostPlayerLine, len(in.Players)),
+	}
+	if in.InterchangeMarker != nil {
+		f.InterchangeMarker = *in.InterchangeMarker
+	}
+	for i, s := range in.Sections {
+		f.Sections[i] = application.PostSection{Header: s.Header, Position: s.Position}
+	}
+	for i, pl := range in.Players {
+		f.Players[i] = application.PostPlayerLine{
+			Section:             derefString(pl.Section),
+			Pattern:             pl.Pattern,
+			BackupPositions:     derefString(pl.BackupPositions),
+			InterchangePosition: derefString(pl.InterchangePosition),
+			Score:               pl.Score,
+			Notes:               derefString(pl.Notes),
+		}
+	}
+	if len(in.BenchCodes) > 0 {
+		f.BenchCodes = make(map[string]string, len(in.BenchCodes))
+		for _, c := range in.BenchCodes {
+			f.BenchCodes[c.Code] = c.Position
+		}
+	}
+	if len(in.RawStatMultipliers) > 0 {
+		f.RawStatMultipliers = make(map[string]int, len(in.RawStatMultipliers))
+		for _, m := range in.RawStatMultipliers {
+			f.RawStatMultipliers[m.Position] = m.Multiplier
+		}
+	}
+	if l := in.Layout; l != nil {
+		f.Layout = application.PostLayout{
+			Title:           derefString(l.Title),
+			Footer:          derefString(l.Footer),
+			Headers:         make(map[string]string, len(l.Headers)),
+			Player:          l.Player,
+			Bench:           l.Bench,
+			BenchStar:       derefString(l.BenchStar),
+			Subbed:          derefString(l.Subbed),
+			Interchanged:    derefString(l.Interchanged),
+			InterchangeCode: derefString(l.InterchangeCode),
+			InterchangeLine: derefString(l.InterchangeLine),
+		}
+		for _, h := range l.Headers {
+			f.Layout.Headers[h.Position] = h.Header
+		}
+	}
+	return f, nil
+}
+
+func convertParsedPlayerRows(rows []application.ParsedPlayerRow) []*FFLParsedPlayerRow {
+	out := make([]*FFLParsedPlayerRow, len(rows))
+	for i, r := range rows {
+		out[i] = &FFLParsedPlayerRow{
+			Name:                r.Name,
+			ClubHint:            r.ClubHint,
+			Position:            r.Position,
+			BackupPositions:     r.BackupPositions,
+			InterchangePosition: r.InterchangePosition,
+			Score:               r.Score,
+			Notes:               r.Notes,
+		}
+	}
+	return out
+}
+
+func convertPlayerAlias(a application.PlayerAlias) *FFLPlayerAlias {
+	result := &FFLPlayerAlias{
+		ID:      toID(a.ID),
+		Alias:   a.Alias,
+		Learned: a.Learned,
+	}
+	if a.AFLPlayerID != nil {
+		id := toID(*a.AFLPlayerID)
+		result.AflPlayerID = &id
+		result.AflPlayer = &AFLPlayer{ID: id}
+	}
+	return result
+}
+
+// convertParseResult converts a parsed team post for review.
+func convertParseResult(result application.ParseTeamSubmissionResult) *ParseFFLTeamSubmissionResult {
+	needsReview := make([]int, len(result.NeedsReview))
+	copy(needsReview, result.NeedsReview)
+
+	out := &ParseFFLTeamSubmissionResult{
+		ResolvedPlayers: convertResolvedPlayers(result.ResolvedPlayers),
+		NeedsReview:     needsReview,
+	}
+	if result.SubmissionID != 0 {
+		id := toID(result.SubmissionID)
+		out.SubmissionID = &id
+	}
+	return out
+}
+
+func convertResolvedPlayers(players []application.ResolvedPlayer) []*ResolvedPlayer {
+	resolvedGQL := make([]*ResolvedPlayer, len(players))
+	for i, rp := range players {
+		var psID *string
+		var resolvedName, resolvedClub *string
+		if rp.PlayerSeasonID != 0 {
+			id := toID(rp.PlayerSeasonID)
+			psID = &id
+			name := rp.BestMatch.Candidate.Name
+			resolvedName = &name
+			club := rp.BestMatch.Candidate.Club
+			resolvedClub = &club
+		}
+		resolvedGQL[i] = &ResolvedPlayer{
+			ParsedName:          rp.Parsed.Name,
+			ClubHint:            rp.Parsed.ClubHint,
+			ResolvedName:        resolvedName,
+			ResolvedClub:        resolvedClub,
+			Position:            rp.Parsed.Position,
+			BackupPositions:     rp.Parsed.BackupPositions,
+			InterchangePosition: rp.Parsed.InterchangePosition,
+			Score:               rp.Parsed.Score,
+			Notes:               rp.Parsed.Notes,
+			PlayerSeasonID:      psID,
+			Confidence:          rp.BestMatch.Confidence,
+		}
+	}
+	return resolvedGQL
+}
+
+func convertSubmissionHistoryEntry(e application.SubmissionHistoryEntry) *FFLTeamSubmissionRecord {
+	s := e.Submission
+	out := &FFLTeamSubmissionRecord{
+		ID:          toID(s.ID),
+		ClubMatchID: toID(s.ClubMatchID),
+		SubmittedAt: *formatTimePtr(&s.SubmittedAt),
+		Author:      optionalString(s.Author),
+		Format:      optionalString(s.Format),
+		Post:        s.Post,
+		Parsed:      convertResolvedPlayers(s.Parsed),
+		ConfirmedAt: formatTimePtr(s.ConfirmedAt),
+		Corrections: convertSubmissionChanges(e.Corrections),
+		Changes:     convertSubmissionChanges(e.Changes),
+		PostDiff:    nonNil(e.PostDiff),
+	}
+	if s.Confirmed != nil {
+		out.Confirmed = convertResolvedPlayers(s.Confirmed)
+	}
+	return out
+}
+
+func convertSubmissionChanges(changes []application.SubmissionChange) []*FFLSubmissionChange {
+	out := make([]*FFLSubmissionChange, len(changes))
+	for i, c := range changes {
+		out[i] = &FFLSubmissionChange{
+			Kind:   FFLSubmissionChangeKind(c.Kind),
+			Player: c.Player,
+			From:   optionalString(c.From),
+			To:     optionalString(c.To),
+		}
+	}
+	return out
+}
+
+// confirmedPlayers converts a reviewed team back to resolved players.
+func confirmedPlayers(players []*ConfirmedFFLPlayerInput) ([]application.ResolvedPlayer, error) {
+	resolved := make([]application.ResolvedPlayer, 0, len(players))
+	for _, p := range players {
+		psID, err := fromID(p.PlayerSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		parsed := application.ParsedPlayerRow{
+			Position: p.Position,
+			Score:    p.Score,
+		}
+		if p.BackupPositions != nil {
+			parsed.BackupPositions = *p.BackupPositions
+		}
+		if p.InterchangePosition != nil {
+			parsed.InterchangePosition = *p.InterchangePosition
+		}
+		rp := application.ResolvedPlayer{
+			Parsed:         parsed,
+			PlayerSeasonID: psID,
+			Confident:      true,
+		}
+		if p.ParsedName != nil && p.Confidence != nil {
+			rp.Parsed.Name = *p.ParsedName
+			rp.BestMatch.Confidence = *p.Confidence
+			rp.Confident = *p.Confidence >= application.ConfidenceThreshold
+		}
+		resolved = append(resolved, rp)
+	}
+	return resolved, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -20,6 +20,7 @@ import (
 	"xffl/services/ffl/internal/infrastructure/forum"
 	pg "xffl/services/ffl/internal/infrastructure/postgres"
 	"xffl/services/ffl/internal/infrastructure/postgres/sqlcgen"
+	"xffl/services/ffl/internal/infrastructure/teamsheet"
 	gql "xffl/services/ffl/internal/interface/graphql"
 	memevents "xffl/shared/events/memory"
 )
@@ -72,6 +73,8 @@ func setupDataOpsServer(t *testing.T, pool *pgxpool.Pool, dataOps *application.D
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -83,6 +86,7 @@ func setupDataOpsServer(t *testing.T, pool *pgxpool.Pool, dataOps *application.D
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
@@ -133,14 +137,21 @@ func TestParseAndConfirmFFLTeamSubmission(t *testing.T) {
 		pg.NewClubMatchRepository(testQ),
 		pg.NewClubSeasonRepository(testQ),
 		pg.NewRoundRepository(testQ),
+		pg.NewSeasonRepository(testQ),
 		pg.NewPlayerMatchRepository(testQ),
 		pg.NewPlayerSeasonRepository(testQ),
 	)
+	aliases := pg.NewDataopsPlayerAliasRepository(testQ)
 	dataOps := application.NewDataOpsCommands(
 		testDB,
 		stub,
-		forum.NewLevenshteinResolver(),
-		forum.NewParser(),
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(testQ),
+		aliases,
+		pg.NewDataopsSubmissionRepository(testQ),
+		pg.NewClubRepository(testQ),
 		memevents.New(),
 		testCommands,
 	)
@@ -248,28 +259,37 @@ func TestParseAndConfirmFFLTeamSubmission(t *testing.T) {
 				score: 15
 			}]
 		}) {
-			id
-			position
-			score
+			playerMatches {
+				id
+				position
+				score
+			}
+			completeness { complete }
 		}
 	}`)
 
 	require.Empty(t, confirmResult.Errors)
 
 	var confirmData struct {
-		ConfirmFFLTeamSubmission []struct {
-			ID       string `json:"id"`
-			Position string `json:"position"`
-			Score    int    `json:"score"`
+		ConfirmFFLTeamSubmission struct {
+			PlayerMatches []struct {
+				ID       string `json:"id"`
+				Position string `json:"position"`
+				Score    int    `json:"score"`
+			} `json:"playerMatches"`
+			Completeness struct {
+				Complete bool `json:"complete"`
+			} `json:"completeness"`
 		} `json:"confirmFFLTeamSubmission"`
 	}
 	require.NoError(t, json.Unmarshal(confirmResult.Data, &confirmData))
 
 	t.Run("confirm creates one player_match record", func(t *testing.T) {
-		require.Len(t, confirmData.ConfirmFFLTeamSubmission, 1)
-		pm := confirmData.ConfirmFFLTeamSubmission[0]
+		require.Len(t, confirmData.ConfirmFFLTeamSubmission.PlayerMatches, 1)
+		pm := confirmData.ConfirmFFLTeamSubmission.PlayerMatches[0]
 		assert.Equal(t, "goals", pm.Position)
 		assert.Equal(t, 15, pm.Score)
+		assert.False(t, confirmData.ConfirmFFLTeamSubmission.Completeness.Complete, "one starter is not a full team")
 	})
 
 	t.Run("player_match is persisted in DB", func(t *testing.T) {
@@ -279,6 +299,66 @@ func TestParseAndConfirmFFLTeamSubmission(t *testing.T) {
 			ids.homeClubMatchID, jeremyPSID).Scan(&count))
 		assert.Equal(t, 1, count)
 	})
+
+	// ── Team sheets ──────────────────────────────────────────────────────────
+
+	t.Run("a CSV team sheet resolves like a post", func(t *testing.T) {
+		sheet := "Player,Club,Position,Backups\nJeremy Cameron,Geel,Goals,\nJeremy Cameron,Geel,bench,Goals/Marks\n"
+		resp := execQuery(t, server, `mutation {
+			parseFFLTeamSubmission(input: {
+				clubSeasonId: "`+clubSeaID+`"
+				clubMatchId: "`+clubMatchID+`"
+				format: csv
+				post: `+jsonString(sheet)+`
+			}) {
+				submissionId
+				resolvedPlayers { parsedName position backupPositions playerSeasonId confidence }
+			}
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			ParseFFLTeamSubmission struct {
+				SubmissionID    *string `json:"submissionId"`
+				ResolvedPlayers []struct {
+					ParsedName      string  `json:"parsedName"`
+					Position        string  `json:"position"`
+					BackupPositions string  `json:"backupPositions"`
+					PlayerSeasonID  *string `json:"playerSeasonId"`
+					Confidence      float64 `json:"confidence"`
+				} `json:"resolvedPlayers"`
+			} `json:"parseFFLTeamSubmission"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		assert.NotNil(t, data.ParseFFLTeamSubmission.SubmissionID)
+		rows := data.ParseFFLTeamSubmission.ResolvedPlayers
+		require.Len(t, rows, 2)
+		assert.Equal(t, "goals", rows[0].Position)
+		assert.Equal(t, "bench", rows[1].Position)
+		assert.Equal(t, "goals,marks", rows[1].BackupPositions)
+		require.NotNil(t, rows[0].PlayerSeasonID)
+		assert.Equal(t, *jeremy.PlayerSeasonID, *rows[0].PlayerSeasonID)
+		assert.InDelta(t, 1.0, rows[0].Confidence, 0.01)
+
+		var format string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT format FROM ffl.dataops_team_submission WHERE id = $1",
+			*data.ParseFFLTeamSubmission.SubmissionID).Scan(&format))
+		assert.Equal(t, "csv", format)
+	})
+
+	t.Run("an invalid team sheet is rejected", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			parseFFLTeamSubmission(input: {
+				clubSeasonId: "`+clubSeaID+`"
+				clubMatchId: "`+clubMatchID+`"
+				format: json
+				post: `+jsonString(`[{"name": "Jeremy Cameron", "position": "ruck"}]`)+`
+			}) { submissionId }
+		}`)
+		require.NotEmpty(t, resp.Errors)
+		assert.Equal(t, "INVALID_TEAM_SHEET", resp.Errors[0].Extensions["code"])
+	})
 }
 
 func toIDStr(id int) string {
@@ -308,14 +388,21 @@ func TestMarkFFLTeamFinal(t *testing.T) {
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
 	dataOps := application.NewDataOpsCommands(
 		db,
 		&stubPlayerLookup{pool: pool},
-		forum.NewLevenshteinResolver(),
-		forum.NewParser(),
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
 		memevents.New(),
 		cmds,
 	)
@@ -358,3 +445,715 @@ func TestMarkFFLTeamFinal(t *testing.T) {
 		assert.Equal(t, "no_data", status)
 	})
 }
+
+// ════════════════════════════════════════════════════════════════
+// Team submission history integration test
+// ════════════════════════════════════════════════════════════════
+
+func TestFFLTeamSubmissionHistory(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+
+	db := pg.NewDB(pool)
+	q := sqlcgen.New(pool)
+	stub := &stubPlayerLookup{pool: pool, candidates: []application.PlayerCandidate{
+		{AFLPlayerID: ids.aflPlayerID, Name: "Seeded AFL Player", Club: "Geel"},
+	}}
+	commands := application.NewCommands(
+		db,
+		memevents.New(),
+		stub,
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+	)
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
+	dataOps := application.NewDataOpsCommands(
+		db,
+		stub,
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
+		memevents.New(),
+		commands,
+	)
+	server := setupDataOpsServer(t, pool, dataOps)
+	defer server.Close()
+
+	clubSeasonID := toIDStr(ids.homeClubSeaID)
+	clubMatchID := toIDStr(ids.homeClubMatchID)
+	parse := func(t *testing.T, post string) string {
+		t.Helper()
+		resp := execQuery(t, server, `mutation {
+			parseFFLTeamSubmission(input: {
+				clubSeasonId: "`+clubSeasonID+`"
+				clubMatchId: "`+clubMatchID+`"
+				teamName: "Ruiboys"
+				post: `+jsonString(post)+`
+			}) { submissionId }
+		}`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			ParseFFLTeamSubmission struct {
+				SubmissionID string `json:"submissionId"`
+			} `json:"parseFFLTeamSubmission"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.NotEmpty(t, data.ParseFFLTeamSubmission.SubmissionID)
+		return data.ParseFFLTeamSubmission.SubmissionID
+	}
+	confirm := func(clubMatchID, submissionID string) graphqlResponse {
+		return execQuery(t, server, `mutation {
+			confirmFFLTeamSubmission(input: {
+				clubMatchId: "`+clubMatchID+`"
+				submissionId: "`+submissionID+`"
+				players: [{
+					playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`"
+					position: "goals"
+					score: 20
+				}]
+			}) { completeness { complete } }
+		}`)
+	}
+
+	first := "GOALS\nSeeded AFL Player – Geel 15\nTaylor Walker – Adel 5"
+	second := "GOALS\nSeeded AFL Player – Geel 15\nTaylor Walker – Adel 7"
+
+	var firstID, secondID string
+	t.Run("parsing records the post once until it is confirmed", func(t *testing.T) {
+		firstID = parse(t, first)
+		assert.Equal(t, firstID, parse(t, first))
+
+		// The reviewer fixes Seeded AFL Player's score and leaves out the
+		// unresolved Taylor Walker.
+		resp := confirm(clubMatchID, firstID)
+		require.Empty(t, resp.Errors)
+
+		secondID = parse(t, second)
+		assert.NotEqual(t, firstID, secondID)
+	})
+
+	t.Run("confirm rejects another club match's submission", func(t *testing.T) {
+		resp := confirm(toIDStr(ids.awayClubMatchID), secondID)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "NOT_FOUND", resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("history shows corrections and changes between submissions", func(t *testing.T) {
+		resp := execQuery(t, server, `{
+			fflTeamSubmissionHistory(clubMatchId: "`+clubMatchID+`") {
+				id post format
+				parsed { parsedName score }
+				confirmed { resolvedName score }
+				confirmedAt
+				corrections { kind player from to }
+				changes { kind player from to }
+				postDiff
+			}
+		}`)
+		require.Empty(t, resp.Errors)
+
+		type change struct {
+			Kind   string  `json:"kind"`
+			Player string  `json:"player"`
+			From   *string `json:"from"`
+			To     *string `json:"to"`
+		}
+		var data struct {
+			History []struct {
+				ID     string `json:"id"`
+				Post   string `json:"post"`
+				Format string `json:"format"`
+				Parsed []struct {
+					ParsedName string `json:"parsedName"`
+				} `json:"parsed"`
+				Confirmed []struct {
+					ResolvedName string `json:"resolvedName"`
+					Score        int    `json:"score"`
+				} `json:"confirmed"`
+				ConfirmedAt *string  `json:"confirmedAt"`
+				Corrections []change `json:"corrections"`
+				Changes     []change `json:"changes"`
+				PostDiff    []string `json:"postDiff"`
+			} `json:"fflTeamSubmissionHistory"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.History, 2)
+		str := func(s string) *string { return &s }
+
+		h := data.History[0]
+		assert.Equal(t, firstID, h.ID)
+		assert.Equal(t, first, h.Post)
+		assert.Equal(t, "Ruiboys", h.Format)
+		assert.Len(t, h.Parsed, 2)
+		require.Len(t, h.Confirmed, 1)
+		assert.Equal(t, "Seeded AFL Player", h.Confirmed[0].ResolvedName)
+		assert.NotNil(t, h.ConfirmedAt)
+		assert.Equal(t, []change{
+			{Kind: "removed", Player: "Taylor Walker", From: str("goals")},
+			{Kind: "score", Player: "Seeded AFL Player", From: str("15"), To: str("20")},
+		}, h.Corrections)
+		assert.Empty(t, h.Changes)
+
+		h = data.History[1]
+		assert.Equal(t, secondID, h.ID)
+		assert.Nil(t, h.Confirmed)
+		assert.Empty(t, h.Corrections)
+		assert.Equal(t, []change{
+			{Kind: "score", Player: "Seeded AFL Player", From: str("20"), To: str("15")},
+			{Kind: "added", Player: "Taylor Walker", To: str("goals")},
+		}, h.Changes)
+		assert.Equal(t, []string{"- Taylor Walker – Adel 5", "+ Taylor Walker – Adel 7"}, h.PostDiff)
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// Post format integration test
+// ════════════════════════════════════════════════════════════════
+
+func TestRegisterFFLPostFormat(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+
+	db := pg.NewDB(pool)
+	q := sqlcgen.New(pool)
+	stub := &stubPlayerLookup{pool: pool}
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
+	dataOps := application.NewDataOpsCommands(
+		db,
+		stub,
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
+		memevents.New(),
+		nil,
+	)
+	server := setupDataOpsServer(t, pool, dataOps)
+	defer server.Close()
+
+	// A layout none of the built-in formats reads: "Name | Club | Score".
+	format := `{
+		clubId: "` + toIDStr(ids.homeClubID) + `"
+		name: "Eagles"
+		detect: "(?i)EAGLES TEAM"
+		sections: [{ header: "(?i)^GOALS$", position: "goals" }, { header: "(?i)^BENCH$", position: "bench" }]
+		players: [{ pattern: "^(?P<name>[^|]+?)\\s*\\|\\s*(?P<club>\\w+)(?:\\s*\\|\\s*(?P<score>\\d+))?$" }]
+		layout: {
+			title: "EAGLES TEAM"
+			headers: [{ header: "GOALS", position: "goals" }, { header: "BENCH", position: "bench" }]
+			player: "{name} | {club}[ | {score}]"
+			bench: "{name} | {club}[ | {score}]"
+		}
+	}`
+	post := "EAGLES TEAM\nGOALS\nSeeded AFL Player | Geel | 15\nBENCH\nSomeone Else | Rich"
+
+	t.Run("test parses a sample post without saving", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			testFFLPostFormat(format: `+format+`, post: `+jsonString(post)+`) { name clubHint position score }
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			TestFFLPostFormat []struct {
+				Name     string `json:"name"`
+				ClubHint string `json:"clubHint"`
+				Position string `json:"position"`
+				Score    *int   `json:"score"`
+			} `json:"testFFLPostFormat"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		rows := data.TestFFLPostFormat
+		require.Len(t, rows, 2)
+		assert.Equal(t, "Seeded AFL Player", rows[0].Name)
+		assert.Equal(t, "goals", rows[0].Position)
+		require.NotNil(t, rows[0].Score)
+		assert.Equal(t, 15, *rows[0].Score)
+		assert.Equal(t, "bench", rows[1].Position)
+
+		var count int
+		require.NoError(t, pool.QueryRow(context.Background(),
+			"SELECT COUNT(*) FROM ffl.dataops_post_format").Scan(&count))
+		assert.Zero(t, count)
+	})
+
+	t.Run("test rejects an invalid format", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			testFFLPostFormat(format: {
+				clubId: "1", name: "Broken", detect: "(",
+				sections: [{ header: "GOALS", position: "goals" }], players: [{ pattern: "(?P<name>.+)" }]
+			}, post: "") { name }
+		}`)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "INVALID_POST_FORMAT", resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("register stores the format and parsing uses it", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			registerFFLPostFormat(input: `+format+`) { clubId club { name } name sections { position } }
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			RegisterFFLPostFormat struct {
+				ClubID string `json:"clubId"`
+				Club   struct {
+					Name string `json:"name"`
+				} `json:"club"`
+				Name     string `json:"name"`
+				Sections []struct {
+					Position string `json:"position"`
+				} `json:"sections"`
+			} `json:"registerFFLPostFormat"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		assert.Equal(t, "Test Eagles", data.RegisterFFLPostFormat.Club.Name)
+		assert.Equal(t, "Eagles", data.RegisterFFLPostFormat.Name)
+		assert.Len(t, data.RegisterFFLPostFormat.Sections, 2)
+
+		// No teamName: the club's registered format is used.
+		parse := execQuery(t, server, `mutation {
+			parseFFLTeamSubmission(input: {
+				clubSeasonId: "`+toIDStr(ids.homeClubSeaID)+`"
+				clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`"
+				post: `+jsonString(post)+`
+			}) { resolvedPlayers { parsedName position } }
+		}`)
+		require.Empty(t, parse.Errors)
+		var parsed struct {
+			ParseFFLTeamSubmission struct {
+				ResolvedPlayers []struct {
+					ParsedName string `json:"parsedName"`
+					Position   string `json:"position"`
+				} `json:"resolvedPlayers"`
+			} `json:"parseFFLTeamSubmission"`
+		}
+		require.NoError(t, json.Unmarshal(parse.Data, &parsed))
+		require.Len(t, parsed.ParseFFLTeamSubmission.ResolvedPlayers, 2)
+		assert.Equal(t, "Seeded AFL Player", parsed.ParseFFLTeamSubmission.ResolvedPlayers[0].ParsedName)
+	})
+
+	t.Run("fflPostFormats lists registered formats", func(t *testing.T) {
+		resp := execQuery(t, server, `{ fflPostFormats { name detect } }`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			FflPostFormats []struct {
+				Name string `json:"name"`
+			} `json:"fflPostFormats"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.FflPostFormats, 1)
+		assert.Equal(t, "Eagles", data.FflPostFormats[0].Name)
+	})
+
+	stub.candidates = []application.PlayerCandidate{
+		{AFLPlayerID: ids.aflPlayerID, Name: "Seeded AFL Player", Club: "Geelong Cats"},
+	}
+
+	t.Run("fflTeamExport writes the team in the registered layout", func(t *testing.T) {
+		resp := execQuery(t, server, `{ fflTeamExport(clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`") }`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			FflTeamExport string `json:"fflTeamExport"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		assert.Equal(t, "EAGLES TEAM\n\nGOALS\nSeeded AFL Player | Geel", data.FflTeamExport)
+	})
+
+	t.Run("fflRoundResultsExport writes scores and scored teams", func(t *testing.T) {
+		resp := execQuery(t, server, `{ fflRoundResultsExport(roundId: "`+toIDStr(ids.roundID)+`") }`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			FflRoundResultsExport string `json:"fflRoundResultsExport"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		assert.Equal(t, "Round 1\nTest Eagles 85 v Test Lions 72\n\nEAGLES TEAM\n\nGOALS\nSeeded AFL Player | Geel | 15",
+			data.FflRoundResultsExport)
+	})
+
+	t.Run("fflTeamExport rejects a club without a post format", func(t *testing.T) {
+		_, err := pool.Exec(context.Background(), "DELETE FROM ffl.dataops_post_format")
+		require.NoError(t, err)
+		resp := execQuery(t, server, `{ fflTeamExport(clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`") }`)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "CANNOT_WRITE_POST", resp.Errors[0].Extensions["code"])
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// Player alias integration test
+// ════════════════════════════════════════════════════════════════
+
+func TestFFLPlayerAliases(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	ctx := context.Background()
+
+	db := pg.NewDB(pool)
+	q := sqlcgen.New(pool)
+	cmds := application.NewCommands(
+		db,
+		memevents.New(),
+		&stubPlayerLookup{pool: pool},
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+	)
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
+	stub := &stubPlayerLookup{
+		pool: pool,
+		candidates: []application.PlayerCandidate{
+			{AFLPlayerID: ids.aflPlayerID, Name: "Seeded AFL Player", Club: "Geel"},
+		},
+	}
+	dataOps := application.NewDataOpsCommands(
+		db,
+		stub,
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
+		memevents.New(),
+		cmds,
+	)
+	server := setupDataOpsServer(t, pool, dataOps)
+	defer server.Close()
+
+	aflPlayerID := toIDStr(ids.aflPlayerID)
+
+	var aliasID string
+	t.Run("create normalises and stores an alias", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			createFFLPlayerAlias(input: { alias: "  The  Seed! ", aflPlayerId: "`+aflPlayerID+`" }) {
+				id alias aflPlayerId aflPlayer { id } learned
+			}
+		}`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			CreateFFLPlayerAlias struct {
+				ID          string `json:"id"`
+				Alias       string `json:"alias"`
+				AflPlayerID string `json:"aflPlayerId"`
+				Learned     bool   `json:"learned"`
+			} `json:"createFFLPlayerAlias"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		assert.Equal(t, "the seed", data.CreateFFLPlayerAlias.Alias)
+		assert.Equal(t, aflPlayerID, data.CreateFFLPlayerAlias.AflPlayerID)
+		assert.False(t, data.CreateFFLPlayerAlias.Learned)
+		aliasID = data.CreateFFLPlayerAlias.ID
+	})
+
+	t.Run("create rejects an empty alias", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			createFFLPlayerAlias(input: { alias: "?!", aflPlayerId: "`+aflPlayerID+`" }) { id }
+		}`)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "INVALID_PLAYER_ALIAS", resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("update changes the alias text", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			updateFFLPlayerAlias(input: { id: "`+aliasID+`", alias: "Seedy", aflPlayerId: "`+aflPlayerID+`" }) { alias }
+		}`)
+		require.Empty(t, resp.Errors)
+		assert.Contains(t, string(resp.Data), `"alias":"seedy"`)
+	})
+
+	t.Run("create without a player stores a strip word", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			createFFLPlayerAlias(input: { alias: "Journeyman" }) { alias aflPlayerId aflPlayer { id } }
+		}`)
+		require.Empty(t, resp.Errors)
+		assert.JSONEq(t, `{"createFFLPlayerAlias":{"alias":"journeyman","aflPlayerId":null,"aflPlayer":null}}`, string(resp.Data))
+	})
+
+	t.Run("parsing resolves an alias with full confidence", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			parseFFLTeamSubmission(input: {
+				clubSeasonId: "`+toIDStr(ids.homeClubSeaID)+`"
+				clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`"
+				teamName: "Ruiboys"
+				post: `+jsonString("GOALS\nSeedy – Geel 15")+`
+			}) { resolvedPlayers { parsedName playerSeasonId confidence } needsReview }
+		}`)
+		require.Empty(t, resp.Errors)
+		var data struct {
+			ParseFFLTeamSubmission struct {
+				ResolvedPlayers []struct {
+					PlayerSeasonID *string `json:"playerSeasonId"`
+					Confidence     float64 `json:"confidence"`
+				} `json:"resolvedPlayers"`
+				NeedsReview []int `json:"needsReview"`
+			} `json:"parseFFLTeamSubmission"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		rps := data.ParseFFLTeamSubmission.ResolvedPlayers
+		require.Len(t, rps, 1)
+		require.NotNil(t, rps[0].PlayerSeasonID)
+		assert.Equal(t, toIDStr(ids.playerSeasonID), *rps[0].PlayerSeasonID)
+		assert.Equal(t, 1.0, rps[0].Confidence)
+		assert.Empty(t, data.ParseFFLTeamSubmission.NeedsReview)
+	})
+
+	t.Run("confirming a low-confidence match learns the parsed name", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			confirmFFLTeamSubmission(input: {
+				clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`"
+				players: [{
+					playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`"
+					position: "goals"
+					score: 15
+					parsedName: "Sded Plyr"
+					confidence: 0.4
+				}]
+			}) { playerMatches { id } }
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var aflID int
+		var learned bool
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT afl_player_id, learned FROM ffl.dataops_player_alias WHERE alias = 'sded plyr'").Scan(&aflID, &learned))
+		assert.Equal(t, ids.aflPlayerID, aflID)
+		assert.True(t, learned)
+	})
+
+	t.Run("a learned alias leaves an admin's as it is", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			updateFFLPlayerAlias(input: { id: "`+aliasID+`", alias: "Seedy", aflPlayerId: "999999" }) { alias }
+		}`)
+		require.Empty(t, resp.Errors)
+		resp = execQuery(t, server, `mutation {
+			confirmFFLTeamSubmission(input: {
+				clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`"
+				players: [{
+					playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`"
+					position: "goals"
+					score: 15
+					parsedName: "Seedy"
+					confidence: 0.4
+				}]
+			}) { playerMatches { id } }
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var aflID int
+		var learned bool
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT afl_player_id, learned FROM ffl.dataops_player_alias WHERE alias = 'seedy'").Scan(&aflID, &learned))
+		assert.Equal(t, 999999, aflID)
+		assert.False(t, learned)
+	})
+
+	t.Run("delete removes the alias", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation { deleteFFLPlayerAlias(id: "`+aliasID+`") }`)
+		require.Empty(t, resp.Errors)
+
+		resp = execQuery(t, server, `{ fflPlayerAliases { alias } }`)
+		require.Empty(t, resp.Errors)
+		assert.NotContains(t, string(resp.Data), "seedy")
+		assert.Contains(t, string(resp.Data), "sded plyr")
+
+		resp = execQuery(t, server, `mutation { deleteFFLPlayerAlias(id: "`+aliasID+`") }`)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "NOT_FOUND", resp.Errors[0].Extensions["code"])
+	})
+}
+
+// ════════════════════════════════════════════════════════════════
+// Round thread integration test
+// ════════════════════════════════════════════════════════════════
+
+func TestFFLRoundThread(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	ctx := context.Background()
+
+	db := pg.NewDB(pool)
+	q := sqlcgen.New(pool)
+	cmds := application.NewCommands(
+		db,
+		memevents.New(),
+		&stubPlayerLookup{pool: pool},
+		pg.NewMatchRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+	)
+	aliases := pg.NewDataopsPlayerAliasRepository(q)
+	stub := &stubPlayerLookup{
+		pool: pool,
+		candidates: []application.PlayerCandidate{
+			{AFLPlayerID: ids.aflPlayerID, Name: "Seeded AFL Player", Club: "Geel"},
+		},
+	}
+	dataOps := application.NewDataOpsCommands(
+		db,
+		stub,
+		forum.NewNameResolver(aliases),
+		forum.NewParser(aliases),
+		teamsheet.Parsers(),
+		pg.NewDataopsPostFormatRepository(q),
+		aliases,
+		pg.NewDataopsSubmissionRepository(q),
+		pg.NewClubRepository(q),
+		memevents.New(),
+		cmds,
+	)
+	server := setupDataOpsServer(t, pool, dataOps)
+	defer server.Close()
+
+	// The Eagles post in a registered format; the Lions, posting as
+	// "TestLions", use a built-in one. The chat reply is not a team.
+	_, err := dataOps.RegisterPostFormat(ctx, application.PostFormat{
+		ClubID:   ids.homeClubID,
+		Name:     "Eagles",
+		Detect:   `(?i)EAGLES TEAM`,
+		Sections: []application.PostSection{{Header: `(?i)^GOALS$`, Position: "goals"}},
+		Players:  []application.PostPlayerLine{{Pattern: `^(?P<name>[^|]+?)\s*\|\s*(?P<club>\w+)(?:\s*\|\s*(?P<score>\d+))?$`}},
+	})
+	require.NoError(t, err)
+
+	thread := "EAGLES TEAM\nGOALS\nSeeded AFL Player | Geel | 15\n" +
+		"Quote\nEdit\nLike\nDislike\nShare\n\n" +
+		"TestLions\n12345\nBloody Legend\n\n8:35 PM - Mar 15#2\n" +
+		"R1 40\nGOALS\nSomeone Else – Rich 10\n" +
+		"Quote\nEdit\nShare\n\n" +
+		"thc\n1,53071\nBloody Legend\n\n9:02 PM - Mar 15#3\n" +
+		"Good luck all\n"
+
+	t.Run("parse maps each team post to its club match", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			parseFFLRoundThread(input: { roundId: "`+toIDStr(ids.roundID)+`", thread: `+jsonString(thread)+` }) {
+				teams {
+					author format
+					clubMatch { id club { name } }
+					resolvedPlayers { parsedName playerSeasonId confidence }
+					needsReview
+				}
+				missingClubMatches { id }
+			}
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			ParseFFLRoundThread struct {
+				Teams []struct {
+					Author    *string `json:"author"`
+					Format    string  `json:"format"`
+					ClubMatch *struct {
+						ID   string `json:"id"`
+						Club struct {
+							Name string `json:"name"`
+						} `json:"club"`
+					} `json:"clubMatch"`
+					ResolvedPlayers []struct {
+						ParsedName     string  `json:"parsedName"`
+						PlayerSeasonID *string `json:"playerSeasonId"`
+						Confidence     float64 `json:"confidence"`
+					} `json:"resolvedPlayers"`
+					NeedsReview []int `json:"needsReview"`
+				} `json:"teams"`
+				MissingClubMatches []struct {
+					ID string `json:"id"`
+				} `json:"missingClubMatches"`
+			} `json:"parseFFLRoundThread"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		teams := data.ParseFFLRoundThread.Teams
+		require.Len(t, teams, 2)
+
+		eagles := teams[0]
+		assert.Nil(t, eagles.Author)
+		assert.Equal(t, "Eagles", eagles.Format)
+		require.NotNil(t, eagles.ClubMatch)
+		assert.Equal(t, toIDStr(ids.homeClubMatchID), eagles.ClubMatch.ID)
+		require.Len(t, eagles.ResolvedPlayers, 1)
+		require.NotNil(t, eagles.ResolvedPlayers[0].PlayerSeasonID)
+		assert.Equal(t, toIDStr(ids.playerSeasonID), *eagles.ResolvedPlayers[0].PlayerSeasonID)
+		assert.Empty(t, eagles.NeedsReview)
+
+		lions := teams[1]
+		require.NotNil(t, lions.Author)
+		assert.Equal(t, "TestLions", *lions.Author)
+		assert.Equal(t, "Ruiboys", lions.Format)
+		require.NotNil(t, lions.ClubMatch)
+		assert.Equal(t, "Test Lions", lions.ClubMatch.Club.Name)
+		require.Len(t, lions.ResolvedPlayers, 1)
+		assert.Equal(t, "Someone Else", lions.ResolvedPlayers[0].ParsedName)
+
+		assert.Empty(t, data.ParseFFLRoundThread.MissingClubMatches)
+	})
+
+	t.Run("confirm saves no team when one is invalid", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			confirmFFLRoundThread(input: { teams: [
+				{ clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`", players: [
+					{ playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`", position: "kicks", score: 20 }
+				] },
+				{ clubMatchId: "`+toIDStr(ids.awayClubMatchID)+`", players: [
+					{ playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`", position: "bench", interchangePosition: "star" }
+				] }
+			] }) { playerMatches { id } }
+		}`)
+		require.NotEmpty(t, resp.Errors)
+
+		var position string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT position FROM ffl.player_match WHERE id = $1", ids.playerMatchID).Scan(&position))
+		assert.Equal(t, "goals", position, "the valid team was rolled back with the invalid one")
+	})
+
+	t.Run("confirm saves every team", func(t *testing.T) {
+		resp := execQuery(t, server, `mutation {
+			confirmFFLRoundThread(input: { teams: [
+				{ clubMatchId: "`+toIDStr(ids.homeClubMatchID)+`", players: [
+					{ playerSeasonId: "`+toIDStr(ids.playerSeasonID)+`", position: "kicks", score: 20 }
+				] },
+				{ clubMatchId: "`+toIDStr(ids.awayClubMatchID)+`", players: [] }
+			] }) { playerMatches { id position } }
+		}`)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			ConfirmFFLRoundThread []struct {
+				PlayerMatches []struct {
+					ID       string  `json:"id"`
+					Position *string `json:"position"`
+				} `json:"playerMatches"`
+			} `json:"confirmFFLRoundThread"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.ConfirmFFLRoundThread, 2)
+		require.Len(t, data.ConfirmFFLRoundThread[0].PlayerMatches, 1)
+		assert.Equal(t, "kicks", *data.ConfirmFFLRoundThread[0].PlayerMatches[0].Position)
+		assert.Empty(t, data.ConfirmFFLRoundThread[1].PlayerMatches)
+
+		var status string
+		require.NoError(t, pool.QueryRow(ctx,
+			"SELECT data_status FROM ffl.club_match WHERE id = $1", ids.awayClubMatchID).Scan(&status))
+		assert.Equal(t, "submitted", status)
+	})
+}
@@ -0,0 +1,249 @@
+//go:build integration
+
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"xffl/services/ffl/internal/application"
+	pg "xffl/services/ffl/internal/infrastructure/postgres"
+	"xffl/services/ffl/internal/infrastructure/postgres/sqlcgen"
+	gql "xffl/services/ffl/internal/interface/graphql"
+)
+
+// stepClock is a test clock that only moves when advanced.
+type stepClock struct{ t time.Time }
+
+func (c *stepClock) Now() time.Time { return c.t }
+
+// draftPlayerLookup maps every AFL player season to a synthetic AFL player ID.
+// ffl.player.afl_player_id has no FK, so no AFL rows are needed.
+type draftPlayerLookup struct{ stubPlayerLookup }
+
+func (*draftPlayerLookup) LookupPlayerSeason(_ context.Context, aflPlayerSeasonID int) (int, error) {
+	return aflPlayerSeasonID + 10000, nil
+}
+
+func setupDraftServer(t *testing.T, pool *pgxpool.Pool, clk *stepClock) *httptest.Server {
+	t.Helper()
+
+	q := sqlcgen.New(pool)
+	queries := application.NewQueries(
+		pg.NewClubRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewRoundRepository(q),
+		pg.NewMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewPlayerRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
+	)
+	drafts := application.NewDraftCommands(
+		pg.NewDB(pool),
+		clk,
+		&draftPlayerLookup{},
+		pg.NewClubSeasonRepository(q),
+		pg.NewDraftRepository(q),
+	)
+
+	resolver := &gql.Resolver{Queries: queries, Drafts: drafts}
+	srv := gqlhandler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := gql.InjectLoaders(r.Context(), gql.NewLoaders(queries))
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	})
+	return httptest.NewServer(h)
+}
+
+type draftPickResult struct {
+	PickNumber        int     `json:"pickNumber"`
+	ClubSeasonID      string  `json:"clubSeasonId"`
+	Status            string  `json:"status"`
+	AflPlayerSeasonID *string `json:"aflPlayerSeasonId"`
+	PlayerSeason      *struct {
+		ClubSeasonID string `json:"clubSeasonId"`
+	} `json:"playerSeason"`
+}
+
+func makePick(t *testing.T, server *httptest.Server, draftID string, clubSeasonID, aflPlayerSeasonID int) graphqlResponse {
+	t.Helper()
+	return execQuery(t, server, fmt.Sprintf(`mutation {
+		makeFFLDraftPick(input: { draftId: "%s", clubSeasonId: "%d", aflPlayerSeasonId: "%d" }) {
+			pickNumber status
+		}
+	}`, draftID, clubSeasonID, aflPlayerSeasonID))
+}
+
+func TestFflDraft_SnakeWithAutoPick(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	clk := &stepClock{t: time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)}
+	server := setupDraftServer(t, pool, clk)
+	defer server.Close()
+
+	// Create: away club picks first in a two-round snake.
+	result := execQuery(t, server, fmt.Sprintf(`mutation {
+		createFFLDraft(input: {
+			seasonId: "%d", style: snake, rounds: 2, pickSeconds: 60,
+			clubSeasonIds: ["%d", "%d"]
+		}) { id status picks { pickNumber clubSeasonId } }
+	}`, ids.seasonID, ids.awayClubSeaID, ids.homeClubSeaID))
+	require.Empty(t, result.Errors)
+	var created struct {
+		CreateFFLDraft struct {
+			ID     string            `json:"id"`
+			Status string            `json:"status"`
+			Picks  []draftPickResult `json:"picks"`
+		} `json:"createFFLDraft"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &created))
+	draftID := created.CreateFFLDraft.ID
+	assert.Equal(t, "pending", created.CreateFFLDraft.Status)
+	away, home := fmt.Sprint(ids.awayClubSeaID), fmt.Sprint(ids.homeClubSeaID)
+	require.Len(t, created.CreateFFLDraft.Picks, 4)
+	for i, want := range []string{away, home, home, away} {
+		assert.Equal(t, want, created.CreateFFLDraft.Picks[i].ClubSeasonID, "pick %d", i+1)
+	}
+
+	// Picking before the draft starts is rejected.
+	result = makePick(t, server, draftID, ids.awayClubSeaID, 501)
+	require.NotEmpty(t, result.Errors)
+
+	result = execQuery(t, server, fmt.Sprintf(`mutation { startFFLDraft(draftId: "%s") { status currentPickNumber pickDeadline } }`, draftID))
+	require.Empty(t, result.Errors)
+	assert.Contains(t, string(result.Data), `"currentPickNumber":1`)
+	assert.Contains(t, string(result.Data), `"pickDeadline":"2026-03-01T19:01:00Z"`)
+
+	// Out-of-turn pick is rejected.
+	result = makePick(t, server, draftID, ids.homeClubSeaID, 501)
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "not this club's pick")
+
+	// Away makes pick 1.
+	result = makePick(t, server, draftID, ids.awayClubSeaID, 501)
+	require.Empty(t, result.Errors)
+
+	// Home ranks 501 (already taken) then 502, then lets the clock run out.
+	result = execQuery(t, server, fmt.Sprintf(`mutation {
+		setFFLDraftRankings(input: { draftId: "%s", clubSeasonId: "%d", aflPlayerSeasonIds: ["501", "502"] })
+	}`, draftID, ids.homeClubSeaID))
+	require.Empty(t, result.Errors)
+	clk.t = clk.t.Add(61 * time.Second)
+
+	// Home's next pick triggers the auto-pick for pick 2 first, then makes pick 3.
+	result = makePick(t, server, draftID, ids.homeClubSeaID, 503)
+	require.Empty(t, result.Errors)
+	assert.Contains(t, string(result.Data), `"pickNumber":3`)
+
+	// Away cannot take a player already in the home squad (seeded afl_player_season_id = 1).
+	result = makePick(t, server, draftID, ids.awayClubSeaID, 1)
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "already in another club's squad")
+
+	result = execQuery(t, server, fmt.Sprintf(`{
+		fflDraft(seasonId: "%d") {
+			status
+			picks { pickNumber clubSeasonId status aflPlayerSeasonId playerSeason { clubSeasonId } }
+		}
+	}`, ids.seasonID))
+	require.Empty(t, result.Errors)
+	var board struct {
+		FflDraft struct {
+			Status string            `json:"status"`
+			Picks  []draftPickResult `json:"picks"`
+		} `json:"fflDraft"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &board))
+	assert.Equal(t, "in_progress", board.FflDraft.Status)
+	picks := board.FflDraft.Picks
+	assert.Equal(t, "picked", picks[0].Status)
+	assert.Equal(t, "auto_picked", picks[1].Status)
+	require.NotNil(t, picks[1].AflPlayerSeasonID)
+	assert.Equal(t, "502", *picks[1].AflPlayerSeasonID)
+	require.NotNil(t, picks[1].PlayerSeason)
+	assert.Equal(t, home, picks[1].PlayerSeason.ClubSeasonID)
+	assert.Equal(t, "picked", picks[2].Status)
+	assert.Equal(t, "pending", picks[3].Status)
+}
+
+func TestFflDraft_RespectsMaxSquadSize(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	clk := &stepClock{t: time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)}
+	server := setupDraftServer(t, pool, clk)
+	defer server.Close()
+
+	// Home already has the seeded player, so a squad of two leaves it one pick.
+	_, err := pool.Exec(context.Background(), "UPDATE ffl.season SET max_squad_size = 2 WHERE id = $1", ids.seasonID)
+	require.NoError(t, err)
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation {
+		createFFLDraft(input: {
+			seasonId: "%d", style: linear, rounds: 2, pickSeconds: 60,
+			clubSeasonIds: ["%d", "%d"]
+		}) { id }
+	}`, ids.seasonID, ids.awayClubSeaID, ids.homeClubSeaID))
+	require.Empty(t, result.Errors)
+	var created struct {
+		CreateFFLDraft struct {
+			ID string `json:"id"`
+		} `json:"createFFLDraft"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &created))
+	draftID := created.CreateFFLDraft.ID
+	result = execQuery(t, server, fmt.Sprintf(`mutation { startFFLDraft(draftId: "%s") { status } }`, draftID))
+	require.Empty(t, result.Errors)
+
+	require.Empty(t, makePick(t, server, draftID, ids.awayClubSeaID, 501).Errors)
+	require.Empty(t, makePick(t, server, draftID, ids.homeClubSeaID, 502).Errors)
+	require.Empty(t, makePick(t, server, draftID, ids.awayClubSeaID, 503).Errors)
+
+	// Home's squad is full: a manual pick is rejected, and the auto-pick forfeits.
+	result = makePick(t, server, draftID, ids.homeClubSeaID, 504)
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "maximum size")
+
+	result = execQuery(t, server, fmt.Sprintf(`mutation {
+		setFFLDraftRankings(input: { draftId: "%s", clubSeasonId: "%d", aflPlayerSeasonIds: ["504"] })
+	}`, draftID, ids.homeClubSeaID))
+	require.Empty(t, result.Errors)
+	clk.t = clk.t.Add(61 * time.Second)
+	q := sqlcgen.New(pool)
+	drafts := application.NewDraftCommands(pg.NewDB(pool), clk, &draftPlayerLookup{}, pg.NewClubSeasonRepository(q), pg.NewDraftRepository(q))
+	require.NoError(t, drafts.ProcessDraftClocks(context.Background()))
+
+	result = execQuery(t, server, fmt.Sprintf(`{
+		fflDraft(seasonId: "%d") { status picks { pickNumber clubSeasonId status aflPlayerSeasonId } }
+	}`, ids.seasonID))
+	require.Empty(t, result.Errors)
+	var board struct {
+		FflDraft struct {
+			Status string            `json:"status"`
+			Picks  []draftPickResult `json:"picks"`
+		} `json:"fflDraft"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &board))
+	assert.Equal(t, "complete", board.FflDraft.Status)
+	require.Len(t, board.FflDraft.Picks, 4)
+	assert.Equal(t, "forfeited", board.FflDraft.Picks[3].Status)
+	assert.Nil(t, board.FflDraft.Picks[3].AflPlayerSeasonID)
+
+	var squad int
+	require.NoError(t, pool.QueryRow(context.Background(),
+		"SELECT count(*) FROM ffl.player_season WHERE club_season_id = $1", ids.homeClubSeaID).Scan(&squad))
+	assert.Equal(t, 2, squad)
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"xffl/services/ffl/internal/application"
+	"xffl/services/ffl/internal/domain"
+)
+
+// errorCodes maps domain errors to the code clients see in the GraphQL error's
+// extensions, so they can react without matching on message text.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrNotFound, "NOT_FOUND"},
+	{domain.ErrSquadFull, "SQUAD_FULL"},
+	{domain.ErrSquadBelowMinimum, "SQUAD_BELOW_MINIMUM"},
+	{domain.ErrPlayerInAnotherSquad, "PLAYER_IN_ANOTHER_SQUAD"},
+	{domain.ErrDraftPlayerInSquad, "PLAYER_IN_ANOTHER_SQUAD"},
+	{domain.ErrInvalidSquadLimits, "INVALID_SQUAD_LIMITS"},
+	{domain.ErrDraftNotInProgress, "DRAFT_NOT_IN_PROGRESS"},
+	{domain.ErrDraftNotYourPick, "DRAFT_NOT_YOUR_PICK"},
+	{domain.ErrDraftPlayerDrafted, "DRAFT_PLAYER_DRAFTED"},
+	{domain.ErrDraftAlreadyStarted, "DRAFT_ALREADY_STARTED"},
+	{domain.ErrDraftPickMade, "DRAFT_PICK_MADE"},
+	{domain.ErrWaiversProcessed, "WAIVERS_PROCESSED"},
+	{domain.ErrWaiverClaimClosed, "WAIVER_CLAIM_CLOSED"},
+	{domain.ErrNoFollowingRound, "NO_FOLLOWING_ROUND"},
+	{application.ErrInvalidPostFormat, "INVALID_POST_FORMAT"},
+	{application.ErrUnknownPostFormat, "UNKNOWN_POST_FORMAT"},
+	{application.ErrCannotWritePost, "CANNOT_WRITE_POST"},
+	{application.ErrInvalidPlayerAlias, "INVALID_PLAYER_ALIAS"},
+	{application.ErrInvalidTeamSheet, "INVALID_TEAM_SHEET"},
+}
+
+// ErrorPresenter adds a "code" extension to errors that wrap a known domain error.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	for _, ec := range errorCodes {
+		if errors.Is(err, ec.err) {
+			if gqlErr.Extensions == nil {
+				gqlErr.Extensions = map[string]any{}
+			}
+			gqlErr.Extensions["code"] = ec.code
+			break
+		}
+	}
+	return gqlErr
+}
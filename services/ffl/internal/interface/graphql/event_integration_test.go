@@ -5,6 +5,7 @@ package graphql_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -162,6 +163,7 @@ func setupCommandsWithDispatcher(t *testing.T, pool *pgxpool.Pool) (*application
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
@@ -206,6 +208,61 @@ func TestHandleAflPlayerMatchUpdated_scores_ffl_player_match(t *testing.T) {
 	assert.Equal(t, 999, *aflPMID)
 }
 
+func TestFflScoreImpact(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedEventTestData(t, pool)
+	server := setupTestServer(t, pool)
+	defer server.Close()
+	ctx := context.Background()
+
+	result := execQuery(t, server, fmt.Sprintf(`{
+		fflScoreImpact(aflRoundId: "%d", changes: [
+			{aflPlayerSeasonId: "%d", goals: 2, kicks: 20, handballs: 10, marks: 5, tackles: 3, hitouts: 0}
+			{aflPlayerSeasonId: "99999", goals: 5, kicks: 5, handballs: 5, marks: 5, tackles: 5, hitouts: 5}
+		]) {
+			clubMatch { id }
+			oldScore newScore
+			players { playerMatch { playerSeasonId score } newScore }
+		}
+	}`, ids.aflRoundID, ids.aflPlayerSeasonID))
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		FflScoreImpact []struct {
+			ClubMatch struct {
+				ID string `json:"id"`
+			} `json:"clubMatch"`
+			OldScore int `json:"oldScore"`
+			NewScore int `json:"newScore"`
+			Players  []struct {
+				PlayerMatch struct {
+					PlayerSeasonID string `json:"playerSeasonId"`
+					Score          int    `json:"score"`
+				} `json:"playerMatch"`
+				NewScore int `json:"newScore"`
+			} `json:"players"`
+		} `json:"fflScoreImpact"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+
+	// The player is named at kicks, so only the 20 kicks count; the unknown
+	// AFL player isn't in any FFL team.
+	require.Len(t, data.FflScoreImpact, 1)
+	impact := data.FflScoreImpact[0]
+	assert.Equal(t, fmt.Sprint(ids.fflClubMatchID), impact.ClubMatch.ID)
+	assert.Equal(t, []int{0, 20}, []int{impact.OldScore, impact.NewScore})
+	require.Len(t, impact.Players, 1)
+	assert.Equal(t, fmt.Sprint(ids.fflPlayerSeasonID), impact.Players[0].PlayerMatch.PlayerSeasonID)
+	assert.Equal(t, []int{0, 20}, []int{impact.Players[0].PlayerMatch.Score, impact.Players[0].NewScore})
+
+	// Nothing is written.
+	var score int
+	require.NoError(t, pool.QueryRow(ctx,
+		"SELECT drv_score FROM ffl.player_match WHERE player_season_id = $1 AND club_match_id = $2",
+		ids.fflPlayerSeasonID, ids.fflClubMatchID).Scan(&score))
+	assert.Zero(t, score)
+}
+
 func TestHandleAflPlayerMatchUpdated_ignores_unknown_player(t *testing.T) {
 	pool := connectDB(t)
 	seedEventTestData(t, pool)
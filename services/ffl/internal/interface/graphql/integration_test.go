@@ -45,6 +45,8 @@ func setupTestServer(t *testing.T, pool *pgxpool.Pool) *httptest.Server {
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -56,12 +58,14 @@ func setupTestServer(t *testing.T, pool *pgxpool.Pool) *httptest.Server {
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
 
 	resolver := &gql.Resolver{Queries: queries, Commands: commands}
 	srv := gqlhandler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
+	srv.SetErrorPresenter(gql.ErrorPresenter)
 
 	// inject per-request loaders via HTTP middleware
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -170,7 +174,7 @@ func seedTestData(t *testing.T, pool *pgxpool.Pool) testIDs {
 func cleanupTestData(ctx context.Context, t *testing.T, pool *pgxpool.Pool) {
 	t.Helper()
 	tables := []string{
-		"ffl.player_match",
+		"ffl.player_match", "ffl.dataops_post_format", "ffl.dataops_player_alias", "ffl.dataops_team_submission",
 		"ffl.player_season", "ffl.player",
 		"ffl.club_match", "ffl.match", "ffl.club_season",
 		"ffl.club", "ffl.round", "ffl.season", "ffl.league",
@@ -191,7 +195,8 @@ type graphqlRequest struct {
 type graphqlResponse struct {
 	Data   json.RawMessage `json:"data"`
 	Errors []struct {
-		Message string `json:"message"`
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
 	} `json:"errors"`
 }
 
@@ -728,7 +733,10 @@ func buildSetTeamMutation(clubMatchID string, players []teamPlayer) string {
 		setFFLTeam(input: {
 			clubMatchId: "%s"
 			players: [%s]
-		}) { id position backupPositions interchangePosition }
+		}) {
+			playerMatches { id position backupPositions interchangePosition }
+			completeness { complete emptySlots { position empty } uncoveredPositions noInterchange }
+		}
 	}`, clubMatchID, playersStr)
 }
 
@@ -770,6 +778,80 @@ func TestSetFFLTeam_ValidTeamSaves(t *testing.T) {
 	t.Run("single starter saves without errors", func(t *testing.T) {
 		assert.Empty(t, result.Errors)
 	})
+
+	t.Run("reports the team as incomplete", func(t *testing.T) {
+		var data struct {
+			SetFFLTeam struct {
+				PlayerMatches []struct {
+					ID string `json:"id"`
+				} `json:"playerMatches"`
+				Completeness struct {
+					Complete   bool `json:"complete"`
+					EmptySlots []struct {
+						Position string `json:"position"`
+						Empty    int    `json:"empty"`
+					} `json:"emptySlots"`
+					UncoveredPositions []string `json:"uncoveredPositions"`
+					NoInterchange      bool     `json:"noInterchange"`
+				} `json:"completeness"`
+			} `json:"setFFLTeam"`
+		}
+		require.NoError(t, json.Unmarshal(result.Data, &data))
+		require.Len(t, data.SetFFLTeam.PlayerMatches, 1)
+		c := data.SetFFLTeam.Completeness
+		assert.False(t, c.Complete)
+		require.NotEmpty(t, c.EmptySlots)
+		assert.Equal(t, "goals", c.EmptySlots[0].Position)
+		assert.Equal(t, 2, c.EmptySlots[0].Empty)
+		assert.Len(t, c.UncoveredPositions, 7)
+		assert.True(t, c.NoInterchange)
+	})
+}
+
+func TestFFLRoundTeamStatus(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	server := setupTestServer(t, pool)
+	defer server.Close()
+
+	// The seeded home team has one starter; the away club has not submitted.
+	_, err := pool.Exec(context.Background(),
+		"UPDATE ffl.club_match SET data_status = 'submitted' WHERE id = $1", ids.homeClubMatchID)
+	require.NoError(t, err)
+
+	result := execQuery(t, server, fmt.Sprintf(`{
+		fflRoundTeamStatus(roundId: "%d") {
+			clubMatch { id club { name } }
+			submitted
+			lockout
+			completeness { complete noInterchange }
+		}
+	}`, ids.roundID))
+	require.Empty(t, result.Errors)
+
+	var data struct {
+		FflRoundTeamStatus []struct {
+			ClubMatch struct {
+				ID string `json:"id"`
+			} `json:"clubMatch"`
+			Submitted    bool    `json:"submitted"`
+			Lockout      *string `json:"lockout"`
+			Completeness struct {
+				Complete      bool `json:"complete"`
+				NoInterchange bool `json:"noInterchange"`
+			} `json:"completeness"`
+		} `json:"fflRoundTeamStatus"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+	require.Len(t, data.FflRoundTeamStatus, 2)
+
+	home, away := data.FflRoundTeamStatus[0], data.FflRoundTeamStatus[1]
+	assert.Equal(t, fmt.Sprint(ids.homeClubMatchID), home.ClubMatch.ID)
+	assert.True(t, home.Submitted)
+	assert.False(t, home.Completeness.Complete)
+	assert.Equal(t, fmt.Sprint(ids.awayClubMatchID), away.ClubMatch.ID)
+	assert.False(t, away.Submitted)
+	assert.True(t, away.Completeness.NoInterchange)
 }
 
 func TestSetFFLTeam_TooManyStartersForPosition(t *testing.T) {
@@ -1079,11 +1161,35 @@ func TestAddFFLPlayerToSeason_FromAFLPlayerSeason(t *testing.T) {
 		assert.Equal(t, 1, fflPlayerCount)
 	})
 
+	awayClubSeasonID := fmt.Sprintf("%d", ids.awayClubSeaID)
+
+	t.Run("rejects a player still active in another club's squad", func(t *testing.T) {
+		result := execQuery(t, server, `mutation {
+			addFFLPlayerToSeason(input: {
+				clubSeasonId: "`+awayClubSeasonID+`"
+				aflPlayerSeasonId: "`+apsIDStr+`"
+			}) { id }
+		}`)
+		require.NotEmpty(t, result.Errors)
+		assert.Equal(t, "PLAYER_IN_ANOTHER_SQUAD", result.Errors[0].Extensions["code"])
+	})
+
 	t.Run("reuses the existing FFL player when called again for a different club season", func(t *testing.T) {
+		// Trade the player out of the home club after round 1, into the away club from round 2.
+		var round2ID int
+		require.NoError(t, pool.QueryRow(ctx,
+			"INSERT INTO ffl.round (name, season_id, afl_round_id) VALUES ('Round 2', $1, 2) RETURNING id",
+			ids.seasonID).Scan(&round2ID))
+		_, err := pool.Exec(ctx,
+			"UPDATE ffl.player_season SET to_round_id = $1 WHERE club_season_id = $2 AND afl_player_season_id = $3",
+			ids.roundID, ids.homeClubSeaID, aflPlayerSeasonID)
+		require.NoError(t, err)
+
 		result := execQuery(t, server, `mutation {
 			addFFLPlayerToSeason(input: {
-				clubSeasonId: "`+fmt.Sprintf("%d", ids.awayClubSeaID)+`"
+				clubSeasonId: "`+awayClubSeasonID+`"
 				aflPlayerSeasonId: "`+apsIDStr+`"
+				fromRoundId: "`+fmt.Sprintf("%d", round2ID)+`"
 			}) {
 				id
 				player { id }
@@ -1421,6 +1527,65 @@ func TestRemoveFFLPlayerFromSeason_HistoryAndReAdd(t *testing.T) {
 	})
 }
 
+func TestFFLSeason_SquadLimits(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	server := setupTestServer(t, pool)
+	defer server.Close()
+
+	aflSeasonID := insertAFLSeason(t, pool)
+	aflPlayerSeasonID := insertAFLPlayerSeason(t, pool, aflSeasonID)
+	seasonID := fmt.Sprintf("%d", ids.seasonID)
+	homeClubSeasonID := fmt.Sprintf("%d", ids.homeClubSeaID)
+
+	setLimits := func(limits string) graphqlResponse {
+		return execQuery(t, server, `mutation {
+			setFFLSeasonSquadLimits(input: { seasonId: "`+seasonID+`"`+limits+` }) { minSquadSize maxSquadSize }
+		}`)
+	}
+
+	t.Run("rejects minimum above maximum", func(t *testing.T) {
+		result := setLimits(", minSquadSize: 3, maxSquadSize: 2")
+		require.NotEmpty(t, result.Errors)
+		assert.Equal(t, "INVALID_SQUAD_LIMITS", result.Errors[0].Extensions["code"])
+	})
+
+	t.Run("limits are stored on the season", func(t *testing.T) {
+		result := setLimits(", minSquadSize: 1, maxSquadSize: 1")
+		require.Empty(t, result.Errors)
+		assert.JSONEq(t, `{"setFFLSeasonSquadLimits":{"minSquadSize":1,"maxSquadSize":1}}`, string(result.Data))
+	})
+
+	t.Run("full squad rejects another player", func(t *testing.T) {
+		// The seeded home squad already has one active player.
+		result := execQuery(t, server, `mutation {
+			addFFLPlayerToSeason(input: {
+				clubSeasonId: "`+homeClubSeasonID+`"
+				aflPlayerSeasonId: "`+fmt.Sprintf("%d", aflPlayerSeasonID)+`"
+			}) { id }
+		}`)
+		require.NotEmpty(t, result.Errors)
+		assert.Equal(t, "SQUAD_FULL", result.Errors[0].Extensions["code"])
+	})
+
+	t.Run("removal below minimum is rejected", func(t *testing.T) {
+		result := execQuery(t, server, `mutation {
+			removeFFLPlayerFromSeason(input: { id: "`+fmt.Sprintf("%d", ids.playerSeasonID)+`", toRoundId: "`+fmt.Sprintf("%d", ids.roundID)+`" })
+		}`)
+		require.NotEmpty(t, result.Errors)
+		assert.Equal(t, "SQUAD_BELOW_MINIMUM", result.Errors[0].Extensions["code"])
+	})
+
+	t.Run("clearing limits allows changes again", func(t *testing.T) {
+		result := setLimits("")
+		require.Empty(t, result.Errors)
+		result = execQuery(t, server, `mutation {
+			removeFFLPlayerFromSeason(input: { id: "`+fmt.Sprintf("%d", ids.playerSeasonID)+`", toRoundId: "`+fmt.Sprintf("%d", ids.roundID)+`" })
+		}`)
+		require.Empty(t, result.Errors)
+	})
+}
+
 func TestFFLSeason_AflSeasonTraversal(t *testing.T) {
 	pool := connectDB(t)
 	ids := seedTestData(t, pool)
@@ -1475,6 +1640,8 @@ func setupTestServerWithScoreCommands(t *testing.T, pool *pgxpool.Pool) *httptes
 		pg.NewPlayerRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
 	)
 
 	db := pg.NewDB(pool)
@@ -1486,6 +1653,7 @@ func setupTestServerWithScoreCommands(t *testing.T, pool *pgxpool.Pool) *httptes
 		pg.NewClubMatchRepository(q),
 		pg.NewClubSeasonRepository(q),
 		pg.NewRoundRepository(q),
+		pg.NewSeasonRepository(q),
 		pg.NewPlayerMatchRepository(q),
 		pg.NewPlayerSeasonRepository(q),
 	)
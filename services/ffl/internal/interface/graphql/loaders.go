@@ -15,7 +15,9 @@ type loadersKey struct{}
 type Loaders struct {
 	PlayerByPlayerSeasonID *dataloadgen.Loader[int, *domain.Player]
 	ClubByID               *dataloadgen.Loader[int, *domain.Club]
+	ClubByClubSeasonID     *dataloadgen.Loader[int, *domain.Club]
 	MatchByID              *dataloadgen.Loader[int, *domain.Match]
+	PlayerSeasonByID       *dataloadgen.Loader[int, *domain.PlayerSeason]
 }
 
 func NewLoaders(q *application.Queries) *Loaders {
@@ -28,10 +30,18 @@ func NewLoaders(q *application.Queries) *Loaders {
 			m, err := q.GetClubsByIDs(ctx, ids)
 			return mapToSlice(ids, m, err)
 		}),
+		ClubByClubSeasonID: dataloadgen.NewLoader(func(ctx context.Context, ids []int) ([]*domain.Club, []error) {
+			m, err := q.GetClubsForClubSeasonIDs(ctx, ids)
+			return mapToSlice(ids, m, err)
+		}),
 		MatchByID: dataloadgen.NewLoader(func(ctx context.Context, ids []int) ([]*domain.Match, []error) {
 			m, err := q.GetMatchesByIDs(ctx, ids)
 			return mapToSlice(ids, m, err)
 		}),
+		PlayerSeasonByID: dataloadgen.NewLoader(func(ctx context.Context, ids []int) ([]*domain.PlayerSeason, []error) {
+			m, err := q.GetPlayerSeasonsByIDs(ctx, ids)
+			return mapToSlice(ids, m, err)
+		}),
 	}
 }
 
@@ -59,7 +69,7 @@ func mapToSlice[V any](ids []int, m map[int]V, batchErr error) ([]*V, []error) {
 			cp := v
 			out[i] = &cp
 		} else {
-			errs[i] = fmt.Errorf("id %d: not found", id)
+			errs[i] = fmt.Errorf("id %d: %w", id, domain.ErrNotFound)
 		}
 	}
 	return out, errs
@@ -56,9 +56,15 @@ type CalculateFFLFantasyScoreInput struct {
 	Hitouts       int    `json:"hitouts"`
 }
 
+type ConfirmFFLRoundThreadInput struct {
+	Teams []*ConfirmFFLTeamSubmissionInput `json:"teams"`
+}
+
 type ConfirmFFLTeamSubmissionInput struct {
-	ClubMatchID string                     `json:"clubMatchId"`
-	Players     []*ConfirmedFFLPlayerInput `json:"players"`
+	ClubMatchID string `json:"clubMatchId"`
+	// The submission the team was parsed from; the confirmed team is recorded against it. Omit for a team entered without a post.
+	SubmissionID *string                    `json:"submissionId,omitempty"`
+	Players      []*ConfirmedFFLPlayerInput `json:"players"`
 }
 
 type ConfirmedFFLPlayerInput struct {
@@ -67,6 +73,27 @@ type ConfirmedFFLPlayerInput struct {
 	BackupPositions     *string `json:"backupPositions,omitempty"`
 	InterchangePosition *string `json:"interchangePosition,omitempty"`
 	Score               *int    `json:"score,omitempty"`
+	// The name as parsed from the post. With a confidence below the review threshold, it is learned as an alias.
+	ParsedName *string `json:"parsedName,omitempty"`
+	// The resolver's confidence from parseFFLTeamSubmission.
+	Confidence *float64 `json:"confidence,omitempty"`
+}
+
+type CreateFFLDraftInput struct {
+	SeasonID    string        `json:"seasonId"`
+	Style       FFLDraftStyle `json:"style"`
+	Rounds      int           `json:"rounds"`
+	PickSeconds int           `json:"pickSeconds"`
+	// Explicit first-round order. Omit when using reverseLadderSeasonId.
+	ClubSeasonIds []string `json:"clubSeasonIds,omitempty"`
+	// Derive the first-round order from this season's ladder, bottom club first.
+	ReverseLadderSeasonID *string `json:"reverseLadderSeasonId,omitempty"`
+}
+
+type CreateFFLPlayerAliasInput struct {
+	Alias string `json:"alias"`
+	// Omit for a strip word.
+	AflPlayerID *string `json:"aflPlayerId,omitempty"`
 }
 
 type DeclareFFLSubstitutionsInput struct {
@@ -75,6 +102,27 @@ type DeclareFFLSubstitutionsInput struct {
 	InterchangeApplied      bool     `json:"interchangeApplied"`
 }
 
+// An AFL player's stat line as a correction would leave it.
+type FFLAFLStatsInput struct {
+	AflPlayerSeasonID string `json:"aflPlayerSeasonId"`
+	Goals             int    `json:"goals"`
+	Kicks             int    `json:"kicks"`
+	Handballs         int    `json:"handballs"`
+	Marks             int    `json:"marks"`
+	Tackles           int    `json:"tackles"`
+	Hitouts           int    `json:"hitouts"`
+}
+
+type FFLBenchCode struct {
+	Code     string `json:"code"`
+	Position string `json:"position"`
+}
+
+type FFLBenchCodeInput struct {
+	Code     string `json:"code"`
+	Position string `json:"position"`
+}
+
 type FFLClub struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -91,6 +139,13 @@ type FFLClubMatch struct {
 	PlayerMatches []*FFLPlayerMatch `json:"playerMatches"`
 }
 
+type FFLClubMatchScoreImpact struct {
+	ClubMatch *FFLClubMatch           `json:"clubMatch"`
+	OldScore  int                     `json:"oldScore"`
+	NewScore  int                     `json:"newScore"`
+	Players   []*FFLPlayerScoreImpact `json:"players"`
+}
+
 type FFLClubSeason struct {
 	ID         string                     `json:"id"`
 	Club       *FFLClub                   `json:"club"`
@@ -105,6 +160,34 @@ type FFLClubSeason struct {
 	Players    *FFLPlayerSeasonConnection `json:"players"`
 }
 
+type FFLDraft struct {
+	ID          string         `json:"id"`
+	SeasonID    string         `json:"seasonId"`
+	Style       FFLDraftStyle  `json:"style"`
+	Rounds      int            `json:"rounds"`
+	PickSeconds int            `json:"pickSeconds"`
+	Status      FFLDraftStatus `json:"status"`
+	// Pick number currently on the clock; null unless the draft is in progress.
+	CurrentPickNumber *int `json:"currentPickNumber,omitempty"`
+	// When the current pick's clock expires; null unless the draft is in progress.
+	PickDeadline *string         `json:"pickDeadline,omitempty"`
+	Picks        []*FFLDraftPick `json:"picks"`
+}
+
+type FFLDraftPick struct {
+	ID                string             `json:"id"`
+	PickNumber        int                `json:"pickNumber"`
+	Round             int                `json:"round"`
+	ClubSeasonID      string             `json:"clubSeasonId"`
+	Club              *FFLClub           `json:"club"`
+	Status            FFLDraftPickStatus `json:"status"`
+	AflPlayerSeasonID *string            `json:"aflPlayerSeasonId,omitempty"`
+	AflPlayerSeason   *AFLPlayerSeason   `json:"aflPlayerSeason,omitempty"`
+	PlayerSeasonID    *string            `json:"playerSeasonId,omitempty"`
+	PlayerSeason      *FFLPlayerSeason   `json:"playerSeason,omitempty"`
+	PickedAt          *string            `json:"pickedAt,omitempty"`
+}
+
 type FFLMatch struct {
 	ID            string        `json:"id"`
 	Venue         *string       `json:"venue,omitempty"`
@@ -115,12 +198,35 @@ type FFLMatch struct {
 	AwayClubMatch *FFLClubMatch `json:"awayClubMatch,omitempty"`
 }
 
+// One player line read from a post, before players are resolved against the squad.
+type FFLParsedPlayerRow struct {
+	Name                string `json:"name"`
+	ClubHint            string `json:"clubHint"`
+	Position            string `json:"position"`
+	BackupPositions     string `json:"backupPositions"`
+	InterchangePosition string `json:"interchangePosition"`
+	Score               *int   `json:"score,omitempty"`
+	Notes               string `json:"notes"`
+}
+
 type FFLPlayer struct {
 	ID          string     `json:"id"`
 	AflPlayerID string     `json:"aflPlayerId"`
 	AflPlayer   *AFLPlayer `json:"aflPlayer"`
 }
 
+// A forum name for an AFL player: a nickname, or a spelling the resolver could not match. An alias with no player is a strip word, a nickname written inside a player's name ("Jack Journeyman Smith") and dropped from it.
+type FFLPlayerAlias struct {
+	ID string `json:"id"`
+	// Lowercased, with punctuation and extra spaces removed.
+	Alias string `json:"alias"`
+	// Null for a strip word.
+	AflPlayerID *string    `json:"aflPlayerId,omitempty"`
+	AflPlayer   *AFLPlayer `json:"aflPlayer,omitempty"`
+	// True when recorded from a confirmed low-confidence match rather than added by an admin.
+	Learned bool `json:"learned"`
+}
+
 type FFLPlayerMatch struct {
 	ID                  string                   `json:"id"`
 	PlayerSeasonID      string                   `json:"playerSeasonId"`
@@ -136,6 +242,12 @@ type FFLPlayerMatch struct {
 	AflPlayerMatch      *AFLPlayerMatch          `json:"aflPlayerMatch,omitempty"`
 }
 
+type FFLPlayerScoreImpact struct {
+	// Carries the current score.
+	PlayerMatch *FFLPlayerMatch `json:"playerMatch"`
+	NewScore    int             `json:"newScore"`
+}
+
 type FFLPlayerSeason struct {
 	ID                string           `json:"id"`
 	Player            *FFLPlayer       `json:"player"`
@@ -158,6 +270,124 @@ type FFLPlayerSeasonFilter struct {
 	Active *bool `json:"active,omitempty"`
 }
 
+type FFLPositionGap struct {
+	Position string `json:"position"`
+	// Starter slots left unfilled at this position.
+	Empty int `json:"empty"`
+}
+
+// How a club lays out its team posts on the forum. Patterns are Go regular
+// expressions matched against one trimmed line; detect is matched against the
+// whole post.
+type FFLPostFormat struct {
+	ClubID             string                  `json:"clubId"`
+	Club               *FFLClub                `json:"club"`
+	Name               string                  `json:"name"`
+	Detect             string                  `json:"detect"`
+	ScoreLines         []string                `json:"scoreLines"`
+	Strip              []string                `json:"strip"`
+	Sections           []*FFLPostSection       `json:"sections"`
+	Players            []*FFLPostPlayerLine    `json:"players"`
+	BenchCodes         []*FFLBenchCode         `json:"benchCodes"`
+	RawStatMultipliers []*FFLRawStatMultiplier `json:"rawStatMultipliers"`
+	InterchangeMarker  *string                 `json:"interchangeMarker,omitempty"`
+	// How teams are written in this format; null if they can't be exported in it.
+	Layout *FFLPostLayout `json:"layout,omitempty"`
+}
+
+type FFLPostFormatInput struct {
+	ClubID             string                       `json:"clubId"`
+	Name               string                       `json:"name"`
+	Detect             string                       `json:"detect"`
+	ScoreLines         []string                     `json:"scoreLines,omitempty"`
+	Strip              []string                     `json:"strip,omitempty"`
+	Sections           []*FFLPostSectionInput       `json:"sections"`
+	Players            []*FFLPostPlayerLineInput    `json:"players"`
+	BenchCodes         []*FFLBenchCodeInput         `json:"benchCodes,omitempty"`
+	RawStatMultipliers []*FFLRawStatMultiplierInput `json:"rawStatMultipliers,omitempty"`
+	InterchangeMarker  *string                      `json:"interchangeMarker,omitempty"`
+	Layout             *FFLPostLayoutInput          `json:"layout,omitempty"`
+}
+
+// How a club writes a team post. Templates replace {placeholder} with its
+// value and keep an optional [segment] only when its placeholders all have
+// values; a line whose other placeholders can't be filled is left out.
+// Title and footer take {round} and {total}; headers take {subtotal}; player
+// lines take {name}, {club} and {score}, bench lines also {code}, and subbed
+// and interchanged lines also {sub} and {subscore}.
+type FFLPostLayout struct {
+	Title  *string `json:"title,omitempty"`
+	Footer *string `json:"footer,omitempty"`
+	// Each section's header line; position is a scoring position or bench.
+	Headers   []*FFLPostSection `json:"headers"`
+	Player    string            `json:"player"`
+	Bench     string            `json:"bench"`
+	BenchStar *string           `json:"benchStar,omitempty"`
+	// A starter who didn't play and was covered from the bench.
+	Subbed *string `json:"subbed,omitempty"`
+	// A starter the interchange beat.
+	Interchanged *string `json:"interchanged,omitempty"`
+	// The star interchange's bench code; * (INT) if null.
+	InterchangeCode *string `json:"interchangeCode,omitempty"`
+	// Written after the bench when the bench star is the interchange.
+	InterchangeLine *string `json:"interchangeLine,omitempty"`
+}
+
+type FFLPostLayoutInput struct {
+	Title           *string                `json:"title,omitempty"`
+	Footer          *string                `json:"footer,omitempty"`
+	Headers         []*FFLPostSectionInput `json:"headers"`
+	Player          string                 `json:"player"`
+	Bench           string                 `json:"bench"`
+	BenchStar       *string                `json:"benchStar,omitempty"`
+	Subbed          *string                `json:"subbed,omitempty"`
+	Interchanged    *string                `json:"interchanged,omitempty"`
+	InterchangeCode *string                `json:"interchangeCode,omitempty"`
+	InterchangeLine *string                `json:"interchangeLine,omitempty"`
+}
+
+// One way a club writes a player line. The pattern's named groups are name
+// (required), club, code, score and interchange; notes may use {group}
+// placeholders for any named group.
+type FFLPostPlayerLine struct {
+	Section             *string `json:"section,omitempty"`
+	Pattern             string  `json:"pattern"`
+	BackupPositions     *string `json:"backupPositions,omitempty"`
+	InterchangePosition *string `json:"interchangePosition,omitempty"`
+	Score               *int    `json:"score,omitempty"`
+	Notes               *string `json:"notes,omitempty"`
+}
+
+type FFLPostPlayerLineInput struct {
+	Section             *string `json:"section,omitempty"`
+	Pattern             string  `json:"pattern"`
+	BackupPositions     *string `json:"backupPositions,omitempty"`
+	InterchangePosition *string `json:"interchangePosition,omitempty"`
+	Score               *int    `json:"score,omitempty"`
+	Notes               *string `json:"notes,omitempty"`
+}
+
+// A section header; position is a scoring position or bench.
+type FFLPostSection struct {
+	Header   string `json:"header"`
+	Position string `json:"position"`
+}
+
+type FFLPostSectionInput struct {
+	Header   string `json:"header"`
+	Position string `json:"position"`
+}
+
+type FFLRawStatMultiplier struct {
+	Position   string `json:"position"`
+	Multiplier int    `json:"multiplier"`
+}
+
+type FFLRawStatMultiplierInput struct {
+	Position   string `json:"position"`
+	Multiplier int    `json:"multiplier"`
+}
+
 type FFLRound struct {
 	ID         string      `json:"id"`
 	Name       string      `json:"name"`
@@ -167,12 +397,46 @@ type FFLRound struct {
 	Matches    []*FFLMatch `json:"matches"`
 }
 
+type FFLRoundTeamStatus struct {
+	ClubMatch *FFLClubMatch `json:"clubMatch"`
+	// False while the club match has no team (data status no_data).
+	Submitted bool `json:"submitted"`
+	// Match start, when teams lock; null if the match has no start time.
+	Lockout      *string              `json:"lockout,omitempty"`
+	Completeness *FFLTeamCompleteness `json:"completeness"`
+}
+
 type FFLSeason struct {
-	ID        string           `json:"id"`
-	Name      string           `json:"name"`
-	Ladder    []*FFLClubSeason `json:"ladder"`
-	Rounds    []*FFLRound      `json:"rounds"`
-	AflSeason *AFLSeason       `json:"aflSeason,omitempty"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Smallest squad a club may drop to; null for no limit.
+	MinSquadSize *int `json:"minSquadSize,omitempty"`
+	// Largest squad a club may hold at any round; null for no limit.
+	MaxSquadSize *int             `json:"maxSquadSize,omitempty"`
+	Ladder       []*FFLClubSeason `json:"ladder"`
+	Rounds       []*FFLRound      `json:"rounds"`
+	AflSeason    *AFLSeason       `json:"aflSeason,omitempty"`
+}
+
+// One difference between two versions of a team. from is null for an added player, to for a removed one.
+type FFLSubmissionChange struct {
+	Kind FFLSubmissionChangeKind `json:"kind"`
+	// The player's name, or the parsed name of a row that didn't resolve.
+	Player string `json:"player"`
+	// The old position, score or player.
+	From *string `json:"from,omitempty"`
+	// The new position, score or player.
+	To *string `json:"to,omitempty"`
+}
+
+// What a team is missing compared with a full sheet. Incomplete teams are still accepted and scored.
+type FFLTeamCompleteness struct {
+	Complete   bool              `json:"complete"`
+	EmptySlots []*FFLPositionGap `json:"emptySlots"`
+	// Positions no bench player backs up.
+	UncoveredPositions []string `json:"uncoveredPositions"`
+	// True when no bench player has an interchange position set.
+	NoInterchange bool `json:"noInterchange"`
 }
 
 type FFLTeamPlayerInput struct {
@@ -182,6 +446,79 @@ type FFLTeamPlayerInput struct {
 	InterchangePosition *string `json:"interchangePosition,omitempty"`
 }
 
+type FFLTeamSubmission struct {
+	PlayerMatches []*FFLPlayerMatch    `json:"playerMatches"`
+	Completeness  *FFLTeamCompleteness `json:"completeness"`
+}
+
+// A team post as it was submitted, kept for audit: the raw post, what was read from it and the team confirmed after review.
+type FFLTeamSubmissionRecord struct {
+	ID          string `json:"id"`
+	ClubMatchID string `json:"clubMatchId"`
+	SubmittedAt string `json:"submittedAt"`
+	// Forum username, for posts read from a thread.
+	Author *string `json:"author,omitempty"`
+	// Name of the post format the post was read with.
+	Format *string `json:"format,omitempty"`
+	// The post as pasted. Empty for a team confirmed without one.
+	Post   string            `json:"post"`
+	Parsed []*ResolvedPlayer `json:"parsed"`
+	// The team saved after review; null if it never was.
+	Confirmed   []*ResolvedPlayer `json:"confirmed,omitempty"`
+	ConfirmedAt *string           `json:"confirmedAt,omitempty"`
+	// What the reviewer changed between the parsed and the confirmed team.
+	Corrections []*FFLSubmissionChange `json:"corrections"`
+	// How the team differs from the previous submission's. Empty for the first.
+	Changes []*FFLSubmissionChange `json:"changes"`
+	// The post's lines removed ("- ") and added ("+ ") since the previous submission.
+	PostDiff []string `json:"postDiff"`
+}
+
+// A team post found in a forum thread.
+type FFLThreadTeam struct {
+	// Forum username from the post header. Null for a post without one, such as the first on a page.
+	Author *string `json:"author,omitempty"`
+	// Name of the post format the post was read with.
+	Format string `json:"format"`
+	Post   string `json:"post"`
+	// The club match the post belongs to. Null when no club playing the round could be matched.
+	ClubMatch *FFLClubMatch `json:"clubMatch,omitempty"`
+	// The recorded submission; null when no club match was found.
+	SubmissionID    *string           `json:"submissionId,omitempty"`
+	ResolvedPlayers []*ResolvedPlayer `json:"resolvedPlayers"`
+	NeedsReview     []int             `json:"needsReview"`
+}
+
+type FFLWaiverClaim struct {
+	ID                 string           `json:"id"`
+	RoundID            string           `json:"roundId"`
+	ClubSeasonID       string           `json:"clubSeasonId"`
+	Club               *FFLClub         `json:"club"`
+	AflPlayerSeasonID  string           `json:"aflPlayerSeasonId"`
+	AflPlayerSeason    *AFLPlayerSeason `json:"aflPlayerSeason"`
+	DropPlayerSeasonID string           `json:"dropPlayerSeasonId"`
+	DropPlayerSeason   *FFLPlayerSeason `json:"dropPlayerSeason,omitempty"`
+	// The club's preference order for its own claims; 1 is first choice.
+	Priority      int                  `json:"priority"`
+	Status        FFLWaiverClaimStatus `json:"status"`
+	FailureReason *string              `json:"failureReason,omitempty"`
+	// Squad entry created for a successful claim, active from the next round.
+	PlayerSeasonID *string `json:"playerSeasonId,omitempty"`
+	ProcessedAt    *string `json:"processedAt,omitempty"`
+}
+
+type FFLWaiverSchedule struct {
+	RoundID     string  `json:"roundId"`
+	ProcessAt   string  `json:"processAt"`
+	ProcessedAt *string `json:"processedAt,omitempty"`
+}
+
+type MakeFFLDraftPickInput struct {
+	DraftID           string `json:"draftId"`
+	ClubSeasonID      string `json:"clubSeasonId"`
+	AflPlayerSeasonID string `json:"aflPlayerSeasonId"`
+}
+
 type MarkFFLTeamFinalInput struct {
 	ClubMatchID string `json:"clubMatchId"`
 	MatchID     string `json:"matchId"`
@@ -197,14 +534,32 @@ type PageInfo struct {
 	TotalCount  *int    `json:"totalCount,omitempty"`
 }
 
+type ParseFFLRoundThreadInput struct {
+	RoundID string `json:"roundId"`
+	// The whole thread page, as pasted.
+	Thread string `json:"thread"`
+}
+
+type ParseFFLRoundThreadResult struct {
+	Teams []*FFLThreadTeam `json:"teams"`
+	// Club matches in the round no team post was found for.
+	MissingClubMatches []*FFLClubMatch `json:"missingClubMatches"`
+}
+
 type ParseFFLTeamSubmissionInput struct {
 	ClubSeasonID string `json:"clubSeasonId"`
 	ClubMatchID  string `json:"clubMatchId"`
-	TeamName     string `json:"teamName"`
-	Post         string `json:"post"`
+	// How the post is written. Defaults to forum.
+	Format *FFLSubmissionFormat `json:"format,omitempty"`
+	// Post format to read a forum post with when the club has none registered. Omit to detect it from the post.
+	TeamName *string `json:"teamName,omitempty"`
+	// The forum post, or the team sheet: a row per player with name, club, position, backups, interchange and optionally score.
+	Post string `json:"post"`
 }
 
 type ParseFFLTeamSubmissionResult struct {
+	// The recorded submission; pass it to confirmFFLTeamSubmission.
+	SubmissionID    *string           `json:"submissionId,omitempty"`
 	ResolvedPlayers []*ResolvedPlayer `json:"resolvedPlayers"`
 	NeedsReview     []int             `json:"needsReview"`
 }
@@ -231,27 +586,62 @@ type ResolvedPlayer struct {
 	Confidence          float64 `json:"confidence"`
 }
 
+type SetFFLDraftRankingsInput struct {
+	DraftID            string   `json:"draftId"`
+	ClubSeasonID       string   `json:"clubSeasonId"`
+	AflPlayerSeasonIds []string `json:"aflPlayerSeasonIds"`
+}
+
+type SetFFLSeasonSquadLimitsInput struct {
+	SeasonID     string `json:"seasonId"`
+	MinSquadSize *int   `json:"minSquadSize,omitempty"`
+	MaxSquadSize *int   `json:"maxSquadSize,omitempty"`
+}
+
 type SetFFLTeamInput struct {
 	ClubMatchID string                `json:"clubMatchId"`
 	Players     []*FFLTeamPlayerInput `json:"players"`
 }
 
+type SubmitFFLWaiverClaimInput struct {
+	RoundID            string `json:"roundId"`
+	ClubSeasonID       string `json:"clubSeasonId"`
+	AflPlayerSeasonID  string `json:"aflPlayerSeasonId"`
+	DropPlayerSeasonID string `json:"dropPlayerSeasonId"`
+	// The club's preference for this claim among its own; 1 is first choice.
+	Priority int `json:"priority"`
+}
+
+type UpdateFFLPlayerAliasInput struct {
+	ID    string `json:"id"`
+	Alias string `json:"alias"`
+	// Omit for a strip word.
+	AflPlayerID *string `json:"aflPlayerId,omitempty"`
+}
+
 type UpdateFFLPlayerSeasonInput struct {
 	ID    string  `json:"id"`
 	Notes *string `json:"notes,omitempty"`
 }
 
+// Where the player stands in their AFL match. The first four are pre-match availability; playing, played and dnp come from stats.
 type FFLAFLPlayerMatchStatus string
 
 const (
-	FFLAFLPlayerMatchStatusNamed   FFLAFLPlayerMatchStatus = "named"
-	FFLAFLPlayerMatchStatusPlaying FFLAFLPlayerMatchStatus = "playing"
-	FFLAFLPlayerMatchStatusPlayed  FFLAFLPlayerMatchStatus = "played"
-	FFLAFLPlayerMatchStatusDnp     FFLAFLPlayerMatchStatus = "dnp"
+	FFLAFLPlayerMatchStatusNamed     FFLAFLPlayerMatchStatus = "named"
+	FFLAFLPlayerMatchStatusEmergency FFLAFLPlayerMatchStatus = "emergency"
+	FFLAFLPlayerMatchStatusInjured   FFLAFLPlayerMatchStatus = "injured"
+	FFLAFLPlayerMatchStatusSuspended FFLAFLPlayerMatchStatus = "suspended"
+	FFLAFLPlayerMatchStatusPlaying   FFLAFLPlayerMatchStatus = "playing"
+	FFLAFLPlayerMatchStatusPlayed    FFLAFLPlayerMatchStatus = "played"
+	FFLAFLPlayerMatchStatusDnp       FFLAFLPlayerMatchStatus = "dnp"
 )
 
 var AllFFLAFLPlayerMatchStatus = []FFLAFLPlayerMatchStatus{
 	FFLAFLPlayerMatchStatusNamed,
+	FFLAFLPlayerMatchStatusEmergency,
+	FFLAFLPlayerMatchStatusInjured,
+	FFLAFLPlayerMatchStatusSuspended,
 	FFLAFLPlayerMatchStatusPlaying,
 	FFLAFLPlayerMatchStatusPlayed,
 	FFLAFLPlayerMatchStatusDnp,
@@ -259,7 +649,7 @@ var AllFFLAFLPlayerMatchStatus = []FFLAFLPlayerMatchStatus{
 
 func (e FFLAFLPlayerMatchStatus) IsValid() bool {
 	switch e {
-	case FFLAFLPlayerMatchStatusNamed, FFLAFLPlayerMatchStatusPlaying, FFLAFLPlayerMatchStatusPlayed, FFLAFLPlayerMatchStatusDnp:
+	case FFLAFLPlayerMatchStatusNamed, FFLAFLPlayerMatchStatusEmergency, FFLAFLPlayerMatchStatusInjured, FFLAFLPlayerMatchStatusSuspended, FFLAFLPlayerMatchStatusPlaying, FFLAFLPlayerMatchStatusPlayed, FFLAFLPlayerMatchStatusDnp:
 		return true
 	}
 	return false
@@ -300,6 +690,177 @@ func (e FFLAFLPlayerMatchStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type FFLDraftPickStatus string
+
+const (
+	FFLDraftPickStatusPending    FFLDraftPickStatus = "pending"
+	FFLDraftPickStatusPicked     FFLDraftPickStatus = "picked"
+	FFLDraftPickStatusAutoPicked FFLDraftPickStatus = "auto_picked"
+	FFLDraftPickStatusForfeited  FFLDraftPickStatus = "forfeited"
+)
+
+var AllFFLDraftPickStatus = []FFLDraftPickStatus{
+	FFLDraftPickStatusPending,
+	FFLDraftPickStatusPicked,
+	FFLDraftPickStatusAutoPicked,
+	FFLDraftPickStatusForfeited,
+}
+
+func (e FFLDraftPickStatus) IsValid() bool {
+	switch e {
+	case FFLDraftPickStatusPending, FFLDraftPickStatusPicked, FFLDraftPickStatusAutoPicked, FFLDraftPickStatusForfeited:
+		return true
+	}
+	return false
+}
+
+func (e FFLDraftPickStatus) String() string {
+	return string(e)
+}
+
+func (e *FFLDraftPickStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLDraftPickStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLDraftPickStatus", str)
+	}
+	return nil
+}
+
+func (e FFLDraftPickStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLDraftPickStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLDraftPickStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FFLDraftStatus string
+
+const (
+	FFLDraftStatusPending    FFLDraftStatus = "pending"
+	FFLDraftStatusInProgress FFLDraftStatus = "in_progress"
+	FFLDraftStatusComplete   FFLDraftStatus = "complete"
+)
+
+var AllFFLDraftStatus = []FFLDraftStatus{
+	FFLDraftStatusPending,
+	FFLDraftStatusInProgress,
+	FFLDraftStatusComplete,
+}
+
+func (e FFLDraftStatus) IsValid() bool {
+	switch e {
+	case FFLDraftStatusPending, FFLDraftStatusInProgress, FFLDraftStatusComplete:
+		return true
+	}
+	return false
+}
+
+func (e FFLDraftStatus) String() string {
+	return string(e)
+}
+
+func (e *FFLDraftStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLDraftStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLDraftStatus", str)
+	}
+	return nil
+}
+
+func (e FFLDraftStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLDraftStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLDraftStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FFLDraftStyle string
+
+const (
+	FFLDraftStyleLinear FFLDraftStyle = "linear"
+	FFLDraftStyleSnake  FFLDraftStyle = "snake"
+)
+
+var AllFFLDraftStyle = []FFLDraftStyle{
+	FFLDraftStyleLinear,
+	FFLDraftStyleSnake,
+}
+
+func (e FFLDraftStyle) IsValid() bool {
+	switch e {
+	case FFLDraftStyleLinear, FFLDraftStyleSnake:
+		return true
+	}
+	return false
+}
+
+func (e FFLDraftStyle) String() string {
+	return string(e)
+}
+
+func (e *FFLDraftStyle) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLDraftStyle(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLDraftStyle", str)
+	}
+	return nil
+}
+
+func (e FFLDraftStyle) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLDraftStyle) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLDraftStyle) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type FFLPlayerMatchStatus string
 
 const (
@@ -356,3 +917,182 @@ func (e FFLPlayerMatchStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type FFLSubmissionChangeKind string
+
+const (
+	FFLSubmissionChangeKindAdded   FFLSubmissionChangeKind = "added"
+	FFLSubmissionChangeKindRemoved FFLSubmissionChangeKind = "removed"
+	// The same parsed name, resolved to another player.
+	FFLSubmissionChangeKindPlayer   FFLSubmissionChangeKind = "player"
+	FFLSubmissionChangeKindPosition FFLSubmissionChangeKind = "position"
+	FFLSubmissionChangeKindScore    FFLSubmissionChangeKind = "score"
+)
+
+var AllFFLSubmissionChangeKind = []FFLSubmissionChangeKind{
+	FFLSubmissionChangeKindAdded,
+	FFLSubmissionChangeKindRemoved,
+	FFLSubmissionChangeKindPlayer,
+	FFLSubmissionChangeKindPosition,
+	FFLSubmissionChangeKindScore,
+}
+
+func (e FFLSubmissionChangeKind) IsValid() bool {
+	switch e {
+	case FFLSubmissionChangeKindAdded, FFLSubmissionChangeKindRemoved, FFLSubmissionChangeKindPlayer, FFLSubmissionChangeKindPosition, FFLSubmissionChangeKindScore:
+		return true
+	}
+	return false
+}
+
+func (e FFLSubmissionChangeKind) String() string {
+	return string(e)
+}
+
+func (e *FFLSubmissionChangeKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLSubmissionChangeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLSubmissionChangeKind", str)
+	}
+	return nil
+}
+
+func (e FFLSubmissionChangeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLSubmissionChangeKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLSubmissionChangeKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How a team submission is written: a forum post, or a team sheet exported from a spreadsheet.
+type FFLSubmissionFormat string
+
+const (
+	FFLSubmissionFormatForum FFLSubmissionFormat = "forum"
+	FFLSubmissionFormatCSV   FFLSubmissionFormat = "csv"
+	FFLSubmissionFormatJSON  FFLSubmissionFormat = "json"
+)
+
+var AllFFLSubmissionFormat = []FFLSubmissionFormat{
+	FFLSubmissionFormatForum,
+	FFLSubmissionFormatCSV,
+	FFLSubmissionFormatJSON,
+}
+
+func (e FFLSubmissionFormat) IsValid() bool {
+	switch e {
+	case FFLSubmissionFormatForum, FFLSubmissionFormatCSV, FFLSubmissionFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e FFLSubmissionFormat) String() string {
+	return string(e)
+}
+
+func (e *FFLSubmissionFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLSubmissionFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLSubmissionFormat", str)
+	}
+	return nil
+}
+
+func (e FFLSubmissionFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLSubmissionFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLSubmissionFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FFLWaiverClaimStatus string
+
+const (
+	FFLWaiverClaimStatusPending   FFLWaiverClaimStatus = "pending"
+	FFLWaiverClaimStatusSucceeded FFLWaiverClaimStatus = "succeeded"
+	FFLWaiverClaimStatusFailed    FFLWaiverClaimStatus = "failed"
+	FFLWaiverClaimStatusCancelled FFLWaiverClaimStatus = "cancelled"
+)
+
+var AllFFLWaiverClaimStatus = []FFLWaiverClaimStatus{
+	FFLWaiverClaimStatusPending,
+	FFLWaiverClaimStatusSucceeded,
+	FFLWaiverClaimStatusFailed,
+	FFLWaiverClaimStatusCancelled,
+}
+
+func (e FFLWaiverClaimStatus) IsValid() bool {
+	switch e {
+	case FFLWaiverClaimStatusPending, FFLWaiverClaimStatusSucceeded, FFLWaiverClaimStatusFailed, FFLWaiverClaimStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e FFLWaiverClaimStatus) String() string {
+	return string(e)
+}
+
+func (e *FFLWaiverClaimStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FFLWaiverClaimStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FFLWaiverClaimStatus", str)
+	}
+	return nil
+}
+
+func (e FFLWaiverClaimStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FFLWaiverClaimStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FFLWaiverClaimStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -8,6 +8,7 @@ package graphql
 import (
 	"context"
 	"fmt"
+	"time"
 	"xffl/services/ffl/internal/application"
 	"xffl/services/ffl/internal/domain"
 )
@@ -57,6 +58,19 @@ func (r *mutationResolver) RemoveFFLPlayerFromSeason(ctx context.Context, input
 	return true, nil
 }
 
+// SetFFLSeasonSquadLimits is the resolver for the setFFLSeasonSquadLimits field.
+func (r *mutationResolver) SetFFLSeasonSquadLimits(ctx context.Context, input SetFFLSeasonSquadLimitsInput) (*FFLSeason, error) {
+	seasonID, err := fromID(input.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	season, err := r.Commands.SetSeasonSquadLimits(ctx, seasonID, input.MinSquadSize, input.MaxSquadSize)
+	if err != nil {
+		return nil, err
+	}
+	return convertSeason(season), nil
+}
+
 // UpdateFFLPlayerSeason is the resolver for the updateFFLPlayerSeason field.
 func (r *mutationResolver) UpdateFFLPlayerSeason(ctx context.Context, input UpdateFFLPlayerSeasonInput) (*FFLPlayerSeason, error) {
 	id, err := fromID(input.ID)
@@ -100,7 +114,7 @@ func (r *mutationResolver) CalculateFFLFantasyScore(ctx context.Context, input C
 }
 
 // SetFFLTeam is the resolver for the setFFLTeam field.
-func (r *mutationResolver) SetFFLTeam(ctx context.Context, input SetFFLTeamInput) ([]*FFLPlayerMatch, error) {
+func (r *mutationResolver) SetFFLTeam(ctx context.Context, input SetFFLTeamInput) (*FFLTeamSubmission, error) {
 	clubMatchID, err := fromID(input.ClubMatchID)
 	if err != nil {
 		return nil, err
@@ -118,19 +132,11 @@ func (r *mutationResolver) SetFFLTeam(ctx context.Context, input SetFFLTeamInput
 			InterchangePosition: p.InterchangePosition,
 		}
 	}
-	pms, err := r.Commands.SetTeam(ctx, application.SetTeamParams{ClubMatchID: clubMatchID, Entries: entries})
+	ts, err := r.Commands.SetTeam(ctx, application.SetTeamParams{ClubMatchID: clubMatchID, Entries: entries})
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*FFLPlayerMatch, len(pms))
-	for i, pm := range pms {
-		player, err := r.Queries.GetPlayerForPlayerSeason(ctx, pm.PlayerSeasonID)
-		if err != nil {
-			return nil, err
-		}
-		result[i] = convertPlayerMatch(pm, player)
-	}
-	return result, nil
+	return r.convertTeamSubmission(ctx, ts)
 }
 
 // ParseFFLTeamSubmission is the resolver for the parseFFLTeamSubmission field.
@@ -143,8 +149,6 @@ func (r *mutationResolver) ParseFFLTeamSubmission(ctx context.Context, input Par
 	if err != nil {
 		return nil, err
 	}
-	_ = clubMatchID // passed back to client for use in confirmFFLTeamSubmission
-
 	playerSeasons, err := r.Queries.GetPlayerSeasons(ctx, clubSeasonID)
 	if err != nil {
 		return nil, fmt.Errorf("fetch squad: %w", err)
@@ -164,98 +168,119 @@ func (r *mutationResolver) ParseFFLTeamSubmission(ctx context.Context, input Par
 		return nil, fmt.Errorf("lookup candidates: %w", err)
 	}
 
+	var format application.SubmissionFormat
+	if input.Format != nil {
+		format = application.SubmissionFormat(*input.Format)
+	}
 	result, err := r.DataOps.ParseTeamSubmission(ctx, application.ParseTeamSubmissionParams{
 		ClubSeasonID: clubSeasonID,
-		TeamName:     input.TeamName,
+		ClubMatchID:  clubMatchID,
+		Format:       format,
+		TeamName:     derefString(input.TeamName),
 		Post:         input.Post,
 	}, playerSeasons, candidates)
 	if err != nil {
 		return nil, err
 	}
 
-	resolvedGQL := make([]*ResolvedPlayer, len(result.ResolvedPlayers))
-	for i, rp := range result.ResolvedPlayers {
-		var psID *string
-		var resolvedName, resolvedClub *string
-		if rp.PlayerSeasonID != 0 {
-			id := toID(rp.PlayerSeasonID)
-			psID = &id
-			name := rp.BestMatch.Candidate.Name
-			resolvedName = &name
-			club := rp.BestMatch.Candidate.Club
-			resolvedClub = &club
-		}
-		resolvedGQL[i] = &ResolvedPlayer{
-			ParsedName:          rp.Parsed.Name,
-			ClubHint:            rp.Parsed.ClubHint,
-			ResolvedName:        resolvedName,
-			ResolvedClub:        resolvedClub,
-			Position:            rp.Parsed.Position,
-			BackupPositions:     rp.Parsed.BackupPositions,
-			InterchangePosition: rp.Parsed.InterchangePosition,
-			Score:               rp.Parsed.Score,
-			Notes:               rp.Parsed.Notes,
-			PlayerSeasonID:      psID,
-			Confidence:          rp.BestMatch.Confidence,
-		}
+	return convertParseResult(result), nil
+}
+
+// ConfirmFFLTeamSubmission is the resolver for the confirmFFLTeamSubmission field.
+func (r *mutationResolver) ConfirmFFLTeamSubmission(ctx context.Context, input ConfirmFFLTeamSubmissionInput) (*FFLTeamSubmission, error) {
+	clubMatchID, err := fromID(input.ClubMatchID)
+	if err != nil {
+		return nil, err
 	}
 
-	needsReview := make([]int, len(result.NeedsReview))
-	copy(needsReview, result.NeedsReview)
+	submissionID, err := optionalID(input.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := confirmedPlayers(input.Players)
+	if err != nil {
+		return nil, err
+	}
 
-	return &ParseFFLTeamSubmissionResult{
-		ResolvedPlayers: resolvedGQL,
-		NeedsReview:     needsReview,
-	}, nil
+	ts, err := r.DataOps.ImportRoundTeams(ctx, application.ImportRoundTeamsParams{
+		ClubMatchID:     clubMatchID,
+		SubmissionID:    submissionID,
+		ResolvedPlayers: resolved,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.convertTeamSubmission(ctx, ts)
 }
 
-// ConfirmFFLTeamSubmission is the resolver for the confirmFFLTeamSubmission field.
-func (r *mutationResolver) ConfirmFFLTeamSubmission(ctx context.Context, input ConfirmFFLTeamSubmissionInput) ([]*FFLPlayerMatch, error) {
-	clubMatchID, err := fromID(input.ClubMatchID)
+// ParseFFLRoundThread is the resolver for the parseFFLRoundThread field.
+func (r *mutationResolver) ParseFFLRoundThread(ctx context.Context, input ParseFFLRoundThreadInput) (*ParseFFLRoundThreadResult, error) {
+	roundID, err := fromID(input.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.DataOps.ParseThread(ctx, application.ParseThreadParams{RoundID: roundID, Thread: input.Thread})
 	if err != nil {
 		return nil, err
 	}
 
-	resolved := make([]application.ResolvedPlayer, 0, len(input.Players))
-	for _, p := range input.Players {
-		psID, err := fromID(p.PlayerSeasonID)
-		if err != nil {
+	teams := make([]*FFLThreadTeam, len(result.Teams))
+	for i, t := range result.Teams {
+		parsed := convertParseResult(t.Result)
+		team := &FFLThreadTeam{
+			Author:          optionalString(t.Author),
+			Format:          t.Format,
+			Post:            t.Post,
+			SubmissionID:    parsed.SubmissionID,
+			ResolvedPlayers: parsed.ResolvedPlayers,
+			NeedsReview:     parsed.NeedsReview,
+		}
+		if t.ClubMatchID != 0 {
+			if team.ClubMatch, err = r.loadClubMatch(ctx, t.ClubMatchID); err != nil {
+				return nil, err
+			}
+		}
+		teams[i] = team
+	}
+	missing := make([]*FFLClubMatch, len(result.MissingClubMatchIDs))
+	for i, id := range result.MissingClubMatchIDs {
+		if missing[i], err = r.loadClubMatch(ctx, id); err != nil {
 			return nil, err
 		}
-		parsed := application.ParsedPlayerRow{
-			Position: p.Position,
-			Score:    p.Score,
+	}
+	return &ParseFFLRoundThreadResult{Teams: teams, MissingClubMatches: missing}, nil
+}
+
+// ConfirmFFLRoundThread is the resolver for the confirmFFLRoundThread field.
+func (r *mutationResolver) ConfirmFFLRoundThread(ctx context.Context, input ConfirmFFLRoundThreadInput) ([]*FFLTeamSubmission, error) {
+	teams := make([]application.ImportRoundTeamsParams, len(input.Teams))
+	for i, t := range input.Teams {
+		clubMatchID, err := fromID(t.ClubMatchID)
+		if err != nil {
+			return nil, err
 		}
-		if p.BackupPositions != nil {
-			parsed.BackupPositions = *p.BackupPositions
+		submissionID, err := optionalID(t.SubmissionID)
+		if err != nil {
+			return nil, err
 		}
-		if p.InterchangePosition != nil {
-			parsed.InterchangePosition = *p.InterchangePosition
+		resolved, err := confirmedPlayers(t.Players)
+		if err != nil {
+			return nil, err
 		}
-		resolved = append(resolved, application.ResolvedPlayer{
-			Parsed:         parsed,
-			PlayerSeasonID: psID,
-			Confident:      true,
-		})
+		teams[i] = application.ImportRoundTeamsParams{ClubMatchID: clubMatchID, SubmissionID: submissionID, ResolvedPlayers: resolved}
 	}
 
-	pms, err := r.DataOps.ImportRoundTeams(ctx, application.ImportRoundTeamsParams{
-		ClubMatchID:     clubMatchID,
-		ResolvedPlayers: resolved,
-	})
+	subs, err := r.DataOps.ImportThreadTeams(ctx, teams)
 	if err != nil {
 		return nil, err
 	}
-
-	result := make([]*FFLPlayerMatch, len(pms))
-	for i, pm := range pms {
-		player, err := r.Queries.GetPlayerForPlayerSeason(ctx, pm.PlayerSeasonID)
-		if err != nil {
+	out := make([]*FFLTeamSubmission, len(subs))
+	for i, ts := range subs {
+		if out[i], err = r.convertTeamSubmission(ctx, ts); err != nil {
 			return nil, err
 		}
-		result[i] = convertPlayerMatch(pm, player)
 	}
-	return result, nil
+	return out, nil
 }
 
 // MarkFFLTeamFinal is the resolver for the markFFLTeamFinal field.
@@ -335,6 +360,245 @@ func (r *mutationResolver) DeclareFFLSubstitutions(ctx context.Context, input De
 	return result, nil
 }
 
+// CreateFFLDraft is the resolver for the createFFLDraft field.
+func (r *mutationResolver) CreateFFLDraft(ctx context.Context, input CreateFFLDraftInput) (*FFLDraft, error) {
+	seasonID, err := fromID(input.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	order := make([]int, len(input.ClubSeasonIds))
+	for i, raw := range input.ClubSeasonIds {
+		id, err := fromID(raw)
+		if err != nil {
+			return nil, err
+		}
+		order[i] = id
+	}
+	var ladderSeasonID *int
+	if input.ReverseLadderSeasonID != nil {
+		id, err := fromID(*input.ReverseLadderSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		ladderSeasonID = &id
+	}
+	d, err := r.Drafts.CreateDraft(ctx, application.CreateDraftParams{
+		SeasonID:              seasonID,
+		Style:                 domain.DraftStyle(input.Style),
+		Rounds:                input.Rounds,
+		PickDuration:          time.Duration(input.PickSeconds) * time.Second,
+		Order:                 order,
+		ReverseLadderSeasonID: ladderSeasonID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertDraft(d), nil
+}
+
+// StartFFLDraft is the resolver for the startFFLDraft field.
+func (r *mutationResolver) StartFFLDraft(ctx context.Context, draftID string) (*FFLDraft, error) {
+	id, err := fromID(draftID)
+	if err != nil {
+		return nil, err
+	}
+	d, err := r.Drafts.StartDraft(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertDraft(d), nil
+}
+
+// SetFFLDraftRankings is the resolver for the setFFLDraftRankings field.
+func (r *mutationResolver) SetFFLDraftRankings(ctx context.Context, input SetFFLDraftRankingsInput) (bool, error) {
+	draftID, err := fromID(input.DraftID)
+	if err != nil {
+		return false, err
+	}
+	clubSeasonID, err := fromID(input.ClubSeasonID)
+	if err != nil {
+		return false, err
+	}
+	ranked := make([]int, len(input.AflPlayerSeasonIds))
+	for i, raw := range input.AflPlayerSeasonIds {
+		id, err := fromID(raw)
+		if err != nil {
+			return false, err
+		}
+		ranked[i] = id
+	}
+	if err := r.Drafts.SetDraftRankings(ctx, draftID, clubSeasonID, ranked); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MakeFFLDraftPick is the resolver for the makeFFLDraftPick field.
+func (r *mutationResolver) MakeFFLDraftPick(ctx context.Context, input MakeFFLDraftPickInput) (*FFLDraftPick, error) {
+	draftID, err := fromID(input.DraftID)
+	if err != nil {
+		return nil, err
+	}
+	clubSeasonID, err := fromID(input.ClubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	aflPlayerSeasonID, err := fromID(input.AflPlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	pick, err := r.Drafts.MakeDraftPick(ctx, draftID, clubSeasonID, aflPlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	return convertDraftPick(pick), nil
+}
+
+// ScheduleFFLWaivers is the resolver for the scheduleFFLWaivers field.
+func (r *mutationResolver) ScheduleFFLWaivers(ctx context.Context, roundID string, processAt string) (*FFLWaiverSchedule, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	at, err := time.Parse(time.RFC3339, processAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid processAt: %w", err)
+	}
+	s, err := r.Waivers.ScheduleWaivers(ctx, id, at)
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverSchedule(s), nil
+}
+
+// SubmitFFLWaiverClaim is the resolver for the submitFFLWaiverClaim field.
+func (r *mutationResolver) SubmitFFLWaiverClaim(ctx context.Context, input SubmitFFLWaiverClaimInput) (*FFLWaiverClaim, error) {
+	roundID, err := fromID(input.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	clubSeasonID, err := fromID(input.ClubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	aflPlayerSeasonID, err := fromID(input.AflPlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	dropPlayerSeasonID, err := fromID(input.DropPlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := r.Waivers.SubmitWaiverClaim(ctx, application.SubmitWaiverClaimParams{
+		RoundID:            roundID,
+		ClubSeasonID:       clubSeasonID,
+		AFLPlayerSeasonID:  aflPlayerSeasonID,
+		DropPlayerSeasonID: dropPlayerSeasonID,
+		Priority:           input.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverClaim(claim), nil
+}
+
+// CancelFFLWaiverClaim is the resolver for the cancelFFLWaiverClaim field.
+func (r *mutationResolver) CancelFFLWaiverClaim(ctx context.Context, id string) (*FFLWaiverClaim, error) {
+	claimID, err := fromID(id)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := r.Waivers.CancelWaiverClaim(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverClaim(claim), nil
+}
+
+// ProcessFFLWaivers is the resolver for the processFFLWaivers field.
+func (r *mutationResolver) ProcessFFLWaivers(ctx context.Context, roundID string) ([]*FFLWaiverClaim, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := r.Waivers.ProcessWaivers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverClaims(claims), nil
+}
+
+// RegisterFFLPostFormat is the resolver for the registerFFLPostFormat field.
+func (r *mutationResolver) RegisterFFLPostFormat(ctx context.Context, input FFLPostFormatInput) (*FFLPostFormat, error) {
+	format, err := postFormatFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Queries.GetClub(ctx, format.ClubID); err != nil {
+		return nil, err
+	}
+	saved, err := r.DataOps.RegisterPostFormat(ctx, format)
+	if err != nil {
+		return nil, err
+	}
+	return convertPostFormat(saved), nil
+}
+
+// TestFFLPostFormat is the resolver for the testFFLPostFormat field.
+func (r *mutationResolver) TestFFLPostFormat(ctx context.Context, format FFLPostFormatInput, post string) ([]*FFLParsedPlayerRow, error) {
+	f, err := postFormatFromInput(format)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.DataOps.TestPostFormat(ctx, f, post)
+	if err != nil {
+		return nil, err
+	}
+	return convertParsedPlayerRows(rows), nil
+}
+
+// CreateFFLPlayerAlias is the resolver for the createFFLPlayerAlias field.
+func (r *mutationResolver) CreateFFLPlayerAlias(ctx context.Context, input CreateFFLPlayerAliasInput) (*FFLPlayerAlias, error) {
+	aflPlayerID, err := optionalIDPtr(input.AflPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := r.DataOps.CreatePlayerAlias(ctx, input.Alias, aflPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerAlias(a), nil
+}
+
+// UpdateFFLPlayerAlias is the resolver for the updateFFLPlayerAlias field.
+func (r *mutationResolver) UpdateFFLPlayerAlias(ctx context.Context, input UpdateFFLPlayerAliasInput) (*FFLPlayerAlias, error) {
+	id, err := fromID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	aflPlayerID, err := optionalIDPtr(input.AflPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := r.DataOps.UpdatePlayerAlias(ctx, id, input.Alias, aflPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerAlias(a), nil
+}
+
+// DeleteFFLPlayerAlias is the resolver for the deleteFFLPlayerAlias field.
+func (r *mutationResolver) DeleteFFLPlayerAlias(ctx context.Context, id string) (bool, error) {
+	aliasID, err := fromID(id)
+	if err != nil {
+		return false, err
+	}
+	if err := r.DataOps.DeletePlayerAlias(ctx, aliasID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -8,7 +8,7 @@ package graphql
 import (
 	"context"
 	"errors"
-
+	"xffl/services/ffl/internal/application"
 	"xffl/services/ffl/internal/domain"
 )
 
@@ -60,6 +60,40 @@ func (r *fFLClubSeasonResolver) Players(ctx context.Context, obj *FFLClubSeason,
 	}, nil
 }
 
+// Club is the resolver for the club field.
+func (r *fFLDraftPickResolver) Club(ctx context.Context, obj *FFLDraftPick) (*FFLClub, error) {
+	clubSeasonID, err := fromID(obj.ClubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	club, err := LoadersFromCtx(ctx).ClubByClubSeasonID.Load(ctx, clubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	return convertClub(*club), nil
+}
+
+// PlayerSeason is the resolver for the playerSeason field.
+func (r *fFLDraftPickResolver) PlayerSeason(ctx context.Context, obj *FFLDraftPick) (*FFLPlayerSeason, error) {
+	if obj.PlayerSeasonID == nil {
+		return nil, nil
+	}
+	id, err := fromID(*obj.PlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	loaders := LoadersFromCtx(ctx)
+	ps, err := loaders.PlayerSeasonByID.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	player, err := loaders.PlayerByPlayerSeasonID.Load(ctx, ps.ID)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerSeason(*ps, *player), nil
+}
+
 // HomeClubMatch is the resolver for the homeClubMatch field.
 func (r *fFLMatchResolver) HomeClubMatch(ctx context.Context, obj *FFLMatch) (*FFLClubMatch, error) {
 	matchID, err := fromID(obj.ID)
@@ -156,6 +190,19 @@ func (r *fFLPlayerSeasonResolver) AflPlayerSeason(ctx context.Context, obj *FFLP
 	return &AFLPlayerSeason{ID: *obj.AflPlayerSeasonID}, nil
 }
 
+// Club is the resolver for the club field.
+func (r *fFLPostFormatResolver) Club(ctx context.Context, obj *FFLPostFormat) (*FFLClub, error) {
+	clubID, err := fromID(obj.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	club, err := LoadersFromCtx(ctx).ClubByID.Load(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	return convertClub(*club), nil
+}
+
 // AflRound is the resolver for the aflRound field.
 func (r *fFLRoundResolver) AflRound(ctx context.Context, obj *FFLRound) (*AFLRound, error) {
 	if obj.AflRoundID == nil {
@@ -249,6 +296,40 @@ func (r *fFLSeasonResolver) AflSeason(ctx context.Context, obj *FFLSeason) (*AFL
 	return &AFLSeason{ID: toID(season.AFLSeasonID)}, nil
 }
 
+// Club is the resolver for the club field.
+func (r *fFLWaiverClaimResolver) Club(ctx context.Context, obj *FFLWaiverClaim) (*FFLClub, error) {
+	clubSeasonID, err := fromID(obj.ClubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	club, err := LoadersFromCtx(ctx).ClubByClubSeasonID.Load(ctx, clubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	return convertClub(*club), nil
+}
+
+// DropPlayerSeason is the resolver for the dropPlayerSeason field.
+func (r *fFLWaiverClaimResolver) DropPlayerSeason(ctx context.Context, obj *FFLWaiverClaim) (*FFLPlayerSeason, error) {
+	id, err := fromID(obj.DropPlayerSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	loaders := LoadersFromCtx(ctx)
+	ps, err := loaders.PlayerSeasonByID.Load(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	player, err := loaders.PlayerByPlayerSeasonID.Load(ctx, ps.ID)
+	if err != nil {
+		return nil, err
+	}
+	return convertPlayerSeason(*ps, *player), nil
+}
+
 // FflSeasons is the resolver for the fflSeasons field.
 func (r *queryResolver) FflSeasons(ctx context.Context) ([]*FFLSeason, error) {
 	seasons, err := r.Queries.GetSeasons(ctx)
@@ -414,12 +495,219 @@ func (r *queryResolver) FflClubMatch(ctx context.Context, id string) (*FFLClubMa
 	return result, nil
 }
 
+// FflDraft is the resolver for the fflDraft field.
+func (r *queryResolver) FflDraft(ctx context.Context, seasonID string) (*FFLDraft, error) {
+	id, err := fromID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	d, err := r.Queries.GetDraftBySeason(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertDraft(d), nil
+}
+
+// FflDraftRankings is the resolver for the fflDraftRankings field.
+func (r *queryResolver) FflDraftRankings(ctx context.Context, draftID string, clubSeasonID string) ([]string, error) {
+	dID, err := fromID(draftID)
+	if err != nil {
+		return nil, err
+	}
+	csID, err := fromID(clubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	rankings, err := r.Queries.GetDraftRankings(ctx, dID, csID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, len(rankings))
+	for i, rk := range rankings {
+		out[i] = toID(rk.AFLPlayerSeasonID)
+	}
+	return out, nil
+}
+
+// FflWaiverClaims is the resolver for the fflWaiverClaims field.
+func (r *queryResolver) FflWaiverClaims(ctx context.Context, roundID string) ([]*FFLWaiverClaim, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := r.Queries.GetWaiverClaims(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverClaims(claims), nil
+}
+
+// FflWaiverSchedule is the resolver for the fflWaiverSchedule field.
+func (r *queryResolver) FflWaiverSchedule(ctx context.Context, roundID string) (*FFLWaiverSchedule, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.Queries.GetWaiverSchedule(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertWaiverSchedule(s), nil
+}
+
+// FflRoundTeamStatus is the resolver for the fflRoundTeamStatus field.
+func (r *queryResolver) FflRoundTeamStatus(ctx context.Context, roundID string) ([]*FFLRoundTeamStatus, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := r.Queries.GetRoundTeamStatus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	loaders := LoadersFromCtx(ctx)
+	result := make([]*FFLRoundTeamStatus, len(statuses))
+	for i, s := range statuses {
+		club, err := loaders.ClubByClubSeasonID.Load(ctx, s.ClubMatch.ClubSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = convertRoundTeamStatus(s, *club)
+	}
+	return result, nil
+}
+
+// FflPostFormats is the resolver for the fflPostFormats field.
+func (r *queryResolver) FflPostFormats(ctx context.Context) ([]*FFLPostFormat, error) {
+	formats, err := r.DataOps.PostFormats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*FFLPostFormat, len(formats))
+	for i, f := range formats {
+		result[i] = convertPostFormat(f)
+	}
+	return result, nil
+}
+
+// FflPlayerAliases is the resolver for the fflPlayerAliases field.
+func (r *queryResolver) FflPlayerAliases(ctx context.Context) ([]*FFLPlayerAlias, error) {
+	aliases, err := r.DataOps.PlayerAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*FFLPlayerAlias, len(aliases))
+	for i, a := range aliases {
+		result[i] = convertPlayerAlias(a)
+	}
+	return result, nil
+}
+
+// FflTeamSubmissionHistory is the resolver for the fflTeamSubmissionHistory field.
+func (r *queryResolver) FflTeamSubmissionHistory(ctx context.Context, clubMatchID string) ([]*FFLTeamSubmissionRecord, error) {
+	id, err := fromID(clubMatchID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.DataOps.SubmissionHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*FFLTeamSubmissionRecord, len(entries))
+	for i, e := range entries {
+		result[i] = convertSubmissionHistoryEntry(e)
+	}
+	return result, nil
+}
+
+// FflTeamExport is the resolver for the fflTeamExport field.
+func (r *queryResolver) FflTeamExport(ctx context.Context, clubMatchID string) (string, error) {
+	id, err := fromID(clubMatchID)
+	if err != nil {
+		return "", err
+	}
+	return r.DataOps.ExportTeam(ctx, id)
+}
+
+// FflRoundResultsExport is the resolver for the fflRoundResultsExport field.
+func (r *queryResolver) FflRoundResultsExport(ctx context.Context, roundID string) (string, error) {
+	id, err := fromID(roundID)
+	if err != nil {
+		return "", err
+	}
+	return r.DataOps.ExportRoundResults(ctx, id)
+}
+
+// FflScoreImpact is the resolver for the fflScoreImpact field.
+func (r *queryResolver) FflScoreImpact(ctx context.Context, aflRoundID string, changes []*FFLAFLStatsInput) ([]*FFLClubMatchScoreImpact, error) {
+	id, err := fromID(aflRoundID)
+	if err != nil {
+		return nil, err
+	}
+	statsChanges := make([]application.AFLStatsChange, len(changes))
+	for i, c := range changes {
+		psID, err := fromID(c.AflPlayerSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		statsChanges[i] = application.AFLStatsChange{
+			AFLPlayerSeasonID: psID,
+			Stats: domain.AFLStats{
+				Goals:     c.Goals,
+				Kicks:     c.Kicks,
+				Handballs: c.Handballs,
+				Marks:     c.Marks,
+				Tackles:   c.Tackles,
+				Hitouts:   c.Hitouts,
+			},
+		}
+	}
+	impacts, err := r.Queries.ScoreImpact(ctx, id, statsChanges)
+	if err != nil {
+		return nil, err
+	}
+	loaders := LoadersFromCtx(ctx)
+	result := make([]*FFLClubMatchScoreImpact, len(impacts))
+	for i, impact := range impacts {
+		club, err := loaders.ClubByClubSeasonID.Load(ctx, impact.ClubMatch.ClubSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &FFLClubMatchScoreImpact{
+			ClubMatch: convertClubMatch(impact.ClubMatch, *club),
+			OldScore:  impact.OldScore,
+			NewScore:  impact.NewScore,
+			Players:   make([]*FFLPlayerScoreImpact, len(impact.Players)),
+		}
+		for j, p := range impact.Players {
+			player, err := loaders.PlayerByPlayerSeasonID.Load(ctx, p.PlayerMatch.PlayerSeasonID)
+			if err != nil {
+				return nil, err
+			}
+			result[i].Players[j] = &FFLPlayerScoreImpact{
+				PlayerMatch: convertPlayerMatch(p.PlayerMatch, *player),
+				NewScore:    p.NewScore,
+			}
+		}
+	}
+	return result, nil
+}
+
 // FFLClubMatch returns FFLClubMatchResolver implementation.
 func (r *Resolver) FFLClubMatch() FFLClubMatchResolver { return &fFLClubMatchResolver{r} }
 
 // FFLClubSeason returns FFLClubSeasonResolver implementation.
 func (r *Resolver) FFLClubSeason() FFLClubSeasonResolver { return &fFLClubSeasonResolver{r} }
 
+// FFLDraftPick returns FFLDraftPickResolver implementation.
+func (r *Resolver) FFLDraftPick() FFLDraftPickResolver { return &fFLDraftPickResolver{r} }
+
 // FFLMatch returns FFLMatchResolver implementation.
 func (r *Resolver) FFLMatch() FFLMatchResolver { return &fFLMatchResolver{r} }
 
@@ -432,21 +720,30 @@ func (r *Resolver) FFLPlayerMatch() FFLPlayerMatchResolver { return &fFLPlayerMa
 // FFLPlayerSeason returns FFLPlayerSeasonResolver implementation.
 func (r *Resolver) FFLPlayerSeason() FFLPlayerSeasonResolver { return &fFLPlayerSeasonResolver{r} }
 
+// FFLPostFormat returns FFLPostFormatResolver implementation.
+func (r *Resolver) FFLPostFormat() FFLPostFormatResolver { return &fFLPostFormatResolver{r} }
+
 // FFLRound returns FFLRoundResolver implementation.
 func (r *Resolver) FFLRound() FFLRoundResolver { return &fFLRoundResolver{r} }
 
 // FFLSeason returns FFLSeasonResolver implementation.
 func (r *Resolver) FFLSeason() FFLSeasonResolver { return &fFLSeasonResolver{r} }
 
+// FFLWaiverClaim returns FFLWaiverClaimResolver implementation.
+func (r *Resolver) FFLWaiverClaim() FFLWaiverClaimResolver { return &fFLWaiverClaimResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 type fFLClubMatchResolver struct{ *Resolver }
 type fFLClubSeasonResolver struct{ *Resolver }
+type fFLDraftPickResolver struct{ *Resolver }
 type fFLMatchResolver struct{ *Resolver }
 type fFLPlayerResolver struct{ *Resolver }
 type fFLPlayerMatchResolver struct{ *Resolver }
 type fFLPlayerSeasonResolver struct{ *Resolver }
+type fFLPostFormatResolver struct{ *Resolver }
 type fFLRoundResolver struct{ *Resolver }
 type fFLSeasonResolver struct{ *Resolver }
+type fFLWaiverClaimResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
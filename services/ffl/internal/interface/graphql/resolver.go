@@ -1,10 +1,45 @@
 package graphql
 
-import "xffl/services/ffl/internal/application"
+import (
+	"context"
+
+	"xffl/services/ffl/internal/application"
+)
 
 // Resolver is the dependency injection container for GraphQL resolvers.
 type Resolver struct {
 	Queries  *application.Queries
 	Commands *application.Commands
 	DataOps  *application.DataOpsCommands
+	Drafts   *application.DraftCommands
+	Waivers  *application.WaiverCommands
+}
+
+// convertTeamSubmission loads each player match's player and converts a saved team.
+func (r *Resolver) convertTeamSubmission(ctx context.Context, ts application.TeamSubmission) (*FFLTeamSubmission, error) {
+	pms := make([]*FFLPlayerMatch, len(ts.PlayerMatches))
+	for i, pm := range ts.PlayerMatches {
+		player, err := r.Queries.GetPlayerForPlayerSeason(ctx, pm.PlayerSeasonID)
+		if err != nil {
+			return nil, err
+		}
+		pms[i] = convertPlayerMatch(pm, player)
+	}
+	return &FFLTeamSubmission{
+		PlayerMatches: pms,
+		Completeness:  convertTeamCompleteness(ts.Completeness),
+	}, nil
+}
+
+// loadClubMatch loads a club match with its club and converts it.
+func (r *Resolver) loadClubMatch(ctx context.Context, id int) (*FFLClubMatch, error) {
+	cm, err := r.Queries.GetClubMatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	club, err := r.Queries.GetClubForClubSeason(ctx, cm.ClubSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	return convertClubMatch(cm, club), nil
 }
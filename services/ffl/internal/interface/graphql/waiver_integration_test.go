@@ -0,0 +1,201 @@
+//go:build integration
+
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contractevents "xffl/contracts/events"
+	"xffl/services/ffl/internal/application"
+	pg "xffl/services/ffl/internal/infrastructure/postgres"
+	"xffl/services/ffl/internal/infrastructure/postgres/sqlcgen"
+	gql "xffl/services/ffl/internal/interface/graphql"
+	memevents "xffl/shared/events/memory"
+)
+
+func setupWaiverServer(t *testing.T, pool *pgxpool.Pool, clk *stepClock, dispatcher *memevents.Dispatcher) (*httptest.Server, *application.WaiverCommands) {
+	t.Helper()
+
+	q := sqlcgen.New(pool)
+	queries := application.NewQueries(
+		pg.NewClubRepository(q),
+		pg.NewSeasonRepository(q),
+		pg.NewRoundRepository(q),
+		pg.NewMatchRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewClubMatchRepository(q),
+		pg.NewPlayerRepository(q),
+		pg.NewPlayerMatchRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewDraftRepository(q),
+		pg.NewWaiverRepository(q),
+	)
+	waivers := application.NewWaiverCommands(
+		pg.NewDB(pool),
+		clk,
+		dispatcher,
+		&draftPlayerLookup{},
+		pg.NewRoundRepository(q),
+		pg.NewClubSeasonRepository(q),
+		pg.NewPlayerSeasonRepository(q),
+		pg.NewWaiverRepository(q),
+	)
+
+	resolver := &gql.Resolver{Queries: queries, Waivers: waivers}
+	srv := gqlhandler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: resolver}))
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := gql.InjectLoaders(r.Context(), gql.NewLoaders(queries))
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	})
+	return httptest.NewServer(h), waivers
+}
+
+func submitClaim(t *testing.T, server *httptest.Server, roundID, clubSeasonID, aflPlayerSeasonID, dropPlayerSeasonID, priority int) graphqlResponse {
+	t.Helper()
+	return execQuery(t, server, fmt.Sprintf(`mutation {
+		submitFFLWaiverClaim(input: {
+			roundId: "%d", clubSeasonId: "%d", aflPlayerSeasonId: "%d", dropPlayerSeasonId: "%d", priority: %d
+		}) { id status }
+	}`, roundID, clubSeasonID, aflPlayerSeasonID, dropPlayerSeasonID, priority))
+}
+
+func TestFflWaivers_ReverseLadderPriority(t *testing.T) {
+	pool := connectDB(t)
+	ids := seedTestData(t, pool)
+	ctx := context.Background()
+
+	// Round 2 has no matches, so it sorts after round 1.
+	var round2ID, awayPlayerID, awayPlayerSeasonID int
+	require.NoError(t, pool.QueryRow(ctx,
+		"INSERT INTO ffl.round (name, season_id, afl_round_id) VALUES ('Round 2', $1, 2) RETURNING id",
+		ids.seasonID).Scan(&round2ID))
+	require.NoError(t, pool.QueryRow(ctx,
+		"INSERT INTO ffl.player (afl_player_id) VALUES (20002) RETURNING id").Scan(&awayPlayerID))
+	require.NoError(t, pool.QueryRow(ctx,
+		"INSERT INTO ffl.player_season (player_id, club_season_id, afl_player_season_id) VALUES ($1, $2, 2) RETURNING id",
+		awayPlayerID, ids.awayClubSeaID).Scan(&awayPlayerSeasonID))
+
+	clk := &stepClock{t: time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC)}
+	dispatcher := memevents.New()
+	var processed []contractevents.FflWaiverClaimProcessedPayload
+	dispatcher.Subscribe(contractevents.FflWaiverClaimProcessed, func(_ context.Context, payload []byte) error {
+		var p contractevents.FflWaiverClaimProcessedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return err
+		}
+		processed = append(processed, p)
+		return nil
+	})
+	server, waivers := setupWaiverServer(t, pool, clk, dispatcher)
+	defer server.Close()
+
+	result := execQuery(t, server, fmt.Sprintf(`mutation {
+		scheduleFFLWaivers(roundId: "%d", processAt: "2026-04-01T09:00:00Z") { roundId processAt processedAt }
+	}`, ids.roundID))
+	require.Empty(t, result.Errors)
+	assert.Contains(t, string(result.Data), `"processAt":"2026-04-01T09:00:00Z"`)
+
+	// The season's last round has no following round for claimed players to join.
+	result = execQuery(t, server, fmt.Sprintf(`mutation {
+		scheduleFFLWaivers(roundId: "%d", processAt: "2026-04-08T09:00:00Z") { roundId }
+	}`, round2ID))
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "no following round")
+
+	// Claiming a player already in a squad is rejected up front.
+	result = submitClaim(t, server, ids.roundID, ids.awayClubSeaID, 1, awayPlayerSeasonID, 1)
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "already in a squad")
+
+	// Dropping another club's player is rejected up front.
+	result = submitClaim(t, server, ids.roundID, ids.awayClubSeaID, 700, ids.playerSeasonID, 1)
+	require.NotEmpty(t, result.Errors)
+
+	// Home (top of ladder) and away (bottom) both want 700. Home falls back to 701,
+	// then 702 with the same drop player.
+	for _, c := range []struct{ club, afl, drop, priority int }{
+		{ids.homeClubSeaID, 700, ids.playerSeasonID, 1},
+		{ids.homeClubSeaID, 701, ids.playerSeasonID, 2},
+		{ids.homeClubSeaID, 702, ids.playerSeasonID, 3},
+		{ids.awayClubSeaID, 700, awayPlayerSeasonID, 1},
+		{ids.awayClubSeaID, 703, awayPlayerSeasonID, 2},
+	} {
+		result = submitClaim(t, server, ids.roundID, c.club, c.afl, c.drop, c.priority)
+		require.Empty(t, result.Errors)
+	}
+	var cancelled struct {
+		SubmitFFLWaiverClaim struct {
+			ID string `json:"id"`
+		} `json:"submitFFLWaiverClaim"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &cancelled))
+	result = execQuery(t, server, fmt.Sprintf(`mutation { cancelFFLWaiverClaim(id: "%s") { status } }`, cancelled.SubmitFFLWaiverClaim.ID))
+	require.Empty(t, result.Errors)
+	assert.Contains(t, string(result.Data), `"status":"cancelled"`)
+
+	// Not yet due.
+	require.NoError(t, waivers.ProcessDueWaivers(ctx))
+	assert.Empty(t, processed)
+
+	clk.t = clk.t.Add(time.Hour)
+	require.NoError(t, waivers.ProcessDueWaivers(ctx))
+	require.Len(t, processed, 4, "one event per decided claim; cancelled claims are skipped")
+
+	result = execQuery(t, server, fmt.Sprintf(`{
+		fflWaiverClaims(roundId: "%d") {
+			clubSeasonId aflPlayerSeasonId priority status failureReason playerSeasonId
+		}
+	}`, ids.roundID))
+	require.Empty(t, result.Errors)
+	var got struct {
+		FflWaiverClaims []struct {
+			ClubSeasonID      string  `json:"clubSeasonId"`
+			AflPlayerSeasonID string  `json:"aflPlayerSeasonId"`
+			Status            string  `json:"status"`
+			FailureReason     *string `json:"failureReason"`
+			PlayerSeasonID    *string `json:"playerSeasonId"`
+		} `json:"fflWaiverClaims"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &got))
+	status := make(map[string]string)
+	for _, c := range got.FflWaiverClaims {
+		status[c.ClubSeasonID+"/"+c.AflPlayerSeasonID] = c.Status
+	}
+	home, away := fmt.Sprint(ids.homeClubSeaID), fmt.Sprint(ids.awayClubSeaID)
+	assert.Equal(t, map[string]string{
+		away + "/700": "succeeded",
+		away + "/703": "cancelled",
+		home + "/700": "failed",
+		home + "/701": "succeeded",
+		home + "/702": "failed",
+	}, status)
+
+	// Dropped players end at round 1; claimed players start at round 2.
+	var homeToRound *int
+	require.NoError(t, pool.QueryRow(ctx,
+		"SELECT to_round_id FROM ffl.player_season WHERE id = $1", ids.playerSeasonID).Scan(&homeToRound))
+	require.NotNil(t, homeToRound)
+	assert.Equal(t, ids.roundID, *homeToRound)
+	var fromRound *int
+	require.NoError(t, pool.QueryRow(ctx,
+		"SELECT from_round_id FROM ffl.player_season WHERE club_season_id = $1 AND afl_player_season_id = 701",
+		ids.homeClubSeaID).Scan(&fromRound))
+	require.NotNil(t, fromRound)
+	assert.Equal(t, round2ID, *fromRound)
+
+	// A processed round cannot take more claims or be processed again.
+	result = execQuery(t, server, fmt.Sprintf(`mutation { processFFLWaivers(roundId: "%d") { id } }`, ids.roundID))
+	require.NotEmpty(t, result.Errors)
+	assert.Contains(t, result.Errors[0].Message, "already been processed")
+}
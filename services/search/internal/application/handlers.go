@@ -49,6 +49,10 @@ func (h *Handlers) HandleAflPlayerMatchUpdated(ctx context.Context, payload []by
 			"behinds":          p.Behinds,
 		},
 	}
+	// Extra stats are indexed alongside the core ones, under their own names.
+	for name, v := range p.Extra {
+		doc.Data[name] = v
+	}
 
 	return h.index.Execute(ctx, doc)
 }
@@ -91,6 +91,28 @@ func TestHandleAflPlayerMatchUpdated(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "extra stats",
+			payload: mustMarshal(t, map[string]any{
+				"player_match_id": 5,
+				"kicks":           12,
+				"extra": map[string]any{
+					"contested_possessions": 9,
+					"time_on_ground_pct":    84,
+				},
+			}),
+			wantDoc: domain.SearchDocument{
+				ID:     "afl_player_match_5",
+				Source: domain.SourceAFL,
+				Type:   domain.TypePlayerMatch,
+				Data: map[string]any{
+					"player_match_id":       5,
+					"kicks":                 12,
+					"contested_possessions": 9,
+					"time_on_ground_pct":    84,
+				},
+			},
+		},
 		{
 			name:    "malformed JSON",
 			payload: []byte(`{bad`),
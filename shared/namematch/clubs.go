@@ -0,0 +1,25 @@
+package namematch
+
+// AFLClubs maps each AFL club's name, as stored in afl.club, to the codes
+// and nicknames it is written as on team sheets and forum posts, its usual
+// code first.
+var AFLClubs = map[string][]string{
+	"Adelaide Crows":                {"Adel", "Ade", "Adelaide", "Crows"},
+	"Brisbane Lions":                {"Bris", "Bri", "BL", "Brisbane", "Lions"},
+	"Carlton Blues":                 {"Carl", "Car", "Carlton", "Blues"},
+	"Collingwood Magpies":           {"Coll", "Col", "Collingwood", "Pies", "Magpies"},
+	"Essendon Bombers":              {"Ess", "Essendon", "Bombers", "Dons"},
+	"Fremantle Dockers":             {"Fre", "Freo", "Fremantle", "Dockers"},
+	"Geelong Cats":                  {"Geel", "Gee", "Gel", "Geelong", "Cats"},
+	"Gold Coast Suns":               {"GC", "GCS", "Gold Coast", "Suns"},
+	"Greater Western Sydney Giants": {"GWS", "Giants", "GWS Giants", "Greater Western Sydney"},
+	"Hawthorn Hawks":                {"Haw", "Hawthorn", "Hawks"},
+	"Melbourne Demons":              {"Melb", "Mel", "Melbourne", "Demons", "Dees"},
+	"North Melbourne Kangaroos":     {"NM", "Nth", "North", "North Melbourne", "Kangaroos", "Roos"},
+	"Port Adelaide Power":           {"PA", "Port", "Port Adelaide", "Power"},
+	"Richmond Tigers":               {"Rich", "Ric", "Richmond", "Tigers"},
+	"St Kilda Saints":               {"StK", "SK", "St Kilda", "Saints"},
+	"Sydney Swans":                  {"Syd", "Sydney", "Swans"},
+	"West Coast Eagles":             {"WC", "WCE", "West Coast", "Eagles"},
+	"Western Bulldogs":              {"WB", "Bulldogs", "Dogs", "Footscray"},
+}
@@ -0,0 +1,3 @@
+module xffl/shared/namematch
+
+go 1.25
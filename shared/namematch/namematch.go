@@ -0,0 +1,253 @@
+// Package namematch scores how well a player's name, as written by a person
+// or a scraped site, matches a player's canonical name. Both the AFL and FFL
+// name resolvers use it, so a name that resolves in one service resolves the
+// same way in the other.
+//
+// Names are compared token by token as well as whole, so initials ("M
+// Bontempelli"), abbreviated or partial double-barrel surnames ("J U-Hagan",
+// "Jamarra Hagan") and sound-alike spellings ("Kelley" for "Kelly") still
+// score highly. A club written with the name ("Geel", "WB") boosts players
+// of that club.
+package namematch
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// ClubBoost is added to the confidence of a candidate whose club matches
+	// the club hint, capped at 1.0.
+	ClubBoost = 0.1
+
+	// minBoostable is the lowest similarity a club match can boost: a club
+	// hint separates namesakes, it doesn't make an unrelated name plausible.
+	minBoostable = 0.5
+
+	// initialScore is the score of an initial against the token it abbreviates.
+	initialScore = 0.9
+	// phoneticScore is the lowest score of two tokens that sound alike.
+	phoneticScore = 0.85
+	// minTokenScore is the lowest score at which two tokens are aligned; below
+	// it they count as different words.
+	minTokenScore = 0.5
+)
+
+// Matcher scores names against candidates, boosting candidates whose club
+// matches the club hint. Its zero value has no known clubs.
+type Matcher struct {
+	clubs map[string]string // normalised club name or code → canonical club
+	codes map[string]string // canonical club → the code it is usually written as
+}
+
+// New returns a Matcher that knows clubs by the given aliases: each key is a
+// club's canonical name, each value the codes and nicknames written for it.
+// A club is also known by its canonical name. Its first alias is the code it
+// is usually written as.
+func New(clubAliases map[string][]string) *Matcher {
+	m := &Matcher{clubs: make(map[string]string), codes: make(map[string]string)}
+	for club, aliases := range clubAliases {
+		m.clubs[Normalise(club)] = club
+		for _, a := range aliases {
+			m.clubs[Normalise(a)] = club
+		}
+		if len(aliases) > 0 {
+			m.codes[club] = aliases[0]
+		}
+	}
+	return m
+}
+
+// Confidence returns a 0.0–1.0 score of how well name, written with
+// clubHint, matches a candidate named candidateName who plays for
+// candidateClub. Either club may be empty.
+func (m *Matcher) Confidence(name, clubHint, candidateName, candidateClub string) float64 {
+	conf := Similarity(name, candidateName)
+	if conf >= minBoostable && m.SameClub(clubHint, candidateClub) {
+		conf = min(1.0, conf+ClubBoost)
+	}
+	return conf
+}
+
+// SameClub reports whether a and b are names or codes of the same known club.
+func (m *Matcher) SameClub(a, b string) bool {
+	ca, ok := m.Club(a)
+	if !ok {
+		return false
+	}
+	cb, ok := m.Club(b)
+	return ok && ca == cb
+}
+
+// Club returns the canonical name of the club s names or abbreviates.
+func (m *Matcher) Club(s string) (string, bool) {
+	club, ok := m.clubs[Normalise(s)]
+	return club, ok
+}
+
+// Code returns the code the club s names is usually written as ("Geel" for
+// "Geelong Cats"), or s itself if the club is unknown or has no code.
+func (m *Matcher) Code(s string) string {
+	if club, ok := m.Club(s); ok {
+		if code, ok := m.codes[club]; ok {
+			return code
+		}
+	}
+	return s
+}
+
+// Similarity returns a 0.0–1.0 score of how alike two names are: 1.0 when
+// they are the same after normalising, lower the more they differ. It is
+// the better of a whole-name edit distance, which forgives typos, and a
+// token alignment, which forgives initials, missing tokens and phonetic
+// misspellings.
+func Similarity(a, b string) float64 {
+	ta, tb := tokens(a), tokens(b)
+	na, nb := strings.Join(ta, " "), strings.Join(tb, " ")
+	if na == nb {
+		return 1.0
+	}
+	if na == "" || nb == "" {
+		return 0
+	}
+	return max(editSimilarity(na, nb), tokenSimilarity(ta, tb))
+}
+
+// Normalise lowercases s and reduces it to space-separated words of letters
+// and digits. Apostrophes are dropped ("O'Brien" → "obrien"); hyphens and
+// other punctuation separate words ("Ugle-Hagan" → "ugle hagan").
+func Normalise(s string) string {
+	return strings.Join(tokens(s), " ")
+}
+
+func tokens(s string) []string {
+	return strings.FieldsFunc(strings.Map(func(r rune) rune {
+		switch {
+		case r == '\'' || r == '’' || r == '`':
+			return -1
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		default:
+			return ' '
+		}
+	}, s), func(r rune) bool { return r == ' ' })
+}
+
+// tokenSimilarity aligns the tokens of two names in order, pairing each
+// token with at most one in the other name, and scores the best alignment
+// against the average token count. Unpaired tokens score nothing, so
+// "Jamarra Hagan" is a partial match for "Jamarra Ugle-Hagan".
+func tokenSimilarity(a, b []string) float64 {
+	// best[i][j] is the best total score aligning a[:i] with b[:j].
+	best := make([][]float64, len(a)+1)
+	for i := range best {
+		best[i] = make([]float64, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			paired := best[i-1][j-1]
+			if s := tokenScore(a[i-1], b[j-1]); s >= minTokenScore {
+				paired += s
+			}
+			best[i][j] = max(best[i-1][j], best[i][j-1], paired)
+		}
+	}
+	return best[len(a)][len(b)] / (float64(len(a)+len(b)) / 2)
+}
+
+// tokenScore scores two tokens: 1.0 when equal, initialScore when one is the
+// other's initial, otherwise their edit similarity, raised to phoneticScore
+// when they sound alike and are spelt at least half alike ("Lee" and "Law"
+// share a Soundex code but aren't the same name misspelt).
+func tokenScore(a, b string) float64 {
+	if a == b {
+		return 1.0
+	}
+	ra, rb := []rune(a), []rune(b)
+	if (len(ra) == 1 || len(rb) == 1) && ra[0] == rb[0] {
+		return initialScore
+	}
+	score := editSimilarity(a, b)
+	if score >= minTokenScore && len(ra) > 2 && len(rb) > 2 && soundex(a) == soundex(b) {
+		score = max(score, phoneticScore)
+	}
+	return score
+}
+
+// editSimilarity is 1 minus the Levenshtein distance between a and b over
+// the length of the longer.
+func editSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1.0
+	}
+	return 1.0 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the number of single-rune insertions, deletions and
+// substitutions that turn a into b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// soundex returns the American Soundex code of a lowercase word: its first
+// letter then three digits for the consonant sounds that follow.
+func soundex(s string) string {
+	code := func(r rune) rune {
+		switch r {
+		case 'b', 'f', 'p', 'v':
+			return '1'
+		case 'c', 'g', 'j', 'k', 'q', 's', 'x', 'z':
+			return '2'
+		case 'd', 't':
+			return '3'
+		case 'l':
+			return '4'
+		case 'm', 'n':
+			return '5'
+		case 'r':
+			return '6'
+		case 'h', 'w':
+			return 'h' // doesn't separate equal codes
+		}
+		return 0 // vowels separate equal codes
+	}
+
+	rs := []rune(s)
+	out := []rune{unicode.ToUpper(rs[0])}
+	last := code(rs[0])
+	for _, r := range rs[1:] {
+		if len(out) == 4 {
+			break
+		}
+		c := code(r)
+		switch {
+		case c == 'h':
+			continue
+		case c != 0 && c != last:
+			out = append(out, c)
+		}
+		last = c
+	}
+	for len(out) < 4 {
+		out = append(out, '0')
+	}
+	return string(out)
+}
@@ -0,0 +1,221 @@
+package namematch
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalise(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Marcus Bontempelli", "marcus bontempelli"},
+		{"  Jamarra  Ugle-Hagan ", "jamarra ugle hagan"},
+		{"Jordan De Goey", "jordan de goey"},
+		{"Brodie O'Brien", "brodie obrien"},
+		{"Sam D’Ambrosio", "sam dambrosio"},
+		{"M. Bontempelli", "m bontempelli"},
+		{"St Kilda", "st kilda"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalise(tt.in); got != tt.want {
+			t.Errorf("Normalise(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarityMatches(t *testing.T) {
+	tests := []struct {
+		name, candidate string
+		min             float64
+	}{
+		{"Marcus Bontempelli", "Marcus Bontempelli", 1.0},
+		{"marcus  bontempelli", "Marcus Bontempelli", 1.0},
+		{"M Bontempelli", "Marcus Bontempelli", 0.95},
+		{"M. Bontempelli", "Marcus Bontempelli", 0.95},
+		{"Jeremy Camron", "Jeremy Cameron", 0.9},
+		{"Jamarra U-Hagan", "Jamarra Ugle-Hagan", 0.95},
+		{"J Ugle-Hagan", "Jamarra Ugle-Hagan", 0.95},
+		{"Jamarra UgleHagan", "Jamarra Ugle-Hagan", 0.9},
+		{"Jamarra Hagan", "Jamarra Ugle-Hagan", 0.8},
+		{"Sam Powell Pepper", "Sam Powell-Pepper", 1.0},
+		{"Brodie OBrien", "Brodie O'Brien", 1.0},
+		{"Tom Degoey", "Jordan De Goey", 0.5},
+		{"Josh Kelley", "Josh Kelly", 0.9},
+		{"Nic Daicos", "Nick Daicos", 0.9},
+		{"Jack Steven", "Jack Stephen", 0.9},
+	}
+	for _, tt := range tests {
+		if got := Similarity(tt.name, tt.candidate); got < tt.min {
+			t.Errorf("Similarity(%q, %q) = %.3f, want >= %.2f", tt.name, tt.candidate, got, tt.min)
+		}
+	}
+}
+
+func TestSimilarityDistinguishes(t *testing.T) {
+	tests := []struct {
+		name, candidate string
+		max             float64
+	}{
+		{"Jeremy Cameron", "Charlie Cameron", 0.7},
+		{"Josh Kelly", "Josh Daicos", 0.6},
+		{"M Bontempelli", "Nick Holman", 0.3},
+		{"Jamarra U-Hagan", "Nick Holman", 0.3},
+		{"Lee", "Law", 0.5},
+		{"", "Nick Holman", 0},
+		{"Nick Holman", "", 0},
+	}
+	for _, tt := range tests {
+		if got := Similarity(tt.name, tt.candidate); got > tt.max {
+			t.Errorf("Similarity(%q, %q) = %.3f, want <= %.2f", tt.name, tt.candidate, got, tt.max)
+		}
+	}
+}
+
+func TestSimilarityRanksTheRightPlayerFirst(t *testing.T) {
+	tests := []struct {
+		name  string
+		right string
+		wrong []string
+	}{
+		{"M Bontempelli", "Marcus Bontempelli", []string{"Marcus Windhager", "Jack Bontempelli"}},
+		{"J Cameron", "Jeremy Cameron", []string{"Charlie Cameron", "Jeremy Howe"}},
+		{"C Cameron", "Charlie Cameron", []string{"Jeremy Cameron"}},
+		{"Jamarra U-Hagan", "Jamarra Ugle-Hagan", []string{"Nick Holman", "Wil Powell"}},
+		{"Jy Simpkin", "Jy Simpkin", []string{"Jye Simpkin"}},
+	}
+	for _, tt := range tests {
+		right := Similarity(tt.name, tt.right)
+		for _, w := range tt.wrong {
+			if wrong := Similarity(tt.name, w); wrong >= right {
+				t.Errorf("%q: %q scores %.3f, not below %q at %.3f", tt.name, w, wrong, tt.right, right)
+			}
+		}
+	}
+}
+
+func TestSimilarityIsSymmetric(t *testing.T) {
+	pairs := [][2]string{
+		{"M Bontempelli", "Marcus Bontempelli"},
+		{"Jamarra Hagan", "Jamarra Ugle-Hagan"},
+		{"Josh Kelley", "Josh Kelly"},
+		{"Jeremy Camron", "Jeremy Cameron"},
+	}
+	for _, p := range pairs {
+		if a, b := Similarity(p[0], p[1]), Similarity(p[1], p[0]); a != b {
+			t.Errorf("Similarity(%q, %q) = %.3f but reversed = %.3f", p[0], p[1], a, b)
+		}
+	}
+}
+
+func TestMatcherClub(t *testing.T) {
+	m := New(AFLClubs)
+	tests := []struct {
+		in, want string
+	}{
+		{"Geel", "Geelong Cats"},
+		{"geel", "Geelong Cats"},
+		{"Geelong Cats", "Geelong Cats"},
+		{"WB", "Western Bulldogs"},
+		{"StK", "St Kilda Saints"},
+		{"SK", "St Kilda Saints"},
+		{"GC", "Gold Coast Suns"},
+		{"GWS", "Greater Western Sydney Giants"},
+		{"Freo", "Fremantle Dockers"},
+	}
+	for _, tt := range tests {
+		got, ok := m.Club(tt.in)
+		if !ok || got != tt.want {
+			t.Errorf("Club(%q) = %q, %v; want %q", tt.in, got, ok, tt.want)
+		}
+	}
+	if got, ok := m.Club("INT"); ok {
+		t.Errorf("Club(%q) = %q, want unknown", "INT", got)
+	}
+}
+
+func TestMatcherCode(t *testing.T) {
+	m := New(AFLClubs)
+	tests := []struct {
+		in, want string
+	}{
+		{"Geelong Cats", "Geel"},
+		{"geelong", "Geel"},
+		{"Western Bulldogs", "WB"},
+		{"St Kilda Saints", "StK"},
+		{"Test Tigers", "Test Tigers"},
+	}
+	for _, tt := range tests {
+		if got := m.Code(tt.in); got != tt.want {
+			t.Errorf("Code(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatcherSameClub(t *testing.T) {
+	m := New(AFLClubs)
+	if !m.SameClub("Geel", "Geelong Cats") {
+		t.Error("Geel should be Geelong Cats")
+	}
+	if m.SameClub("WB", "Geelong Cats") {
+		t.Error("WB should not be Geelong Cats")
+	}
+	if m.SameClub("", "") {
+		t.Error("two unknown clubs should not be the same club")
+	}
+	if m.SameClub("Geel", "") {
+		t.Error("a hint should not match a candidate without a club")
+	}
+}
+
+func TestMatcherConfidenceBoostsClub(t *testing.T) {
+	m := New(AFLClubs)
+
+	plain := m.Confidence("Cameron", "", "Jeremy Cameron", "Geelong Cats")
+	boosted := m.Confidence("Cameron", "Geel", "Jeremy Cameron", "Geelong Cats")
+	if want := plain + ClubBoost; math.Abs(boosted-want) > 1e-9 {
+		t.Errorf("boosted confidence = %.3f, want %.3f", boosted, want)
+	}
+
+	// The club separates namesakes.
+	rightClub := m.Confidence("Cameron", "Geel", "Jeremy Cameron", "Geelong Cats")
+	wrongClub := m.Confidence("Cameron", "Geel", "Charlie Cameron", "Brisbane Lions")
+	if rightClub <= wrongClub {
+		t.Errorf("Geelong's Cameron = %.3f, want above Brisbane's %.3f", rightClub, wrongClub)
+	}
+
+	if got := m.Confidence("Marcus Bontempelli", "WB", "Marcus Bontempelli", "Western Bulldogs"); got != 1.0 {
+		t.Errorf("boost should cap at 1.0, got %.3f", got)
+	}
+	if got := m.Confidence("Nick Holman", "Geel", "Jeremy Cameron", "Geelong Cats"); got != Similarity("Nick Holman", "Jeremy Cameron") {
+		t.Errorf("an unrelated name should not be boosted, got %.3f", got)
+	}
+}
+
+func TestZeroMatcherKnowsNoClubs(t *testing.T) {
+	var m Matcher
+	if got, want := m.Confidence("J Cameron", "Geel", "Jeremy Cameron", "Geelong Cats"), Similarity("J Cameron", "Jeremy Cameron"); got != want {
+		t.Errorf("Confidence = %.3f, want unboosted %.3f", got, want)
+	}
+}
+
+func TestSoundex(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"robert", "R163"},
+		{"rupert", "R163"},
+		{"ashcraft", "A261"},
+		{"tymczak", "T522"},
+		{"pfister", "P236"},
+		{"kelly", "K400"},
+		{"kelley", "K400"},
+		{"lee", "L000"},
+	}
+	for _, tt := range tests {
+		if got := soundex(tt.in); got != tt.want {
+			t.Errorf("soundex(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}